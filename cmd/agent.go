@@ -29,7 +29,8 @@ These commands provide:
 Examples:
   linctl agent validate     # Validate environment for agent workflows
   linctl agent status       # Get comprehensive agent status
-  linctl agent config       # Show agent configuration`,
+  linctl agent config       # Show agent configuration
+  linctl agent schema       # Print the JSON Schema for agent responses`,
 }
 
 var agentValidateCmd = &cobra.Command{
@@ -41,6 +42,7 @@ This command checks:
 - OAuth configuration (LINEAR_CLIENT_ID, LINEAR_CLIENT_SECRET)
 - Authentication status
 - Actor configuration (LINEAR_DEFAULT_ACTOR, LINEAR_DEFAULT_AVATAR_URL)
+- Default team (LINEAR_DEFAULT_TEAM)
 - Network connectivity
 
 Exit codes:
@@ -127,6 +129,7 @@ Shows:
 				fmt.Printf("Actor Configured: %v\n", oauthConfig["actor_configured"])
 				fmt.Printf("Default Actor: %s\n", agentConfig.DefaultActor)
 				fmt.Printf("Default Avatar URL: %s\n", agentConfig.DefaultAvatarURL)
+				fmt.Printf("Default Team: %s\n", agentConfig.DefaultTeam)
 			} else {
 				fmt.Println(color.New(color.FgCyan, color.Bold).Sprint("🤖 Agent Configuration"))
 				fmt.Println()
@@ -158,6 +161,19 @@ Shows:
 						color.New(color.FgBlue).Sprint("💡"))
 				}
 
+				// Default team
+				if agentConfig.DefaultTeam != "" {
+					fmt.Printf("%s Default Team: %s\n",
+						color.New(color.FgGreen).Sprint("✅"),
+						color.New(color.FgCyan).Sprint(agentConfig.DefaultTeam))
+				} else {
+					fmt.Printf("%s Default Team: %s\n",
+						color.New(color.FgYellow).Sprint("⚠️"),
+						color.New(color.FgYellow).Sprint("Not Configured"))
+					fmt.Printf("  %s Set LINEAR_DEFAULT_TEAM to omit --team on issue create and list\n",
+						color.New(color.FgBlue).Sprint("💡"))
+				}
+
 				// Environment variables
 				fmt.Println()
 				fmt.Println(color.New(color.FgCyan).Sprint("Environment Variables:"))
@@ -225,6 +241,7 @@ Useful for verifying agent setup before running automated workflows.`,
 
 		// Create final response
 		response := &agent.AgentResponse{
+			Version:   agent.ResponseVersion,
 			Success:   allPassed,
 			Data:      testResults,
 			Timestamp: time.Now().UTC().Format(time.RFC3339),
@@ -251,10 +268,26 @@ Useful for verifying agent setup before running automated workflows.`,
 	},
 }
 
+var agentSchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON Schema for agent responses",
+	Long: `Print the JSON Schema (draft 2020-12) describing the responses of agent
+commands, so downstream parsers can validate them. Every response carries a
+"version" field matching the schema's version.
+
+Examples:
+  linctl agent schema > agent-response.schema.json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Print(string(agent.ResponseSchema()))
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(agentCmd)
 	agentCmd.AddCommand(agentValidateCmd)
 	agentCmd.AddCommand(agentStatusCmd)
 	agentCmd.AddCommand(agentConfigCmd)
 	agentCmd.AddCommand(agentTestCmd)
+	agentCmd.AddCommand(agentSchemaCmd)
 }
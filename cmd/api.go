@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// apiCmd represents the api command
+var apiCmd = &cobra.Command{
+	Use:   "api",
+	Short: "Make raw Linear API requests",
+	Long: `Make raw requests against the Linear GraphQL API.
+
+Useful for queries the CLI does not support yet. Requests go through the
+same retry and rate limiting logic as the rest of linctl.
+
+Examples:
+  linctl api query --query '{ viewer { id name } }'
+  linctl api query --query @issue.graphql --var id=LIN-123
+  linctl api query --query @issues.graphql --var first:=10`,
+}
+
+var apiQueryCmd = &cobra.Command{
+	Use:   "query",
+	Short: "Execute a raw GraphQL query",
+	Long: `Execute a raw GraphQL query or mutation and print the response data as JSON.
+
+The query can be given inline or read from a file with an @ prefix.
+Variables are passed with --var key=value (string values) or
+--var key:=json (raw JSON values such as numbers, booleans or objects).
+
+GraphQL errors are printed to stderr and the command exits non-zero. Any
+partial data returned with them is still printed to stdout.
+
+When LINCTL_QUERY_ALLOWLIST names a file of operation names (one per line),
+only documents whose operations are all named and listed are sent.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		queryFlag, _ := cmd.Flags().GetString("query")
+		query, err := readGraphQLDocument(queryFlag)
+		if err != nil {
+			output.Error(err.Error(), plaintext, false)
+			os.Exit(exitCode(err))
+		}
+
+		// Enforce LINCTL_QUERY_ALLOWLIST before any network access
+		allowlist, err := api.LoadQueryAllowlistFromEnv()
+		if err != nil {
+			output.Error(err.Error(), plaintext, false)
+			os.Exit(exitCode(err))
+		}
+		if allowlist != nil {
+			if err := allowlist.Check(query); err != nil {
+				output.Error(err.Error(), plaintext, false)
+				os.Exit(exitCode(err))
+			}
+		}
+
+		varPairs, _ := cmd.Flags().GetStringArray("var")
+		variables, err := parseQueryVariables(varPairs)
+		if err != nil {
+			output.Error(err.Error(), plaintext, false)
+			os.Exit(exitCode(err))
+		}
+
+		// Get auth header
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, false)
+			os.Exit(exitAuth)
+		}
+
+		config := api.DefaultEnhancedClientConfig()
+		// Keep structured logs off stderr unless requested, so it only carries GraphQL errors
+		if os.Getenv("LINCTL_LOG_LEVEL") == "" {
+			config.Logger = logging.NewNoOpLogger()
+		}
+		client := api.NewEnhancedClient(authHeader, config)
+
+		var data json.RawMessage
+		if err := client.Execute(commandContext(), query, variables, &data); err != nil {
+			// Linear can resolve some fields and fail others; keep what it returned
+			var apiErr *api.APIError
+			if errors.As(err, &apiErr) && len(apiErr.Data) > 0 {
+				if writeErr := writeRawJSON(apiErr.Data); writeErr != nil {
+					output.Error(writeErr.Error(), plaintext, false)
+				}
+			}
+
+			var gqlErrs api.GraphQLErrors
+			if errors.As(err, &gqlErrs) {
+				printGraphQLErrors(gqlErrs, plaintext, jsonOut)
+			} else {
+				output.Error(fmt.Sprintf("Request failed: %v", err), plaintext, false)
+			}
+			os.Exit(exitCode(err))
+		}
+
+		if err := writeRawJSON(data); err != nil {
+			output.Error(err.Error(), plaintext, false)
+			os.Exit(exitCode(err))
+		}
+	},
+}
+
+// readGraphQLDocument returns the query text, reading it from a file when prefixed with @
+func readGraphQLDocument(value string) (string, error) {
+	if strings.HasPrefix(value, "@") {
+		path := strings.TrimPrefix(value, "@")
+		if path == "" {
+			return "", fmt.Errorf("--query @ requires a file path")
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read query file: %w", err)
+		}
+		value = string(data)
+	}
+
+	if strings.TrimSpace(value) == "" {
+		return "", fmt.Errorf("query is empty")
+	}
+
+	return value, nil
+}
+
+// parseQueryVariables converts key=value and key:=json pairs into a variables map
+func parseQueryVariables(pairs []string) (map[string]interface{}, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	variables := make(map[string]interface{}, len(pairs))
+	for _, pair := range pairs {
+		idx := strings.Index(pair, "=")
+		if idx <= 0 {
+			return nil, fmt.Errorf("invalid variable %q, expected key=value", pair)
+		}
+
+		key, value := pair[:idx], pair[idx+1:]
+		if strings.HasSuffix(key, ":") {
+			key = strings.TrimSuffix(key, ":")
+			if key == "" {
+				return nil, fmt.Errorf("invalid variable %q, expected key:=json", pair)
+			}
+
+			var raw interface{}
+			if err := json.Unmarshal([]byte(value), &raw); err != nil {
+				return nil, fmt.Errorf("invalid JSON value for variable %q: %w", key, err)
+			}
+			variables[key] = raw
+			continue
+		}
+
+		variables[key] = value
+	}
+
+	return variables, nil
+}
+
+// printGraphQLErrors writes GraphQL errors to stderr
+func printGraphQLErrors(errs api.GraphQLErrors, plaintext, jsonOut bool) {
+	if jsonOut {
+		data, err := json.MarshalIndent(map[string]interface{}{"errors": []api.GraphQLError(errs)}, "", "  ")
+		if err == nil {
+			fmt.Fprintln(os.Stderr, string(data))
+			return
+		}
+	}
+
+	for _, gqlErr := range errs {
+		message := gqlErr.Message
+		if len(gqlErr.Path) > 0 {
+			path := make([]string, len(gqlErr.Path))
+			for i, p := range gqlErr.Path {
+				path[i] = fmt.Sprint(p)
+			}
+			message = fmt.Sprintf("%s (path: %s)", message, strings.Join(path, "."))
+		}
+		output.Error(message, plaintext, false)
+	}
+}
+
+// writeRawJSON prints the response data as indented JSON to stdout
+func writeRawJSON(data json.RawMessage) error {
+	if len(data) == 0 {
+		data = json.RawMessage("null")
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return fmt.Errorf("failed to format response: %w", err)
+	}
+	buf.WriteByte('\n')
+
+	_, err := os.Stdout.Write(buf.Bytes())
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(apiCmd)
+	apiCmd.AddCommand(apiQueryCmd)
+
+	apiQueryCmd.Flags().StringP("query", "q", "", "GraphQL document, or @file to read it from a file (required)")
+	apiQueryCmd.Flags().StringArray("var", nil, "Query variable as key=value, or key:=json for raw JSON values (repeatable)")
+	_ = apiQueryCmd.MarkFlagRequired("query")
+}
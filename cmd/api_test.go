@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+func TestReadGraphQLDocument(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "viewer.graphql")
+	if err := os.WriteFile(path, []byte("query { viewer { id } }\n"), 0600); err != nil {
+		t.Fatalf("Failed to write query file: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		value     string
+		expected  string
+		expectErr bool
+	}{
+		{"inline", "{ viewer { id } }", "{ viewer { id } }", false},
+		{"from file", "@" + path, "query { viewer { id } }\n", false},
+		{"missing file", "@" + filepath.Join(dir, "missing.graphql"), "", true},
+		{"empty path", "@", "", true},
+		{"empty query", "   ", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readGraphQLDocument(tt.value)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Expected error, got query %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseQueryVariables(t *testing.T) {
+	vars, err := parseQueryVariables([]string{"id=LIN-123", "filter=a=b", "first:=10", "archived:=true"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if vars["id"] != "LIN-123" {
+		t.Errorf("Expected id LIN-123, got %v", vars["id"])
+	}
+	if vars["filter"] != "a=b" {
+		t.Errorf("Expected value to keep later '=', got %v", vars["filter"])
+	}
+	if vars["first"] != float64(10) {
+		t.Errorf("Expected first to be numeric 10, got %v (%T)", vars["first"], vars["first"])
+	}
+	if vars["archived"] != true {
+		t.Errorf("Expected archived to be true, got %v", vars["archived"])
+	}
+
+	if vars, err := parseQueryVariables(nil); err != nil || vars != nil {
+		t.Errorf("Expected nil variables for no pairs, got %v, %v", vars, err)
+	}
+
+	for _, bad := range []string{"noequals", "=value", ":=1", "n:=notjson"} {
+		if _, err := parseQueryVariables([]string{bad}); err == nil {
+			t.Errorf("Expected error for %q", bad)
+		}
+	}
+}
+
+func TestAPIQueryCommand_PartialData(t *testing.T) {
+	dir := t.TempDir()
+	response := `{"data": {"viewer": {"id": "user-1"}, "issue": null}, "errors": [{"message": "Entity not found", "path": ["issue"]}]}`
+	if err := os.WriteFile(filepath.Join(dir, "Partial.json"), []byte(response), 0600); err != nil {
+		t.Fatal(err)
+	}
+	env := []string{api.MockDirEnvVar + "=" + dir}
+
+	stdout, stderr, code := runLinctl(t, env, "--mock", "api", "query", "--query", `query Partial { viewer { id } issue(id: "ENG-404") { id } }`)
+	if code == 0 {
+		t.Error("Expected a non-zero exit when the response has errors")
+	}
+	if !strings.Contains(stdout, `"id": "user-1"`) {
+		t.Errorf("Expected the partial data on stdout, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "Entity not found (path: issue)") || strings.Contains(stdout, "Entity not found") {
+		t.Errorf("Expected the errors on stderr only, got stderr %q", stderr)
+	}
+
+	// Without data only the errors are printed
+	response = `{"data": null, "errors": [{"message": "Syntax Error"}]}`
+	if err := os.WriteFile(filepath.Join(dir, "Partial.json"), []byte(response), 0600); err != nil {
+		t.Fatal(err)
+	}
+	stdout, stderr, code = runLinctl(t, env, "--mock", "api", "query", "--query", `query Partial { viewer { id } }`)
+	if code == 0 || stdout != "" || !strings.Contains(stderr, "Syntax Error") {
+		t.Errorf("Expected only errors and a non-zero exit, exited %d with stdout %q and stderr %q", code, stdout, stderr)
+	}
+}
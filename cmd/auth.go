@@ -13,7 +13,11 @@ import (
 	"github.com/spf13/viper"
 )
 
-var oauthFlag bool
+var (
+	oauthFlag  bool
+	deviceFlag bool
+	apiKeyFlag string
+)
 
 // authCmd represents the auth command
 var authCmd = &cobra.Command{
@@ -35,7 +39,12 @@ Examples:
 var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Login to Linear",
-	Long:  `Authenticate with Linear using Personal API Key or OAuth.`,
+	Long: `Authenticate with Linear using Personal API Key or OAuth.
+
+Use --device on headless machines to approve the login from another device's browser.
+
+For CI, pass the Personal API Key with --api-key or set LINEAR_API_KEY to log in
+without the interactive prompt. LINEAR_API_KEY keeps the key out of your shell history.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -45,19 +54,40 @@ var loginCmd = &cobra.Command{
 			fmt.Println()
 		}
 
+		apiKey := apiKeyFlag
+		if !cmd.Flags().Changed("api-key") {
+			apiKey = os.Getenv("LINEAR_API_KEY")
+		}
+		if cmd.Flags().Changed("api-key") && (oauthFlag || deviceFlag) {
+			output.Error("--api-key cannot be used with --oauth or --device", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
 		var err error
-		if oauthFlag {
+		var user *auth.User
+		if deviceFlag {
+			user, err = auth.LoginWithDevice(plaintext, jsonOut)
+		} else if oauthFlag {
 			err = auth.LoginWithOAuth(plaintext, jsonOut)
+		} else if apiKey != "" || cmd.Flags().Changed("api-key") {
+			user, err = auth.LoginWithAPIKey(apiKey, plaintext, jsonOut)
 		} else {
 			err = auth.Login(plaintext, jsonOut)
 		}
+		recordAudit("auth.login", "", "", err)
 
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
-		if !plaintext && !jsonOut {
+		if jsonOut && user != nil {
+			output.JSON(map[string]interface{}{
+				"status":  "success",
+				"message": "Successfully authenticated with Linear",
+				"user":    user,
+			})
+		} else if !plaintext && !jsonOut {
 			fmt.Println(color.New(color.FgGreen).Sprint("✅ Successfully authenticated with Linear!"))
 		} else if jsonOut {
 			output.JSON(map[string]interface{}{
@@ -73,12 +103,30 @@ var loginCmd = &cobra.Command{
 var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Check authentication status",
-	Long:  `Check if you are currently authenticated with Linear and get helpful guidance.`,
+	Long: `Check if you are currently authenticated with Linear and get helpful guidance.
+
+With --check-expiry, exit with code 2 when the OAuth token expires within
+--warn-threshold (default 10m), so cron jobs can refresh it ahead of time.
+The check is reported as not applicable for API key authentication.
+
+With --refresh, the OAuth token is re-validated against the API (and
+refreshed if it is rejected) before the user is fetched live.
+
+Examples:
+  linctl auth status --refresh                            # Re-validate the token
+  linctl auth status --check-expiry                       # Warn 10 minutes ahead
+  linctl auth status --check-expiry --warn-threshold 1h --json || linctl auth refresh`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		verbose, _ := cmd.Flags().GetBool("verbose")
+		refresh, _ := cmd.Flags().GetBool("refresh")
 
-		status, err := auth.GetAuthStatus()
+		getStatus := auth.GetAuthStatus
+		if refresh {
+			getStatus = auth.RefreshAuthStatus
+		}
+		status, err := getStatus()
 		if err != nil {
 			if jsonOut {
 				output.JSON(map[string]interface{}{
@@ -91,8 +139,25 @@ var statusCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		var expiry *tokenExpiryCheck
+		if checkExpiry, _ := cmd.Flags().GetBool("check-expiry"); checkExpiry {
+			threshold, _ := cmd.Flags().GetDuration("warn-threshold")
+			check := checkTokenExpiry(status.Method, auth.GetOAuthTokenInfo, threshold, time.Now())
+			expiry = &check
+		}
+
 		if jsonOut {
-			output.JSON(status)
+			if expiry == nil {
+				output.JSON(status)
+				return
+			}
+			output.JSON(struct {
+				*auth.AuthStatus
+				*tokenExpiryCheck
+			}{status, expiry})
+			if expiry.NeedsRefresh {
+				os.Exit(2)
+			}
 			return
 		}
 
@@ -109,7 +174,7 @@ var statusCmd = &cobra.Command{
 					fmt.Printf("%s %s\n", color.New(color.FgBlue).Sprint("💡"), suggestion)
 				}
 			}
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		// Authenticated - show status
@@ -121,6 +186,14 @@ var statusCmd = &cobra.Command{
 			if len(status.Scopes) > 0 {
 				fmt.Printf("Scopes: %s\n", strings.Join(status.Scopes, ", "))
 			}
+			if status.DefaultTeam != nil {
+				fmt.Printf("Default team: %s (LINEAR_DEFAULT_TEAM)\n", status.DefaultTeam.Key)
+			}
+			if verbose {
+				for _, team := range status.User.Teams {
+					fmt.Printf("Team: %s\t%s\n", team.Key, team.Name)
+				}
+			}
 			for _, suggestion := range status.Suggestions {
 				fmt.Printf("Suggestion: %s\n", suggestion)
 			}
@@ -150,6 +223,17 @@ var statusCmd = &cobra.Command{
 				fmt.Printf("📋 Scopes: %s\n", color.New(color.FgCyan).Sprint(strings.Join(status.Scopes, ", ")))
 			}
 
+			// Default team, plus every membership when verbose
+			if status.DefaultTeam != nil {
+				fmt.Printf("👥 Default team: %s (LINEAR_DEFAULT_TEAM)\n", color.New(color.FgCyan).Sprint(status.DefaultTeam.Key))
+			}
+			if verbose && len(status.User.Teams) > 0 {
+				fmt.Println("👥 Teams:")
+				for _, team := range status.User.Teams {
+					fmt.Printf("   %s %s\n", color.New(color.FgCyan, color.Bold).Sprint(team.Key), team.Name)
+				}
+			}
+
 			// Show suggestions if any
 			if len(status.Suggestions) > 0 {
 				fmt.Println()
@@ -158,9 +242,85 @@ var statusCmd = &cobra.Command{
 				}
 			}
 		}
+
+		if expiry != nil {
+			if plaintext {
+				fmt.Printf("Expiry check: %s\n", expiry.Message)
+			} else if expiry.NeedsRefresh {
+				fmt.Printf("\n%s %s\n", color.New(color.FgYellow).Sprint("⚠️"), expiry.Message)
+			} else {
+				fmt.Printf("\n%s %s\n", color.New(color.FgGreen).Sprint("✓"), expiry.Message)
+			}
+			if expiry.NeedsRefresh {
+				os.Exit(2)
+			}
+		}
 	},
 }
 
+// Results of auth status --check-expiry
+const (
+	expiryCheckOK            = "ok"
+	expiryCheckNeedsRefresh  = "needs_refresh"
+	expiryCheckNotApplicable = "not_applicable"
+)
+
+// tokenExpiryCheck reports whether the OAuth token expires within the warning threshold
+type tokenExpiryCheck struct {
+	ExpiryCheck      string `json:"expiry_check"`
+	ExpiresInSeconds *int64 `json:"expires_in_seconds,omitempty"`
+	NeedsRefresh     bool   `json:"needs_refresh"`
+	Message          string `json:"expiry_message"`
+}
+
+// checkTokenExpiry inspects the stored OAuth token's expires_at. A token that
+// expires within threshold, has expired, or has no readable expiry needs a
+// refresh. The check does not apply to API keys, which do not expire.
+func checkTokenExpiry(method string, tokenInfo func() (map[string]interface{}, error), threshold time.Duration, now time.Time) tokenExpiryCheck {
+	if method != "oauth" {
+		message := "not applicable: API keys do not expire"
+		if method != "api_key" {
+			message = "not applicable: not authenticated with OAuth"
+		}
+		return tokenExpiryCheck{ExpiryCheck: expiryCheckNotApplicable, Message: message}
+	}
+
+	needsRefresh := tokenExpiryCheck{ExpiryCheck: expiryCheckNeedsRefresh, NeedsRefresh: true}
+
+	info, err := tokenInfo()
+	if err != nil {
+		needsRefresh.Message = fmt.Sprintf("could not read the OAuth token: %v", err)
+		return needsRefresh
+	}
+	expiresAtValue, _ := info["expires_at"].(string)
+	expiresAt, err := time.Parse(time.RFC3339, expiresAtValue)
+	if err != nil {
+		needsRefresh.Message = "the OAuth token has no readable expiry; refresh with: linctl auth refresh"
+		return needsRefresh
+	}
+
+	remaining := expiresAt.Sub(now)
+	seconds := int64(remaining / time.Second)
+	if seconds < 0 {
+		seconds = 0
+	}
+
+	check := tokenExpiryCheck{ExpiryCheck: expiryCheckOK, ExpiresInSeconds: &seconds}
+	switch {
+	case remaining <= 0:
+		check.Message = fmt.Sprintf("OAuth token expired %s ago; refresh with: linctl auth refresh", (-remaining).Round(time.Second))
+	case remaining <= threshold:
+		check.Message = fmt.Sprintf("OAuth token expires in %s, within the %s warning threshold; refresh with: linctl auth refresh", remaining.Round(time.Second), threshold)
+	default:
+		check.Message = fmt.Sprintf("OAuth token expires in %s", remaining.Round(time.Second))
+		return check
+	}
+
+	check.ExpiryCheck = expiryCheckNeedsRefresh
+	check.NeedsRefresh = true
+	return check
+}
+
 var logoutCmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Logout from Linear",
@@ -170,9 +330,10 @@ var logoutCmd = &cobra.Command{
 		jsonOut := viper.GetBool("json")
 
 		err := auth.Logout()
+		recordAudit("auth.logout", "", "", err)
 		if err != nil {
 			output.Error(fmt.Sprintf("Logout failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -201,6 +362,7 @@ var refreshCmd = &cobra.Command{
 		}
 
 		err := auth.RefreshOAuthTokenWithFeedback()
+		recordAudit("auth.refresh", "", "", err)
 		if err != nil {
 			if jsonOut {
 				output.JSON(map[string]interface{}{
@@ -212,7 +374,7 @@ var refreshCmd = &cobra.Command{
 			} else {
 				fmt.Printf("%s %v\n", color.New(color.FgRed).Sprint("❌"), err)
 			}
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		if jsonOut {
@@ -228,10 +390,136 @@ var refreshCmd = &cobra.Command{
 	},
 }
 
+var clearTokenCmd = &cobra.Command{
+	Use:   "clear-token",
+	Short: "Clear the stored OAuth token but keep the API key",
+	Long: `Remove the stored OAuth token without logging out. The API key saved by
+'linctl auth login' is kept, so the next command falls back to it or obtains a
+fresh OAuth token (client credentials). Use this when a token may be compromised.
+
+Examples:
+  linctl auth clear-token          # Clear after confirmation
+  linctl auth clear-token --yes    # Clear without prompting
+  linctl auth clear-token --json   # Report what was cleared`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		// JSON mode is non-interactive, so it never prompts
+		if yes, _ := cmd.Flags().GetBool("yes"); !yes && !jsonOut {
+			if !confirmAction("Clear the stored OAuth token?") {
+				fmt.Println("Aborted")
+				return
+			}
+		}
+
+		cleared, err := auth.ClearOAuthToken()
+		recordAudit("auth.clear-token", "", "", err)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to clear OAuth token: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(cleared)
+			return
+		}
+
+		message := "No stored OAuth token to clear"
+		if cleared.Cleared {
+			message = fmt.Sprintf("Cleared OAuth token from %s", cleared.Location)
+		}
+		if plaintext {
+			fmt.Println(message)
+			if cleared.APIKeyPreserved {
+				fmt.Println("API key kept")
+			}
+		} else {
+			fmt.Println(color.New(color.FgGreen).Sprint("✅ " + message))
+			if cleared.APIKeyPreserved {
+				fmt.Println("   API key kept as the fallback credential")
+			}
+		}
+	},
+}
+
+var authTokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Print the current access token",
+	Long: `Print the token linctl uses to authenticate, for use in scripts.
+
+OAuth tokens are printed without the "Bearer " prefix; API keys are printed as-is.
+To avoid exposing the token in an interactive terminal by accident, --plaintext
+or --json must be given explicitly.
+
+Examples:
+  linctl auth token --plaintext   # Print the raw token
+  linctl auth token --json        # Token with method and expiry`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		// LINCTL_OUTPUT_FORMAT also sets these, so only the flags themselves count
+		if !cmd.Flags().Changed("plaintext") && !cmd.Flags().Changed("json") {
+			output.Error("Refusing to print the token to an interactive terminal. Use --plaintext or --json.", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		token, err := resolveAuthToken(auth.GetAuthHeader, auth.GetOAuthTokenInfo)
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		if jsonOut {
+			output.JSON(token)
+		} else {
+			fmt.Println(token.Token)
+		}
+	},
+}
+
+// authToken is the output of the auth token command
+type authToken struct {
+	Token     string `json:"token"`
+	Method    string `json:"method"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// resolveAuthToken returns the token behind the current auth header, stripping
+// the Bearer prefix used for OAuth tokens
+func resolveAuthToken(authHeader func() (string, error), tokenInfo func() (map[string]interface{}, error)) (*authToken, error) {
+	header, err := authHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(header, "Bearer ") {
+		return &authToken{Token: header, Method: "api_key"}, nil
+	}
+
+	token := &authToken{
+		Token:  strings.TrimPrefix(header, "Bearer "),
+		Method: "oauth",
+	}
+	if info, err := tokenInfo(); err == nil {
+		if expiresAt, ok := info["expires_at"].(string); ok {
+			token.ExpiresAt = expiresAt
+		}
+	}
+
+	return token, nil
+}
+
 var whoamiCmd = &cobra.Command{
 	Use:   "whoami",
 	Short: "Show current user",
-	Long:  `Display information about the currently authenticated user.`,
+	Long: `Display information about the currently authenticated user.
+
+Use --refresh after switching profiles or credentials to re-validate the token
+and fetch the user live.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		statusCmd.Run(cmd, args)
 	},
@@ -273,6 +561,8 @@ var authAgentStatusCmd = &cobra.Command{
 			"user":             status.User,
 			"token_expires_at": status.TokenExpiry,
 			"scopes":           status.Scopes,
+			"default_team":     status.DefaultTeam,
+			"team_configured":  status.TeamConfigured,
 			"suggestions":      status.Suggestions,
 			"environment":      status.Environment,
 			"oauth_info":       oauthInfo,
@@ -290,6 +580,7 @@ var authAgentStatusCmd = &cobra.Command{
 					if status.User != nil {
 						fmt.Printf("User: %s (%s)\n", status.User.Name, status.User.Email)
 					}
+					fmt.Printf("Default team configured: %v\n", status.TeamConfigured)
 				} else {
 					fmt.Println(color.New(color.FgGreen).Sprint("✅ Agent Status: Ready"))
 					fmt.Printf("🔐 Method: %s\n", color.New(color.FgCyan).Sprint(status.Method))
@@ -298,6 +589,11 @@ var authAgentStatusCmd = &cobra.Command{
 							color.New(color.FgCyan).Sprint(status.User.Name),
 							color.New(color.FgCyan).Sprint(status.User.Email))
 					}
+					if status.TeamConfigured {
+						fmt.Printf("👥 Default team: %s\n", color.New(color.FgCyan).Sprint(status.DefaultTeam.Key))
+					} else {
+						fmt.Printf("%s Set LINEAR_DEFAULT_TEAM to omit --team on issue create and list\n", color.New(color.FgBlue).Sprint("💡"))
+					}
 				}
 			} else {
 				if plaintext {
@@ -318,7 +614,7 @@ var authAgentStatusCmd = &cobra.Command{
 		}
 
 		if !status.Authenticated {
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 	},
 }
@@ -329,10 +625,23 @@ func init() {
 	authCmd.AddCommand(statusCmd)
 	authCmd.AddCommand(refreshCmd)
 	authCmd.AddCommand(logoutCmd)
+	authCmd.AddCommand(clearTokenCmd)
 	authCmd.AddCommand(authAgentStatusCmd)
+	authCmd.AddCommand(authTokenCmd)
 
 	// Add OAuth flag to login command
 	loginCmd.Flags().BoolVar(&oauthFlag, "oauth", false, "Use OAuth authentication instead of API key")
+	loginCmd.Flags().BoolVar(&deviceFlag, "device", false, "Use the OAuth device flow (for headless machines)")
+	loginCmd.Flags().StringVar(&apiKeyFlag, "api-key", "", "Personal API Key to log in with non-interactively (or set LINEAR_API_KEY)")
+
+	// List team memberships in human-readable status output
+	statusCmd.Flags().BoolP("verbose", "v", false, "List all of your team memberships")
+	statusCmd.Flags().Bool("check-expiry", false, "Exit with code 2 if the OAuth token expires within --warn-threshold")
+	statusCmd.Flags().Duration("warn-threshold", 10*time.Minute, "How soon before expiry --check-expiry warns")
+	statusCmd.Flags().Bool("refresh", false, "Re-validate the token and fetch the user live")
+	whoamiCmd.Flags().BoolP("verbose", "v", false, "List all of your team memberships")
+	whoamiCmd.Flags().Bool("refresh", false, "Re-validate the token and fetch the user live")
+	clearTokenCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
 
 	// Add whoami as a top-level command too
 	rootCmd.AddCommand(whoamiCmd)
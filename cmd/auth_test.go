@@ -3,11 +3,14 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/nicholls-inc/linctl/pkg/api"
 	"github.com/nicholls-inc/linctl/pkg/auth"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -327,3 +330,124 @@ func TestGlobalFlags_Integration(t *testing.T) {
 		})
 	}
 }
+
+func TestLoginCommand_DeviceFlag(t *testing.T) {
+	flag := loginCmd.Flags().Lookup("device")
+	if flag == nil {
+		t.Fatal("Expected --device flag to be available on login command")
+	}
+
+	if flag.DefValue != "false" {
+		t.Errorf("Expected device flag default value false, got %s", flag.DefValue)
+	}
+
+	deviceFlag = false
+	loginCmd.ParseFlags([]string{"--device"})
+	if !deviceFlag {
+		t.Error("Expected deviceFlag to be set after parsing --device")
+	}
+	deviceFlag = false
+}
+
+func TestAuthTokenCommand_RequiresExplicitFormat(t *testing.T) {
+	// Mock mode authenticates with a fixed token
+	env := []string{api.MockDirEnvVar + "=" + t.TempDir(), "LINCTL_OUTPUT_FORMAT=json"}
+
+	stdout, _, code := runLinctl(t, env, "--mock", "auth", "token")
+	if code != exitValidation {
+		t.Errorf("Expected exit %d without --plaintext or --json, got %d", exitValidation, code)
+	}
+	if strings.Contains(stdout, api.MockAuthHeader) {
+		t.Errorf("Expected the token not to be printed when only LINCTL_OUTPUT_FORMAT is set, got %s", stdout)
+	}
+
+	stdout, stderr, code := runLinctl(t, env, "--mock", "auth", "token", "--plaintext")
+	if code != 0 || strings.TrimSpace(stdout) != api.MockAuthHeader {
+		t.Errorf("Expected --plaintext to print the token, exited %d: %s%s", code, stdout, stderr)
+	}
+}
+
+func TestResolveAuthToken(t *testing.T) {
+	tokenInfo := func() (map[string]interface{}, error) {
+		return map[string]interface{}{"expires_at": "2026-01-01T00:00:00Z"}, nil
+	}
+
+	t.Run("oauth_strips_bearer_prefix", func(t *testing.T) {
+		token, err := resolveAuthToken(func() (string, error) { return "Bearer lin_oauth_abc", nil }, tokenInfo)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if token.Token != "lin_oauth_abc" || token.Method != "oauth" {
+			t.Errorf("Expected stripped oauth token, got %+v", token)
+		}
+		if token.ExpiresAt != "2026-01-01T00:00:00Z" {
+			t.Errorf("Expected expiry from token info, got %q", token.ExpiresAt)
+		}
+	})
+
+	t.Run("api_key_returned_raw", func(t *testing.T) {
+		token, err := resolveAuthToken(func() (string, error) { return "lin_api_abc", nil }, tokenInfo)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if token.Token != "lin_api_abc" || token.Method != "api_key" || token.ExpiresAt != "" {
+			t.Errorf("Expected raw api key without expiry, got %+v", token)
+		}
+	})
+
+	t.Run("not_authenticated", func(t *testing.T) {
+		_, err := resolveAuthToken(func() (string, error) { return "", errors.New("not authenticated") }, tokenInfo)
+		if err == nil {
+			t.Error("Expected error when not authenticated")
+		}
+	})
+}
+
+func TestCheckTokenExpiry(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tokenExpiringIn := func(d time.Duration) func() (map[string]interface{}, error) {
+		return func() (map[string]interface{}, error) {
+			return map[string]interface{}{"expires_at": now.Add(d).Format(time.RFC3339)}, nil
+		}
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		tokenInfo    func() (map[string]interface{}, error)
+		result       string
+		needsRefresh bool
+		expiresIn    *int64
+	}{
+		{"api key is not applicable", "api_key", nil, expiryCheckNotApplicable, false, nil},
+		{"plenty of time", "oauth", tokenExpiringIn(2 * time.Hour), expiryCheckOK, false, int64Ptr(7200)},
+		{"within threshold", "oauth", tokenExpiringIn(5 * time.Minute), expiryCheckNeedsRefresh, true, int64Ptr(300)},
+		{"expired", "oauth", tokenExpiringIn(-time.Minute), expiryCheckNeedsRefresh, true, int64Ptr(0)},
+		{"missing expiry", "oauth", func() (map[string]interface{}, error) { return map[string]interface{}{}, nil }, expiryCheckNeedsRefresh, true, nil},
+		{"unreadable token", "oauth", func() (map[string]interface{}, error) { return nil, errors.New("no token") }, expiryCheckNeedsRefresh, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := checkTokenExpiry(tt.method, tt.tokenInfo, 10*time.Minute, now)
+
+			if check.ExpiryCheck != tt.result {
+				t.Errorf("Expected result %s, got %s", tt.result, check.ExpiryCheck)
+			}
+			if check.NeedsRefresh != tt.needsRefresh {
+				t.Errorf("Expected needs_refresh %v, got %v", tt.needsRefresh, check.NeedsRefresh)
+			}
+			if (check.ExpiresInSeconds == nil) != (tt.expiresIn == nil) ||
+				(tt.expiresIn != nil && *check.ExpiresInSeconds != *tt.expiresIn) {
+				t.Errorf("Expected expires_in_seconds %v, got %v", tt.expiresIn, check.ExpiresInSeconds)
+			}
+			if check.Message == "" {
+				t.Error("Expected a message")
+			}
+		})
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -11,6 +13,7 @@ import (
 	"github.com/nicholls-inc/linctl/pkg/api"
 	"github.com/nicholls-inc/linctl/pkg/auth"
 	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/security"
 	"github.com/nicholls-inc/linctl/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -20,30 +23,121 @@ import (
 var commentCmd = &cobra.Command{
 	Use:   "comment",
 	Short: "Manage issue comments",
-	Long: `Manage comments on Linear issues including listing and creating comments.
+	Long: `Manage comments on Linear issues including listing, creating, updating and deleting comments.
 
 Examples:
   linctl comment list LIN-123        # List comments for an issue
+  linctl comment list LIN-123 --author jane@example.com  # Only comments by one author
   linctl comment create LIN-123 --body "This is fixed"  # Add a comment
-  linctl comment create LIN-123 --body "Working on this" --actor "AI Agent"  # Add comment with actor attribution`,
+  linctl comment create LIN-123 --body "Working on this" --actor "AI Agent"  # Add comment with actor attribution
+  linctl comment create LIN-123 --body "Agreed" --reply-to COMMENT-ID  # Reply in a thread
+  linctl comment update COMMENT-ID --body "Updated text"  # Edit a comment
+  linctl comment delete COMMENT-ID --yes                  # Delete a comment without prompting
+  linctl comment react COMMENT-ID --emoji 👍              # React to a comment
+  linctl comment broadcast --issues LIN-1,LIN-2 --body "Heads up"  # Post to several issues`,
+}
+
+// commentPageSize is the number of comments requested per page
+const commentPageSize = 50
+
+// commentLister is the subset of the API client used to list comments
+type commentLister interface {
+	GetIssueComments(ctx context.Context, issueID string, filter map[string]interface{}, first int, after string, orderBy string) (*api.Comments, error)
+}
+
+// fetchIssueComments pages through an issue's comments until limit is reached
+// or no pages remain. A limit of 0 or less returns every comment.
+func fetchIssueComments(ctx context.Context, client commentLister, issueID string, filter map[string]interface{}, limit int, orderBy string) ([]api.Comment, error) {
+	comments, _, err := fetchLimited(ctx, limit, commentPageSize, func(first int, after string) ([]api.Comment, api.PageInfo, error) {
+		page, err := client.GetIssueComments(ctx, issueID, filter, first, after, orderBy)
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		return page.Nodes, page.PageInfo, nil
+	})
+	return comments, err
+}
+
+// threadedComment is a comment with its depth in a reply thread
+type threadedComment struct {
+	api.Comment
+	Depth int
+}
+
+// threadComments orders comments so that replies follow their parent, keeping
+// the original order among siblings. Replies whose parent is not in comments,
+// e.g. because --author filtered it out, are shown at the top level.
+func threadComments(comments []api.Comment) []threadedComment {
+	present := make(map[string]bool, len(comments))
+	for _, comment := range comments {
+		present[comment.ID] = true
+	}
+
+	var roots []api.Comment
+	replies := map[string][]api.Comment{}
+	for _, comment := range comments {
+		if comment.Parent != nil && present[comment.Parent.ID] && comment.Parent.ID != comment.ID {
+			replies[comment.Parent.ID] = append(replies[comment.Parent.ID], comment)
+		} else {
+			roots = append(roots, comment)
+		}
+	}
+
+	threaded := make([]threadedComment, 0, len(comments))
+	visited := make(map[string]bool, len(comments))
+	var walk func(comment api.Comment, depth int)
+	walk = func(comment api.Comment, depth int) {
+		if visited[comment.ID] {
+			return
+		}
+		visited[comment.ID] = true
+		threaded = append(threaded, threadedComment{Comment: comment, Depth: depth})
+		for _, reply := range replies[comment.ID] {
+			walk(reply, depth+1)
+		}
+	}
+	for _, root := range roots {
+		walk(root, 0)
+	}
+	return threaded
+}
+
+// indentLines prefixes every line of text with indent
+func indentLines(text, indent string) string {
+	if indent == "" {
+		return text
+	}
+	return indent + strings.ReplaceAll(text, "\n", "\n"+indent)
 }
 
 var commentListCmd = &cobra.Command{
-	Use:     "list ISSUE-ID",
-	Aliases: []string{"ls"},
-	Short:   "List comments for an issue",
-	Long:    `List all comments for a specific issue.`,
-	Args:    cobra.ExactArgs(1),
+	Use:               "list ISSUE-ID",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Aliases:           []string{"ls"},
+	Short:             "List comments for an issue",
+	Long: `List all comments for a specific issue. Replies are shown indented under
+the comment they answer.
+
+Examples:
+  linctl comment list LIN-123                  # Every comment, threaded
+  linctl comment list LIN-123 --author me      # Only your comments
+  linctl comment list LIN-123 --author "Jane Doe" --limit 0`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
-		issueID := args[0]
+		issueID := strings.ToUpper(strings.TrimSpace(args[0]))
+
+		if err := security.ValidateIssueID(issueID); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
 
 		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		// Create API client
@@ -66,36 +160,61 @@ var commentListCmd = &cobra.Command{
 				orderBy = ""
 			default:
 				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitValidation)
+			}
+		}
+
+		ctx, cancel := paginationContext()
+		defer cancel()
+
+		// Resolve --author to a user ID and filter on the server
+		var filter map[string]interface{}
+		if author, _ := cmd.Flags().GetString("author"); author != "" {
+			userID, err := resolveAssigneeID(ctx, client, author)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to resolve author: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			filter = map[string]interface{}{
+				"user": map[string]interface{}{"id": map[string]interface{}{"eq": userID}},
 			}
 		}
 
 		// Get comments
-		comments, err := client.GetIssueComments(context.Background(), issueID, limit, "", orderBy)
+		comments, err := fetchIssueComments(ctx, client, issueID, filter, limit, orderBy)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list comments: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
 		if jsonOut {
-			output.JSON(comments.Nodes)
-		} else if plaintext {
-			for i, comment := range comments.Nodes {
+			output.JSON(comments)
+			return
+		}
+
+		threaded := threadComments(comments)
+		if plaintext {
+			for i, comment := range threaded {
 				if i > 0 {
 					fmt.Println("---")
 				}
+				indent := strings.Repeat("  ", comment.Depth)
 				authorName := "Unknown"
 				if comment.User != nil {
 					authorName = comment.User.Name
 				}
-				fmt.Printf("Author: %s\n", authorName)
-				fmt.Printf("Date: %s\n", comment.CreatedAt.Format("2006-01-02 15:04:05"))
-				fmt.Printf("Comment:\n%s\n", comment.Body)
+				fmt.Printf("%sID: %s\n", indent, comment.ID)
+				if comment.Parent != nil {
+					fmt.Printf("%sReply to: %s\n", indent, comment.Parent.ID)
+				}
+				fmt.Printf("%sAuthor: %s\n", indent, authorName)
+				fmt.Printf("%sDate: %s\n", indent, comment.CreatedAt.Format("2006-01-02 15:04:05"))
+				fmt.Printf("%sComment:\n%s\n", indent, indentLines(comment.Body, indent))
 			}
 		} else {
 			// Rich display
-			if len(comments.Nodes) == 0 {
+			if len(threaded) == 0 {
 				fmt.Printf("\n%s No comments on issue %s\n",
 					color.New(color.FgYellow).Sprint("ℹ️"),
 					color.New(color.FgCyan).Sprint(issueID))
@@ -105,100 +224,796 @@ var commentListCmd = &cobra.Command{
 			fmt.Printf("\n%s Comments on %s (%d)\n\n",
 				color.New(color.FgCyan, color.Bold).Sprint("💬"),
 				color.New(color.FgCyan).Sprint(issueID),
-				len(comments.Nodes))
+				len(threaded))
 
-			for i, comment := range comments.Nodes {
-				if i > 0 {
+			for i, comment := range threaded {
+				// Threads are separated by a rule; replies follow their parent directly
+				if i > 0 && comment.Depth == 0 {
 					fmt.Println(strings.Repeat("─", 50))
 				}
 
-				// Header with author and time
+				indent := strings.Repeat("    ", comment.Depth)
+				marker := ""
+				if comment.Depth > 0 {
+					marker = color.New(color.FgWhite, color.Faint).Sprint("↳ ")
+				}
+
+				// Header with author, time and ID
 				timeAgo := formatTimeAgo(comment.CreatedAt)
 				authorName := "Unknown"
 				if comment.User != nil {
 					authorName = comment.User.Name
 				}
-				fmt.Printf("%s %s %s\n",
+				fmt.Printf("%s%s%s %s %s %s\n",
+					indent,
+					marker,
 					color.New(color.FgCyan, color.Bold).Sprint(authorName),
 					color.New(color.FgWhite, color.Faint).Sprint("•"),
-					color.New(color.FgWhite, color.Faint).Sprint(timeAgo))
+					color.New(color.FgWhite, color.Faint).Sprint(timeAgo),
+					color.New(color.FgWhite, color.Faint).Sprint(comment.ID))
 
 				// Comment body
-				fmt.Printf("\n%s\n\n", comment.Body)
+				fmt.Printf("\n%s\n\n", indentLines(comment.Body, indent))
 			}
 		}
 	},
 }
 
 var commentCreateCmd = &cobra.Command{
-	Use:     "create ISSUE-ID",
-	Aliases: []string{"add", "new"},
-	Short:   "Create a comment on an issue",
-	Long:    `Add a new comment to a specific issue.`,
+	Use:               "create ISSUE-ID",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Aliases:           []string{"add", "new"},
+	Short:             "Create a comment on an issue",
+	Long:              `Add a new comment to a specific issue.`,
+	Args:              cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		issueID := strings.ToUpper(strings.TrimSpace(args[0]))
+
+		// Get comment body from --body, --body-file or stdin
+		body, err := readTextInput(cmd, "body", "body-file", os.Stdin)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if body == "" {
+			output.Error("Comment body is required (--body or --body-file)", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		runCommentCreate(cmd, issueID, body)
+	},
+}
+
+// runCommentCreate adds body as a comment on issueID, resolving the actor and
+// honouring --reply-to, --preview, --dry-run and --idempotency-key from cmd.
+// Both comment create and the issue comment shortcut end up here.
+func runCommentCreate(cmd *cobra.Command, issueID, body string) {
+	plaintext := viper.GetBool("plaintext")
+	jsonOut := viper.GetBool("json")
+
+	if err := security.ValidateIssueID(issueID); err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	var parentID *string
+	if replyTo, _ := cmd.Flags().GetString("reply-to"); replyTo != "" {
+		replyTo = strings.TrimSpace(replyTo)
+		if err := security.ValidateCommentID(replyTo); err != nil {
+			output.Error(fmt.Sprintf("Invalid --reply-to: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		parentID = &replyTo
+	}
+
+	// JSON mode is non-interactive, so it skips the preview; --yes and
+	// --dry-run show it without asking
+	if preview, _ := cmd.Flags().GetBool("preview"); preview && !jsonOut {
+		yes, _ := cmd.Flags().GetBool("yes")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if !previewComment(issueID, body, plaintext, !yes && !dryRun) {
+			fmt.Println("Aborted")
+			return
+		}
+	}
+
+	// Get auth header
+	authHeader, err := auth.GetAuthHeader()
+	if err != nil {
+		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+		os.Exit(exitAuth)
+	}
+
+	requireScope(authHeader, "comment.create", plaintext, jsonOut)
+
+	// Create API client
+	client := api.NewClient(authHeader)
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	client.SetDryRun(dryRun)
+
+	// Get actor parameters
+	actor, _ := cmd.Flags().GetString("actor")
+	avatarURL, _ := cmd.Flags().GetString("avatar-url")
+
+	// Resolve actor parameters
+	actorParams := utils.ResolveActorParams(actor, avatarURL)
+
+	// Build input
+	input := api.CommentCreateInput{
+		IssueID:        issueID,
+		Body:           body,
+		ParentID:       parentID,
+		CreateAsUser:   actorParams.ToCreateAsUser(),
+		DisplayIconURL: actorParams.ToDisplayIconURL(),
+	}
+
+	// With an idempotency key, a repeat within the local window returns the earlier comment
+	var guard *idempotencyGuard
+	var comment *api.Comment
+	if idempotencyKey, _ := cmd.Flags().GetString("idempotency-key"); idempotencyKey != "" {
+		guard, err = openIdempotencyGuard("comment.create", idempotencyKey, input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid --idempotency-key: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		input.ID = guard.entityID()
+
+		if !dryRun {
+			var cached api.Comment
+			replayed, err := guard.replay(&cached)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			if replayed {
+				fmt.Fprintf(os.Stderr, "Note: returning comment %s created earlier with this idempotency key\n", cached.ID)
+				comment = &cached
+			}
+		}
+	}
+
+	if comment == nil {
+		// Create comment
+		comment, err = createComment(commandContext(), client, input)
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		recordAudit("comment.create", issueID, actorParams.Actor, err)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to create comment: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if guard != nil {
+			guard.remember(comment)
+		}
+	}
+
+	if urlKey := workspaceURLKey(commandContext(), client); urlKey != "" {
+		comment.URL = commentWebURL(urlKey, issueID, comment.ID)
+	}
+
+	// Handle output
+	if jsonOut {
+		output.JSON(comment)
+	} else if plaintext {
+		fmt.Printf("Created comment on %s\n", issueID)
+		if comment.Parent != nil {
+			fmt.Printf("Reply to: %s\n", comment.Parent.ID)
+		}
+		authorName := "Unknown"
+		if comment.User != nil {
+			authorName = comment.User.Name
+		}
+		fmt.Printf("Author: %s\n", authorName)
+		fmt.Printf("Date: %s\n", comment.CreatedAt.Format("2006-01-02 15:04:05"))
+		if comment.URL != "" {
+			fmt.Printf("URL: %s\n", comment.URL)
+		}
+	} else {
+		fmt.Printf("%s Added comment to %s\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			color.New(color.FgCyan, color.Bold).Sprint(issueID))
+		fmt.Printf("\n%s\n", comment.Body)
+		if comment.URL != "" {
+			fmt.Printf("\n%s\n", color.New(color.FgBlue, color.Underline).Sprint(comment.URL))
+		}
+	}
+
+	if open, _ := cmd.Flags().GetBool("open"); open {
+		openWebURL(comment.URL)
+	}
+}
+
+// previewComment prints the rendered comment body and, when confirm is set,
+// asks whether to send it. It reports whether the comment should be created.
+// A preview being confirmed is part of the prompt, so --quiet does not hide it.
+func previewComment(issueID, body string, plaintext, confirm bool) bool {
+	var out io.Writer = os.Stdout
+	if confirm {
+		out = output.PromptWriter()
+	}
+
+	if plaintext {
+		fmt.Fprintf(out, "Comment on %s:\n\n%s\n\n", issueID, body)
+	} else {
+		fmt.Fprintf(out, "%s %s\n\n%s\n\n",
+			color.New(color.Bold).Sprint("Preview of comment on"),
+			color.New(color.FgCyan, color.Bold).Sprint(issueID),
+			output.RenderMarkdown(body))
+	}
+
+	if !confirm {
+		return true
+	}
+	return confirmAction(fmt.Sprintf("Post this comment to %s?", issueID))
+}
+
+var commentUpdateCmd = &cobra.Command{
+	Use:     "update COMMENT-ID",
+	Aliases: []string{"edit"},
+	Short:   "Update a comment",
+	Long:    `Replace the body of an existing comment.`,
 	Args:    cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
-		issueID := args[0]
+		commentID := strings.TrimSpace(args[0])
+
+		// Get comment body from --body, --body-file or stdin
+		body, err := readTextInput(cmd, "body", "body-file", os.Stdin)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if body == "" {
+			output.Error("Comment body is required (--body or --body-file)", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
 
-		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
-			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
 		}
 
-		// Create API client
 		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
 
-		// Get comment body and actor parameters
-		body, _ := cmd.Flags().GetString("body")
 		actor, _ := cmd.Flags().GetString("actor")
 		avatarURL, _ := cmd.Flags().GetString("avatar-url")
-
-		if body == "" {
-			output.Error("Comment body is required (--body)", plaintext, jsonOut)
-			os.Exit(1)
-		}
-
-		// Resolve actor parameters
 		actorParams := utils.ResolveActorParams(actor, avatarURL)
 
-		// Build input
-		input := api.CommentCreateInput{
-			IssueID:        issueID,
+		input := api.CommentUpdateInput{
+			ID:             commentID,
 			Body:           body,
 			CreateAsUser:   actorParams.ToCreateAsUser(),
 			DisplayIconURL: actorParams.ToDisplayIconURL(),
 		}
 
-		// Create comment
-		comment, err := client.CreateComment(context.Background(), input)
+		requireScope(authHeader, "comment.update", plaintext, jsonOut)
+
+		comment, err := client.UpdateComment(commandContext(), input)
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		recordAudit("comment.update", commentID, actorParams.Actor, err)
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to create comment: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			message := fmt.Sprintf("Failed to update comment: %v", err)
+			if isNotFoundError(err) {
+				message = fmt.Sprintf("Comment %s not found", commentID)
+			}
+			output.Error(message, plaintext, jsonOut)
+			os.Exit(exitCode(err))
 		}
 
-		// Handle output
 		if jsonOut {
 			output.JSON(comment)
 		} else if plaintext {
-			fmt.Printf("Created comment on %s\n", issueID)
-			authorName := "Unknown"
-			if comment.User != nil {
-				authorName = comment.User.Name
-			}
-			fmt.Printf("Author: %s\n", authorName)
-			fmt.Printf("Date: %s\n", comment.CreatedAt.Format("2006-01-02 15:04:05"))
+			fmt.Printf("Updated comment %s\n", comment.ID)
 		} else {
-			fmt.Printf("%s Added comment to %s\n",
+			fmt.Printf("%s Updated comment %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
-				color.New(color.FgCyan, color.Bold).Sprint(issueID))
+				color.New(color.FgCyan, color.Bold).Sprint(comment.ID))
 			fmt.Printf("\n%s\n", comment.Body)
 		}
 	},
 }
 
+var commentDeleteCmd = &cobra.Command{
+	Use:     "delete COMMENT-ID",
+	Aliases: []string{"rm"},
+	Short:   "Delete a comment",
+	Long: `Permanently delete a comment.
+
+Examples:
+  linctl comment delete COMMENT-ID        # Delete after confirmation
+  linctl comment delete COMMENT-ID --yes  # Delete without prompting`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		commentID := strings.TrimSpace(args[0])
+
+		yes, _ := cmd.Flags().GetBool("yes")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		// JSON mode is non-interactive, so it never prompts
+		if !yes && !jsonOut && !dryRun {
+			if !confirmAction(fmt.Sprintf("Are you sure you want to delete comment %s?", commentID)) {
+				fmt.Println("Aborted")
+				return
+			}
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		client.SetDryRun(dryRun)
+		requireScope(authHeader, "comment.delete", plaintext, jsonOut)
+
+		success, err := client.DeleteComment(commandContext(), commentID)
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		if err == nil && !success {
+			err = fmt.Errorf("the deletion was not confirmed by Linear")
+		}
+		recordAudit("comment.delete", commentID, "", err)
+
+		if err != nil {
+			message := fmt.Sprintf("Failed to delete comment: %v", err)
+			if isNotFoundError(err) {
+				message = fmt.Sprintf("Comment %s not found", commentID)
+			}
+			output.Error(message, plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"status":  "success",
+				"comment": commentID,
+			})
+		} else if plaintext {
+			fmt.Printf("Deleted comment %s\n", commentID)
+		} else {
+			fmt.Printf("%s Deleted comment %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(commentID))
+		}
+	},
+}
+
+// commonReactions are the reactions Linear offers in its reaction picker, as
+// emoji and shortcodes. Others are passed through with a warning.
+var commonReactions = map[string]bool{
+	"👍": true, "👎": true, "❤️": true, "🎉": true, "😄": true, "😕": true,
+	"🚀": true, "👀": true, "✅": true, "🙏": true, "🔥": true, "💯": true,
+	"+1": true, "-1": true, "thumbsup": true, "thumbsdown": true, "heart": true, "tada": true,
+	"smile": true, "confused": true, "rocket": true, "eyes": true, "white_check_mark": true,
+	"pray": true, "fire": true, "100": true,
+}
+
+// normalizeEmoji strips the colons from a :shortcode: and validates the emoji.
+// known reports whether it is one of the common reactions.
+func normalizeEmoji(emoji string) (normalized string, known bool, err error) {
+	emoji = strings.TrimSpace(emoji)
+	if len(emoji) > 2 && strings.HasPrefix(emoji, ":") && strings.HasSuffix(emoji, ":") {
+		emoji = emoji[1 : len(emoji)-1]
+	}
+	if err := security.ValidateEmoji(emoji); err != nil {
+		return "", false, err
+	}
+	return emoji, commonReactions[emoji], nil
+}
+
+// reactionState is the JSON result of comment react
+type reactionState struct {
+	Comment  string        `json:"comment"`
+	Emoji    string        `json:"emoji"`
+	Reacted  bool          `json:"reacted"`
+	Reaction *api.Reaction `json:"reaction,omitempty"`
+}
+
+// reactionRemover is the subset of the API client used to remove a reaction
+type reactionRemover interface {
+	GetViewer(ctx context.Context) (*api.User, error)
+	GetCommentReactions(ctx context.Context, commentID string) ([]api.Reaction, error)
+	DeleteReaction(ctx context.Context, id string) (bool, error)
+}
+
+// removeReaction deletes the viewer's emoji reaction on a comment, returning
+// the removed reaction or nil when there was none
+func removeReaction(ctx context.Context, client reactionRemover, commentID, emoji string) (*api.Reaction, error) {
+	viewer, err := client.GetViewer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	reactions, err := client.GetCommentReactions(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reaction := range reactions {
+		if reaction.Emoji != emoji || reaction.User == nil || reaction.User.ID != viewer.ID {
+			continue
+		}
+		success, err := client.DeleteReaction(ctx, reaction.ID)
+		if err == nil && !success {
+			err = fmt.Errorf("the deletion was not confirmed by Linear")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &reaction, nil
+	}
+	return nil, nil
+}
+
+var commentReactCmd = &cobra.Command{
+	Use:   "react COMMENT-ID",
+	Short: "Add or remove an emoji reaction on a comment",
+	Long: `React to a comment with an emoji, or remove your reaction with --remove.
+
+The emoji may be an emoji character or a shortcode (+1, :rocket:). Emoji outside
+Linear's usual reaction set are sent as given, with a warning. Removing a
+reaction you have not added succeeds without changing anything.
+
+Examples:
+  linctl comment react COMMENT-ID --emoji 👍
+  linctl comment react COMMENT-ID --emoji :eyes: --json
+  linctl comment react COMMENT-ID --emoji 👍 --remove`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		commentID := strings.TrimSpace(args[0])
+
+		if err := security.ValidateCommentID(commentID); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		emojiFlag, _ := cmd.Flags().GetString("emoji")
+		emoji, known, err := normalizeEmoji(emojiFlag)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if !known {
+			fmt.Fprintf(os.Stderr, "Warning: %q is not one of Linear's usual reactions; sending it as-is\n", emoji)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
+
+		ctx := commandContext()
+		remove, _ := cmd.Flags().GetBool("remove")
+		state := reactionState{Comment: commentID, Emoji: emoji}
+
+		if remove {
+			requireScope(authHeader, "comment.unreact", plaintext, jsonOut)
+
+			removed, err := removeReaction(ctx, client, commentID, emoji)
+			if printDryRun(err, plaintext, jsonOut) {
+				return
+			}
+			recordAudit("comment.unreact", commentID, "", err)
+			if err != nil {
+				output.Error(reactionErrorMessage("remove", commentID, err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			state.Reaction = removed
+
+			switch {
+			case jsonOut:
+				output.JSON(state)
+			case removed == nil:
+				output.Info(fmt.Sprintf("No %s reaction of yours on comment %s", emoji, commentID), plaintext, jsonOut)
+			case plaintext:
+				fmt.Printf("Removed %s from comment %s\n", emoji, commentID)
+			default:
+				fmt.Printf("%s Removed %s from comment %s\n",
+					color.New(color.FgGreen).Sprint("✓"), emoji,
+					color.New(color.FgCyan, color.Bold).Sprint(commentID))
+			}
+			return
+		}
+
+		requireScope(authHeader, "comment.react", plaintext, jsonOut)
+
+		reaction, err := client.CreateReaction(ctx, api.ReactionInput{CommentID: commentID, Emoji: emoji})
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		recordAudit("comment.react", commentID, "", err)
+		if err != nil {
+			output.Error(reactionErrorMessage("add", commentID, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		state.Reacted = true
+		state.Reaction = reaction
+
+		if jsonOut {
+			output.JSON(state)
+		} else if plaintext {
+			fmt.Printf("Reacted %s to comment %s\n", emoji, commentID)
+		} else {
+			fmt.Printf("%s Reacted %s to comment %s\n",
+				color.New(color.FgGreen).Sprint("✓"), emoji,
+				color.New(color.FgCyan, color.Bold).Sprint(commentID))
+		}
+	},
+}
+
+// reactionErrorMessage describes a failure to add or remove a reaction
+func reactionErrorMessage(action, commentID string, err error) string {
+	if isNotFoundError(err) {
+		return fmt.Sprintf("Comment %s not found", commentID)
+	}
+	return fmt.Sprintf("Failed to %s reaction: %v", action, err)
+}
+
+var commentBroadcastCmd = &cobra.Command{
+	Use:   "broadcast",
+	Short: "Post the same comment to several issues",
+	Long: `Post one comment to each of several issues, e.g. for an announcement.
+
+Every issue is looked up before anything is posted. If any is invalid or
+cannot be found nothing is posted, unless --continue-on-error is given, in
+which case the comment goes to the issues that were found. Comments are posted
+one at a time under the rate limiter, and the --actor attribution is the same
+as for comment create.
+
+Examples:
+  linctl comment broadcast --issues LIN-1,LIN-2,LIN-3 --body "Heads up: the deploy freeze starts Friday"
+  linctl comment broadcast --issues LIN-1,LIN-2 --body-file notice.md --actor "Release Bot"
+  linctl comment broadcast --issues LIN-1,LIN-404 --body "FYI" --continue-on-error --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		issueIDs, _ := cmd.Flags().GetStringSlice("issues")
+		issueIDs = normalizeBroadcastIssues(issueIDs)
+		if len(issueIDs) == 0 {
+			output.Error("At least one issue is required (--issues LIN-1,LIN-2)", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		body, err := readTextInput(cmd, "body", "body-file", os.Stdin)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if body == "" {
+			output.Error("Comment body is required (--body or --body-file)", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		requireScope(authHeader, "comment.create", plaintext, jsonOut)
+
+		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
+
+		actor, _ := cmd.Flags().GetString("actor")
+		avatarURL, _ := cmd.Flags().GetString("avatar-url")
+		continueOnError, _ := cmd.Flags().GetBool("continue-on-error")
+		actorParams := utils.ResolveActorParams(actor, avatarURL)
+
+		ctx := commandContext()
+		results, broadcastErr := broadcastComment(ctx, client, issueIDs, body, actorParams, continueOnError)
+		payload := broadcastPayload(results)
+
+		urlKey := ""
+		if payload["posted"].(int) > 0 {
+			urlKey = workspaceURLKey(ctx, client)
+		}
+		for i := range results {
+			result := &results[i]
+			switch result.Status {
+			case "posted":
+				result.URL = commentWebURL(urlKey, result.Issue, result.CommentID)
+				recordAudit("comment.create", result.Issue, actorParams.Actor, nil)
+			case "failed":
+				recordAudit("comment.create", result.Issue, actorParams.Actor, errors.New(result.Error))
+			}
+		}
+
+		if jsonOut {
+			if broadcastErr != nil {
+				payload["error"] = broadcastErr.Error()
+			}
+			output.JSON(payload)
+		} else {
+			if plaintext {
+				fmt.Println("Issue\tStatus\tDetail")
+			}
+			for _, result := range results {
+				switch {
+				case result.Status == "posted" && plaintext:
+					fmt.Printf("%s\tposted\t%s\n", result.Issue, result.URL)
+				case result.Status == "posted":
+					fmt.Printf("%s %s %s\n", color.New(color.FgGreen).Sprint("✓"),
+						color.New(color.FgCyan, color.Bold).Sprint(result.Issue), result.URL)
+				case plaintext:
+					fmt.Printf("%s\t%s\t%s\n", result.Issue, result.Status, result.Error)
+				case result.Status == "dry-run" || result.Status == "skipped":
+					fmt.Printf("%s %s %s\n", color.New(color.FgYellow).Sprint("-"),
+						color.New(color.FgCyan, color.Bold).Sprint(result.Issue), result.Status)
+				default:
+					fmt.Printf("%s %s %s\n", color.New(color.FgRed).Sprint("✗"),
+						color.New(color.FgCyan, color.Bold).Sprint(result.Issue), result.Error)
+				}
+			}
+			if dryRun {
+				fmt.Printf("\nDry run: the comment would be posted to %v of %d issues\n", payload["dry_run"], len(results))
+			} else {
+				fmt.Printf("\nPosted to %v of %d issues\n", payload["posted"], len(results))
+			}
+			if broadcastErr != nil {
+				output.Error(broadcastErr.Error(), plaintext, jsonOut)
+			}
+		}
+
+		if broadcastErr != nil {
+			os.Exit(exitValidation)
+		}
+		if payload["failed"].(int) > 0 {
+			os.Exit(exitError)
+		}
+	},
+}
+
+// errBroadcastInvalidIssues is returned when issues fail validation and --continue-on-error is not set
+var errBroadcastInvalidIssues = errors.New("one or more issues are invalid; nothing was posted (use --continue-on-error to post to the valid issues)")
+
+// broadcastResult is the outcome of a broadcast comment on a single issue
+type broadcastResult struct {
+	Issue     string              `json:"issue"`
+	Status    string              `json:"status"`
+	CommentID string              `json:"comment_id,omitempty"`
+	URL       string              `json:"url,omitempty"`
+	Error     string              `json:"error,omitempty"`
+	Request   *api.GraphQLRequest `json:"request,omitempty"`
+}
+
+// commentBroadcaster is the subset of the API client used by comment broadcast
+type commentBroadcaster interface {
+	GetIssue(ctx context.Context, id string) (*api.Issue, error)
+	BroadcastComment(ctx context.Context, issueIDs []string, body string, actor *api.CommentActor) []api.BroadcastResult
+}
+
+// normalizeBroadcastIssues upper-cases and trims the --issues values, dropping
+// blanks and repeats so no issue gets the comment twice
+func normalizeBroadcastIssues(issueIDs []string) []string {
+	seen := map[string]bool{}
+	var normalized []string
+	for _, id := range issueIDs {
+		id = strings.ToUpper(strings.TrimSpace(id))
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		normalized = append(normalized, id)
+	}
+	return normalized
+}
+
+// broadcastComment validates and looks up every issue, then posts body to
+// those found. If any issue is invalid and continueOnError is false, nothing
+// is posted and errBroadcastInvalidIssues is returned.
+func broadcastComment(ctx context.Context, client commentBroadcaster, issueIDs []string, body string, actorParams *utils.ActorParams, continueOnError bool) ([]broadcastResult, error) {
+	results := make([]broadcastResult, len(issueIDs))
+	var valid []string
+	var indexes []int
+	for i, id := range issueIDs {
+		results[i] = broadcastResult{Issue: id, Status: "invalid"}
+		if err := security.ValidateIssueID(id); err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		issue, err := client.GetIssue(ctx, id)
+		if err != nil {
+			results[i].Error = err.Error()
+			if isNotFoundError(err) {
+				results[i].Error = fmt.Sprintf("issue %s not found", id)
+			}
+			continue
+		}
+		results[i].Issue = issue.Identifier
+		valid = append(valid, issue.ID)
+		indexes = append(indexes, i)
+	}
+
+	if len(valid) < len(issueIDs) && !continueOnError {
+		for _, i := range indexes {
+			results[i].Status = "skipped"
+		}
+		return results, errBroadcastInvalidIssues
+	}
+
+	actor := &api.CommentActor{
+		CreateAsUser:   actorParams.ToCreateAsUser(),
+		DisplayIconURL: actorParams.ToDisplayIconURL(),
+	}
+	for j, posted := range client.BroadcastComment(ctx, valid, body, actor) {
+		result := &results[indexes[j]]
+		var dryRun *api.DryRunError
+		if errors.As(posted.Error, &dryRun) {
+			result.Status = "dry-run"
+			result.Request = &dryRun.Request
+			continue
+		}
+		if posted.Error != nil {
+			result.Status = "failed"
+			result.Error = posted.Error.Error()
+			continue
+		}
+		result.Status = "posted"
+		result.CommentID = posted.Comment.ID
+	}
+
+	return results, nil
+}
+
+// broadcastPayload summarises a broadcast for JSON output
+func broadcastPayload(results []broadcastResult) map[string]interface{} {
+	posted, failed, planned := 0, 0, 0
+	for _, result := range results {
+		switch result.Status {
+		case "posted":
+			posted++
+		case "dry-run":
+			planned++
+		case "invalid", "failed":
+			failed++
+		}
+	}
+
+	status := "success"
+	if failed > 0 && posted > 0 {
+		status = "partial"
+	} else if failed > 0 || posted+planned == 0 {
+		status = "error"
+	}
+
+	payload := map[string]interface{}{
+		"status":  status,
+		"posted":  posted,
+		"failed":  failed,
+		"results": results,
+	}
+	if planned > 0 {
+		payload["dry_run"] = planned
+	}
+	return payload
+}
+
 // formatTimeAgo formats a time as a human-readable "time ago" string
 func formatTimeAgo(t time.Time) string {
 	duration := time.Since(t)
@@ -242,14 +1057,53 @@ func init() {
 	rootCmd.AddCommand(commentCmd)
 	commentCmd.AddCommand(commentListCmd)
 	commentCmd.AddCommand(commentCreateCmd)
+	commentCmd.AddCommand(commentUpdateCmd)
+	commentCmd.AddCommand(commentDeleteCmd)
+	commentCmd.AddCommand(commentReactCmd)
+	commentCmd.AddCommand(commentBroadcastCmd)
 
 	// List command flags
-	commentListCmd.Flags().IntP("limit", "l", 50, "Maximum number of comments to return")
+	commentListCmd.Flags().IntP("limit", "l", 50, "Maximum number of comments to return (0 for all)")
+	commentListCmd.Flags().String("author", "", "Only show comments by this user (email, name or 'me')")
 	commentListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 
 	// Create command flags
-	commentCreateCmd.Flags().StringP("body", "b", "", "Comment body (required)")
+	commentCreateCmd.Flags().StringP("body", "b", "", "Comment body (use - to read from stdin)")
+	commentCreateCmd.Flags().String("body-file", "", "Read the comment body from a file")
 	commentCreateCmd.Flags().String("actor", "", "Actor name for attribution (uses LINEAR_DEFAULT_ACTOR if not specified)")
 	commentCreateCmd.Flags().String("avatar-url", "", "Avatar URL for actor (uses LINEAR_DEFAULT_AVATAR_URL if not specified)")
-	_ = commentCreateCmd.MarkFlagRequired("body")
+	commentCreateCmd.Flags().Bool("dry-run", false, "Print the API request without creating the comment")
+	commentCreateCmd.Flags().String("reply-to", "", "ID of the comment to reply to")
+	commentCreateCmd.Flags().String("idempotency-key", "", "Unique key (e.g. a UUID) that makes retrying this create safe")
+	commentCreateCmd.Flags().Bool("preview", false, "Show the rendered comment and confirm before posting")
+	commentCreateCmd.Flags().BoolP("yes", "y", false, "Post without confirming when used with --preview")
+	commentCreateCmd.Flags().Bool("open", false, "Open the created comment in the default browser")
+
+	// Update command flags
+	commentUpdateCmd.Flags().StringP("body", "b", "", "New comment body (use - to read from stdin)")
+	commentUpdateCmd.Flags().String("body-file", "", "Read the new comment body from a file")
+	commentUpdateCmd.Flags().String("actor", "", "Actor name for attribution (uses LINEAR_DEFAULT_ACTOR if not specified)")
+	commentUpdateCmd.Flags().String("avatar-url", "", "Avatar URL for actor (uses LINEAR_DEFAULT_AVATAR_URL if not specified)")
+	commentUpdateCmd.Flags().Bool("dry-run", false, "Print the API request without updating the comment")
+
+	// Delete command flags
+	commentDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	commentDeleteCmd.Flags().Bool("dry-run", false, "Print the API request without deleting the comment")
+
+	// React command flags
+	commentReactCmd.Flags().StringP("emoji", "e", "", "Emoji or shortcode to react with, e.g. 👍 or +1 (required)")
+	commentReactCmd.Flags().Bool("remove", false, "Remove your reaction instead of adding it")
+	commentReactCmd.Flags().Bool("dry-run", false, "Print the API request without changing the reaction")
+	_ = commentReactCmd.MarkFlagRequired("emoji")
+
+	// Broadcast command flags
+	commentBroadcastCmd.Flags().StringSlice("issues", nil, "Comma-separated issue identifiers to comment on (required)")
+	commentBroadcastCmd.Flags().StringP("body", "b", "", "Comment body (use - to read from stdin)")
+	commentBroadcastCmd.Flags().String("body-file", "", "Read the comment body from a file")
+	commentBroadcastCmd.Flags().String("actor", "", "Actor name for attribution (uses LINEAR_DEFAULT_ACTOR if not specified)")
+	commentBroadcastCmd.Flags().String("avatar-url", "", "Avatar URL for actor (uses LINEAR_DEFAULT_AVATAR_URL if not specified)")
+	commentBroadcastCmd.Flags().Bool("continue-on-error", false, "Post to the valid issues even if some are invalid or not found")
+	commentBroadcastCmd.Flags().Bool("dry-run", false, "Print the API requests without posting any comments")
+	_ = commentBroadcastCmd.MarkFlagRequired("issues")
+	_ = commentBroadcastCmd.RegisterFlagCompletionFunc("issues", completeIssueList)
 }
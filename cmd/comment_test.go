@@ -1,10 +1,15 @@
 package cmd
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -522,3 +527,277 @@ func TestCommentCreateCommand_ActorFlagShortcuts(t *testing.T) {
 		})
 	}
 }
+
+func TestCommentUpdateCommand_Flags(t *testing.T) {
+	for _, name := range []string{"body", "body-file", "actor", "avatar-url"} {
+		if commentUpdateCmd.Flags().Lookup(name) == nil {
+			t.Errorf("Expected --%s flag on comment update", name)
+		}
+	}
+
+	if commentUpdateCmd.Flags().ShorthandLookup("b") == nil {
+		t.Error("Expected -b shorthand for --body")
+	}
+}
+
+func TestCommentDeleteCommand_Flags(t *testing.T) {
+	flag := commentDeleteCmd.Flags().Lookup("yes")
+	if flag == nil {
+		t.Fatal("Expected --yes flag on comment delete")
+	}
+	if flag.DefValue != "false" {
+		t.Errorf("Expected --yes to default to false, got %s", flag.DefValue)
+	}
+	if commentDeleteCmd.Flags().ShorthandLookup("y") == nil {
+		t.Error("Expected -y shorthand for --yes")
+	}
+}
+
+// fakeCommentLister serves comments in pages and records each request
+type fakeCommentLister struct {
+	comments []api.Comment
+	requests []int
+	filters  []map[string]interface{}
+}
+
+func (f *fakeCommentLister) GetIssueComments(ctx context.Context, issueID string, filter map[string]interface{}, first int, after string, orderBy string) (*api.Comments, error) {
+	f.requests = append(f.requests, first)
+	f.filters = append(f.filters, filter)
+
+	start := 0
+	if after != "" {
+		fmt.Sscanf(after, "%d", &start)
+	}
+	end := start + first
+	if end > len(f.comments) {
+		end = len(f.comments)
+	}
+	return &api.Comments{
+		Nodes:    f.comments[start:end],
+		PageInfo: api.PageInfo{HasNextPage: end < len(f.comments), EndCursor: fmt.Sprint(end)},
+	}, nil
+}
+
+func TestFetchIssueComments(t *testing.T) {
+	comments := make([]api.Comment, commentPageSize+10)
+	for i := range comments {
+		comments[i] = api.Comment{ID: fmt.Sprintf("comment-%d", i)}
+	}
+	client := &fakeCommentLister{comments: comments}
+	filter := map[string]interface{}{"user": map[string]interface{}{"id": map[string]interface{}{"eq": "user-1"}}}
+
+	result, err := fetchIssueComments(context.Background(), client, "LIN-123", filter, 0, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result) != len(comments) {
+		t.Errorf("Expected every comment across pages, got %d", len(result))
+	}
+	if len(client.requests) != 2 {
+		t.Errorf("Expected 2 page requests, got %v", client.requests)
+	}
+	for _, got := range client.filters {
+		if fmt.Sprint(got) != fmt.Sprint(filter) {
+			t.Errorf("Expected the author filter on every page, got %v", got)
+		}
+	}
+}
+
+func TestThreadComments(t *testing.T) {
+	parent := func(id string) *api.Comment { return &api.Comment{ID: id} }
+	comments := []api.Comment{
+		{ID: "a"},
+		{ID: "b"},
+		{ID: "a1", Parent: parent("a")},
+		{ID: "b1", Parent: parent("b")},
+		{ID: "a1x", Parent: parent("a1")},
+		{ID: "a2", Parent: parent("a")},
+		{ID: "orphan", Parent: parent("missing")},
+	}
+
+	var got []string
+	for _, comment := range threadComments(comments) {
+		got = append(got, fmt.Sprintf("%s:%d", comment.ID, comment.Depth))
+	}
+
+	expected := "a:0 a1:1 a1x:2 a2:1 b:0 b1:1 orphan:0"
+	if strings.Join(got, " ") != expected {
+		t.Errorf("Expected %q, got %q", expected, strings.Join(got, " "))
+	}
+}
+
+func TestIndentLines(t *testing.T) {
+	if got := indentLines("one\ntwo", "  "); got != "  one\n  two" {
+		t.Errorf("Unexpected indentation %q", got)
+	}
+	if got := indentLines("one\ntwo", ""); got != "one\ntwo" {
+		t.Errorf("Expected text unchanged without indent, got %q", got)
+	}
+}
+
+func TestNormalizeEmoji(t *testing.T) {
+	tests := []struct {
+		input     string
+		expected  string
+		known     bool
+		expectErr bool
+	}{
+		{input: "👍", expected: "👍", known: true},
+		{input: ":rocket:", expected: "rocket", known: true},
+		{input: "+1", expected: "+1", known: true},
+		{input: "🦄", expected: "🦄", known: false},
+		{input: "", expectErr: true},
+		{input: "two words", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		got, known, err := normalizeEmoji(tt.input)
+		if (err != nil) != tt.expectErr {
+			t.Errorf("normalizeEmoji(%q) error = %v, expectErr %v", tt.input, err, tt.expectErr)
+			continue
+		}
+		if got != tt.expected || known != tt.known {
+			t.Errorf("normalizeEmoji(%q) = %q, %v; want %q, %v", tt.input, got, known, tt.expected, tt.known)
+		}
+	}
+}
+
+// fakeReactionRemover serves reactions from memory and records deletions
+type fakeReactionRemover struct {
+	reactions []api.Reaction
+	deleted   []string
+}
+
+func (f *fakeReactionRemover) GetViewer(ctx context.Context) (*api.User, error) {
+	return &api.User{ID: "me"}, nil
+}
+
+func (f *fakeReactionRemover) GetCommentReactions(ctx context.Context, commentID string) ([]api.Reaction, error) {
+	return f.reactions, nil
+}
+
+func (f *fakeReactionRemover) DeleteReaction(ctx context.Context, id string) (bool, error) {
+	f.deleted = append(f.deleted, id)
+	return true, nil
+}
+
+func TestRemoveReaction(t *testing.T) {
+	client := &fakeReactionRemover{reactions: []api.Reaction{
+		{ID: "theirs", Emoji: "👍", User: &api.User{ID: "someone-else"}},
+		{ID: "other-emoji", Emoji: "🎉", User: &api.User{ID: "me"}},
+		{ID: "mine", Emoji: "👍", User: &api.User{ID: "me"}},
+	}}
+
+	removed, err := removeReaction(context.Background(), client, "comment-1", "👍")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if removed == nil || removed.ID != "mine" || len(client.deleted) != 1 || client.deleted[0] != "mine" {
+		t.Errorf("Expected only the viewer's 👍 reaction to be deleted, got %+v, deleted %v", removed, client.deleted)
+	}
+
+	removed, err = removeReaction(context.Background(), client, "comment-1", "🚀")
+	if err != nil || removed != nil || len(client.deleted) != 1 {
+		t.Errorf("Expected removing a missing reaction to be a no-op, got %+v, %v", removed, err)
+	}
+}
+
+// fakeCommentBroadcaster resolves issues from a map and records what it posts
+type fakeCommentBroadcaster struct {
+	issues   map[string]*api.Issue
+	failures map[string]error
+	posted   []string
+	actor    *api.CommentActor
+}
+
+func (f *fakeCommentBroadcaster) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	if issue, ok := f.issues[id]; ok {
+		return issue, nil
+	}
+	return nil, fmt.Errorf("Entity not found: Issue")
+}
+
+func (f *fakeCommentBroadcaster) BroadcastComment(ctx context.Context, issueIDs []string, body string, actor *api.CommentActor) []api.BroadcastResult {
+	f.actor = actor
+	results := make([]api.BroadcastResult, len(issueIDs))
+	for i, id := range issueIDs {
+		results[i].IssueID = id
+		if err := f.failures[id]; err != nil {
+			results[i].Error = err
+			continue
+		}
+		f.posted = append(f.posted, id)
+		results[i].Comment = &api.Comment{ID: "comment-" + id, Body: body}
+	}
+	return results
+}
+
+func newFakeCommentBroadcaster() *fakeCommentBroadcaster {
+	return &fakeCommentBroadcaster{issues: map[string]*api.Issue{
+		"LIN-1": {ID: "issue-1", Identifier: "LIN-1"},
+		"LIN-2": {ID: "issue-2", Identifier: "LIN-2"},
+	}}
+}
+
+func TestNormalizeBroadcastIssues(t *testing.T) {
+	got := normalizeBroadcastIssues([]string{" lin-1", "LIN-2", "", "LIN-1 "})
+	if strings.Join(got, ",") != "LIN-1,LIN-2" {
+		t.Errorf("Expected trimmed, upper-cased and de-duplicated issues, got %v", got)
+	}
+}
+
+func TestBroadcastComment(t *testing.T) {
+	client := newFakeCommentBroadcaster()
+	client.failures = map[string]error{"issue-2": fmt.Errorf("rate limited")}
+	actorParams := &utils.ActorParams{Actor: "Release Bot"}
+
+	results, err := broadcastComment(context.Background(), client, []string{"LIN-1", "LIN-2"}, "Heads up", actorParams, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if results[0].Status != "posted" || results[0].CommentID != "comment-issue-1" {
+		t.Errorf("Expected LIN-1 to be posted, got %+v", results[0])
+	}
+	if results[1].Status != "failed" || results[1].Error != "rate limited" {
+		t.Errorf("Expected LIN-2 to report its failure, got %+v", results[1])
+	}
+	if client.actor == nil || client.actor.CreateAsUser == nil || *client.actor.CreateAsUser != "Release Bot" {
+		t.Errorf("Expected the resolved actor to be used for attribution, got %+v", client.actor)
+	}
+
+	payload := broadcastPayload(results)
+	if payload["status"] != "partial" || payload["posted"] != 1 || payload["failed"] != 1 {
+		t.Errorf("Unexpected payload %v", payload)
+	}
+}
+
+func TestBroadcastComment_InvalidIssues(t *testing.T) {
+	client := newFakeCommentBroadcaster()
+	issues := []string{"LIN-1", "not an id", "LIN-404"}
+
+	results, err := broadcastComment(context.Background(), client, issues, "Heads up", nil, false)
+	if !errors.Is(err, errBroadcastInvalidIssues) {
+		t.Fatalf("Expected errBroadcastInvalidIssues, got %v", err)
+	}
+	if len(client.posted) != 0 {
+		t.Errorf("Expected nothing to be posted, got %v", client.posted)
+	}
+	if results[0].Status != "skipped" || results[1].Status != "invalid" || results[2].Status != "invalid" {
+		t.Errorf("Unexpected statuses %+v", results)
+	}
+	if results[2].Error != "issue LIN-404 not found" {
+		t.Errorf("Expected a not found reason, got %q", results[2].Error)
+	}
+
+	client = newFakeCommentBroadcaster()
+	results, err = broadcastComment(context.Background(), client, issues, "Heads up", nil, true)
+	if err != nil {
+		t.Fatalf("Unexpected error with --continue-on-error: %v", err)
+	}
+	if len(client.posted) != 1 || results[0].Status != "posted" {
+		t.Errorf("Expected only the valid issue to be posted, got %v", client.posted)
+	}
+	if payload := broadcastPayload(results); payload["status"] != "partial" || payload["failed"] != 2 {
+		t.Errorf("Unexpected payload %v", payload)
+	}
+}
@@ -0,0 +1,310 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// completionCacheTTL is how long completion results are reused between Tab presses
+	completionCacheTTL = 30 * time.Second
+	// completionTimeout bounds API calls made while completing
+	completionTimeout = 3 * time.Second
+)
+
+// completionClient is the subset of the API client used for shell completion
+type completionClient interface {
+	RecentIssueIdentifiers(ctx context.Context, prefix string) ([]string, error)
+	GetTeams(ctx context.Context, first int, after string, orderBy string) (*api.Teams, error)
+}
+
+// completionCacheEntry is a cached set of completion values
+type completionCacheEntry struct {
+	Values    []string  `json:"values"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// completionCache stores completion results on disk so repeated Tab presses
+// don't each make an API call
+type completionCache struct {
+	path string
+	now  func() time.Time
+}
+
+// newCompletionCache returns the cache for the active profile, so issues and
+// teams from one workspace are never offered while using another
+func newCompletionCache() *completionCache {
+	cache := &completionCache{now: time.Now}
+	if path, err := completionCachePath(config.ActiveProfile()); err == nil {
+		cache.path = path
+	}
+	return cache
+}
+
+// completionCachePath returns the cache path for a profile. Named profiles keep
+// it under ~/.linctl/profiles/<name>/, the default profile uses
+// ~/.linctl-completion-cache.json.
+func completionCachePath(profile string) (string, error) {
+	profileDir, err := config.ProfileDir(profile)
+	if err != nil {
+		return "", err
+	}
+	if profileDir != "" {
+		return filepath.Join(profileDir, "completion-cache.json"), nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".linctl-completion-cache.json"), nil
+}
+
+// get returns the cached values for key, calling fetch when they are missing or stale
+func (c *completionCache) get(key string, fetch func() ([]string, error)) ([]string, error) {
+	entries := c.load()
+	if entry, ok := entries[key]; ok && c.now().Sub(entry.FetchedAt) < completionCacheTTL {
+		return entry.Values, nil
+	}
+
+	values, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	entries[key] = completionCacheEntry{Values: values, FetchedAt: c.now()}
+	c.save(entries)
+	return values, nil
+}
+
+func (c *completionCache) load() map[string]completionCacheEntry {
+	entries := make(map[string]completionCacheEntry)
+	if c.path == "" {
+		return entries
+	}
+	if data, err := os.ReadFile(c.path); err == nil {
+		_ = json.Unmarshal(data, &entries)
+	}
+	return entries
+}
+
+// save writes the cache, ignoring errors since the cache is best effort
+func (c *completionCache) save(entries map[string]completionCacheEntry) {
+	if c.path == "" {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return
+	}
+	if data, err := json.Marshal(entries); err == nil {
+		_ = os.WriteFile(c.path, data, 0600)
+	}
+}
+
+// issueIdentifierCompletions returns issue identifiers matching toComplete.
+// Results are cached per team prefix and filtered locally as more is typed.
+func issueIdentifierCompletions(ctx context.Context, client completionClient, cache *completionCache, toComplete string) []string {
+	teamPrefix := ""
+	if teamKey, _, found := strings.Cut(strings.ToUpper(toComplete), "-"); found {
+		teamPrefix = teamKey + "-"
+	}
+
+	identifiers, err := cache.get("issues:"+teamPrefix, func() ([]string, error) {
+		return client.RecentIssueIdentifiers(ctx, teamPrefix)
+	})
+	if err != nil {
+		return nil
+	}
+
+	return filterCompletions(identifiers, toComplete)
+}
+
+// teamKeyCompletions returns team keys matching toComplete
+func teamKeyCompletions(ctx context.Context, client completionClient, cache *completionCache, toComplete string) []string {
+	keys, err := cache.get("teams", func() ([]string, error) {
+		teams, err := client.GetTeams(ctx, 100, "", "")
+		if err != nil {
+			return nil, err
+		}
+		keys := make([]string, len(teams.Nodes))
+		for i, team := range teams.Nodes {
+			keys[i] = team.Key
+		}
+		return keys, nil
+	})
+	if err != nil {
+		return nil
+	}
+
+	return filterCompletions(keys, toComplete)
+}
+
+// filterCompletions keeps the values starting with prefix, ignoring case
+func filterCompletions(values []string, prefix string) []string {
+	prefix = strings.ToUpper(prefix)
+	matches := []string{}
+	for _, value := range values {
+		if strings.HasPrefix(strings.ToUpper(value), prefix) {
+			matches = append(matches, value)
+		}
+	}
+	return matches
+}
+
+// newCompletionClient returns an API client, or nil when not authenticated
+func newCompletionClient() completionClient {
+	authHeader, err := auth.GetAuthHeader()
+	if err != nil {
+		return nil
+	}
+	return api.NewClient(authHeader)
+}
+
+// completeIssueIdentifiers is a ValidArgsFunction for commands taking a single issue ID
+func completeIssueIdentifiers(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return issueCompletions(toComplete)
+}
+
+// completeIssuePair is a ValidArgsFunction for commands taking two issue IDs
+func completeIssuePair(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 1 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return issueCompletions(toComplete)
+}
+
+// completeIssueFlag completes flags that take an issue ID, such as --blocks
+func completeIssueFlag(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return issueCompletions(toComplete)
+}
+
+// completeIssueList completes the last identifier of a comma-separated list
+// of issues, such as --issues LIN-1,LIN-<Tab>
+func completeIssueList(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	done, last := "", toComplete
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		done, last = toComplete[:i+1], toComplete[i+1:]
+	}
+
+	identifiers, directive := issueCompletions(last)
+	for i := range identifiers {
+		identifiers[i] = done + identifiers[i]
+	}
+	return identifiers, directive | cobra.ShellCompDirectiveNoSpace
+}
+
+// issueCompletions returns the issue identifiers matching toComplete
+func issueCompletions(toComplete string) ([]string, cobra.ShellCompDirective) {
+	client := newCompletionClient()
+	if client == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	return issueIdentifierCompletions(ctx, client, newCompletionCache(), toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeTeamKeys completes the --team flag
+func completeTeamKeys(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	client := newCompletionClient()
+	if client == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	return teamKeyCompletions(ctx, client, newCompletionCache(), toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeTeamKeyArg is a ValidArgsFunction for commands taking a single team key
+func completeTeamKeyArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeTeamKeys(cmd, args, toComplete)
+}
+
+// completionShells are the shells completion scripts can be generated for
+var completionShells = []string{"bash", "zsh", "fish", "powershell"}
+
+var completionCmd = &cobra.Command{
+	Use:   "completion bash|zsh|fish|powershell",
+	Short: "Generate a shell completion script",
+	Long: `Print the completion script for bash, zsh, fish or PowerShell.
+
+Besides commands and flags, the scripts complete issue identifiers (issue get,
+update, comment and the other commands taking an issue) and team keys (team get
+and every --team flag). These are fetched from Linear, cached for 30 seconds
+per profile, and only offered when linctl is authenticated.
+
+Bash (needs the bash-completion package):
+  # Current session
+  source <(linctl completion bash)
+  # Every session, Linux
+  linctl completion bash > /etc/bash_completion.d/linctl
+  # Every session, macOS with Homebrew
+  linctl completion bash > $(brew --prefix)/etc/bash_completion.d/linctl
+
+Zsh:
+  # Enable completion once, if not already done
+  echo "autoload -U compinit; compinit" >> ~/.zshrc
+  # Every session
+  linctl completion zsh > "${fpath[1]}/_linctl"
+
+Fish:
+  linctl completion fish > ~/.config/fish/completions/linctl.fish
+
+PowerShell:
+  # Current session
+  linctl completion powershell | Out-String | Invoke-Expression
+  # Every session: add the line above to your $PROFILE
+
+Start a new shell after installing a script for it to take effect.`,
+	ValidArgs:             completionShells,
+	Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := writeCompletionScript(cmd.Root(), args[0], os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+	},
+}
+
+// writeCompletionScript writes the completion script for shell, with command
+// and flag descriptions where the shell supports them
+func writeCompletionScript(root *cobra.Command, shell string, w io.Writer) error {
+	switch shell {
+	case "bash":
+		return root.GenBashCompletionV2(w, true)
+	case "zsh":
+		return root.GenZshCompletion(w)
+	case "fish":
+		return root.GenFishCompletion(w, true)
+	case "powershell":
+		return root.GenPowerShellCompletionWithDesc(w)
+	default:
+		return fmt.Errorf("unsupported shell %q: use %s", shell, strings.Join(completionShells, ", "))
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+}
@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+type fakeCompletionClient struct {
+	identifiers []string
+	teams       []api.Team
+	err         error
+	calls       []string
+}
+
+func (f *fakeCompletionClient) RecentIssueIdentifiers(ctx context.Context, prefix string) ([]string, error) {
+	f.calls = append(f.calls, "issues:"+prefix)
+	if f.err != nil {
+		return nil, f.err
+	}
+	var matches []string
+	for _, id := range f.identifiers {
+		if strings.HasPrefix(id, prefix) {
+			matches = append(matches, id)
+		}
+	}
+	return matches, nil
+}
+
+func (f *fakeCompletionClient) GetTeams(ctx context.Context, first int, after string, orderBy string) (*api.Teams, error) {
+	f.calls = append(f.calls, "teams")
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &api.Teams{Nodes: f.teams}, nil
+}
+
+func newTestCompletionCache(t *testing.T, now *time.Time) *completionCache {
+	t.Helper()
+	return &completionCache{
+		path: filepath.Join(t.TempDir(), "completion.json"),
+		now:  func() time.Time { return *now },
+	}
+}
+
+func TestCompletionCachePath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	path, err := completionCachePath("")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := filepath.Join(home, ".linctl-completion-cache.json"); path != expected {
+		t.Errorf("Expected default path %s, got %s", expected, path)
+	}
+
+	path, err = completionCachePath("work")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := filepath.Join(home, ".linctl", "profiles", "work", "completion-cache.json"); path != expected {
+		t.Errorf("Expected profile path %s, got %s", expected, path)
+	}
+
+	// Profiles don't share cached values
+	now := time.Now()
+	work := &completionCache{path: path, now: func() time.Time { return now }}
+	client := &fakeCompletionClient{teams: []api.Team{{Key: "ENG"}}}
+	teamKeyCompletions(context.Background(), client, work, "")
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("Expected the cache to be written in the profile directory: %v", err)
+	}
+
+	personal, _ := completionCachePath("personal")
+	other := &completionCache{path: personal, now: func() time.Time { return now }}
+	teamKeyCompletions(context.Background(), client, other, "")
+
+	if len(client.calls) != 2 {
+		t.Errorf("Expected each profile to fetch its own teams, got calls %v", client.calls)
+	}
+}
+
+func TestIssueIdentifierCompletions(t *testing.T) {
+	now := time.Now()
+	cache := newTestCompletionCache(t, &now)
+	client := &fakeCompletionClient{identifiers: []string{"ENG-12", "ENG-123", "ENG-45", "DES-1"}}
+
+	got := issueIdentifierCompletions(context.Background(), client, cache, "eng-12")
+	if strings.Join(got, ",") != "ENG-12,ENG-123" {
+		t.Errorf("Expected ENG-12 and ENG-123, got %v", got)
+	}
+
+	// Typing more of the same team prefix reuses the cached results
+	got = issueIdentifierCompletions(context.Background(), client, cache, "ENG-4")
+	if strings.Join(got, ",") != "ENG-45" {
+		t.Errorf("Expected ENG-45, got %v", got)
+	}
+	if len(client.calls) != 1 || client.calls[0] != "issues:ENG-" {
+		t.Errorf("Expected a single team-scoped API call, got %v", client.calls)
+	}
+
+	// Stale entries are refetched
+	now = now.Add(completionCacheTTL + time.Second)
+	issueIdentifierCompletions(context.Background(), client, cache, "ENG-")
+	if len(client.calls) != 2 {
+		t.Errorf("Expected stale cache to be refreshed, got %v", client.calls)
+	}
+}
+
+func TestTeamKeyCompletions(t *testing.T) {
+	now := time.Now()
+	cache := newTestCompletionCache(t, &now)
+	client := &fakeCompletionClient{teams: []api.Team{{Key: "ENG"}, {Key: "DES"}, {Key: "EXP"}}}
+
+	got := teamKeyCompletions(context.Background(), client, cache, "e")
+	if strings.Join(got, ",") != "ENG,EXP" {
+		t.Errorf("Expected ENG and EXP, got %v", got)
+	}
+
+	teamKeyCompletions(context.Background(), client, cache, "")
+	if len(client.calls) != 1 {
+		t.Errorf("Expected cached team keys to be reused, got %v", client.calls)
+	}
+}
+
+func TestCompletions_ErrorReturnsNothing(t *testing.T) {
+	now := time.Now()
+	cache := newTestCompletionCache(t, &now)
+	client := &fakeCompletionClient{err: errors.New("network down")}
+
+	if got := issueIdentifierCompletions(context.Background(), client, cache, "ENG-"); len(got) != 0 {
+		t.Errorf("Expected no completions on error, got %v", got)
+	}
+	if got := teamKeyCompletions(context.Background(), client, cache, ""); len(got) != 0 {
+		t.Errorf("Expected no completions on error, got %v", got)
+	}
+}
+
+func TestWriteCompletionScript(t *testing.T) {
+	for _, shell := range completionShells {
+		var buf bytes.Buffer
+		if err := writeCompletionScript(rootCmd, shell, &buf); err != nil {
+			t.Errorf("Unexpected error for %s: %v", shell, err)
+			continue
+		}
+		if !strings.Contains(buf.String(), "linctl") {
+			t.Errorf("Expected the %s script to complete linctl", shell)
+		}
+	}
+
+	if err := writeCompletionScript(rootCmd, "tcsh", &bytes.Buffer{}); err == nil {
+		t.Error("Expected an unsupported shell to be rejected")
+	}
+}
+
+func TestCompletionCommand_Registered(t *testing.T) {
+	cmd, _, err := rootCmd.Find([]string{"completion"})
+	if err != nil || cmd != completionCmd {
+		t.Fatalf("Expected linctl's own completion command, got %v (err %v)", cmd, err)
+	}
+	if err := cmd.Args(cmd, []string{"zsh"}); err != nil {
+		t.Errorf("Expected zsh to be accepted, got %v", err)
+	}
+	if err := cmd.Args(cmd, []string{"tcsh"}); err == nil {
+		t.Error("Expected an unknown shell to be rejected")
+	}
+}
+
+// TestCompletion_DynamicArgs checks every command taking an issue identifier
+// or team key as an argument completes it
+func TestCompletion_DynamicArgs(t *testing.T) {
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		use := strings.ToUpper(cmd.Use)
+		if (strings.Contains(use, "ISSUE-ID") || strings.Contains(use, "TEAM-KEY")) && cmd.ValidArgsFunction == nil {
+			t.Errorf("Expected %q to complete its arguments", cmd.CommandPath())
+		}
+		for _, child := range cmd.Commands() {
+			walk(child)
+		}
+	}
+	walk(rootCmd)
+}
@@ -0,0 +1,492 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/agent"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/config"
+	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/oauth"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// configCmd represents the config command
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect linctl configuration",
+	Long: `Inspect the configuration linctl runs with.
+
+Examples:
+  linctl config metrics         # Show client metrics for a probe request
+  linctl config metrics --json  # Structured output
+  linctl config metrics --format prometheus  # Prometheus exposition text
+  linctl config validate        # Check configuration, OAuth, and token end-to-end`,
+}
+
+var configMetricsCmd = &cobra.Command{
+	Use:   "metrics",
+	Short: "Show API client metrics",
+	Long: `Send a probe request through the API client using the configured retry
+and rate limit settings, then print the resulting client metrics.
+
+Retry counts and total retry wait show how much retry pressure the API is
+under, which helps when tuning LINCTL_RETRY_MAX_ATTEMPTS.
+
+When LINCTL_METRICS_ENABLED=true the metrics are also written as JSON to
+LINCTL_METRICS_EXPORT_PATH.
+
+--format prometheus prints the metrics and rate limit status in the Prometheus
+text exposition format instead, for a sidecar or textfile collector to scrape.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		format, _ := cmd.Flags().GetString("format")
+		switch format {
+		case "", metricsFormatPrometheus:
+		case metricsFormatJSON:
+			jsonOut = true
+		default:
+			output.Error(fmt.Sprintf("Invalid --format %q: use json or prometheus", format), plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+		prometheus := format == metricsFormatPrometheus
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		prodConfig, err := config.LoadProductionConfig()
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to load configuration: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		clientConfig := api.DefaultEnhancedClientConfig()
+		clientConfig.RetryConfig = prodConfig.Retry
+		clientConfig.RateLimitConfig = prodConfig.RateLimit
+		clientConfig.CircuitBreaker = prodConfig.CircuitBreaker
+		clientConfig.Logger = logging.NewNoOpLogger()
+		client := api.NewEnhancedClient(authHeader, clientConfig)
+
+		report := collectMetrics(commandContext(), client, prodConfig)
+
+		if prometheus {
+			writePrometheusMetrics(os.Stdout, client.GetMetrics(), client.GetRateLimitStatus(), report.ProbeError == "")
+		} else if jsonOut {
+			output.JSON(report)
+		} else {
+			printMetricsReport(report, plaintext)
+		}
+
+		if report.ExportError != "" {
+			if prometheus {
+				fmt.Fprintf(os.Stderr, "Error: failed to export metrics: %s\n", report.ExportError)
+			} else if !jsonOut {
+				output.Error(fmt.Sprintf("Failed to export metrics: %s", report.ExportError), plaintext, jsonOut)
+			}
+			os.Exit(1)
+		}
+	},
+}
+
+// Output formats accepted by config metrics --format
+const (
+	metricsFormatJSON       = "json"
+	metricsFormatPrometheus = "prometheus"
+)
+
+// metricsReport is the output of the config metrics command
+type metricsReport struct {
+	Metrics     metricsSummary `json:"metrics"`
+	MaxAttempts int            `json:"retry_max_attempts"`
+	ProbeError  string         `json:"probe_error,omitempty"`
+	ExportPath  string         `json:"export_path,omitempty"`
+	ExportError string         `json:"export_error,omitempty"`
+	Timestamp   string         `json:"timestamp"`
+}
+
+// metricsSummary is ClientMetrics with durations in milliseconds for readability
+type metricsSummary struct {
+	RequestCount     int64  `json:"request_count"`
+	ErrorCount       int64  `json:"error_count"`
+	RateLimitHits    int64  `json:"rate_limit_hits"`
+	RetryCount       int64  `json:"retry_count"`
+	RetryWaitTotalMS int64  `json:"retry_wait_total_ms"`
+	AverageMS        int64  `json:"average_duration_ms"`
+	CircuitState     string `json:"circuit_state,omitempty"`
+}
+
+// collectMetrics runs a probe request and gathers the client metrics,
+// exporting them when metrics are enabled
+func collectMetrics(ctx context.Context, client *api.EnhancedClient, prodConfig *config.ProductionConfig) *metricsReport {
+	report := &metricsReport{
+		MaxAttempts: prodConfig.Retry.MaxAttempts,
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+	}
+
+	if err := client.Execute(ctx, `query MetricsProbe { viewer { id } }`, nil, nil); err != nil {
+		report.ProbeError = err.Error()
+	}
+
+	metrics := client.GetMetrics()
+	report.Metrics = metricsSummary{
+		RequestCount:     metrics.RequestCount,
+		ErrorCount:       metrics.ErrorCount,
+		RateLimitHits:    metrics.RateLimitHits,
+		RetryCount:       metrics.RetryCount,
+		RetryWaitTotalMS: metrics.RetryWaitTotal.Milliseconds(),
+		AverageMS:        metrics.AverageDuration.Milliseconds(),
+		CircuitState:     metrics.CircuitState,
+	}
+
+	if prodConfig.Metrics.Enabled && prodConfig.Metrics.ExportPath != "" {
+		report.ExportPath = prodConfig.Metrics.ExportPath
+		if err := exportMetrics(prodConfig.Metrics.ExportPath, metrics); err != nil {
+			report.ExportError = err.Error()
+		}
+	}
+
+	return report
+}
+
+// exportMetrics writes client metrics to path as indented JSON
+func exportMetrics(path string, metrics api.ClientMetrics) error {
+	data, err := json.MarshalIndent(metrics, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0600)
+}
+
+// printMetricsReport prints the report in plaintext or rich format
+func printMetricsReport(report *metricsReport, plaintext bool) {
+	m := report.Metrics
+
+	if plaintext {
+		fmt.Printf("Requests: %d\n", m.RequestCount)
+		fmt.Printf("Errors: %d\n", m.ErrorCount)
+		fmt.Printf("Retries: %d (max attempts %d)\n", m.RetryCount, report.MaxAttempts)
+		fmt.Printf("Retry wait: %dms\n", m.RetryWaitTotalMS)
+		fmt.Printf("Rate limit hits: %d\n", m.RateLimitHits)
+		fmt.Printf("Average duration: %dms\n", m.AverageMS)
+		if report.ProbeError != "" {
+			fmt.Printf("Probe error: %s\n", report.ProbeError)
+		}
+		if report.ExportPath != "" && report.ExportError == "" {
+			fmt.Printf("Exported to: %s\n", report.ExportPath)
+		}
+		return
+	}
+
+	fmt.Println(color.New(color.FgCyan, color.Bold).Sprint("📊 Client Metrics"))
+	fmt.Println()
+
+	label := color.New(color.Bold).SprintFunc()
+	fmt.Printf("%s         %d\n", label("Requests:"), m.RequestCount)
+	fmt.Printf("%s           %d\n", label("Errors:"), m.ErrorCount)
+	fmt.Printf("%s          %d (max attempts %d)\n", label("Retries:"), m.RetryCount, report.MaxAttempts)
+	fmt.Printf("%s       %dms\n", label("Retry wait:"), m.RetryWaitTotalMS)
+	fmt.Printf("%s  %d\n", label("Rate limit hits:"), m.RateLimitHits)
+	fmt.Printf("%s %dms\n", label("Average duration:"), m.AverageMS)
+
+	if report.ProbeError != "" {
+		fmt.Printf("\n%s %s\n", color.New(color.FgRed).Sprint("❌ Probe failed:"), report.ProbeError)
+	}
+	if report.ExportPath != "" && report.ExportError == "" {
+		fmt.Printf("\n%s Exported to %s\n", color.New(color.FgGreen).Sprint("✓"), report.ExportPath)
+	}
+}
+
+// prometheusRateLimitGauges maps rate limiter status keys to gauge names and help text
+var prometheusRateLimitGauges = []struct {
+	key, name, help string
+}{
+	{"requests_per_second", "linctl_rate_limit_requests_per_second", "Client-side request rate limit."},
+	{"burst", "linctl_rate_limit_burst", "Client-side burst capacity."},
+	{"concurrency", "linctl_rate_limit_concurrency", "Batch workers currently allowed in flight."},
+	{"max_concurrency", "linctl_rate_limit_max_concurrency", "Most requests allowed in flight."},
+	{"linear_limit", "linctl_rate_limit_linear_limit", "Request limit reported by Linear."},
+	{"linear_remaining", "linctl_rate_limit_linear_remaining", "Requests remaining in Linear's current window."},
+}
+
+// writePrometheusMetrics writes client metrics and rate limit status in the
+// Prometheus text exposition format. Linear's limits are only present once a
+// response has reported them.
+func writePrometheusMetrics(w io.Writer, metrics api.ClientMetrics, rateLimit map[string]interface{}, probeOK bool) {
+	metric := func(name, kind, help string, value float64) {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %s\n", name, help, name, kind, name, strconv.FormatFloat(value, 'g', -1, 64))
+	}
+
+	metric("linctl_requests_total", "counter", "Requests sent through the API client.", float64(metrics.RequestCount))
+	metric("linctl_errors_total", "counter", "Requests that failed after retries.", float64(metrics.ErrorCount))
+	metric("linctl_rate_limit_hits_total", "counter", "Responses rejected by Linear's rate limit.", float64(metrics.RateLimitHits))
+	metric("linctl_retries_total", "counter", "Retried request attempts.", float64(metrics.RetryCount))
+	metric("linctl_retry_wait_seconds_total", "counter", "Time spent waiting between retries.", metrics.RetryWaitTotal.Seconds())
+
+	fmt.Fprintf(w, "# HELP linctl_request_duration_seconds Time spent in API requests.\n# TYPE linctl_request_duration_seconds summary\n")
+	fmt.Fprintf(w, "linctl_request_duration_seconds_sum %s\n", strconv.FormatFloat(metrics.TotalDuration.Seconds(), 'g', -1, 64))
+	fmt.Fprintf(w, "linctl_request_duration_seconds_count %d\n", metrics.RequestCount)
+
+	for _, gauge := range prometheusRateLimitGauges {
+		if value, ok := prometheusNumber(rateLimit[gauge.key]); ok {
+			metric(gauge.name, "gauge", gauge.help, value)
+		}
+	}
+	if enabled, ok := rateLimit["enabled"].(bool); ok {
+		metric("linctl_rate_limit_enabled", "gauge", "Whether client-side rate limiting is enabled.", prometheusBool(enabled))
+	}
+	if metrics.CircuitState != "" {
+		fmt.Fprintf(w, "# HELP linctl_circuit_breaker_state Current circuit breaker state.\n# TYPE linctl_circuit_breaker_state gauge\n")
+		fmt.Fprintf(w, "linctl_circuit_breaker_state{state=%q} 1\n", metrics.CircuitState)
+	}
+	metric("linctl_probe_success", "gauge", "Whether the probe request succeeded.", prometheusBool(probeOK))
+}
+
+// prometheusNumber converts a rate limiter status value to a sample value
+func prometheusNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// prometheusBool converts a boolean to a 0 or 1 sample value
+func prometheusBool(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate the linctl environment",
+	Long: `Check the whole linctl setup before running agents:
+
+  production_config  LINCTL_* settings load and pass validation
+  oauth_config       LINEAR_CLIENT_ID and LINEAR_CLIENT_SECRET are set
+  token              The current credentials are accepted by the Linear API
+  agent_environment  The environment is ready for agent workflows
+
+Each check is reported with a remediation hint when it fails. The command exits
+non-zero if any check fails; --json emits a report with a checks array for CI.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		ctx := commandContext()
+		prodConfig, prodErr := config.LoadProductionConfig()
+		oauthConfig, oauthErr := oauth.LoadFromEnvironment()
+		authHeader, authErr := auth.GetAuthHeader()
+
+		report := &configValidationReport{
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}
+		report.add(checkProductionConfig(prodConfig, prodErr))
+		report.add(checkOAuthConfig(oauthConfig, oauthErr))
+		report.add(checkToken(ctx, authHeader, authErr, func(ctx context.Context, authHeader string) error {
+			return validateAuthHeader(ctx, authHeader, oauthConfig)
+		}))
+		report.add(checkAgentEnvironment(agent.ValidateAgentEnvironment()))
+
+		if jsonOut {
+			output.JSON(report)
+		} else {
+			printValidationReport(report, plaintext)
+		}
+
+		if !report.Valid {
+			os.Exit(exitValidation)
+		}
+	},
+}
+
+// Check statuses reported by config validate
+const (
+	checkPass = "pass"
+	checkFail = "fail"
+)
+
+// validationCheck is the result of one config validate check
+type validationCheck struct {
+	Name    string   `json:"name"`
+	Status  string   `json:"status"`
+	Message string   `json:"message"`
+	Hints   []string `json:"hints,omitempty"`
+}
+
+// configValidationReport is the output of the config validate command
+type configValidationReport struct {
+	Valid     bool              `json:"valid"`
+	Checks    []validationCheck `json:"checks"`
+	Timestamp string            `json:"timestamp"`
+}
+
+// add appends a check; the report is valid only while every check passes
+func (r *configValidationReport) add(check validationCheck) {
+	if len(r.Checks) == 0 {
+		r.Valid = true
+	}
+	r.Checks = append(r.Checks, check)
+	if check.Status != checkPass {
+		r.Valid = false
+	}
+}
+
+// checkProductionConfig checks that the LINCTL_* settings load and validate
+func checkProductionConfig(prodConfig *config.ProductionConfig, loadErr error) validationCheck {
+	check := validationCheck{Name: "production_config"}
+	err := loadErr
+	if err == nil {
+		err = prodConfig.Validate()
+	}
+	if err != nil {
+		check.Status = checkFail
+		check.Message = fmt.Sprintf("Invalid configuration: %v", err)
+		check.Hints = []string{"Review the LINCTL_* environment variables: linctl --help"}
+		return check
+	}
+
+	check.Status = checkPass
+	check.Message = "Production configuration is valid"
+	return check
+}
+
+// checkOAuthConfig checks that the OAuth client credentials are configured
+func checkOAuthConfig(oauthConfig *oauth.Config, loadErr error) validationCheck {
+	check := validationCheck{Name: "oauth_config"}
+	if loadErr != nil {
+		check.Status = checkFail
+		check.Message = fmt.Sprintf("Failed to load OAuth configuration: %v", loadErr)
+		return check
+	}
+	if !oauthConfig.IsComplete() {
+		check.Status = checkFail
+		check.Message = "OAuth configuration is incomplete"
+		check.Hints = []string{"Set LINEAR_CLIENT_ID and LINEAR_CLIENT_SECRET"}
+		return check
+	}
+
+	check.Status = checkPass
+	check.Message = fmt.Sprintf("OAuth client %s configured for %s", oauthConfig.ClientID, oauthConfig.BaseURL)
+	return check
+}
+
+// checkToken checks that the current credentials are accepted by the API
+func checkToken(ctx context.Context, authHeader string, authErr error, validate func(ctx context.Context, authHeader string) error) validationCheck {
+	check := validationCheck{Name: "token"}
+	if authErr != nil {
+		check.Status = checkFail
+		check.Message = "No credentials available"
+		check.Hints = []string{"Authenticate with: linctl auth login --oauth (recommended) or linctl auth login"}
+		return check
+	}
+	if err := validate(ctx, authHeader); err != nil {
+		check.Status = checkFail
+		check.Message = fmt.Sprintf("Token was rejected: %v", err)
+		check.Hints = []string{"Refresh with: linctl auth refresh", "Or re-authenticate with: linctl auth login --oauth"}
+		return check
+	}
+
+	check.Status = checkPass
+	check.Message = "Token is valid"
+	return check
+}
+
+// validateAuthHeader makes a live call with the auth header. OAuth tokens go
+// through OAuthClient.ValidateToken; API keys are checked with a viewer query.
+func validateAuthHeader(ctx context.Context, authHeader string, oauthConfig *oauth.Config) error {
+	if token, ok := strings.CutPrefix(authHeader, "Bearer "); ok {
+		baseURL := ""
+		clientID := ""
+		if oauthConfig != nil {
+			baseURL = oauthConfig.BaseURL
+			clientID = oauthConfig.ClientID
+		}
+		return oauth.NewOAuthClient(clientID, "", baseURL).ValidateToken(ctx, token)
+	}
+
+	_, err := api.NewClient(authHeader).GetViewer(ctx)
+	return err
+}
+
+// checkAgentEnvironment converts the agent environment validation into a check
+func checkAgentEnvironment(response *agent.AgentResponse) validationCheck {
+	check := validationCheck{Name: "agent_environment"}
+	if !response.Success {
+		check.Status = checkFail
+		check.Message = "Agent environment is not ready"
+		if response.Error != nil {
+			check.Message = fmt.Sprintf("%s: %s", response.Error.Code, response.Error.Message)
+			check.Hints = response.Error.Suggestions
+		}
+		return check
+	}
+
+	check.Status = checkPass
+	check.Message = "Agent environment is ready"
+	return check
+}
+
+// printValidationReport prints each check in plaintext or rich format
+func printValidationReport(report *configValidationReport, plaintext bool) {
+	for _, check := range report.Checks {
+		if plaintext {
+			fmt.Printf("%s\t%s\t%s\n", check.Status, check.Name, check.Message)
+			for _, hint := range check.Hints {
+				fmt.Printf("Hint: %s\n", hint)
+			}
+			continue
+		}
+
+		mark := color.New(color.FgGreen).Sprint("✓")
+		if check.Status != checkPass {
+			mark = color.New(color.FgRed).Sprint("✗")
+		}
+		fmt.Printf("%s %s %s\n", mark, color.New(color.Bold).Sprint(check.Name+":"), check.Message)
+		for _, hint := range check.Hints {
+			fmt.Printf("  %s %s\n", color.New(color.FgBlue).Sprint("💡"), hint)
+		}
+	}
+
+	if plaintext {
+		return
+	}
+	fmt.Println()
+	if report.Valid {
+		fmt.Println(color.New(color.FgGreen).Sprint("✅ All checks passed"))
+	} else {
+		fmt.Println(color.New(color.FgRed).Sprint("❌ Some checks failed"))
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configMetricsCmd)
+	configCmd.AddCommand(configValidateCmd)
+
+	configMetricsCmd.Flags().String("format", "", "Output format: json or prometheus (default: text, or json with --json)")
+	_ = configMetricsCmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions([]string{metricsFormatJSON, metricsFormatPrometheus}, cobra.ShellCompDirectiveNoFileComp))
+}
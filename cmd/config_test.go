@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/agent"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/config"
+	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/oauth"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+	"github.com/nicholls-inc/linctl/pkg/resilience"
+)
+
+func newMetricsTestClient(t *testing.T, failures int) *api.EnhancedClient {
+	t.Helper()
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"viewer":{"id":"user-1"}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	clientConfig := api.DefaultEnhancedClientConfig()
+	clientConfig.BaseURL = server.URL
+	clientConfig.Logger = logging.NewNoOpLogger()
+	clientConfig.RetryConfig = resilience.RetryConfig{
+		MaxAttempts:  3,
+		InitialDelay: 5 * time.Millisecond,
+		MaxDelay:     50 * time.Millisecond,
+		Multiplier:   2.0,
+	}
+	return api.NewEnhancedClient("test-auth", clientConfig)
+}
+
+func TestCollectMetrics_RecordsRetries(t *testing.T) {
+	prodConfig := &config.ProductionConfig{Retry: resilience.RetryConfig{MaxAttempts: 3}}
+
+	report := collectMetrics(context.Background(), newMetricsTestClient(t, 2), prodConfig)
+
+	if report.ProbeError != "" {
+		t.Fatalf("Unexpected probe error: %s", report.ProbeError)
+	}
+	if report.Metrics.RequestCount != 1 || report.Metrics.RetryCount != 2 {
+		t.Errorf("Expected 1 request with 2 retries, got %+v", report.Metrics)
+	}
+	if report.Metrics.RetryWaitTotalMS != 15 {
+		t.Errorf("Expected 15ms retry wait, got %d", report.Metrics.RetryWaitTotalMS)
+	}
+	if report.MaxAttempts != 3 {
+		t.Errorf("Expected max attempts 3, got %d", report.MaxAttempts)
+	}
+	if report.ExportPath != "" {
+		t.Errorf("Expected no export when metrics are disabled, got %s", report.ExportPath)
+	}
+}
+
+func TestCollectMetrics_ExportsWhenEnabled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	prodConfig := &config.ProductionConfig{
+		Retry:   resilience.RetryConfig{MaxAttempts: 3},
+		Metrics: config.MetricsConfig{Enabled: true, ExportPath: path},
+	}
+
+	report := collectMetrics(context.Background(), newMetricsTestClient(t, 1), prodConfig)
+
+	if report.ExportPath != path || report.ExportError != "" {
+		t.Fatalf("Expected export to %s, got %+v", path, report)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected metrics file: %v", err)
+	}
+	var exported api.ClientMetrics
+	if err := json.Unmarshal(data, &exported); err != nil {
+		t.Fatalf("Expected JSON metrics: %v", err)
+	}
+	if exported.RetryCount != 1 || exported.RequestCount != 1 {
+		t.Errorf("Unexpected exported metrics: %+v", exported)
+	}
+}
+
+func TestWritePrometheusMetrics(t *testing.T) {
+	metrics := api.ClientMetrics{
+		RequestCount:   4,
+		ErrorCount:     1,
+		RateLimitHits:  2,
+		RetryCount:     3,
+		RetryWaitTotal: 1500 * time.Millisecond,
+		TotalDuration:  2 * time.Second,
+		CircuitState:   "closed",
+	}
+	rateLimit := map[string]interface{}{
+		"enabled":             true,
+		"requests_per_second": 10.0,
+		"burst":               20,
+		"linear_remaining":    1490,
+	}
+
+	var buf bytes.Buffer
+	writePrometheusMetrics(&buf, metrics, rateLimit, true)
+	out := buf.String()
+
+	for _, want := range []string{
+		"# TYPE linctl_requests_total counter\nlinctl_requests_total 4\n",
+		"linctl_errors_total 1\n",
+		"linctl_rate_limit_hits_total 2\n",
+		"linctl_retry_wait_seconds_total 1.5\n",
+		"# TYPE linctl_request_duration_seconds summary\n",
+		"linctl_request_duration_seconds_sum 2\n",
+		"linctl_request_duration_seconds_count 4\n",
+		"linctl_rate_limit_requests_per_second 10\n",
+		"linctl_rate_limit_burst 20\n",
+		"linctl_rate_limit_linear_remaining 1490\n",
+		"linctl_rate_limit_enabled 1\n",
+		`linctl_circuit_breaker_state{state="closed"} 1`,
+		"linctl_probe_success 1\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "linctl_rate_limit_linear_limit") {
+		t.Error("Expected Linear's limit to be omitted before it is reported")
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		if !strings.HasPrefix(line, "# ") && len(strings.Fields(line)) != 2 {
+			t.Errorf("Malformed sample line %q", line)
+		}
+	}
+}
+
+func TestConfigValidationChecks(t *testing.T) {
+	validConfig := &config.ProductionConfig{
+		Retry:     resilience.DefaultRetryConfig(),
+		RateLimit: ratelimit.DefaultRateLimitConfig(),
+		Logging:   config.LoggingConfig{Level: "info", Format: "text"},
+	}
+	invalidConfig := &config.ProductionConfig{
+		Retry:     resilience.RetryConfig{},
+		RateLimit: ratelimit.DefaultRateLimitConfig(),
+		Logging:   config.LoggingConfig{Level: "info", Format: "text"},
+	}
+	completeOAuth := &oauth.Config{ClientID: "client", ClientSecret: "secret", BaseURL: "https://api.linear.app"}
+	accept := func(ctx context.Context, authHeader string) error { return nil }
+	reject := func(ctx context.Context, authHeader string) error {
+		return errors.New("access token is invalid or expired")
+	}
+
+	tests := []struct {
+		name       string
+		check      validationCheck
+		wantStatus string
+		wantHints  bool
+	}{
+		{name: "valid production config", check: checkProductionConfig(validConfig, nil), wantStatus: checkPass},
+		{name: "invalid production config", check: checkProductionConfig(invalidConfig, nil), wantStatus: checkFail, wantHints: true},
+		{name: "production config load error", check: checkProductionConfig(nil, errors.New("boom")), wantStatus: checkFail, wantHints: true},
+		{name: "complete oauth config", check: checkOAuthConfig(completeOAuth, nil), wantStatus: checkPass},
+		{name: "incomplete oauth config", check: checkOAuthConfig(&oauth.Config{ClientID: "client"}, nil), wantStatus: checkFail, wantHints: true},
+		{name: "valid token", check: checkToken(context.Background(), "Bearer token", nil, accept), wantStatus: checkPass},
+		{name: "rejected token", check: checkToken(context.Background(), "Bearer token", nil, reject), wantStatus: checkFail, wantHints: true},
+		{name: "no credentials", check: checkToken(context.Background(), "", errors.New("not authenticated"), accept), wantStatus: checkFail, wantHints: true},
+		{name: "agent ready", check: checkAgentEnvironment(&agent.AgentResponse{Success: true}), wantStatus: checkPass},
+		{
+			name: "agent not ready",
+			check: checkAgentEnvironment(&agent.AgentResponse{Error: &agent.AgentError{
+				Code:        "NOT_AUTHENTICATED",
+				Message:     "Not authenticated with Linear",
+				Suggestions: []string{"Run authentication: linctl auth login --oauth"},
+			}}),
+			wantStatus: checkFail,
+			wantHints:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.check.Status != tt.wantStatus {
+				t.Errorf("Expected status %s, got %s (%s)", tt.wantStatus, tt.check.Status, tt.check.Message)
+			}
+			if (len(tt.check.Hints) > 0) != tt.wantHints {
+				t.Errorf("Expected hints=%v, got %v", tt.wantHints, tt.check.Hints)
+			}
+		})
+	}
+}
+
+func TestConfigValidationReport_FailsOnAnyCheck(t *testing.T) {
+	report := &configValidationReport{}
+	report.add(validationCheck{Name: "first", Status: checkPass})
+	if !report.Valid {
+		t.Fatal("Expected report to be valid after a passing check")
+	}
+
+	report.add(validationCheck{Name: "second", Status: checkFail})
+	report.add(validationCheck{Name: "third", Status: checkPass})
+	if report.Valid {
+		t.Error("Expected report to be invalid once any check fails")
+	}
+	if len(report.Checks) != 3 {
+		t.Errorf("Expected 3 checks, got %d", len(report.Checks))
+	}
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// commandCtx is the context every API call made by the running command derives
+// from. It carries the --timeout deadline, if any.
+var commandCtx = context.Background()
+
+// cancelCommand releases the --timeout timer once the command returns
+var cancelCommand context.CancelFunc = func() {}
+
+// commandTimeoutError is the cause of commandCtx's cancellation when --timeout
+// expires, so failed requests say why they were cut short
+type commandTimeoutError struct {
+	Timeout time.Duration
+}
+
+// Error implements error
+func (e *commandTimeoutError) Error() string {
+	return fmt.Sprintf("linctl did not finish within --timeout %s", e.Timeout)
+}
+
+// Unwrap lets errors.Is match context.DeadlineExceeded
+func (e *commandTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// commandContext returns the context for the running command's API calls
+func commandContext() context.Context {
+	return commandCtx
+}
+
+// startCommandContext derives the command context from parent, bounded by
+// timeout when it is positive. The returned cancel releases its timer.
+func startCommandContext(parent context.Context, timeout time.Duration) (context.CancelFunc, error) {
+	if timeout < 0 {
+		return nil, fmt.Errorf("--timeout must not be negative")
+	}
+	if parent == nil {
+		parent = context.Background()
+	}
+	if timeout == 0 {
+		commandCtx = parent
+		return func() {}, nil
+	}
+
+	ctx, cancel := context.WithTimeoutCause(parent, timeout, &commandTimeoutError{Timeout: timeout})
+	commandCtx = ctx
+	return cancel, nil
+}
+
+// commandTimedOut returns the --timeout error if the command ran out of time
+func commandTimedOut() error {
+	var timeoutErr *commandTimeoutError
+	if errors.As(context.Cause(commandCtx), &timeoutErr) {
+		return timeoutErr
+	}
+	return nil
+}
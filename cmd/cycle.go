@@ -0,0 +1,371 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/security"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Keywords accepted by --cycle in place of a cycle number
+const (
+	cycleCurrent = "current"
+	cycleNext    = "next"
+)
+
+// cycleCmd represents the cycle command
+var cycleCmd = &cobra.Command{
+	Use:     "cycle",
+	Aliases: []string{"cycles", "sprint"},
+	Short:   "Manage Linear cycles",
+	Long: `Manage a team's cycles (sprints).
+
+Examples:
+  linctl cycle list --team ENG                       # List team cycles
+  linctl issue create --title "Bug" --team ENG --cycle current  # Add a new issue to the current cycle
+  linctl issue cycle LIN-123 --set next              # Move an existing issue to the next cycle`,
+}
+
+var cycleListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List cycles",
+	Long:    `List a team's cycles with their dates and completion.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamKey, _ := cmd.Flags().GetString("team")
+		if err := security.ValidateTeamKey(teamKey); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+
+		team, err := client.GetTeam(commandContext(), teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		cycles, err := fetchTeamCycles(commandContext(), client, team.ID)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list cycles: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		sortCycles(cycles)
+
+		if jsonOut {
+			if cycles == nil {
+				cycles = []api.Cycle{}
+			}
+			output.JSON(cycles)
+		} else if plaintext {
+			fmt.Println("Number\tName\tStarts\tEnds\tProgress")
+			for _, cycle := range cycles {
+				fmt.Printf("%d\t%s\t%s\t%s\t%.0f%%\n",
+					cycle.Number,
+					cycleName(cycle),
+					formatCycleDate(cycle.StartsAt),
+					formatCycleDate(cycle.EndsAt),
+					cycle.Progress*100,
+				)
+			}
+		} else {
+			now := time.Now()
+			current, _ := resolveCycle(cycles, cycleCurrent, now)
+
+			headers := []string{"#", "Name", "Starts", "Ends", "Progress"}
+			rows := [][]string{}
+			for _, cycle := range cycles {
+				name := cycleName(cycle)
+				if current != nil && cycle.ID == current.ID {
+					name = color.New(color.FgGreen, color.Bold).Sprint(name + " (current)")
+				}
+
+				progress := fmt.Sprintf("%.0f%%", cycle.Progress*100)
+				if cycle.CompletedAt != nil {
+					progress = color.New(color.FgGreen).Sprint(progress + " ✓")
+				}
+
+				rows = append(rows, []string{
+					color.New(color.FgCyan, color.Bold).Sprint(cycle.Number),
+					name,
+					formatCycleDate(cycle.StartsAt),
+					formatCycleDate(cycle.EndsAt),
+					progress,
+				})
+			}
+
+			output.Table(output.TableData{
+				Headers: headers,
+				Rows:    rows,
+			}, plaintext, jsonOut)
+
+			fmt.Printf("\n%s %d cycles in team %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				len(cycles),
+				color.New(color.FgCyan).Sprint(teamKey))
+		}
+	},
+}
+
+var issueCycleCmd = &cobra.Command{
+	Use:               "cycle ISSUE-ID (--set CYCLE | --clear)",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "Move an issue to another cycle or out of its cycle",
+	Long: `Move an existing issue to a cycle of its team, or remove it from its cycle.
+--set takes current, next, or a cycle number. Only the cycle is changed.
+
+Examples:
+  linctl issue cycle LIN-123 --set current   # Pull into the running cycle
+  linctl issue cycle LIN-123 --set next      # Push to the next cycle
+  linctl issue cycle LIN-123 --set 42        # Move to cycle 42
+  linctl issue cycle LIN-123 --clear         # Take it out of its cycle`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		issueID := strings.TrimSpace(args[0])
+		cycleValue, _ := cmd.Flags().GetString("set")
+		clearCycle, _ := cmd.Flags().GetBool("clear")
+		setCycle := strings.TrimSpace(cycleValue) != ""
+
+		if err := security.ValidateIssueID(issueID); err != nil {
+			output.Error(fmt.Sprintf("Invalid issue ID: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if setCycle == clearCycle {
+			output.Error("Use exactly one of --set or --clear", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
+		requireScope(authHeader, "issue.cycle", plaintext, jsonOut)
+
+		change, err := setIssueCycle(commandContext(), client, issueID, cycleValue, time.Now())
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		recordAudit("issue.cycle", issueID, "", err)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to change cycle: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(change.Issue)
+			return
+		}
+
+		from, to := "no cycle", "no cycle"
+		if change.From != nil {
+			from = cycleName(*change.From)
+		}
+		if change.To != nil {
+			to = cycleName(*change.To)
+		}
+		if plaintext {
+			fmt.Printf("Moved %s from %s to %s\n", change.Issue.Identifier, from, to)
+			return
+		}
+		fmt.Printf("%s Moved %s from %s to %s\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			color.New(color.FgCyan, color.Bold).Sprint(change.Issue.Identifier),
+			from,
+			color.New(color.FgCyan).Sprint(to))
+	},
+}
+
+// issueCycleChange is the result of issue cycle: the updated issue and the
+// cycles it moved between, nil meaning no cycle
+type issueCycleChange struct {
+	Issue *api.Issue
+	From  *api.Cycle
+	To    *api.Cycle
+}
+
+// cyclePageSize is the number of cycles requested per page
+const cyclePageSize = 100
+
+// cycleLister is the subset of the API client used to list a team's cycles
+type cycleLister interface {
+	GetCycles(ctx context.Context, teamID string, first int, after string) (*api.Cycles, error)
+}
+
+// fetchTeamCycles pages through every cycle of a team. Teams that have run
+// cycles for years have more than one page, and resolveCycle needs them all
+// to find the current and next cycle.
+func fetchTeamCycles(ctx context.Context, client cycleLister, teamID string) ([]api.Cycle, error) {
+	cycles, _, err := fetchLimited(ctx, 0, cyclePageSize, func(first int, after string) ([]api.Cycle, api.PageInfo, error) {
+		page, err := client.GetCycles(ctx, teamID, first, after)
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		return page.Nodes, page.PageInfo, nil
+	})
+	return cycles, err
+}
+
+// issueCycleClient is the subset of the API client used by issue cycle
+type issueCycleClient interface {
+	cycleLister
+	GetIssue(ctx context.Context, id string) (*api.Issue, error)
+	UpdateIssue(ctx context.Context, id string, input map[string]interface{}) (*api.Issue, error)
+}
+
+// setIssueCycle moves issueID to the cycle of its team named by value, as
+// resolved by resolveCycle, or out of its cycle when value is empty
+func setIssueCycle(ctx context.Context, client issueCycleClient, issueID, value string, now time.Time) (*issueCycleChange, error) {
+	issue, err := client.GetIssue(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	change := &issueCycleChange{From: issue.Cycle}
+
+	input := map[string]interface{}{"cycleId": nil}
+	if strings.TrimSpace(value) != "" {
+		if issue.Team == nil {
+			return nil, fmt.Errorf("issue %s has no team", issueID)
+		}
+		cycles, err := fetchTeamCycles(ctx, client, issue.Team.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cycles for team %s: %w", issue.Team.Key, err)
+		}
+		cycle, err := resolveCycle(cycles, value, now)
+		if err != nil {
+			return nil, err
+		}
+		change.To = cycle
+		input["cycleId"] = cycle.ID
+	}
+
+	change.Issue, err = client.UpdateIssue(ctx, issueID, input)
+	if err != nil {
+		return nil, err
+	}
+	if change.Issue.Cycle != nil {
+		change.To = change.Issue.Cycle
+	}
+	return change, nil
+}
+
+// sortCycles orders cycles by number, oldest first
+func sortCycles(cycles []api.Cycle) {
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i].Number < cycles[j].Number
+	})
+}
+
+// resolveCycle finds the cycle named by value: "current" is the cycle whose
+// dates contain now, "next" the earliest cycle starting after now, and anything
+// else is treated as a cycle number
+func resolveCycle(cycles []api.Cycle, value string, now time.Time) (*api.Cycle, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	switch value {
+	case cycleCurrent:
+		for i := range cycles {
+			startsAt, endsAt, ok := cycleDates(cycles[i])
+			if ok && !now.Before(startsAt) && now.Before(endsAt) {
+				return &cycles[i], nil
+			}
+		}
+		return nil, fmt.Errorf("team has no current cycle")
+	case cycleNext:
+		var next *api.Cycle
+		var nextStart time.Time
+		for i := range cycles {
+			startsAt, _, ok := cycleDates(cycles[i])
+			if ok && startsAt.After(now) && (next == nil || startsAt.Before(nextStart)) {
+				next = &cycles[i]
+				nextStart = startsAt
+			}
+		}
+		if next == nil {
+			return nil, fmt.Errorf("team has no upcoming cycle")
+		}
+		return next, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cycle %q: use current, next, or a cycle number", value)
+	}
+	for i := range cycles {
+		if cycles[i].Number == number {
+			return &cycles[i], nil
+		}
+	}
+	return nil, fmt.Errorf("cycle %d not found", number)
+}
+
+// cycleDates parses a cycle's start and end times
+func cycleDates(cycle api.Cycle) (time.Time, time.Time, bool) {
+	startsAt, err := time.Parse(time.RFC3339, cycle.StartsAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	endsAt, err := time.Parse(time.RFC3339, cycle.EndsAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	return startsAt, endsAt, true
+}
+
+// cycleName returns the cycle's name, or "Cycle N" for unnamed cycles
+func cycleName(cycle api.Cycle) string {
+	if cycle.Name != "" {
+		return cycle.Name
+	}
+	return fmt.Sprintf("Cycle %d", cycle.Number)
+}
+
+// formatCycleDate shortens an RFC3339 timestamp to its date
+func formatCycleDate(value string) string {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.Format("2006-01-02")
+	}
+	return value
+}
+
+func init() {
+	rootCmd.AddCommand(cycleCmd)
+	cycleCmd.AddCommand(cycleListCmd)
+
+	cycleListCmd.Flags().StringP("team", "t", "", "Team key (required)")
+	_ = cycleListCmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
+
+	issueCmd.AddCommand(issueCycleCmd)
+	issueCycleCmd.Flags().String("set", "", "Cycle to move the issue to: current, next, or a cycle number")
+	issueCycleCmd.Flags().Bool("clear", false, "Remove the issue from its cycle")
+	issueCycleCmd.Flags().Bool("dry-run", false, "Print the API request without changing the issue")
+	issueCycleCmd.MarkFlagsMutuallyExclusive("set", "clear")
+	_ = issueCycleCmd.RegisterFlagCompletionFunc("set", cobra.FixedCompletions([]string{cycleCurrent, cycleNext}, cobra.ShellCompDirectiveNoFileComp))
+}
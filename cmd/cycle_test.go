@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+func TestResolveCycle(t *testing.T) {
+	cycles := []api.Cycle{
+		{ID: "cycle-3", Number: 3, StartsAt: "2024-01-29T00:00:00.000Z", EndsAt: "2024-02-12T00:00:00.000Z"},
+		{ID: "cycle-1", Number: 1, StartsAt: "2024-01-01T00:00:00.000Z", EndsAt: "2024-01-15T00:00:00.000Z"},
+		{ID: "cycle-2", Number: 2, StartsAt: "2024-01-15T00:00:00.000Z", EndsAt: "2024-01-29T00:00:00.000Z"},
+	}
+	now := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		now      time.Time
+		expected string
+		wantErr  bool
+	}{
+		{name: "current", value: "current", now: now, expected: "cycle-2"},
+		{name: "current is case-insensitive", value: " Current ", now: now, expected: "cycle-2"},
+		{name: "current at start boundary", value: "current", now: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), expected: "cycle-2"},
+		{name: "next", value: "next", now: now, expected: "cycle-3"},
+		{name: "cycle number", value: "1", now: now, expected: "cycle-1"},
+		{name: "no current cycle", value: "current", now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), wantErr: true},
+		{name: "no next cycle", value: "next", now: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), wantErr: true},
+		{name: "unknown number", value: "9", now: now, wantErr: true},
+		{name: "invalid keyword", value: "previous", now: now, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cycle, err := resolveCycle(cycles, tt.value, tt.now)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got cycle %s", cycle.ID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if cycle.ID != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, cycle.ID)
+			}
+		})
+	}
+}
+
+// fakeCycleLister serves cycles two per page
+type fakeCycleLister struct {
+	cycles  []api.Cycle
+	cursors []string
+}
+
+func (f *fakeCycleLister) GetCycles(ctx context.Context, teamID string, first int, after string) (*api.Cycles, error) {
+	f.cursors = append(f.cursors, after)
+	start := 0
+	if after != "" {
+		fmt.Sscanf(after, "cursor-%d", &start)
+	}
+	end := start + 2
+	if end > len(f.cycles) {
+		end = len(f.cycles)
+	}
+	return &api.Cycles{
+		Nodes:    f.cycles[start:end],
+		PageInfo: api.PageInfo{HasNextPage: end < len(f.cycles), EndCursor: fmt.Sprintf("cursor-%d", end)},
+	}, nil
+}
+
+func TestFetchTeamCycles(t *testing.T) {
+	client := &fakeCycleLister{cycles: []api.Cycle{
+		{ID: "cycle-1", Number: 1}, {ID: "cycle-2", Number: 2}, {ID: "cycle-3", Number: 3},
+	}}
+
+	cycles, err := fetchTeamCycles(context.Background(), client, "team-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(cycles) != 3 || cycles[2].ID != "cycle-3" {
+		t.Errorf("Expected all three cycles, got %+v", cycles)
+	}
+	if len(client.cursors) != 2 || client.cursors[1] != "cursor-2" {
+		t.Errorf("Expected two pages, got cursors %v", client.cursors)
+	}
+}
+
+// fakeIssueCycleClient serves one issue and its team's cycles, two per page,
+// and records the update
+type fakeIssueCycleClient struct {
+	fakeCycleLister
+	issue *api.Issue
+	input map[string]interface{}
+}
+
+func (f *fakeIssueCycleClient) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	return f.issue, nil
+}
+
+func (f *fakeIssueCycleClient) UpdateIssue(ctx context.Context, id string, input map[string]interface{}) (*api.Issue, error) {
+	f.input = input
+	updated := *f.issue
+	updated.Cycle = nil
+	if cycleID, ok := input["cycleId"].(string); ok {
+		for i := range f.cycles {
+			if f.cycles[i].ID == cycleID {
+				updated.Cycle = &f.cycles[i]
+			}
+		}
+	}
+	return &updated, nil
+}
+
+func TestSetIssueCycle(t *testing.T) {
+	cycles := []api.Cycle{
+		{ID: "cycle-1", Number: 1, StartsAt: "2024-01-01T00:00:00.000Z", EndsAt: "2024-01-15T00:00:00.000Z"},
+		{ID: "cycle-2", Number: 2, StartsAt: "2024-01-15T00:00:00.000Z", EndsAt: "2024-01-29T00:00:00.000Z"},
+	}
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	newClient := func() *fakeIssueCycleClient {
+		return &fakeIssueCycleClient{
+			issue: &api.Issue{
+				Identifier: "ENG-1",
+				Team:       &api.Team{ID: "team-1", Key: "ENG"},
+				Cycle:      &cycles[0],
+			},
+			fakeCycleLister: fakeCycleLister{cycles: cycles},
+		}
+	}
+
+	t.Run("set next", func(t *testing.T) {
+		client := newClient()
+		change, err := setIssueCycle(context.Background(), client, "ENG-1", "next", now)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(client.input) != 1 || client.input["cycleId"] != "cycle-2" {
+			t.Errorf("Expected only cycleId=cycle-2, got %v", client.input)
+		}
+		if change.From == nil || change.From.ID != "cycle-1" {
+			t.Errorf("Expected to move from cycle-1, got %+v", change.From)
+		}
+		if change.To == nil || change.To.ID != "cycle-2" || change.Issue.Cycle == nil {
+			t.Errorf("Expected the issue to be in cycle-2, got %+v", change.To)
+		}
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		client := newClient()
+		change, err := setIssueCycle(context.Background(), client, "ENG-1", "", now)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if cycleID, ok := client.input["cycleId"]; !ok || cycleID != nil {
+			t.Errorf("Expected cycleId to be cleared, got %v", client.input)
+		}
+		if change.To != nil || change.Issue.Cycle != nil {
+			t.Errorf("Expected the issue to have no cycle, got %+v", change.To)
+		}
+	})
+
+	t.Run("current on a later page", func(t *testing.T) {
+		client := newClient()
+		client.cycles = []api.Cycle{
+			{ID: "cycle-1", Number: 1, StartsAt: "2023-12-04T00:00:00.000Z", EndsAt: "2023-12-18T00:00:00.000Z"},
+			{ID: "cycle-2", Number: 2, StartsAt: "2023-12-18T00:00:00.000Z", EndsAt: "2024-01-01T00:00:00.000Z"},
+			{ID: "cycle-3", Number: 3, StartsAt: "2024-01-01T00:00:00.000Z", EndsAt: "2024-01-15T00:00:00.000Z"},
+			{ID: "cycle-4", Number: 4, StartsAt: "2024-01-15T00:00:00.000Z", EndsAt: "2024-01-29T00:00:00.000Z"},
+			{ID: "cycle-5", Number: 5, StartsAt: "2024-01-29T00:00:00.000Z", EndsAt: "2024-02-12T00:00:00.000Z"},
+		}
+
+		change, err := setIssueCycle(context.Background(), client, "ENG-1", "current", now)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(client.cursors) != 3 {
+			t.Errorf("Expected all three pages of cycles to be fetched, got cursors %v", client.cursors)
+		}
+		if client.input["cycleId"] != "cycle-3" || change.To == nil || change.To.ID != "cycle-3" {
+			t.Errorf("Expected the issue to move to cycle-3, got %v", client.input)
+		}
+
+		if _, err := setIssueCycle(context.Background(), client, "ENG-1", "next", now); err != nil || client.input["cycleId"] != "cycle-4" {
+			t.Errorf("Expected next to resolve to cycle-4, got %v (%v)", client.input, err)
+		}
+	})
+
+	t.Run("unknown cycle", func(t *testing.T) {
+		client := newClient()
+		if _, err := setIssueCycle(context.Background(), client, "ENG-1", "9", now); err == nil {
+			t.Fatal("Expected an error for a cycle the team does not have")
+		}
+		if client.input != nil {
+			t.Error("Expected no update when the cycle cannot be resolved")
+		}
+	})
+}
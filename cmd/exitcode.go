@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+	"github.com/nicholls-inc/linctl/pkg/security"
+)
+
+// Exit codes shared by every command, so scripts can branch on why a command
+// failed. 2 is left to auth status --check-expiry.
+const (
+	exitError       = 1
+	exitAuth        = 3
+	exitPermission  = 4
+	exitNotFound    = 5
+	exitRateLimited = 6
+	exitValidation  = 7
+)
+
+// exitCodeInfo describes one exit code for --help and --exit-code-map
+type exitCodeInfo struct {
+	Code    int    `json:"code"`
+	Name    string `json:"name"`
+	Meaning string `json:"meaning"`
+}
+
+// exitCodeMap lists the exit codes in the order they are documented
+var exitCodeMap = []exitCodeInfo{
+	{0, "success", "The command succeeded"},
+	{exitError, "error", "Any other failure"},
+	{2, "token_expiring", "auth status --check-expiry: the OAuth token expires soon"},
+	{exitAuth, "auth", "Not authenticated, or Linear rejected the credentials"},
+	{exitPermission, "permission", "The token lacks a required OAuth scope or access was forbidden"},
+	{exitNotFound, "not_found", "The issue, user or other entity does not exist"},
+	{exitRateLimited, "rate_limited", "Rate limited by Linear, or --max-wait or --timeout was exceeded"},
+	{exitValidation, "validation", "Invalid flags or input, rejected locally or by Linear"},
+}
+
+// exitCode returns the exit code for err: the API error category, a local
+// validation error, a rate limit wait or timeout, and exitError for anything else
+func exitCode(err error) int {
+	if err == nil {
+		return exitError
+	}
+
+	var waitErr *ratelimit.ErrRateLimitWait
+	if errors.As(err, &waitErr) || errors.Is(err, context.DeadlineExceeded) {
+		return exitRateLimited
+	}
+	var validationErr security.ValidationError
+	if errors.As(err, &validationErr) {
+		return exitValidation
+	}
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden {
+		return exitPermission
+	}
+
+	switch api.ErrorCategoryOf(err) {
+	case api.CategoryUnauthorized:
+		return exitAuth
+	case api.CategoryForbidden:
+		return exitPermission
+	case api.CategoryNotFound:
+		return exitNotFound
+	case api.CategoryRateLimited:
+		return exitRateLimited
+	case api.CategoryValidation:
+		return exitValidation
+	}
+
+	if isNotFoundError(err) {
+		return exitNotFound
+	}
+	return exitError
+}
+
+// exitCodeHelp is the exit code table appended to the root command's help
+func exitCodeHelp() string {
+	help := "\nExit codes:\n"
+	for _, info := range exitCodeMap {
+		help += fmt.Sprintf("  %d  %s\n", info.Code, info.Meaning)
+	}
+	return help
+}
+
+// printExitCodeMap prints the exit codes for --exit-code-map
+func printExitCodeMap(plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(exitCodeMap)
+		return
+	}
+
+	rows := make([][]string, len(exitCodeMap))
+	for i, info := range exitCodeMap {
+		code := fmt.Sprint(info.Code)
+		if !plaintext {
+			code = color.New(color.FgCyan, color.Bold).Sprint(code)
+		}
+		rows[i] = []string{code, info.Name, info.Meaning}
+	}
+	output.Table(output.TableData{
+		Headers: []string{"Code", "Name", "Meaning"},
+		Rows:    rows,
+	}, plaintext, jsonOut)
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+	"github.com/nicholls-inc/linctl/pkg/security"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, exitError},
+		{"plain error", errors.New("boom"), exitError},
+		{"unauthorized", &api.APIError{Category: api.CategoryUnauthorized, StatusCode: 401}, exitAuth},
+		{"forbidden", &api.APIError{Category: api.CategoryUnauthorized, StatusCode: 403}, exitPermission},
+		{"graphql forbidden", &api.APIError{Category: api.CategoryForbidden, StatusCode: 200}, exitPermission},
+		{"not found", &api.APIError{Category: api.CategoryNotFound}, exitNotFound},
+		{"rate limited", &api.APIError{Category: api.CategoryRateLimited, StatusCode: 429}, exitRateLimited},
+		{"api validation", &api.APIError{Category: api.CategoryValidation}, exitValidation},
+		{"unknown category", &api.APIError{Category: api.CategoryUnknown, StatusCode: 500}, exitError},
+		{"wrapped api error", fmt.Errorf("failed to get issue: %w", &api.APIError{Category: api.CategoryNotFound}), exitNotFound},
+		{"max wait exceeded", &ratelimit.ErrRateLimitWait{Wait: time.Minute, Max: time.Second}, exitRateLimited},
+		{"command timeout", fmt.Errorf("request failed: %w", &commandTimeoutError{Timeout: time.Minute}), exitRateLimited},
+		{"local validation", security.ValidationError{Field: "issue_id", Message: "invalid"}, exitValidation},
+		{"not found message", errors.New("issue not found"), exitNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCode(tt.err); got != tt.want {
+				t.Errorf("exitCode(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExitCodeHelp(t *testing.T) {
+	help := exitCodeHelp()
+	seen := map[int]bool{}
+	for _, info := range exitCodeMap {
+		if seen[info.Code] {
+			t.Errorf("Exit code %d is documented twice", info.Code)
+		}
+		seen[info.Code] = true
+		if !strings.Contains(help, fmt.Sprintf("%d  %s", info.Code, info.Meaning)) {
+			t.Errorf("Help does not document exit code %d", info.Code)
+		}
+	}
+}
@@ -0,0 +1,484 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/security"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Entity types that can be favorited from the command line
+const (
+	favoriteTypeIssue   = "issue"
+	favoriteTypeProject = "project"
+)
+
+// favoritePageSize is how many favorites are requested per page
+const favoritePageSize = 50
+
+// favoriteCmd represents the favorite command
+var favoriteCmd = &cobra.Command{
+	Use:     "favorite",
+	Aliases: []string{"favorites", "fav"},
+	Short:   "Manage your favorite issues and projects",
+	Long: `Add issues and projects to your Linear favorites, list them and remove them.
+
+Examples:
+  linctl favorite add issue LIN-123          # Favorite an issue
+  linctl favorite add project 8f3c2a1b9d4e   # Favorite a project by ID or slug ID
+  linctl favorite list                       # List favorites grouped by type
+  linctl favorite remove issue LIN-123       # Remove an issue from favorites`,
+}
+
+var favoriteAddCmd = &cobra.Command{
+	Use:   "add issue|project ID",
+	Short: "Add an issue or project to your favorites",
+	Long: `Add an issue (by identifier, e.g. LIN-123) or a project (by ID or slug ID)
+to your favorites.
+
+Examples:
+  linctl favorite add issue LIN-123
+  linctl favorite add project 8f3c2a1b9d4e --json`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		kind, id, err := parseFavoriteTarget(args[0], args[1])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
+
+		requireScope(authHeader, "favorite.add", plaintext, jsonOut)
+
+		ctx := commandContext()
+		input, name, err := resolveFavoriteTarget(ctx, client, kind, id)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		favorite, err := client.CreateFavorite(ctx, input)
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		recordAudit("favorite.add", name, "", err)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to add %s %s to favorites: %v", kind, name, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(favorite)
+		} else if plaintext {
+			fmt.Printf("Added %s %s to favorites\n", kind, name)
+		} else {
+			fmt.Printf("%s Added %s %s to favorites\n",
+				color.New(color.FgGreen).Sprint("✓"), kind,
+				color.New(color.FgCyan, color.Bold).Sprint(name))
+		}
+	},
+}
+
+var favoriteListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List your favorites grouped by type",
+	Long: `List your favorites grouped by type, showing each issue's identifier and
+title and each project's name and state. Other favorites (views, cycles, labels)
+are listed by type only.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		ctx := commandContext()
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		favorites, more, err := fetchFavorites(ctx, client, limit)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list favorites: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if err := hydrateFavorites(ctx, client, favorites); err != nil {
+			output.Error(fmt.Sprintf("Failed to load favorites: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		groups := groupFavorites(favorites)
+		if jsonOut {
+			output.JSON(groups)
+			return
+		}
+
+		if len(favorites) == 0 {
+			output.Info("No favorites", plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Println("Type\tName\tTitle\tFavorite ID")
+			for _, group := range groups {
+				for _, favorite := range group.Favorites {
+					name, title := favoriteLabel(favorite)
+					fmt.Printf("%s\t%s\t%s\t%s\n", group.Type, name, title, favorite.ID)
+				}
+			}
+		} else {
+			for _, group := range groups {
+				fmt.Printf("\n%s (%d)\n", color.New(color.FgCyan, color.Bold).Sprint(favoriteGroupTitle(group.Type)), len(group.Favorites))
+				rows := [][]string{}
+				for _, favorite := range group.Favorites {
+					name, title := favoriteLabel(favorite)
+					rows = append(rows, []string{
+						color.New(color.FgCyan).Sprint(name),
+						title,
+						color.New(color.FgWhite, color.Faint).Sprint(favorite.ID),
+					})
+				}
+				output.Table(output.TableData{
+					Headers: []string{"Name", "Title", "Favorite ID"},
+					Rows:    rows,
+				}, plaintext, jsonOut)
+			}
+			fmt.Printf("\n%s %d favorites\n", color.New(color.FgGreen).Sprint("✓"), len(favorites))
+		}
+
+		if more {
+			fmt.Fprintf(os.Stderr, "More favorites exist; raise --limit or pass --limit 0 to list them all\n")
+		}
+	},
+}
+
+var favoriteRemoveCmd = &cobra.Command{
+	Use:     "remove issue|project ID | remove FAVORITE-ID",
+	Aliases: []string{"rm", "delete"},
+	Short:   "Remove an issue or project from your favorites",
+	Long: `Remove an issue or project from your favorites, or remove a favorite by the
+ID shown in 'favorite list'. Removing something that is not a favorite
+succeeds without changing anything.
+
+Examples:
+  linctl favorite remove issue LIN-123
+  linctl favorite remove project 8f3c2a1b9d4e
+  linctl favorite remove 3f2b8c1e-7a54-4d3b-9d0e-2c6f1a8b9e47`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		var kind, id string
+		if len(args) == 1 {
+			id = strings.TrimSpace(args[0])
+			if err := security.ValidateFavoriteID(id); err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		} else {
+			var err error
+			kind, id, err = parseFavoriteTarget(args[0], args[1])
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
+
+		requireScope(authHeader, "favorite.remove", plaintext, jsonOut)
+
+		ctx := commandContext()
+		favoriteID, name := id, id
+		if kind != "" {
+			input, resolved, err := resolveFavoriteTarget(ctx, client, kind, id)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			name = resolved
+
+			favorites, _, err := fetchFavorites(ctx, client, 0)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to list favorites: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			favorite := findFavorite(favorites, input)
+			if favorite == nil {
+				if jsonOut {
+					output.JSON(map[string]interface{}{"removed": false, "type": kind, "id": name})
+				} else {
+					output.Info(fmt.Sprintf("%s %s is not in your favorites", kind, name), plaintext, jsonOut)
+				}
+				return
+			}
+			favoriteID = favorite.ID
+		}
+
+		_, err = client.DeleteFavorite(ctx, favoriteID)
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		recordAudit("favorite.remove", name, "", err)
+		if err != nil {
+			if isNotFoundError(err) {
+				output.Error(fmt.Sprintf("Favorite %s not found", favoriteID), plaintext, jsonOut)
+			} else {
+				output.Error(fmt.Sprintf("Failed to remove favorite: %v", err), plaintext, jsonOut)
+			}
+			os.Exit(exitCode(err))
+		}
+
+		label := name
+		if kind != "" {
+			label = kind + " " + name
+		}
+		if jsonOut {
+			output.JSON(map[string]interface{}{"removed": true, "type": kind, "id": name, "favoriteId": favoriteID})
+		} else if plaintext {
+			fmt.Printf("Removed %s from favorites\n", label)
+		} else {
+			fmt.Printf("%s Removed %s from favorites\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(label))
+		}
+	},
+}
+
+// parseFavoriteTarget checks the type and ID of an issue or project to favorite
+func parseFavoriteTarget(kind, id string) (string, string, error) {
+	kind = strings.ToLower(strings.TrimSpace(kind))
+	id = strings.TrimSpace(id)
+
+	switch kind {
+	case favoriteTypeIssue:
+		if err := security.ValidateIssueID(id); err != nil {
+			return "", "", err
+		}
+	case favoriteTypeProject:
+		if err := security.ValidateProjectID(id); err != nil {
+			return "", "", err
+		}
+	default:
+		return "", "", fmt.Errorf("unsupported favorite type %q: use issue or project", kind)
+	}
+	return kind, id, nil
+}
+
+// favoriteTargetResolver is the subset of the API client used to look up the
+// issue or project behind an identifier or slug
+type favoriteTargetResolver interface {
+	GetIssue(ctx context.Context, id string) (*api.Issue, error)
+	GetProject(ctx context.Context, id string) (*api.Project, error)
+}
+
+// resolveFavoriteTarget looks up the issue or project so the favorite refers
+// to its UUID, returning the input and a display name
+func resolveFavoriteTarget(ctx context.Context, client favoriteTargetResolver, kind, id string) (api.FavoriteInput, string, error) {
+	if kind == favoriteTypeIssue {
+		issue, err := client.GetIssue(ctx, id)
+		if err != nil {
+			return api.FavoriteInput{}, "", fmt.Errorf("failed to get issue %s: %w", id, err)
+		}
+		return api.FavoriteInput{IssueID: issue.ID}, issue.Identifier, nil
+	}
+
+	project, err := client.GetProject(ctx, id)
+	if err != nil {
+		return api.FavoriteInput{}, "", fmt.Errorf("failed to get project %s: %w", id, err)
+	}
+	return api.FavoriteInput{ProjectID: project.ID}, project.Name, nil
+}
+
+// favoriteLister is the subset of the API client used to page through favorites
+type favoriteLister interface {
+	GetFavorites(ctx context.Context, first int, after string) (*api.Favorites, error)
+}
+
+// fetchFavorites returns up to limit favorites (all of them when limit is 0)
+func fetchFavorites(ctx context.Context, client favoriteLister, limit int) ([]api.Favorite, bool, error) {
+	return fetchLimited(ctx, limit, favoritePageSize, func(first int, after string) ([]api.Favorite, api.PageInfo, error) {
+		page, err := client.GetFavorites(ctx, first, after)
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		return page.Nodes, page.PageInfo, nil
+	})
+}
+
+// findFavorite returns the favorite of the issue or project in input, or nil
+func findFavorite(favorites []api.Favorite, input api.FavoriteInput) *api.Favorite {
+	for i, favorite := range favorites {
+		if input.IssueID != "" && favorite.Issue != nil && favorite.Issue.ID == input.IssueID {
+			return &favorites[i]
+		}
+		if input.ProjectID != "" && favorite.Project != nil && favorite.Project.ID == input.ProjectID {
+			return &favorites[i]
+		}
+	}
+	return nil
+}
+
+// favoriteHydrator is the subset of the API client used to load favorited entities
+type favoriteHydrator interface {
+	GetIssuesByIDs(ctx context.Context, ids []string) ([]api.Issue, error)
+	GetProjectsByIDs(ctx context.Context, ids []string) ([]api.Project, error)
+}
+
+// hydrateFavorites replaces the ID-only issues and projects of favorites with
+// the full entities, fetched with one batched read per type
+func hydrateFavorites(ctx context.Context, client favoriteHydrator, favorites []api.Favorite) error {
+	var issueIDs, projectIDs []string
+	for _, favorite := range favorites {
+		if favorite.Issue != nil {
+			issueIDs = append(issueIDs, favorite.Issue.ID)
+		}
+		if favorite.Project != nil {
+			projectIDs = append(projectIDs, favorite.Project.ID)
+		}
+	}
+
+	issues, err := client.GetIssuesByIDs(ctx, issueIDs)
+	if err != nil {
+		return err
+	}
+	projects, err := client.GetProjectsByIDs(ctx, projectIDs)
+	if err != nil {
+		return err
+	}
+
+	issueByID := make(map[string]*api.Issue, len(issues))
+	for i := range issues {
+		issueByID[issues[i].ID] = &issues[i]
+	}
+	projectByID := make(map[string]*api.Project, len(projects))
+	for i := range projects {
+		projectByID[projects[i].ID] = &projects[i]
+	}
+
+	for i := range favorites {
+		if favorites[i].Issue != nil {
+			if issue, ok := issueByID[favorites[i].Issue.ID]; ok {
+				favorites[i].Issue = issue
+			}
+		}
+		if favorites[i].Project != nil {
+			if project, ok := projectByID[favorites[i].Project.ID]; ok {
+				favorites[i].Project = project
+			}
+		}
+	}
+	return nil
+}
+
+// favoriteGroup is the favorites of one type
+type favoriteGroup struct {
+	Type      string         `json:"type"`
+	Favorites []api.Favorite `json:"favorites"`
+}
+
+// groupFavorites groups favorites by type: issues, then projects, then any
+// other types alphabetically. Favorites keep their order within a group.
+func groupFavorites(favorites []api.Favorite) []favoriteGroup {
+	byType := make(map[string][]api.Favorite)
+	var types []string
+	for _, favorite := range favorites {
+		if _, ok := byType[favorite.Type]; !ok {
+			types = append(types, favorite.Type)
+		}
+		byType[favorite.Type] = append(byType[favorite.Type], favorite)
+	}
+
+	rank := map[string]int{favoriteTypeIssue: 0, favoriteTypeProject: 1}
+	sort.SliceStable(types, func(i, j int) bool {
+		ri, iKnown := rank[types[i]]
+		rj, jKnown := rank[types[j]]
+		switch {
+		case iKnown && jKnown:
+			return ri < rj
+		case iKnown != jKnown:
+			return iKnown
+		default:
+			return types[i] < types[j]
+		}
+	})
+
+	groups := make([]favoriteGroup, 0, len(types))
+	for _, favoriteType := range types {
+		groups = append(groups, favoriteGroup{Type: favoriteType, Favorites: byType[favoriteType]})
+	}
+	return groups
+}
+
+// favoriteLabel returns the name (issue identifier or project name) and title
+// (issue title or project state) shown for a favorite
+func favoriteLabel(favorite api.Favorite) (string, string) {
+	switch {
+	case favorite.Issue != nil:
+		return favorite.Issue.Identifier, favorite.Issue.Title
+	case favorite.Project != nil:
+		return favorite.Project.Name, favorite.Project.State
+	default:
+		return "-", ""
+	}
+}
+
+// favoriteGroupTitle is the heading shown above a group of favorites
+func favoriteGroupTitle(favoriteType string) string {
+	switch favoriteType {
+	case favoriteTypeIssue:
+		return "Issues"
+	case favoriteTypeProject:
+		return "Projects"
+	case "":
+		return "Other"
+	default:
+		return strings.ToUpper(favoriteType[:1]) + favoriteType[1:]
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(favoriteCmd)
+	favoriteCmd.AddCommand(favoriteAddCmd)
+	favoriteCmd.AddCommand(favoriteListCmd)
+	favoriteCmd.AddCommand(favoriteRemoveCmd)
+
+	favoriteAddCmd.Flags().Bool("dry-run", false, "Print the API request without adding the favorite")
+	favoriteListCmd.Flags().IntP("limit", "l", 50, "Maximum number of favorites to list (0 for all)")
+	favoriteRemoveCmd.Flags().Bool("dry-run", false, "Print the API request without removing the favorite")
+}
@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+// fakeFavoriteClient serves issues and projects from memory
+type fakeFavoriteClient struct {
+	issues   map[string]api.Issue
+	projects map[string]api.Project
+	calls    int
+}
+
+func (f *fakeFavoriteClient) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	for _, issue := range f.issues {
+		if issue.ID == id || issue.Identifier == id {
+			return &issue, nil
+		}
+	}
+	return nil, errors.New("Entity not found")
+}
+
+func (f *fakeFavoriteClient) GetProject(ctx context.Context, id string) (*api.Project, error) {
+	if project, ok := f.projects[id]; ok {
+		return &project, nil
+	}
+	return nil, errors.New("Entity not found")
+}
+
+func (f *fakeFavoriteClient) GetIssuesByIDs(ctx context.Context, ids []string) ([]api.Issue, error) {
+	f.calls++
+	issues := make([]api.Issue, 0, len(ids))
+	for _, id := range ids {
+		issues = append(issues, f.issues[id])
+	}
+	return issues, nil
+}
+
+func (f *fakeFavoriteClient) GetProjectsByIDs(ctx context.Context, ids []string) ([]api.Project, error) {
+	f.calls++
+	projects := make([]api.Project, 0, len(ids))
+	for _, id := range ids {
+		projects = append(projects, f.projects[id])
+	}
+	return projects, nil
+}
+
+func newFakeFavoriteClient() *fakeFavoriteClient {
+	return &fakeFavoriteClient{
+		issues: map[string]api.Issue{
+			"issue-1": {ID: "issue-1", Identifier: "LIN-1", Title: "Fix login"},
+			"issue-2": {ID: "issue-2", Identifier: "LIN-2", Title: "Add export"},
+		},
+		projects: map[string]api.Project{
+			"project-1": {ID: "project-1", Name: "Roadmap", State: "started"},
+		},
+	}
+}
+
+func TestParseFavoriteTarget(t *testing.T) {
+	tests := []struct {
+		kind, id string
+		wantKind string
+		wantErr  bool
+	}{
+		{kind: "issue", id: "LIN-123", wantKind: "issue"},
+		{kind: "Project", id: "8f3c2a1b9d4e", wantKind: "project"},
+		{kind: "issue", id: "not an issue", wantErr: true},
+		{kind: "project", id: "bad slug!", wantErr: true},
+		{kind: "cycle", id: "LIN-123", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind+" "+tt.id, func(t *testing.T) {
+			kind, _, err := parseFavoriteTarget(tt.kind, tt.id)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Expected an error for %s %q", tt.kind, tt.id)
+				}
+				return
+			}
+			if err != nil || kind != tt.wantKind {
+				t.Errorf("Expected kind %q, got %q (%v)", tt.wantKind, kind, err)
+			}
+		})
+	}
+}
+
+func TestResolveFavoriteTarget(t *testing.T) {
+	client := newFakeFavoriteClient()
+
+	input, name, err := resolveFavoriteTarget(context.Background(), client, favoriteTypeIssue, "LIN-2")
+	if err != nil || input.IssueID != "issue-2" || input.ProjectID != "" || name != "LIN-2" {
+		t.Errorf("Expected issue-2 / LIN-2, got %+v / %q (%v)", input, name, err)
+	}
+
+	input, name, err = resolveFavoriteTarget(context.Background(), client, favoriteTypeProject, "project-1")
+	if err != nil || input.ProjectID != "project-1" || input.IssueID != "" || name != "Roadmap" {
+		t.Errorf("Expected project-1 / Roadmap, got %+v / %q (%v)", input, name, err)
+	}
+
+	if _, _, err := resolveFavoriteTarget(context.Background(), client, favoriteTypeIssue, "LIN-9"); err == nil || !strings.Contains(err.Error(), "LIN-9") {
+		t.Errorf("Expected an error naming LIN-9, got %v", err)
+	}
+}
+
+func TestHydrateAndGroupFavorites(t *testing.T) {
+	client := newFakeFavoriteClient()
+	favorites := []api.Favorite{
+		{ID: "f1", Type: "project", Project: &api.Project{ID: "project-1"}},
+		{ID: "f2", Type: "issue", Issue: &api.Issue{ID: "issue-2"}},
+		{ID: "f3", Type: "customView"},
+		{ID: "f4", Type: "issue", Issue: &api.Issue{ID: "issue-1"}},
+		{ID: "f5", Type: "cycle"},
+	}
+
+	if err := hydrateFavorites(context.Background(), client, favorites); err != nil {
+		t.Fatalf("hydrateFavorites failed: %v", err)
+	}
+	if client.calls != 2 {
+		t.Errorf("Expected one batched read per type, got %d", client.calls)
+	}
+
+	groups := groupFavorites(favorites)
+	var order []string
+	for _, group := range groups {
+		order = append(order, group.Type)
+	}
+	if strings.Join(order, ",") != "issue,project,customView,cycle" {
+		t.Fatalf("Unexpected group order %v", order)
+	}
+
+	issues := groups[0].Favorites
+	if len(issues) != 2 || issues[0].ID != "f2" || issues[1].ID != "f4" {
+		t.Fatalf("Expected issue favorites in listed order, got %+v", issues)
+	}
+	if name, title := favoriteLabel(issues[0]); name != "LIN-2" || title != "Add export" {
+		t.Errorf("Expected LIN-2 / Add export, got %q / %q", name, title)
+	}
+	if name, title := favoriteLabel(groups[1].Favorites[0]); name != "Roadmap" || title != "started" {
+		t.Errorf("Expected Roadmap / started, got %q / %q", name, title)
+	}
+}
+
+func TestFindFavorite(t *testing.T) {
+	favorites := []api.Favorite{
+		{ID: "f1", Type: "project", Project: &api.Project{ID: "project-1"}},
+		{ID: "f2", Type: "issue", Issue: &api.Issue{ID: "issue-2"}},
+	}
+
+	if favorite := findFavorite(favorites, api.FavoriteInput{IssueID: "issue-2"}); favorite == nil || favorite.ID != "f2" {
+		t.Errorf("Expected favorite f2, got %+v", favorite)
+	}
+	if favorite := findFavorite(favorites, api.FavoriteInput{ProjectID: "project-1"}); favorite == nil || favorite.ID != "f1" {
+		t.Errorf("Expected favorite f1, got %+v", favorite)
+	}
+	if favorite := findFavorite(favorites, api.FavoriteInput{IssueID: "issue-1"}); favorite != nil {
+		t.Errorf("Expected no favorite, got %+v", favorite)
+	}
+}
@@ -0,0 +1,306 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/security"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// historyPageSize is the number of history entries requested per page
+const historyPageSize = 50
+
+// Kinds of issue activity
+const (
+	activityChange  = "change"
+	activityComment = "comment"
+)
+
+var issueHistoryCmd = &cobra.Command{
+	Use:               "history ISSUE-ID",
+	Aliases:           []string{"activity", "log"},
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "Show an issue's activity feed",
+	Long: `Show the activity on an issue oldest first: state changes, assignments,
+priority, label and other field changes, and comments, each with its time and actor.
+
+With --json every event includes the raw history entry or comment from Linear.
+
+Examples:
+  linctl issue history LIN-123
+  linctl issue history LIN-123 --no-comments   # Field changes only
+  linctl issue history LIN-123 --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		issueID := strings.TrimSpace(args[0])
+
+		if err := security.ValidateIssueID(issueID); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		noComments, _ := cmd.Flags().GetBool("no-comments")
+
+		ctx, cancel := paginationContext()
+		defer cancel()
+
+		activity, err := fetchIssueActivity(ctx, client, issueID, !noComments)
+		if err != nil {
+			message := fmt.Sprintf("Failed to get issue history: %v", err)
+			if isNotFoundError(err) {
+				message = fmt.Sprintf("Issue %s not found", issueID)
+			}
+			output.Error(message, plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(activity)
+			return
+		}
+		if len(activity) == 0 {
+			output.Info(fmt.Sprintf("No activity on %s", issueID), plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Println("Time\tActor\tKind\tDescription")
+			for _, event := range activity {
+				fmt.Printf("%s\t%s\t%s\t%s\n", event.CreatedAt.Format(time.RFC3339), event.Actor, event.Kind, event.Description)
+			}
+			return
+		}
+
+		rows := make([][]string, len(activity))
+		for i, event := range activity {
+			description := event.Description
+			if event.Kind == activityComment {
+				description = color.New(color.FgWhite, color.Faint).Sprint(description)
+			}
+			rows[i] = []string{
+				event.CreatedAt.Local().Format("2006-01-02 15:04"),
+				color.New(color.FgCyan).Sprint(event.Actor),
+				description,
+			}
+		}
+		output.Table(output.TableData{
+			Headers: []string{"When", "Actor", "Change"},
+			Rows:    rows,
+		}, plaintext, jsonOut)
+		fmt.Printf("\n%s %d events on %s\n", color.New(color.FgGreen).Sprint("✓"), len(activity),
+			color.New(color.FgCyan, color.Bold).Sprint(issueID))
+	},
+}
+
+// issueActivity is one event in an issue's activity feed. History or Comment
+// holds the raw event from Linear.
+type issueActivity struct {
+	Kind        string                 `json:"kind"`
+	CreatedAt   time.Time              `json:"createdAt"`
+	Actor       string                 `json:"actor"`
+	Description string                 `json:"description"`
+	History     *api.IssueHistoryEntry `json:"history,omitempty"`
+	Comment     *api.Comment           `json:"comment,omitempty"`
+}
+
+// issueActivityClient is the subset of the API client used to build the activity feed
+type issueActivityClient interface {
+	commentLister
+	GetIssueHistory(ctx context.Context, issueID string, first int, after string) (*api.IssueHistory, error)
+}
+
+// fetchIssueActivity pages through an issue's history and, if withComments is
+// set, its comments, and merges them oldest first
+func fetchIssueActivity(ctx context.Context, client issueActivityClient, issueID string, withComments bool) ([]issueActivity, error) {
+	history, _, err := fetchLimited(ctx, 0, historyPageSize, func(first int, after string) ([]api.IssueHistoryEntry, api.PageInfo, error) {
+		page, err := client.GetIssueHistory(ctx, issueID, first, after)
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		if page.PageInfo == nil {
+			return page.Nodes, api.PageInfo{}, nil
+		}
+		return page.Nodes, *page.PageInfo, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []api.Comment
+	if withComments {
+		comments, err = fetchIssueComments(ctx, client, issueID, nil, 0, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return mergeIssueActivity(history, comments), nil
+}
+
+// mergeIssueActivity describes history entries and comments as activity
+// events, ordered oldest first
+func mergeIssueActivity(history []api.IssueHistoryEntry, comments []api.Comment) []issueActivity {
+	activity := make([]issueActivity, 0, len(history)+len(comments))
+	for i := range history {
+		entry := &history[i]
+		actor := "Linear"
+		if entry.Actor != nil {
+			actor = entry.Actor.Name
+		}
+		description := "Updated the issue"
+		if changes := historyChanges(entry); len(changes) > 0 {
+			description = strings.Join(changes, "; ")
+		}
+		activity = append(activity, issueActivity{
+			Kind:        activityChange,
+			CreatedAt:   entry.CreatedAt,
+			Actor:       actor,
+			Description: description,
+			History:     entry,
+		})
+	}
+
+	for i := range comments {
+		comment := &comments[i]
+		actor := "Unknown"
+		if comment.User != nil {
+			actor = comment.User.Name
+		}
+		verb := "Commented"
+		if comment.Parent != nil {
+			verb = "Replied"
+		}
+		body := strings.Join(strings.Fields(comment.Body), " ")
+		activity = append(activity, issueActivity{
+			Kind:        activityComment,
+			CreatedAt:   comment.CreatedAt,
+			Actor:       actor,
+			Description: fmt.Sprintf("%s: %s", verb, truncateString(body, 60)),
+			Comment:     comment,
+		})
+	}
+
+	sort.SliceStable(activity, func(i, j int) bool {
+		return activity[i].CreatedAt.Before(activity[j].CreatedAt)
+	})
+	return activity
+}
+
+// historyChanges describes each field change recorded in a history entry
+func historyChanges(entry *api.IssueHistoryEntry) []string {
+	changes := []string{}
+
+	if entry.FromState != nil && entry.ToState != nil {
+		changes = append(changes, fmt.Sprintf("State: %s → %s", entry.FromState.Name, entry.ToState.Name))
+	} else if entry.ToState != nil {
+		changes = append(changes, fmt.Sprintf("State set to %s", entry.ToState.Name))
+	}
+	if entry.FromAssignee != nil && entry.ToAssignee != nil {
+		changes = append(changes, fmt.Sprintf("Assignee: %s → %s", entry.FromAssignee.Name, entry.ToAssignee.Name))
+	} else if entry.FromAssignee != nil && entry.ToAssignee == nil {
+		changes = append(changes, fmt.Sprintf("Unassigned from %s", entry.FromAssignee.Name))
+	} else if entry.FromAssignee == nil && entry.ToAssignee != nil {
+		changes = append(changes, fmt.Sprintf("Assigned to %s", entry.ToAssignee.Name))
+	}
+	if entry.FromPriority != nil && entry.ToPriority != nil {
+		changes = append(changes, fmt.Sprintf("Priority: %s → %s", priorityToString(*entry.FromPriority), priorityToString(*entry.ToPriority)))
+	}
+	if entry.FromTitle != nil && entry.ToTitle != nil {
+		changes = append(changes, fmt.Sprintf("Title: \"%s\" → \"%s\"", *entry.FromTitle, *entry.ToTitle))
+	}
+	if entry.FromCycle != nil && entry.ToCycle != nil {
+		changes = append(changes, fmt.Sprintf("Cycle: %s → %s", cycleName(*entry.FromCycle), cycleName(*entry.ToCycle)))
+	} else if entry.ToCycle != nil {
+		changes = append(changes, fmt.Sprintf("Added to %s", cycleName(*entry.ToCycle)))
+	} else if entry.FromCycle != nil {
+		changes = append(changes, fmt.Sprintf("Removed from %s", cycleName(*entry.FromCycle)))
+	}
+	if entry.FromProject != nil && entry.ToProject != nil {
+		changes = append(changes, fmt.Sprintf("Project: %s → %s", entry.FromProject.Name, entry.ToProject.Name))
+	} else if entry.ToProject != nil {
+		changes = append(changes, fmt.Sprintf("Added to project %s", entry.ToProject.Name))
+	} else if entry.FromProject != nil {
+		changes = append(changes, fmt.Sprintf("Removed from project %s", entry.FromProject.Name))
+	}
+	if entry.FromEstimate != nil || entry.ToEstimate != nil {
+		changes = append(changes, fmt.Sprintf("Estimate: %s → %s", formatHistoryEstimate(entry.FromEstimate), formatHistoryEstimate(entry.ToEstimate)))
+	}
+	if entry.FromDueDate != nil || entry.ToDueDate != nil {
+		changes = append(changes, fmt.Sprintf("Due date: %s → %s", formatHistoryValue(entry.FromDueDate), formatHistoryValue(entry.ToDueDate)))
+	}
+	if entry.ToParent != nil {
+		changes = append(changes, fmt.Sprintf("Made a sub-issue of %s", entry.ToParent.Identifier))
+	} else if entry.FromParent != nil {
+		changes = append(changes, fmt.Sprintf("Removed from parent %s", entry.FromParent.Identifier))
+	}
+	changes = append(changes, labelChanges("Added", entry.AddedLabels, entry.AddedLabelIds)...)
+	changes = append(changes, labelChanges("Removed", entry.RemovedLabels, entry.RemovedLabelIds)...)
+	if entry.UpdatedDescription {
+		changes = append(changes, "Updated the description")
+	}
+	if entry.Archived {
+		changes = append(changes, "Archived")
+	}
+	if entry.Trashed {
+		changes = append(changes, "Deleted")
+	}
+
+	return changes
+}
+
+// labelChanges describes added or removed labels by name, falling back to a
+// count when only the label IDs were fetched
+func labelChanges(verb string, labels []api.Label, ids []string) []string {
+	if len(labels) > 0 {
+		names := make([]string, len(labels))
+		for i, label := range labels {
+			names[i] = label.Name
+		}
+		return []string{fmt.Sprintf("%s label(s) %s", verb, strings.Join(names, ", "))}
+	}
+	if len(ids) > 0 {
+		return []string{fmt.Sprintf("%s %d label(s)", verb, len(ids))}
+	}
+	return nil
+}
+
+// formatHistoryEstimate formats an estimate from a history entry, "none" when unset
+func formatHistoryEstimate(estimate *float64) string {
+	if estimate == nil {
+		return "none"
+	}
+	return strconv.FormatFloat(*estimate, 'f', -1, 64)
+}
+
+// formatHistoryValue formats an optional value from a history entry, "none" when unset
+func formatHistoryValue(value *string) string {
+	if value == nil || *value == "" {
+		return "none"
+	}
+	return *value
+}
+
+func init() {
+	issueCmd.AddCommand(issueHistoryCmd)
+	issueHistoryCmd.Flags().Bool("no-comments", false, "Only show field changes, not comments")
+}
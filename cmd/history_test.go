@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+// fakeActivityClient serves issue history and comments in pages of one
+type fakeActivityClient struct {
+	history  []api.IssueHistoryEntry
+	comments []api.Comment
+}
+
+func (f *fakeActivityClient) GetIssueHistory(ctx context.Context, issueID string, first int, after string) (*api.IssueHistory, error) {
+	i := 0
+	if after != "" {
+		i = 1
+	}
+	return &api.IssueHistory{
+		Nodes:    f.history[i : i+1],
+		PageInfo: &api.PageInfo{HasNextPage: i+1 < len(f.history), EndCursor: "next"},
+	}, nil
+}
+
+func (f *fakeActivityClient) GetIssueComments(ctx context.Context, issueID string, filter map[string]interface{}, first int, after string, orderBy string) (*api.Comments, error) {
+	return &api.Comments{Nodes: f.comments}, nil
+}
+
+func TestFetchIssueActivity(t *testing.T) {
+	base := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	client := &fakeActivityClient{
+		history: []api.IssueHistoryEntry{
+			{
+				ID:        "h2",
+				CreatedAt: base.Add(2 * time.Hour),
+				Actor:     &api.User{Name: "Jane"},
+				FromState: &api.State{Name: "Todo"},
+				ToState:   &api.State{Name: "In Progress"},
+			},
+			{
+				ID:         "h1",
+				CreatedAt:  base,
+				ToAssignee: &api.User{Name: "Sam"},
+			},
+		},
+		comments: []api.Comment{
+			{ID: "c1", CreatedAt: base.Add(time.Hour), User: &api.User{Name: "Sam"}, Body: "Looking\ninto it"},
+		},
+	}
+
+	activity, err := fetchIssueActivity(context.Background(), client, "LIN-1", true)
+	if err != nil {
+		t.Fatalf("fetchIssueActivity failed: %v", err)
+	}
+	if len(activity) != 3 {
+		t.Fatalf("Expected 3 events across both history pages and comments, got %d", len(activity))
+	}
+
+	want := []struct{ kind, actor, description string }{
+		{activityChange, "Linear", "Assigned to Sam"},
+		{activityComment, "Sam", "Commented: Looking into it"},
+		{activityChange, "Jane", "State: Todo → In Progress"},
+	}
+	for i, w := range want {
+		got := activity[i]
+		if got.Kind != w.kind || got.Actor != w.actor || got.Description != w.description {
+			t.Errorf("Event %d: expected %+v, got %s/%s/%s", i, w, got.Kind, got.Actor, got.Description)
+		}
+	}
+	if activity[2].History == nil || activity[2].History.ID != "h2" || activity[1].Comment == nil {
+		t.Error("Expected each event to carry its raw history entry or comment")
+	}
+
+	activity, err = fetchIssueActivity(context.Background(), client, "LIN-1", false)
+	if err != nil || len(activity) != 2 {
+		t.Errorf("Expected only the 2 history events without comments, got %d (%v)", len(activity), err)
+	}
+}
+
+func TestHistoryChanges(t *testing.T) {
+	estimate := 3.0
+	dueDate := "2024-04-01"
+	entry := &api.IssueHistoryEntry{
+		ToCycle:            &api.Cycle{Number: 7},
+		ToEstimate:         &estimate,
+		FromDueDate:        &dueDate,
+		ToParent:           &api.Issue{Identifier: "LIN-9"},
+		AddedLabels:        []api.Label{{Name: "bug"}, {Name: "urgent"}},
+		RemovedLabelIds:    []string{"label-1"},
+		UpdatedDescription: true,
+		Archived:           true,
+	}
+
+	want := []string{
+		"Added to Cycle 7",
+		"Estimate: none → 3",
+		"Due date: 2024-04-01 → none",
+		"Made a sub-issue of LIN-9",
+		"Added label(s) bug, urgent",
+		"Removed 1 label(s)",
+		"Updated the description",
+		"Archived",
+	}
+	changes := historyChanges(entry)
+	if len(changes) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("Change %d: expected %q, got %q", i, want[i], changes[i])
+		}
+	}
+}
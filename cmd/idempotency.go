@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/idempotency"
+)
+
+// idempotencyGuard dedups a single create made under --idempotency-key
+type idempotencyGuard struct {
+	store     *idempotency.Store
+	operation string
+	key       string
+	hash      string
+}
+
+// openIdempotencyGuard validates key and opens the active profile's result cache.
+// inputs are everything that defines the create, so a reused key can be detected.
+func openIdempotencyGuard(operation, key string, inputs ...interface{}) (*idempotencyGuard, error) {
+	if err := idempotency.ValidateKey(key); err != nil {
+		return nil, err
+	}
+
+	store, err := idempotency.NewStore()
+	if err != nil {
+		return nil, err
+	}
+	return newIdempotencyGuard(store, operation, key, inputs...)
+}
+
+// newIdempotencyGuard returns a guard using store
+func newIdempotencyGuard(store *idempotency.Store, operation, key string, inputs ...interface{}) (*idempotencyGuard, error) {
+	hash, err := idempotency.Hash(inputs...)
+	if err != nil {
+		return nil, err
+	}
+	return &idempotencyGuard{store: store, operation: operation, key: key, hash: hash}, nil
+}
+
+// entityID returns the ID the created entity is given, derived from the key
+func (g *idempotencyGuard) entityID() *string {
+	id := idempotency.DeriveID(g.operation, g.key)
+	return &id
+}
+
+// replay decodes a remembered result into result and reports whether there was one
+func (g *idempotencyGuard) replay(result interface{}) (bool, error) {
+	entry, err := g.store.Lookup(g.operation, g.key, g.hash)
+	if err != nil || entry == nil {
+		return false, err
+	}
+	if err := json.Unmarshal(entry.Result, result); err != nil {
+		return false, fmt.Errorf("failed to read remembered result: %w", err)
+	}
+	return true, nil
+}
+
+// remember saves result for later repeats. A failure only loses the local
+// shortcut, since the derived ID still prevents duplicates, so it is a warning.
+func (g *idempotencyGuard) remember(result interface{}) {
+	if err := g.store.Save(g.operation, g.key, g.hash, result); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to remember idempotent result: %v\n", err)
+	}
+}
+
+// issueCreator is the subset of the API client used to create an issue idempotently
+type issueCreator interface {
+	CreateIssue(ctx context.Context, input api.IssueCreateInput) (*api.Issue, error)
+	GetIssue(ctx context.Context, id string) (*api.Issue, error)
+}
+
+// commentCreator is the subset of the API client used to create a comment idempotently
+type commentCreator interface {
+	CreateComment(ctx context.Context, input api.CommentCreateInput) (*api.Comment, error)
+	GetComment(ctx context.Context, id string) (*api.Comment, error)
+}
+
+// createIssue creates an issue. When input.ID is set and the create fails, an
+// earlier attempt with the same ID may already have created it, so that issue is
+// returned instead of the error.
+func createIssue(ctx context.Context, client issueCreator, input api.IssueCreateInput) (*api.Issue, error) {
+	issue, err := client.CreateIssue(ctx, input)
+	if err == nil || input.ID == nil || isDryRun(err) {
+		return issue, err
+	}
+	if existing, getErr := client.GetIssue(ctx, *input.ID); getErr == nil && existing.ID == *input.ID {
+		return existing, nil
+	}
+	return nil, err
+}
+
+// createComment creates a comment, returning the existing comment when input.ID
+// is set and an earlier attempt already created it, like createIssue
+func createComment(ctx context.Context, client commentCreator, input api.CommentCreateInput) (*api.Comment, error) {
+	comment, err := client.CreateComment(ctx, input)
+	if err == nil || input.ID == nil || isDryRun(err) {
+		return comment, err
+	}
+	if existing, getErr := client.GetComment(ctx, *input.ID); getErr == nil && existing.ID == *input.ID {
+		return existing, nil
+	}
+	return nil, err
+}
+
+// isDryRun reports whether err is a captured dry-run request
+func isDryRun(err error) bool {
+	var dryRun *api.DryRunError
+	return errors.As(err, &dryRun)
+}
@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/idempotency"
+	"github.com/nicholls-inc/linctl/pkg/utils"
+)
+
+// flakyLinear stores created entities by ID like Linear, rejects duplicate IDs,
+// and can drop the response of a successful create to simulate a lost reply
+type flakyLinear struct {
+	issues       map[string]api.Issue
+	comments     map[string]api.Comment
+	dropResponse bool
+	creates      int
+}
+
+// derivedID returns the ID a create under key would be given
+func derivedID(operation, key string) *string {
+	id := idempotency.DeriveID(operation, key)
+	return &id
+}
+
+func newFlakyLinear() *flakyLinear {
+	return &flakyLinear{issues: map[string]api.Issue{}, comments: map[string]api.Comment{}}
+}
+
+func (f *flakyLinear) CreateIssue(ctx context.Context, input api.IssueCreateInput) (*api.Issue, error) {
+	id := "generated-issue"
+	if input.ID != nil {
+		id = *input.ID
+	}
+	if _, exists := f.issues[id]; exists {
+		return nil, errors.New("GraphQL errors: [{Entity already exists}]")
+	}
+	f.creates++
+	f.issues[id] = api.Issue{ID: id, Identifier: "ENG-42", Title: input.Title}
+
+	if f.dropResponse {
+		f.dropResponse = false
+		return nil, errors.New("request failed: context deadline exceeded")
+	}
+	issue := f.issues[id]
+	return &issue, nil
+}
+
+func (f *flakyLinear) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	if issue, ok := f.issues[id]; ok {
+		return &issue, nil
+	}
+	return nil, errors.New("Entity not found")
+}
+
+func (f *flakyLinear) CreateComment(ctx context.Context, input api.CommentCreateInput) (*api.Comment, error) {
+	id := "generated-comment"
+	if input.ID != nil {
+		id = *input.ID
+	}
+	if _, exists := f.comments[id]; exists {
+		return nil, errors.New("GraphQL errors: [{Entity already exists}]")
+	}
+	f.creates++
+	f.comments[id] = api.Comment{ID: id, Body: input.Body}
+
+	if f.dropResponse {
+		f.dropResponse = false
+		return nil, errors.New("request failed: context deadline exceeded")
+	}
+	comment := f.comments[id]
+	return &comment, nil
+}
+
+func (f *flakyLinear) GetComment(ctx context.Context, id string) (*api.Comment, error) {
+	if comment, ok := f.comments[id]; ok {
+		return &comment, nil
+	}
+	return nil, errors.New("Entity not found")
+}
+
+func TestCreateIssue_RetryAfterLostResponse(t *testing.T) {
+	linear := newFlakyLinear()
+	linear.dropResponse = true
+	input := api.IssueCreateInput{Title: "Bug", ID: derivedID("issue.create", "key-1")}
+
+	// The create succeeds but its reply is lost; the issue is found by its ID
+	issue, err := createIssue(context.Background(), linear, input)
+	if err != nil {
+		t.Fatalf("Expected the lost response to be recovered, got %v", err)
+	}
+	if issue.ID != *input.ID {
+		t.Errorf("Expected issue %s, got %s", *input.ID, issue.ID)
+	}
+
+	// A retry of the whole command hits the duplicate ID and returns the same issue
+	retried, err := createIssue(context.Background(), linear, input)
+	if err != nil {
+		t.Fatalf("Expected retry to succeed, got %v", err)
+	}
+	if retried.ID != issue.ID {
+		t.Errorf("Expected retry to return %s, got %s", issue.ID, retried.ID)
+	}
+	if linear.creates != 1 {
+		t.Errorf("Expected exactly one issue to be created, got %d", linear.creates)
+	}
+}
+
+func TestCreateIssue_WithoutIDReturnsError(t *testing.T) {
+	linear := newFlakyLinear()
+	linear.dropResponse = true
+
+	if _, err := createIssue(context.Background(), linear, api.IssueCreateInput{Title: "Bug"}); err == nil {
+		t.Fatal("Expected the error to be returned when no ID was chosen")
+	}
+}
+
+func TestCreateIssue_DryRunIsNotRecovered(t *testing.T) {
+	client := api.NewClientWithURL("http://127.0.0.1:0", "test-auth-header")
+	client.SetDryRun(true)
+
+	input := api.IssueCreateInput{Title: "Bug", ID: derivedID("issue.create", "key-1")}
+	_, err := createIssue(context.Background(), client, input)
+	if !isDryRun(err) {
+		t.Fatalf("Expected dry-run error, got %v", err)
+	}
+}
+
+func TestCreateIssueWithComment_RetryDoesNotDuplicateComment(t *testing.T) {
+	linear := newFlakyLinear()
+	input := api.IssueCreateInput{Title: "Bug", ID: derivedID("issue.create", "key-1")}
+
+	first, err := createIssueWithComment(context.Background(), linear, input, "Context", &utils.ActorParams{})
+	if err != nil || first.CommentError != nil {
+		t.Fatalf("Expected first attempt to succeed, got %v / %v", err, first.CommentError)
+	}
+
+	second, err := createIssueWithComment(context.Background(), linear, input, "Context", &utils.ActorParams{})
+	if err != nil || second.CommentError != nil {
+		t.Fatalf("Expected retry to succeed, got %v / %v", err, second.CommentError)
+	}
+
+	if second.Comment.ID != first.Comment.ID {
+		t.Errorf("Expected the same comment on retry, got %s and %s", first.Comment.ID, second.Comment.ID)
+	}
+	if len(linear.issues) != 1 || len(linear.comments) != 1 {
+		t.Errorf("Expected one issue and one comment, got %d and %d", len(linear.issues), len(linear.comments))
+	}
+}
+
+func TestCreateComment_RetryAfterLostResponse(t *testing.T) {
+	linear := newFlakyLinear()
+	linear.dropResponse = true
+	input := api.CommentCreateInput{IssueID: "ENG-42", Body: "Done", ID: derivedID("comment.create", "key-1")}
+
+	if _, err := createComment(context.Background(), linear, input); err != nil {
+		t.Fatalf("Expected the lost response to be recovered, got %v", err)
+	}
+	comment, err := createComment(context.Background(), linear, input)
+	if err != nil {
+		t.Fatalf("Expected retry to succeed, got %v", err)
+	}
+	if comment.ID != *input.ID || linear.creates != 1 {
+		t.Errorf("Expected the existing comment and one create, got %s after %d creates", comment.ID, linear.creates)
+	}
+}
+
+func TestIdempotencyGuard_ReplaysRememberedResult(t *testing.T) {
+	store := idempotency.NewStoreWithPath(filepath.Join(t.TempDir(), "idempotency.json"), time.Hour)
+	input := api.IssueCreateInput{Title: "Bug", TeamID: "team-1"}
+
+	guard, err := newIdempotencyGuard(store, "issue.create", "key-1", input, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var cached issueCreateResult
+	if replayed, err := guard.replay(&cached); err != nil || replayed {
+		t.Fatalf("Expected nothing to replay before the first create, got %v / %v", replayed, err)
+	}
+
+	guard.remember(&issueCreateResult{Issue: &api.Issue{ID: "issue-uuid", Identifier: "ENG-42"}})
+
+	retry, _ := newIdempotencyGuard(store, "issue.create", "key-1", input, "")
+	replayed, err := retry.replay(&cached)
+	if err != nil || !replayed {
+		t.Fatalf("Expected the remembered result, got %v / %v", replayed, err)
+	}
+	if cached.Issue == nil || cached.Issue.Identifier != "ENG-42" {
+		t.Errorf("Expected ENG-42 to be replayed, got %+v", cached.Issue)
+	}
+
+	changed, _ := newIdempotencyGuard(store, "issue.create", "key-1", api.IssueCreateInput{Title: "Other", TeamID: "team-1"}, "")
+	if _, err := changed.replay(&cached); !errors.Is(err, idempotency.ErrKeyConflict) {
+		t.Errorf("Expected ErrKeyConflict for a reused key, got %v", err)
+	}
+}
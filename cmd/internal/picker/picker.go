@@ -0,0 +1,204 @@
+// Package picker implements a small fuzzy picker for choosing one item, such as
+// an issue identifier, in an interactive terminal
+package picker
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// maxVisible is the number of matches shown at once
+const maxVisible = 10
+
+var (
+	// ErrCancelled is returned when the user quits the picker without choosing
+	ErrCancelled = errors.New("selection cancelled")
+	// ErrNoItems is returned when there is nothing to pick from
+	ErrNoItems = errors.New("nothing to pick from")
+)
+
+var (
+	promptStyle   = lipgloss.NewStyle().Bold(true)
+	selectedStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+	faintStyle    = lipgloss.NewStyle().Faint(true)
+)
+
+// Pick shows items under prompt and returns the one the user chooses. The
+// picker renders on stderr so stdout only carries the command's output.
+func Pick(prompt string, items []string) (string, error) {
+	if len(items) == 0 {
+		return "", ErrNoItems
+	}
+
+	final, err := tea.NewProgram(newModel(prompt, items), tea.WithOutput(os.Stderr)).Run()
+	if err != nil {
+		return "", err
+	}
+	m := final.(model)
+	if m.chosen == "" {
+		return "", ErrCancelled
+	}
+	return m.chosen, nil
+}
+
+// Match returns the items containing the characters of query in order, ignoring
+// case. Prefix matches come first, then substring matches, then the rest with
+// the tightest matches first; ties keep the order of items.
+func Match(items []string, query string) []string {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return items
+	}
+
+	type scored struct {
+		item  string
+		score int
+	}
+	var matches []scored
+	for _, item := range items {
+		if score, ok := matchScore(strings.ToLower(item), query); ok {
+			matches = append(matches, scored{item, score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score < matches[j].score
+	})
+
+	result := make([]string, len(matches))
+	for i, match := range matches {
+		result[i] = match.item
+	}
+	return result
+}
+
+// matchScore scores item against query, lower being better, and reports
+// whether query is a subsequence of item at all
+func matchScore(item, query string) (int, bool) {
+	if strings.HasPrefix(item, query) {
+		return 0, true
+	}
+	if strings.Contains(item, query) {
+		return 1, true
+	}
+
+	queryRunes := []rune(query)
+	start, next := -1, 0
+	for i, r := range []rune(item) {
+		if r != queryRunes[next] {
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+		next++
+		if next == len(queryRunes) {
+			// Spread-out matches rank below tight ones
+			return 2 + (i - start), true
+		}
+	}
+	return 0, false
+}
+
+// model is the bubbletea model for the picker
+type model struct {
+	prompt  string
+	items   []string
+	query   string
+	matches []string
+	cursor  int
+	chosen  string
+	done    bool
+}
+
+func newModel(prompt string, items []string) model {
+	return model{prompt: prompt, items: items, matches: items}
+}
+
+// Init implements tea.Model
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch key.Type {
+	case tea.KeyCtrlC, tea.KeyEsc:
+		m.done = true
+		return m, tea.Quit
+	case tea.KeyEnter:
+		if len(m.matches) == 0 {
+			return m, nil
+		}
+		m.chosen = m.matches[m.cursor]
+		m.done = true
+		return m, tea.Quit
+	case tea.KeyUp, tea.KeyCtrlP:
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, nil
+	case tea.KeyDown, tea.KeyCtrlN:
+		if m.cursor < len(m.matches)-1 {
+			m.cursor++
+		}
+		return m, nil
+	case tea.KeyBackspace:
+		if len(m.query) == 0 {
+			return m, nil
+		}
+		runes := []rune(m.query)
+		m.query = string(runes[:len(runes)-1])
+	case tea.KeyRunes, tea.KeySpace:
+		m.query += string(key.Runes)
+	default:
+		return m, nil
+	}
+
+	m.matches = Match(m.items, m.query)
+	m.cursor = 0
+	return m, nil
+}
+
+// View implements tea.Model
+func (m model) View() string {
+	if m.done {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s_\n", promptStyle.Render(m.prompt), m.query)
+
+	// Keep the cursor within the window of visible matches
+	first := 0
+	if m.cursor >= maxVisible {
+		first = m.cursor - maxVisible + 1
+	}
+	last := first + maxVisible
+	if last > len(m.matches) {
+		last = len(m.matches)
+	}
+	for i := first; i < last; i++ {
+		if i == m.cursor {
+			b.WriteString(selectedStyle.Render("> "+m.matches[i]) + "\n")
+		} else {
+			b.WriteString("  " + m.matches[i] + "\n")
+		}
+	}
+	if len(m.matches) == 0 {
+		b.WriteString(faintStyle.Render("  No matches") + "\n")
+	}
+
+	b.WriteString(faintStyle.Render(fmt.Sprintf("%d/%d  ↑/↓ move • enter select • esc cancel", len(m.matches), len(m.items))) + "\n")
+	return b.String()
+}
@@ -0,0 +1,91 @@
+package picker
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMatch(t *testing.T) {
+	items := []string{"ENG-12", "OPS-1", "ENG-120", "DES-21", "ENG-3"}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", items},
+		{"eng-12", []string{"ENG-12", "ENG-120"}},
+		{"12", []string{"ENG-12", "ENG-120"}},
+		{"e3", []string{"ENG-3"}},
+		{"21", []string{"DES-21"}},
+		{"e1", []string{"ENG-12", "ENG-120", "DES-21"}},
+		{"xyz", nil},
+	}
+
+	for _, tt := range tests {
+		got := Match(items, tt.query)
+		if len(got) == 0 && len(tt.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Match(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func update(m model, msgs ...tea.Msg) model {
+	for _, msg := range msgs {
+		next, _ := m.Update(msg)
+		m = next.(model)
+	}
+	return m
+}
+
+func TestModel_FilterAndSelect(t *testing.T) {
+	m := newModel("Issue:", []string{"ENG-1", "ENG-2", "OPS-1"})
+
+	m = update(m, runes("o"), runes("p"))
+	if !reflect.DeepEqual(m.matches, []string{"OPS-1"}) {
+		t.Fatalf("Expected the query to narrow the matches, got %v", m.matches)
+	}
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyBackspace})
+	m = update(m, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown})
+	if m.cursor != 2 {
+		t.Errorf("Expected the cursor to stop at the last match, got %d", m.cursor)
+	}
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.chosen != "OPS-1" || !m.done {
+		t.Errorf("Expected OPS-1 to be chosen, got %q", m.chosen)
+	}
+	if m.View() != "" {
+		t.Error("Expected the picker to clear itself once done")
+	}
+}
+
+func TestModel_Cancel(t *testing.T) {
+	m := update(newModel("Issue:", []string{"ENG-1"}), tea.KeyMsg{Type: tea.KeyEsc})
+	if m.chosen != "" || !m.done {
+		t.Errorf("Expected esc to cancel, got chosen %q", m.chosen)
+	}
+}
+
+func TestModel_EnterWithoutMatches(t *testing.T) {
+	m := update(newModel("Issue:", []string{"ENG-1"}), runes("zz"), tea.KeyMsg{Type: tea.KeyEnter})
+	if m.done {
+		t.Error("Expected enter to do nothing when nothing matches")
+	}
+}
+
+func TestPick_NoItems(t *testing.T) {
+	if _, err := Pick("Issue:", nil); !errors.Is(err, ErrNoItems) {
+		t.Errorf("Expected ErrNoItems, got %v", err)
+	}
+}
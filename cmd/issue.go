@@ -1,15 +1,33 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"syscall"
+	"text/template"
+	"time"
 
 	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/cmd/internal/picker"
 	"github.com/nicholls-inc/linctl/pkg/api"
 	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/batch"
+	"github.com/nicholls-inc/linctl/pkg/idempotency"
+	"github.com/nicholls-inc/linctl/pkg/oauth"
 	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+	"github.com/nicholls-inc/linctl/pkg/security"
+	issuetemplate "github.com/nicholls-inc/linctl/pkg/template"
 	"github.com/nicholls-inc/linctl/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -31,580 +49,929 @@ Examples:
   linctl issue create --title "Bug fix" --team ENG --actor "AI Agent" --avatar-url "https://example.com/agent.png"`,
 }
 
+// issuePageSize is the number of issues requested per page by issue list
+const issuePageSize = 50
+
+// issueSortKeys maps issue list --sort keys to IssueSortInput fields and the
+// direction each sorts in before --reverse: newest first for dates, most
+// urgent first for priority and A-Z for titles
+var issueSortKeys = map[string]struct {
+	field string
+	order string
+}{
+	"created":  {"createdAt", "Descending"},
+	"updated":  {"updatedAt", "Descending"},
+	"priority": {"priority", "Ascending"},
+	"title":    {"title", "Ascending"},
+}
+
+// issueSortNames lists the --sort values in the order shown in help and errors
+var issueSortNames = []string{"linear", "created", "updated", "priority", "title"}
+
+// buildIssueSort turns --sort and --reverse into the GraphQL sort argument.
+// "linear" (or empty) keeps Linear's default order and returns nil.
+func buildIssueSort(sortBy string, reverse bool) ([]map[string]interface{}, error) {
+	switch sortBy {
+	case "", "linear":
+		if reverse {
+			return nil, fmt.Errorf("--reverse requires --sort (%s)", strings.Join(issueSortNames[1:], ", "))
+		}
+		return nil, nil
+	case "createdAt":
+		sortBy = "created"
+	case "updatedAt":
+		sortBy = "updated"
+	}
+
+	key, ok := issueSortKeys[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("Invalid sort option: %s. Valid options are: %s", sortBy, strings.Join(issueSortNames, ", "))
+	}
+
+	order := key.order
+	if reverse {
+		if order == "Ascending" {
+			order = "Descending"
+		} else {
+			order = "Ascending"
+		}
+	}
+	return []map[string]interface{}{{key.field: map[string]interface{}{"order": order}}}, nil
+}
+
+// issueField is a column that issue list --fields can select
+type issueField struct {
+	header string
+	// value is the field as it appears in JSON output
+	value func(api.Issue) interface{}
+	// text renders the field in table and plaintext output
+	text func(api.Issue) string
+}
+
+// issueFields maps --fields names to their accessors
+var issueFields = map[string]issueField{
+	"id": {"ID",
+		func(i api.Issue) interface{} { return i.Identifier },
+		func(i api.Issue) string { return i.Identifier }},
+	"uuid": {"UUID",
+		func(i api.Issue) interface{} { return i.ID },
+		func(i api.Issue) string { return i.ID }},
+	"title": {"Title",
+		func(i api.Issue) interface{} { return i.Title },
+		func(i api.Issue) string { return truncateString(i.Title, 40) }},
+	"state": {"State",
+		func(i api.Issue) interface{} {
+			if i.State == nil {
+				return nil
+			}
+			return i.State.Name
+		},
+		func(i api.Issue) string {
+			if i.State == nil {
+				return ""
+			}
+			return i.State.Name
+		}},
+	"assignee": {"Assignee",
+		func(i api.Issue) interface{} {
+			if i.Assignee == nil {
+				return nil
+			}
+			return i.Assignee.Name
+		},
+		func(i api.Issue) string {
+			if i.Assignee == nil {
+				return "Unassigned"
+			}
+			return i.Assignee.Name
+		}},
+	"team": {"Team",
+		func(i api.Issue) interface{} {
+			if i.Team == nil {
+				return nil
+			}
+			return i.Team.Key
+		},
+		func(i api.Issue) string {
+			if i.Team == nil {
+				return ""
+			}
+			return i.Team.Key
+		}},
+	"priority": {"Priority",
+		func(i api.Issue) interface{} { return i.Priority },
+		func(i api.Issue) string { return priorityToString(i.Priority) }},
+	"estimate": {"Estimate",
+		func(i api.Issue) interface{} { return i.Estimate },
+		func(i api.Issue) string {
+			if i.Estimate == nil {
+				return ""
+			}
+			return strconv.FormatFloat(*i.Estimate, 'f', -1, 64)
+		}},
+	"labels": {"Labels",
+		func(i api.Issue) interface{} { return issueLabelNames(i) },
+		func(i api.Issue) string { return strings.Join(issueLabelNames(i), ", ") }},
+	"created": {"Created",
+		func(i api.Issue) interface{} { return i.CreatedAt },
+		func(i api.Issue) string { return i.CreatedAt.Format("2006-01-02") }},
+	"updated": {"Updated",
+		func(i api.Issue) interface{} { return i.UpdatedAt },
+		func(i api.Issue) string { return i.UpdatedAt.Format("2006-01-02") }},
+	"due": {"Due",
+		func(i api.Issue) interface{} { return i.DueDate },
+		func(i api.Issue) string {
+			if i.DueDate == nil {
+				return ""
+			}
+			return *i.DueDate
+		}},
+	"url": {"URL",
+		func(i api.Issue) interface{} { return i.URL },
+		func(i api.Issue) string { return i.URL }},
+}
+
+// issueFieldNames lists the --fields names in the order shown in help and errors
+var issueFieldNames = []string{"id", "uuid", "title", "state", "assignee", "team", "priority", "estimate", "labels", "created", "updated", "due", "url"}
+
+// issueLabelNames returns the names of an issue's labels
+func issueLabelNames(issue api.Issue) []string {
+	names := []string{}
+	if issue.Labels != nil {
+		for _, label := range issue.Labels.Nodes {
+			names = append(names, label.Name)
+		}
+	}
+	return names
+}
+
+// parseIssueFields parses a comma-separated --fields value. An empty value
+// returns nil, meaning the default columns; duplicates are dropped.
+func parseIssueFields(value string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	var fields []string
+	seen := map[string]bool{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		if _, ok := issueFields[name]; !ok {
+			return nil, fmt.Errorf("unknown field %q. Valid fields: %s", name, strings.Join(issueFieldNames, ", "))
+		}
+		seen[name] = true
+		fields = append(fields, name)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("--fields needs at least one field. Valid fields: %s", strings.Join(issueFieldNames, ", "))
+	}
+	return fields, nil
+}
+
+// renderIssueFields prints only the selected fields: JSON objects keyed by
+// field name, or a table with one column per field
+func renderIssueFields(issues []api.Issue, fields []string, plaintext, jsonOut bool) {
+	if jsonOut {
+		items := make([]map[string]interface{}, len(issues))
+		for i, issue := range issues {
+			item := make(map[string]interface{}, len(fields))
+			for _, name := range fields {
+				item[name] = issueFields[name].value(issue)
+			}
+			items[i] = item
+		}
+		output.JSON(items)
+		return
+	}
+
+	headers := make([]string, len(fields))
+	for i, name := range fields {
+		headers[i] = issueFields[name].header
+	}
+
+	rows := make([][]string, len(issues))
+	for i, issue := range issues {
+		row := make([]string, len(fields))
+		for j, name := range fields {
+			row[j] = issueFields[name].text(issue)
+			if plaintext {
+				continue
+			}
+			// Match the default table's colors
+			switch {
+			case name == "state" && issue.State != nil:
+				row[j] = issueStateColor(issue.State.Type).Sprint(row[j])
+			case name == "assignee" && issue.Assignee == nil:
+				row[j] = color.New(color.FgYellow).Sprint(row[j])
+			}
+		}
+		rows[i] = row
+	}
+
+	output.Table(output.TableData{Headers: headers, Rows: rows}, plaintext, jsonOut)
+
+	if !plaintext {
+		fmt.Printf("\n%s %d issues\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(issues))
+	}
+}
+
 var issueListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List issues",
-	Long:    `List Linear issues with optional filtering.`,
+	Long: `List Linear issues with optional filtering.
+
+--blocked keeps issues with an incomplete blocker and --blocking keeps issues
+that block an incomplete issue; together they keep issues that are both.
+Linear cannot filter on relations, so these flags fetch the relations of each
+page of issues and filter them locally, which makes the listing slower.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		// Validate templates and fields before making any API calls
+		tmpl, err := loadOutputTemplate(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		fieldsFlag, _ := cmd.Flags().GetString("fields")
+		fields, err := parseIssueFields(fieldsFlag)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if fields != nil && tmpl != nil {
+			output.Error("--fields cannot be used with --template", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		client := api.NewClient(authHeader)
 
-		// Build filter from flags
+		// Build filter from flags, scoping to LINEAR_DEFAULT_TEAM when --team is omitted
 		filter := buildIssueFilter(cmd)
+		if _, ok := filter["team"]; !ok {
+			if team, err := oauth.LoadTeamFromEnvironment().GetTeam(""); err == nil {
+				filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": team}}
+			}
+		}
 
 		limit, _ := cmd.Flags().GetInt("limit")
 		if limit == 0 {
 			limit = 50
 		}
+		if all, _ := cmd.Flags().GetBool("all"); all {
+			limit = 0
+		}
 
-		// Get sort option
 		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				// Use empty string for Linear's default sort
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
-			}
+		reverse, _ := cmd.Flags().GetBool("reverse")
+		sort, err := buildIssueSort(sortBy, reverse)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
 		}
 
-		issues, err := client.GetIssues(context.Background(), filter, limit, "", orderBy)
+		includeArchived, _ := cmd.Flags().GetBool("include-archived")
+		blocked, _ := cmd.Flags().GetBool("blocked")
+		blocking, _ := cmd.Flags().GetBool("blocking")
+		filterDependencies := blocked || blocking
+
+		ctx, stop := paginationContext()
+		defer stop()
+
+		nodes, more, err := fetchLimited(ctx, limit, issuePageSize, func(first int, after string) ([]api.Issue, api.PageInfo, error) {
+			// Dependency filters drop issues from each page, so always fetch full pages
+			if filterDependencies {
+				first = issuePageSize
+			}
+			page, err := client.GetIssuesSorted(ctx, filter, first, after, sort, includeArchived)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			if filterDependencies {
+				matched, err := filterIssuesByDependency(ctx, client, page.Nodes, blocked, blocking)
+				return matched, page.PageInfo, err
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
+		}
+		if limit > 0 && len(nodes) > limit {
+			nodes = nodes[:limit]
+			more = true
 		}
+		issues := &api.Issues{Nodes: nodes, PageInfo: api.PageInfo{HasNextPage: more}}
 
-		if len(issues.Nodes) == 0 {
-			output.Info("No issues found", plaintext, jsonOut)
-			return
+		renderIssueList(issues, tmpl, fields, plaintext, jsonOut)
+	},
+}
+
+var issueMineCmd = &cobra.Command{
+	Use:   "mine",
+	Short: "List issues assigned to you",
+	Long: `List the issues assigned to you, across all teams. Takes the same filtering
+and sorting flags as issue list; completed and canceled issues are hidden unless
+--state or --include-completed is given.
+
+Examples:
+  linctl issue mine
+  linctl issue mine --state "In Progress"
+  linctl issue mine --sort priority --all`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runViewerIssueList(cmd, (*api.Client).GetViewerAssignedIssues)
+	},
+}
+
+var issueCreatedCmd = &cobra.Command{
+	Use:   "created",
+	Short: "List issues you created",
+	Long: `List the issues you created, across all teams. Takes the same filtering
+and sorting flags as issue list; completed and canceled issues are hidden unless
+--state or --include-completed is given.
+
+Examples:
+  linctl issue created
+  linctl issue created --team ENG --sort updated
+  linctl issue created --include-completed --newer-than 2_weeks_ago`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runViewerIssueList(cmd, (*api.Client).GetViewerCreatedIssues)
+	},
+}
+
+// viewerIssueQuery is an API client method listing the viewer's issues, such
+// as (*api.Client).GetViewerAssignedIssues
+type viewerIssueQuery func(client *api.Client, ctx context.Context, filter map[string]interface{}, first int, after string, sort []map[string]interface{}, includeArchived bool) (*api.Issues, error)
+
+// runViewerIssueList runs issue mine or issue created: the issue list filters
+// and sort from the flags, applied to the viewer's issues fetched by query
+func runViewerIssueList(cmd *cobra.Command, query viewerIssueQuery) {
+	plaintext := viper.GetBool("plaintext")
+	jsonOut := viper.GetBool("json")
+
+	sortBy, _ := cmd.Flags().GetString("sort")
+	reverse, _ := cmd.Flags().GetBool("reverse")
+	sort, err := buildIssueSort(sortBy, reverse)
+	if err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	authHeader, err := auth.GetAuthHeader()
+	if err != nil {
+		output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+		os.Exit(exitAuth)
+	}
+
+	client := api.NewClient(authHeader)
+	filter := buildIssueFilter(cmd)
+
+	limit, _ := cmd.Flags().GetInt("limit")
+	if all, _ := cmd.Flags().GetBool("all"); all {
+		limit = 0
+	}
+	includeArchived, _ := cmd.Flags().GetBool("include-archived")
+
+	ctx, stop := paginationContext()
+	defer stop()
+
+	nodes, more, err := fetchLimited(ctx, limit, issuePageSize, func(first int, after string) ([]api.Issue, api.PageInfo, error) {
+		page, err := query(client, ctx, filter, first, after, sort, includeArchived)
+		if err != nil {
+			return nil, api.PageInfo{}, err
 		}
+		return page.Nodes, page.PageInfo, nil
+	})
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
 
-		// For JSON output, show raw data
-		if jsonOut {
-			output.JSON(issues.Nodes)
-			return
+	renderIssueList(&api.Issues{Nodes: nodes, PageInfo: api.PageInfo{HasNextPage: more}}, nil, nil, plaintext, jsonOut)
+}
+
+// addViewerIssueListFlags adds the issue list flags that issue mine and issue
+// created support
+func addViewerIssueListFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("state", "s", "", "Filter by state name")
+	cmd.Flags().StringP("team", "t", "", "Filter by team key")
+	_ = cmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
+	cmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	cmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
+	cmd.Flags().Bool("all", false, "Fetch every matching issue, ignoring --limit")
+	cmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
+	cmd.Flags().Bool("include-archived", false, "Include archived issues")
+	cmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated, priority, title")
+	_ = cmd.RegisterFlagCompletionFunc("sort", cobra.FixedCompletions(issueSortNames, cobra.ShellCompDirectiveNoFileComp))
+	cmd.Flags().Bool("reverse", false, "Reverse the --sort order")
+	cmd.Flags().StringP("newer-than", "n", "", "Show issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+}
+
+// renderIssueList prints issues as issue list does: through the output
+// template or --fields when given, otherwise as JSON, Markdown or a table
+func renderIssueList(issues *api.Issues, tmpl *template.Template, fields []string, plaintext, jsonOut bool) {
+	if len(issues.Nodes) == 0 {
+		output.Info("No issues found", plaintext, jsonOut)
+		return
+	}
+
+	if tmpl != nil {
+		if err := output.ExecuteTemplate(tmpl, issues.Nodes); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
 		}
+		return
+	}
 
-		// For plaintext output, use Markdown outline format
-		if plaintext {
-			fmt.Println("# Issues")
-			for _, issue := range issues.Nodes {
-				fmt.Printf("## %s\n", issue.Title)
-				fmt.Printf("- **ID**: %s\n", issue.Identifier)
-				if issue.State != nil {
-					fmt.Printf("- **State**: %s\n", issue.State.Name)
-				}
-				if issue.Assignee != nil {
-					fmt.Printf("- **Assignee**: %s\n", issue.Assignee.Name)
-				} else {
-					fmt.Printf("- **Assignee**: Unassigned\n")
-				}
-				if issue.Team != nil {
-					fmt.Printf("- **Team**: %s\n", issue.Team.Key)
-				}
-				fmt.Printf("- **Created**: %s\n", issue.CreatedAt.Format("2006-01-02"))
-				fmt.Printf("- **URL**: %s\n", issue.URL)
-				if issue.Description != "" {
-					fmt.Printf("- **Description**: %s\n", issue.Description)
-				}
-				fmt.Println()
-			}
-			fmt.Printf("\nTotal: %d issues\n", len(issues.Nodes))
-			return
+	if fields != nil {
+		renderIssueFields(issues.Nodes, fields, plaintext, jsonOut)
+		if !plaintext && !jsonOut && issues.PageInfo.HasNextPage {
+			fmt.Printf("%s Use --limit or --all to see more results\n",
+				color.New(color.FgYellow).Sprint("ℹ️"))
 		}
+		return
+	}
 
-		// Prepare table data for rich output
-		headers := []string{"Title", "State", "Assignee", "Team", "Created", "URL"}
-		rows := make([][]string, len(issues.Nodes))
+	// For JSON output, show raw data
+	if jsonOut {
+		output.JSON(issues.Nodes)
+		return
+	}
 
-		for i, issue := range issues.Nodes {
-			assignee := "Unassigned"
+	// For plaintext output, use Markdown outline format
+	if plaintext {
+		fmt.Println("# Issues")
+		for _, issue := range issues.Nodes {
+			fmt.Printf("## %s\n", issue.Title)
+			fmt.Printf("- **ID**: %s\n", issue.Identifier)
+			if issue.State != nil {
+				fmt.Printf("- **State**: %s\n", issue.State.Name)
+			}
 			if issue.Assignee != nil {
-				assignee = issue.Assignee.Name
+				fmt.Printf("- **Assignee**: %s\n", issue.Assignee.Name)
+			} else {
+				fmt.Printf("- **Assignee**: Unassigned\n")
 			}
-
-			team := ""
 			if issue.Team != nil {
-				team = issue.Team.Key
+				fmt.Printf("- **Team**: %s\n", issue.Team.Key)
 			}
-
-			state := ""
-			if issue.State != nil {
-				state = issue.State.Name
+			fmt.Printf("- **Created**: %s\n", issue.CreatedAt.Format("2006-01-02"))
+			fmt.Printf("- **URL**: %s\n", issue.URL)
+			if issue.Description != "" {
+				fmt.Printf("- **Description**: %s\n", issue.Description)
 			}
+			fmt.Println()
+		}
+		fmt.Printf("\nTotal: %d issues\n", len(issues.Nodes))
+		return
+	}
 
-			// Apply colors for rich output
-			if issue.State != nil {
-				var stateColor *color.Color
-				switch issue.State.Type {
-				case "triage":
-					stateColor = color.New(color.FgMagenta)
-				case "backlog":
-					stateColor = color.New(color.FgCyan)
-				case "unstarted":
-					stateColor = color.New(color.FgWhite)
-				case "started":
-					stateColor = color.New(color.FgBlue)
-				case "completed":
-					stateColor = color.New(color.FgGreen)
-				case "canceled":
-					stateColor = color.New(color.FgRed)
-				default:
-					stateColor = color.New(color.FgWhite)
-				}
-				state = stateColor.Sprint(state)
-			}
+	// Prepare table data for rich output
+	headers := []string{"Title", "State", "Assignee", "Team", "Created", "URL"}
+	rows := make([][]string, len(issues.Nodes))
 
-			// Color unassigned in yellow
-			if issue.Assignee == nil {
-				assignee = color.New(color.FgYellow).Sprint(assignee)
-			}
+	for i, issue := range issues.Nodes {
+		assignee := "Unassigned"
+		if issue.Assignee != nil {
+			assignee = issue.Assignee.Name
+		}
 
-			rows[i] = []string{
-				truncateString(issue.Title, 40),
-				state,
-				assignee,
-				team,
-				issue.CreatedAt.Format("2006-01-02"),
-				issue.URL,
-			}
+		team := ""
+		if issue.Team != nil {
+			team = issue.Team.Key
 		}
 
-		tableData := output.TableData{
-			Headers: headers,
-			Rows:    rows,
+		state := ""
+		if issue.State != nil {
+			state = issue.State.Name
 		}
 
-		output.Table(tableData, plaintext, jsonOut)
+		// Apply colors for rich output
+		if issue.State != nil {
+			state = issueStateColor(issue.State.Type).Sprint(state)
+		}
 
-		// Show summary count like project list does
-		if !plaintext && !jsonOut {
-			fmt.Printf("\n%s %d issues\n",
-				color.New(color.FgGreen).Sprint("✓"),
-				len(issues.Nodes))
+		// Color unassigned in yellow
+		if issue.Assignee == nil {
+			assignee = color.New(color.FgYellow).Sprint(assignee)
 		}
 
-		if !plaintext && !jsonOut && issues.PageInfo.HasNextPage {
-			fmt.Printf("%s Use --limit to see more results\n",
-				color.New(color.FgYellow).Sprint("ℹ️"))
+		rows[i] = []string{
+			truncateString(issue.Title, 40),
+			state,
+			assignee,
+			team,
+			issue.CreatedAt.Format("2006-01-02"),
+			issue.URL,
 		}
-	},
+	}
+
+	tableData := output.TableData{
+		Headers: headers,
+		Rows:    rows,
+	}
+
+	output.Table(tableData, plaintext, jsonOut)
+
+	// Show summary count like project list does
+	if !plaintext && !jsonOut {
+		fmt.Printf("\n%s %d issues\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(issues.Nodes))
+	}
+
+	if !plaintext && !jsonOut && issues.PageInfo.HasNextPage {
+		fmt.Printf("%s Use --limit or --all to see more results\n",
+			color.New(color.FgYellow).Sprint("ℹ️"))
+	}
 }
 
 var issueGetCmd = &cobra.Command{
-	Use:     "get [issue-id]",
-	Aliases: []string{"show"},
-	Short:   "Get issue details",
-	Long:    `Get detailed information about a specific issue.`,
-	Args:    cobra.ExactArgs(1),
+	Use:               "get [issue-id]",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Aliases:           []string{"show"},
+	Short:             "Get issue details",
+	Long: `Get detailed information about a specific issue.
+
+Without an issue ID in an interactive terminal, pick one of the recently updated
+issues instead. With --json, --plaintext or outside a terminal the ID is required.
+
+Examples:
+  linctl issue get LIN-123
+  linctl issue get          # Pick from recent issues`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		if len(args) == 0 && !canPickIssue(plaintext, jsonOut) {
+			output.Error("Issue ID required, e.g. 'linctl issue get LIN-123'. The issue picker only runs in an interactive terminal.", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		// Validate templates before making any API calls
+		tmpl, err := loadOutputTemplate(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		markdown, _ := cmd.Flags().GetBool("markdown")
+		format, _ := cmd.Flags().GetString("output")
+		switch format {
+		case "":
+		case "md", "markdown":
+			markdown = true
+		default:
+			output.Error(fmt.Sprintf("Unsupported output format '%s'. Valid formats: md", format), plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		watch, _ := cmd.Flags().GetBool("watch")
+		interval, _ := cmd.Flags().GetDuration("interval")
+		if watch && interval < minWatchInterval {
+			output.Error(fmt.Sprintf("Watch interval must be at least %s", minWatchInterval), plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		client := api.NewClient(authHeader)
-		issue, err := client.GetIssue(context.Background(), args[0])
+		issueID, err := issueArgOrPick(commandContext(), client, args)
+		if errors.Is(err, picker.ErrCancelled) {
+			return
+		}
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to pick an issue: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		issue, err := client.GetIssue(commandContext(), issueID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
-		if jsonOut {
-			output.JSON(issue)
+		includeArchived, _ := cmd.Flags().GetBool("include-archived")
+		if err := checkArchived(issue, includeArchived); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if watch {
+			first := true
+			watchIssue(client, issue, interval, func(issue *api.Issue) {
+				// JSON watch output is newline-delimited, one object per change
+				if jsonOut && tmpl == nil {
+					output.JSONLine(issue)
+					return
+				}
+				if !first {
+					fmt.Printf("\n--- Updated %s ---\n\n", issue.UpdatedAt.Local().Format("2006-01-02 15:04:05"))
+				}
+				first = false
+				renderIssue(issue, tmpl, markdown, plaintext, jsonOut)
+			}, plaintext, jsonOut)
 			return
 		}
 
-		if plaintext {
-			fmt.Printf("# %s - %s\n\n", issue.Identifier, issue.Title)
+		renderIssue(issue, tmpl, markdown, plaintext, jsonOut)
+	},
+}
 
-			if issue.Description != "" {
-				fmt.Printf("## Description\n%s\n\n", issue.Description)
-			}
+// minWatchInterval is the shortest polling interval allowed for issue get --watch
+const minWatchInterval = 2 * time.Second
 
-			fmt.Printf("## Core Details\n")
-			fmt.Printf("- **ID**: %s\n", issue.Identifier)
-			fmt.Printf("- **Number**: %d\n", issue.Number)
-			if issue.State != nil {
-				fmt.Printf("- **State**: %s (%s)\n", issue.State.Name, issue.State.Type)
-				if issue.State.Description != nil && *issue.State.Description != "" {
-					fmt.Printf("  - Description: %s\n", *issue.State.Description)
-				}
-			}
-			if issue.Assignee != nil {
-				fmt.Printf("- **Assignee**: %s (%s)\n", issue.Assignee.Name, issue.Assignee.Email)
-				if issue.Assignee.DisplayName != "" && issue.Assignee.DisplayName != issue.Assignee.Name {
-					fmt.Printf("  - Display Name: %s\n", issue.Assignee.DisplayName)
-				}
-			} else {
-				fmt.Printf("- **Assignee**: Unassigned\n")
-			}
-			if issue.Creator != nil {
-				fmt.Printf("- **Creator**: %s (%s)\n", issue.Creator.Name, issue.Creator.Email)
-			}
-			if issue.Team != nil {
-				fmt.Printf("- **Team**: %s (%s)\n", issue.Team.Name, issue.Team.Key)
-				if issue.Team.Description != "" {
-					fmt.Printf("  - Description: %s\n", issue.Team.Description)
-				}
-			}
-			fmt.Printf("- **Priority**: %s (%d)\n", priorityToString(issue.Priority), issue.Priority)
-			if issue.PriorityLabel != "" {
-				fmt.Printf("- **Priority Label**: %s\n", issue.PriorityLabel)
-			}
-			if issue.Estimate != nil {
-				fmt.Printf("- **Estimate**: %.1f\n", *issue.Estimate)
-			}
+// watchIssue renders the issue, then polls until interrupted and re-renders whenever it changes
+func watchIssue(client *api.Client, initial *api.Issue, interval time.Duration, render func(*api.Issue), plaintext, jsonOut bool) {
+	ctx, stop := signal.NotifyContext(commandContext(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-			fmt.Printf("\n## Status & Dates\n")
-			fmt.Printf("- **Created**: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05"))
-			fmt.Printf("- **Updated**: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05"))
-			if issue.TriagedAt != nil {
-				fmt.Printf("- **Triaged**: %s\n", issue.TriagedAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.CompletedAt != nil {
-				fmt.Printf("- **Completed**: %s\n", issue.CompletedAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.CanceledAt != nil {
-				fmt.Printf("- **Canceled**: %s\n", issue.CanceledAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.ArchivedAt != nil {
-				fmt.Printf("- **Archived**: %s\n", issue.ArchivedAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.DueDate != nil && *issue.DueDate != "" {
-				fmt.Printf("- **Due Date**: %s\n", *issue.DueDate)
-			}
-			if issue.SnoozedUntilAt != nil {
-				fmt.Printf("- **Snoozed Until**: %s\n", issue.SnoozedUntilAt.Format("2006-01-02 15:04:05"))
-			}
+	limiter := ratelimit.Shared()
+	fetch := func(ctx context.Context) (*api.Issue, error) {
+		if err := api.WaitForRateLimit(ctx, limiter); err != nil {
+			return nil, err
+		}
+		return client.GetIssue(ctx, initial.Identifier)
+	}
 
-			fmt.Printf("\n## Technical Details\n")
-			fmt.Printf("- **Board Order**: %.2f\n", issue.BoardOrder)
-			fmt.Printf("- **Sub-Issue Sort Order**: %.2f\n", issue.SubIssueSortOrder)
-			if issue.BranchName != "" {
-				fmt.Printf("- **Git Branch**: %s\n", issue.BranchName)
-			}
-			if issue.CustomerTicketCount > 0 {
-				fmt.Printf("- **Customer Ticket Count**: %d\n", issue.CustomerTicketCount)
-			}
-			if len(issue.PreviousIdentifiers) > 0 {
-				fmt.Printf("- **Previous Identifiers**: %s\n", strings.Join(issue.PreviousIdentifiers, ", "))
-			}
-			if issue.IntegrationSourceType != nil && *issue.IntegrationSourceType != "" {
-				fmt.Printf("- **Integration Source**: %s\n", *issue.IntegrationSourceType)
-			}
-			if issue.ExternalUserCreator != nil {
-				fmt.Printf("- **External Creator**: %s (%s)\n", issue.ExternalUserCreator.Name, issue.ExternalUserCreator.Email)
-			}
-			fmt.Printf("- **URL**: %s\n", issue.URL)
+	if err := pollIssue(ctx, initial, interval, fetch, render); err != nil {
+		output.Info(err.Error(), plaintext, jsonOut)
+	}
+}
 
-			// Project and Cycle Info
-			if issue.Project != nil {
-				fmt.Printf("\n## Project\n")
-				fmt.Printf("- **Name**: %s\n", issue.Project.Name)
-				fmt.Printf("- **State**: %s\n", issue.Project.State)
-				fmt.Printf("- **Progress**: %.0f%%\n", issue.Project.Progress*100)
-				if issue.Project.Health != "" {
-					fmt.Printf("- **Health**: %s\n", issue.Project.Health)
-				}
-				if issue.Project.Description != "" {
-					fmt.Printf("- **Description**: %s\n", issue.Project.Description)
-				}
-			}
+// pollIssue calls render for the initial issue and again each time its updatedAt changes.
+// It returns nil when ctx is cancelled, or an error describing why the watch stopped.
+func pollIssue(ctx context.Context, initial *api.Issue, interval time.Duration, fetch func(context.Context) (*api.Issue, error), render func(*api.Issue)) error {
+	render(initial)
+	lastUpdated := initial.UpdatedAt
 
-			if issue.Cycle != nil {
-				fmt.Printf("\n## Cycle\n")
-				fmt.Printf("- **Name**: %s (#%d)\n", issue.Cycle.Name, issue.Cycle.Number)
-				if issue.Cycle.Description != nil && *issue.Cycle.Description != "" {
-					fmt.Printf("- **Description**: %s\n", *issue.Cycle.Description)
-				}
-				fmt.Printf("- **Period**: %s to %s\n", issue.Cycle.StartsAt, issue.Cycle.EndsAt)
-				fmt.Printf("- **Progress**: %.0f%%\n", issue.Cycle.Progress*100)
-				if issue.Cycle.CompletedAt != nil {
-					fmt.Printf("- **Completed**: %s\n", issue.Cycle.CompletedAt.Format("2006-01-02"))
-				}
-			}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-			// Labels
-			if issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
-				fmt.Printf("\n## Labels\n")
-				for _, label := range issue.Labels.Nodes {
-					fmt.Printf("- %s", label.Name)
-					if label.Description != nil && *label.Description != "" {
-						fmt.Printf(" - %s", *label.Description)
-					}
-					fmt.Println()
-				}
-			}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
 
-			// Subscribers
-			if issue.Subscribers != nil && len(issue.Subscribers.Nodes) > 0 {
-				fmt.Printf("\n## Subscribers\n")
-				for _, subscriber := range issue.Subscribers.Nodes {
-					fmt.Printf("- %s (%s)\n", subscriber.Name, subscriber.Email)
-				}
+		issue, err := fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
 			}
+			// Transient failures shouldn't end the watch
+			fmt.Fprintf(os.Stderr, "Warning: failed to refresh issue: %v\n", err)
+			continue
+		}
 
-			// Relations
-			if issue.Relations != nil && len(issue.Relations.Nodes) > 0 {
-				fmt.Printf("\n## Related Issues\n")
-				for _, relation := range issue.Relations.Nodes {
-					if relation.RelatedIssue != nil {
-						relationType := relation.Type
-						switch relationType {
-						case "blocks":
-							relationType = "Blocks"
-						case "blocked":
-							relationType = "Blocked by"
-						case "related":
-							relationType = "Related to"
-						case "duplicate":
-							relationType = "Duplicate of"
-						}
-						fmt.Printf("- %s: %s - %s", relationType, relation.RelatedIssue.Identifier, relation.RelatedIssue.Title)
-						if relation.RelatedIssue.State != nil {
-							fmt.Printf(" [%s]", relation.RelatedIssue.State.Name)
-						}
-						fmt.Println()
-					}
-				}
-			}
+		if issue.ArchivedAt != nil && initial.ArchivedAt == nil {
+			return fmt.Errorf("Issue %s was archived, stopping watch", issue.Identifier)
+		}
 
-			// Reactions
-			if len(issue.Reactions) > 0 {
-				fmt.Printf("\n## Reactions\n")
-				reactionMap := make(map[string][]string)
-				for _, reaction := range issue.Reactions {
-					userName := "Unknown"
-					if reaction.User != nil {
-						userName = reaction.User.Name
-					}
-					reactionMap[reaction.Emoji] = append(reactionMap[reaction.Emoji], userName)
-				}
-				for emoji, users := range reactionMap {
-					fmt.Printf("- %s: %s\n", emoji, strings.Join(users, ", "))
-				}
-			}
-
-			// Show parent issue if this is a sub-issue
-			if issue.Parent != nil {
-				fmt.Printf("\n## Parent Issue\n")
-				fmt.Printf("- %s: %s\n", issue.Parent.Identifier, issue.Parent.Title)
-			}
-
-			// Show sub-issues if any
-			if issue.Children != nil && len(issue.Children.Nodes) > 0 {
-				fmt.Printf("\n## Sub-issues\n")
-				for _, child := range issue.Children.Nodes {
-					stateStr := ""
-					if child.State != nil {
-						switch child.State.Type {
-						case "completed", "done":
-							stateStr = "[x]"
-						case "started", "in_progress":
-							stateStr = "[~]"
-						case "canceled":
-							stateStr = "[-]"
-						default:
-							stateStr = "[ ]"
-						}
-					} else {
-						stateStr = "[ ]"
-					}
-
-					assignee := "Unassigned"
-					if child.Assignee != nil {
-						assignee = child.Assignee.Name
-					}
-
-					fmt.Printf("- %s %s: %s (%s)\n", stateStr, child.Identifier, child.Title, assignee)
-				}
-			}
-
-			// Show attachments if any
-			if issue.Attachments != nil && len(issue.Attachments.Nodes) > 0 {
-				fmt.Printf("\n## Attachments\n")
-				for _, attachment := range issue.Attachments.Nodes {
-					fmt.Printf("- [%s](%s)\n", attachment.Title, attachment.URL)
-				}
-			}
-
-			// Show recent comments if any
-			if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
-				fmt.Printf("\n## Recent Comments\n")
-				for _, comment := range issue.Comments.Nodes {
-					authorName := "Unknown"
-					if comment.User != nil {
-						authorName = comment.User.Name
-					}
-					fmt.Printf("\n### %s - %s\n", authorName, comment.CreatedAt.Format("2006-01-02 15:04"))
-					if comment.EditedAt != nil {
-						fmt.Printf("*(edited %s)*\n", comment.EditedAt.Format("2006-01-02 15:04"))
-					}
-					fmt.Printf("%s\n", comment.Body)
-					if comment.Children != nil && len(comment.Children.Nodes) > 0 {
-						for _, reply := range comment.Children.Nodes {
-							replyAuthor := "Unknown"
-							if reply.User != nil {
-								replyAuthor = reply.User.Name
-							}
-							fmt.Printf("\n  **Reply from %s**: %s\n", replyAuthor, reply.Body)
-						}
-					}
-				}
-				fmt.Printf("\n> Use `linctl comment list %s` to see all comments\n", issue.Identifier)
-			}
-
-			// Show history
-			if issue.History != nil && len(issue.History.Nodes) > 0 {
-				fmt.Printf("\n## Recent History\n")
-				for _, entry := range issue.History.Nodes {
-					fmt.Printf("\n- **%s** by %s", entry.CreatedAt.Format("2006-01-02 15:04"), entry.Actor.Name)
-					changes := []string{}
+		if !issue.UpdatedAt.Equal(lastUpdated) {
+			lastUpdated = issue.UpdatedAt
+			render(issue)
+		}
+	}
+}
 
-					if entry.FromState != nil && entry.ToState != nil {
-						changes = append(changes, fmt.Sprintf("State: %s → %s", entry.FromState.Name, entry.ToState.Name))
-					}
-					if entry.FromAssignee != nil && entry.ToAssignee != nil {
-						changes = append(changes, fmt.Sprintf("Assignee: %s → %s", entry.FromAssignee.Name, entry.ToAssignee.Name))
-					} else if entry.FromAssignee != nil && entry.ToAssignee == nil {
-						changes = append(changes, fmt.Sprintf("Unassigned from %s", entry.FromAssignee.Name))
-					} else if entry.FromAssignee == nil && entry.ToAssignee != nil {
-						changes = append(changes, fmt.Sprintf("Assigned to %s", entry.ToAssignee.Name))
-					}
-					if entry.FromPriority != nil && entry.ToPriority != nil {
-						changes = append(changes, fmt.Sprintf("Priority: %s → %s", priorityToString(*entry.FromPriority), priorityToString(*entry.ToPriority)))
-					}
-					if entry.FromTitle != nil && entry.ToTitle != nil {
-						changes = append(changes, fmt.Sprintf("Title: \"%s\" → \"%s\"", *entry.FromTitle, *entry.ToTitle))
-					}
-					if entry.FromCycle != nil && entry.ToCycle != nil {
-						changes = append(changes, fmt.Sprintf("Cycle: %s → %s", entry.FromCycle.Name, entry.ToCycle.Name))
-					}
-					if entry.FromProject != nil && entry.ToProject != nil {
-						changes = append(changes, fmt.Sprintf("Project: %s → %s", entry.FromProject.Name, entry.ToProject.Name))
-					}
-					if len(entry.AddedLabelIds) > 0 {
-						changes = append(changes, fmt.Sprintf("Added %d label(s)", len(entry.AddedLabelIds)))
-					}
-					if len(entry.RemovedLabelIds) > 0 {
-						changes = append(changes, fmt.Sprintf("Removed %d label(s)", len(entry.RemovedLabelIds)))
-					}
+// renderIssue prints a single issue in the selected output format
+func renderIssue(issue *api.Issue, tmpl *template.Template, markdown, plaintext, jsonOut bool) {
+	if tmpl != nil {
+		if err := output.ExecuteTemplate(tmpl, issue); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		return
+	}
 
-					if len(changes) > 0 {
-						fmt.Printf("\n  - %s", strings.Join(changes, "\n  - "))
-					}
-					fmt.Println()
-				}
-			}
+	if jsonOut {
+		output.JSON(issue)
+		return
+	}
 
-			return
-		}
+	if markdown {
+		fmt.Print(output.IssueMarkdown(issue))
+		return
+	}
 
-		// Rich display
-		fmt.Printf("%s %s\n",
-			color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
-			color.New(color.FgWhite, color.Bold).Sprint(issue.Title))
+	if plaintext {
+		fmt.Printf("# %s - %s\n\n", issue.Identifier, issue.Title)
 
 		if issue.Description != "" {
-			fmt.Printf("\n%s\n", issue.Description)
+			fmt.Printf("## Description\n%s\n\n", issue.Description)
 		}
 
-		fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Details:"))
-
+		fmt.Printf("## Core Details\n")
+		fmt.Printf("- **ID**: %s\n", issue.Identifier)
+		fmt.Printf("- **Number**: %d\n", issue.Number)
 		if issue.State != nil {
-			stateStr := issue.State.Name
-			if issue.State.Type == "completed" && issue.CompletedAt != nil {
-				stateStr += fmt.Sprintf(" (%s)", issue.CompletedAt.Format("2006-01-02"))
+			fmt.Printf("- **State**: %s (%s)\n", issue.State.Name, issue.State.Type)
+			if issue.State.Description != nil && *issue.State.Description != "" {
+				fmt.Printf("  - Description: %s\n", *issue.State.Description)
 			}
-			fmt.Printf("State: %s\n",
-				color.New(color.FgGreen).Sprint(stateStr))
 		}
-
 		if issue.Assignee != nil {
-			fmt.Printf("Assignee: %s\n",
-				color.New(color.FgCyan).Sprint(issue.Assignee.Name))
+			fmt.Printf("- **Assignee**: %s (%s)\n", issue.Assignee.Name, issue.Assignee.Email)
+			if issue.Assignee.DisplayName != "" && issue.Assignee.DisplayName != issue.Assignee.Name {
+				fmt.Printf("  - Display Name: %s\n", issue.Assignee.DisplayName)
+			}
 		} else {
-			fmt.Printf("Assignee: %s\n",
-				color.New(color.FgRed).Sprint("Unassigned"))
+			fmt.Printf("- **Assignee**: Unassigned\n")
+		}
+		if issue.Creator != nil {
+			fmt.Printf("- **Creator**: %s (%s)\n", issue.Creator.Name, issue.Creator.Email)
 		}
-
 		if issue.Team != nil {
-			fmt.Printf("Team: %s\n",
-				color.New(color.FgMagenta).Sprint(issue.Team.Name))
+			fmt.Printf("- **Team**: %s (%s)\n", issue.Team.Name, issue.Team.Key)
+			if issue.Team.Description != "" {
+				fmt.Printf("  - Description: %s\n", issue.Team.Description)
+			}
+		}
+		fmt.Printf("- **Priority**: %s (%d)\n", priorityToString(issue.Priority), issue.Priority)
+		if issue.PriorityLabel != "" {
+			fmt.Printf("- **Priority Label**: %s\n", issue.PriorityLabel)
+		}
+		if issue.Estimate != nil {
+			fmt.Printf("- **Estimate**: %.1f\n", *issue.Estimate)
+		}
+
+		fmt.Printf("\n## Status & Dates\n")
+		fmt.Printf("- **Created**: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05"))
+		fmt.Printf("- **Updated**: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05"))
+		if issue.TriagedAt != nil {
+			fmt.Printf("- **Triaged**: %s\n", issue.TriagedAt.Format("2006-01-02 15:04:05"))
+		}
+		if issue.CompletedAt != nil {
+			fmt.Printf("- **Completed**: %s\n", issue.CompletedAt.Format("2006-01-02 15:04:05"))
+		}
+		if issue.CanceledAt != nil {
+			fmt.Printf("- **Canceled**: %s\n", issue.CanceledAt.Format("2006-01-02 15:04:05"))
+		}
+		if issue.ArchivedAt != nil {
+			fmt.Printf("- **Archived**: %s\n", issue.ArchivedAt.Format("2006-01-02 15:04:05"))
+		}
+		if issue.DueDate != nil && *issue.DueDate != "" {
+			fmt.Printf("- **Due Date**: %s\n", *issue.DueDate)
+		}
+		if issue.SnoozedUntilAt != nil {
+			fmt.Printf("- **Snoozed Until**: %s\n", issue.SnoozedUntilAt.Format("2006-01-02 15:04:05"))
 		}
 
-		fmt.Printf("Priority: %s\n", priorityToString(issue.Priority))
+		fmt.Printf("\n## Technical Details\n")
+		fmt.Printf("- **Board Order**: %.2f\n", issue.BoardOrder)
+		fmt.Printf("- **Sub-Issue Sort Order**: %.2f\n", issue.SubIssueSortOrder)
+		if issue.BranchName != "" {
+			fmt.Printf("- **Git Branch**: %s\n", issue.BranchName)
+		}
+		if issue.CustomerTicketCount > 0 {
+			fmt.Printf("- **Customer Ticket Count**: %d\n", issue.CustomerTicketCount)
+		}
+		if len(issue.PreviousIdentifiers) > 0 {
+			fmt.Printf("- **Previous Identifiers**: %s\n", strings.Join(issue.PreviousIdentifiers, ", "))
+		}
+		if issue.IntegrationSourceType != nil && *issue.IntegrationSourceType != "" {
+			fmt.Printf("- **Integration Source**: %s\n", *issue.IntegrationSourceType)
+		}
+		if issue.ExternalUserCreator != nil {
+			fmt.Printf("- **External Creator**: %s (%s)\n", issue.ExternalUserCreator.Name, issue.ExternalUserCreator.Email)
+		}
+		fmt.Printf("- **URL**: %s\n", issue.URL)
 
-		// Show project and cycle info
+		// Project and Cycle Info
 		if issue.Project != nil {
-			fmt.Printf("Project: %s (%s)\n",
-				color.New(color.FgBlue).Sprint(issue.Project.Name),
-				color.New(color.FgWhite, color.Faint).Sprintf("%.0f%%", issue.Project.Progress*100))
+			fmt.Printf("\n## Project\n")
+			fmt.Printf("- **Name**: %s\n", issue.Project.Name)
+			fmt.Printf("- **State**: %s\n", issue.Project.State)
+			fmt.Printf("- **Progress**: %.0f%%\n", issue.Project.Progress*100)
+			if issue.Project.Health != "" {
+				fmt.Printf("- **Health**: %s\n", issue.Project.Health)
+			}
+			if issue.Project.Description != "" {
+				fmt.Printf("- **Description**: %s\n", issue.Project.Description)
+			}
 		}
 
 		if issue.Cycle != nil {
-			fmt.Printf("Cycle: %s\n",
-				color.New(color.FgMagenta).Sprint(issue.Cycle.Name))
+			fmt.Printf("\n## Cycle\n")
+			fmt.Printf("- **Name**: %s (#%d)\n", issue.Cycle.Name, issue.Cycle.Number)
+			if issue.Cycle.Description != nil && *issue.Cycle.Description != "" {
+				fmt.Printf("- **Description**: %s\n", *issue.Cycle.Description)
+			}
+			fmt.Printf("- **Period**: %s to %s\n", issue.Cycle.StartsAt, issue.Cycle.EndsAt)
+			fmt.Printf("- **Progress**: %.0f%%\n", issue.Cycle.Progress*100)
+			if issue.Cycle.CompletedAt != nil {
+				fmt.Printf("- **Completed**: %s\n", issue.Cycle.CompletedAt.Format("2006-01-02"))
+			}
 		}
 
-		fmt.Printf("Created: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05"))
-		fmt.Printf("Updated: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05"))
-
-		if issue.DueDate != nil && *issue.DueDate != "" {
-			fmt.Printf("Due Date: %s\n",
-				color.New(color.FgYellow).Sprint(*issue.DueDate))
+		// Labels
+		if issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
+			fmt.Printf("\n## Labels\n")
+			for _, label := range issue.Labels.Nodes {
+				fmt.Printf("- %s", label.Name)
+				if label.Description != nil && *label.Description != "" {
+					fmt.Printf(" - %s", *label.Description)
+				}
+				fmt.Println()
+			}
 		}
 
-		if issue.SnoozedUntilAt != nil {
-			fmt.Printf("Snoozed Until: %s\n",
-				color.New(color.FgYellow).Sprint(issue.SnoozedUntilAt.Format("2006-01-02 15:04:05")))
+		// Subscribers
+		if issue.Subscribers != nil && len(issue.Subscribers.Nodes) > 0 {
+			fmt.Printf("\n## Subscribers\n")
+			for _, subscriber := range issue.Subscribers.Nodes {
+				fmt.Printf("- %s (%s)\n", subscriber.Name, subscriber.Email)
+			}
 		}
 
-		// Show git branch if available
-		if issue.BranchName != "" {
-			fmt.Printf("Git Branch: %s\n",
-				color.New(color.FgGreen).Sprint(issue.BranchName))
+		// Relations
+		if issue.Relations != nil && len(issue.Relations.Nodes) > 0 {
+			fmt.Printf("\n## Related Issues\n")
+			for _, relation := range issue.Relations.Nodes {
+				if relation.RelatedIssue != nil {
+					relationType := relation.Type
+					switch relationType {
+					case "blocks":
+						relationType = "Blocks"
+					case "blocked":
+						relationType = "Blocked by"
+					case "related":
+						relationType = "Related to"
+					case "duplicate":
+						relationType = "Duplicate of"
+					}
+					fmt.Printf("- %s: %s - %s", relationType, relation.RelatedIssue.Identifier, relation.RelatedIssue.Title)
+					if relation.RelatedIssue.State != nil {
+						fmt.Printf(" [%s]", relation.RelatedIssue.State.Name)
+					}
+					fmt.Println()
+				}
+			}
 		}
 
-		// Show URL
-		if issue.URL != "" {
-			fmt.Printf("URL: %s\n",
-				color.New(color.FgBlue, color.Underline).Sprint(issue.URL))
+		// Reactions
+		if len(issue.Reactions) > 0 {
+			fmt.Printf("\n## Reactions\n")
+			reactionMap := make(map[string][]string)
+			for _, reaction := range issue.Reactions {
+				userName := "Unknown"
+				if reaction.User != nil {
+					userName = reaction.User.Name
+				}
+				reactionMap[reaction.Emoji] = append(reactionMap[reaction.Emoji], userName)
+			}
+			for emoji, users := range reactionMap {
+				fmt.Printf("- %s: %s\n", emoji, strings.Join(users, ", "))
+			}
 		}
 
 		// Show parent issue if this is a sub-issue
 		if issue.Parent != nil {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Parent Issue:"))
-			fmt.Printf("  %s %s\n",
-				color.New(color.FgCyan).Sprint(issue.Parent.Identifier),
-				issue.Parent.Title)
+			fmt.Printf("\n## Parent Issue\n")
+			fmt.Printf("- %s: %s\n", issue.Parent.Identifier, issue.Parent.Title)
 		}
 
 		// Show sub-issues if any
 		if issue.Children != nil && len(issue.Children.Nodes) > 0 {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Sub-issues:"))
+			fmt.Printf("\n## Sub-issues\n")
 			for _, child := range issue.Children.Nodes {
-				stateIcon := "○"
+				stateStr := ""
 				if child.State != nil {
 					switch child.State.Type {
 					case "completed", "done":
-						stateIcon = color.New(color.FgGreen).Sprint("✓")
+						stateStr = "[x]"
 					case "started", "in_progress":
-						stateIcon = color.New(color.FgBlue).Sprint("◐")
+						stateStr = "[~]"
 					case "canceled":
-						stateIcon = color.New(color.FgRed).Sprint("✗")
+						stateStr = "[-]"
+					default:
+						stateStr = "[ ]"
 					}
+				} else {
+					stateStr = "[ ]"
 				}
 
 				assignee := "Unassigned"
@@ -612,50 +979,205 @@ var issueGetCmd = &cobra.Command{
 					assignee = child.Assignee.Name
 				}
 
-				fmt.Printf("  %s %s %s (%s)\n",
-					stateIcon,
-					color.New(color.FgCyan).Sprint(child.Identifier),
-					child.Title,
-					color.New(color.FgWhite, color.Faint).Sprint(assignee))
+				fmt.Printf("- %s %s: %s (%s)\n", stateStr, child.Identifier, child.Title, assignee)
 			}
 		}
 
 		// Show attachments if any
 		if issue.Attachments != nil && len(issue.Attachments.Nodes) > 0 {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Attachments:"))
+			fmt.Printf("\n## Attachments\n")
 			for _, attachment := range issue.Attachments.Nodes {
-				fmt.Printf("  📎 %s - %s\n",
-					attachment.Title,
-					color.New(color.FgBlue, color.Underline).Sprint(attachment.URL))
+				fmt.Printf("- [%s](%s)\n", attachment.Title, attachment.URL)
 			}
 		}
 
 		// Show recent comments if any
 		if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Recent Comments:"))
+			fmt.Printf("\n## Recent Comments\n")
 			for _, comment := range issue.Comments.Nodes {
 				authorName := "Unknown"
 				if comment.User != nil {
 					authorName = comment.User.Name
 				}
-				fmt.Printf("  💬 %s - %s\n",
-					color.New(color.FgCyan).Sprint(authorName),
-					color.New(color.FgWhite, color.Faint).Sprint(comment.CreatedAt.Format("2006-01-02 15:04")))
-				// Show first line of comment
-				lines := strings.Split(comment.Body, "\n")
-				if len(lines) > 0 && lines[0] != "" {
-					preview := lines[0]
-					if len(preview) > 60 {
-						preview = preview[:57] + "..."
+				fmt.Printf("\n### %s - %s\n", authorName, comment.CreatedAt.Format("2006-01-02 15:04"))
+				if comment.EditedAt != nil {
+					fmt.Printf("*(edited %s)*\n", comment.EditedAt.Format("2006-01-02 15:04"))
+				}
+				fmt.Printf("%s\n", comment.Body)
+				if comment.Children != nil && len(comment.Children.Nodes) > 0 {
+					for _, reply := range comment.Children.Nodes {
+						replyAuthor := "Unknown"
+						if reply.User != nil {
+							replyAuthor = reply.User.Name
+						}
+						fmt.Printf("\n  **Reply from %s**: %s\n", replyAuthor, reply.Body)
 					}
-					fmt.Printf("     %s\n", preview)
 				}
 			}
-			fmt.Printf("\n  %s Use 'linctl comment list %s' to see all comments\n",
-				color.New(color.FgWhite, color.Faint).Sprint("→"),
-				issue.Identifier)
+			fmt.Printf("\n> Use `linctl comment list %s` to see all comments\n", issue.Identifier)
 		}
-	},
+
+		// Show history
+		if issue.History != nil && len(issue.History.Nodes) > 0 {
+			fmt.Printf("\n## Recent History\n")
+			for _, entry := range issue.History.Nodes {
+				fmt.Printf("\n- **%s** by %s", entry.CreatedAt.Format("2006-01-02 15:04"), entry.Actor.Name)
+				changes := historyChanges(&entry)
+
+				if len(changes) > 0 {
+					fmt.Printf("\n  - %s", strings.Join(changes, "\n  - "))
+				}
+				fmt.Println()
+			}
+		}
+
+		return
+	}
+
+	// Rich display
+	fmt.Printf("%s %s\n",
+		color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
+		color.New(color.FgWhite, color.Bold).Sprint(issue.Title))
+
+	if issue.Description != "" {
+		fmt.Printf("\n%s\n", issue.Description)
+	}
+
+	fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Details:"))
+
+	if issue.State != nil {
+		stateStr := issue.State.Name
+		if issue.State.Type == "completed" && issue.CompletedAt != nil {
+			stateStr += fmt.Sprintf(" (%s)", issue.CompletedAt.Format("2006-01-02"))
+		}
+		fmt.Printf("State: %s\n",
+			color.New(color.FgGreen).Sprint(stateStr))
+	}
+
+	if issue.Assignee != nil {
+		fmt.Printf("Assignee: %s\n",
+			color.New(color.FgCyan).Sprint(issue.Assignee.Name))
+	} else {
+		fmt.Printf("Assignee: %s\n",
+			color.New(color.FgRed).Sprint("Unassigned"))
+	}
+
+	if issue.Team != nil {
+		fmt.Printf("Team: %s\n",
+			color.New(color.FgMagenta).Sprint(issue.Team.Name))
+	}
+
+	fmt.Printf("Priority: %s\n", priorityToString(issue.Priority))
+
+	// Show project and cycle info
+	if issue.Project != nil {
+		fmt.Printf("Project: %s (%s)\n",
+			color.New(color.FgBlue).Sprint(issue.Project.Name),
+			color.New(color.FgWhite, color.Faint).Sprintf("%.0f%%", issue.Project.Progress*100))
+	}
+
+	if issue.Cycle != nil {
+		fmt.Printf("Cycle: %s\n",
+			color.New(color.FgMagenta).Sprint(issue.Cycle.Name))
+	}
+
+	fmt.Printf("Created: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05"))
+	fmt.Printf("Updated: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05"))
+
+	if issue.DueDate != nil && *issue.DueDate != "" {
+		fmt.Printf("Due Date: %s\n",
+			color.New(color.FgYellow).Sprint(*issue.DueDate))
+	}
+
+	if issue.SnoozedUntilAt != nil {
+		fmt.Printf("Snoozed Until: %s\n",
+			color.New(color.FgYellow).Sprint(issue.SnoozedUntilAt.Format("2006-01-02 15:04:05")))
+	}
+
+	// Show git branch if available
+	if issue.BranchName != "" {
+		fmt.Printf("Git Branch: %s\n",
+			color.New(color.FgGreen).Sprint(issue.BranchName))
+	}
+
+	// Show URL
+	if issue.URL != "" {
+		fmt.Printf("URL: %s\n",
+			color.New(color.FgBlue, color.Underline).Sprint(issue.URL))
+	}
+
+	// Show parent issue if this is a sub-issue
+	if issue.Parent != nil {
+		fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Parent Issue:"))
+		fmt.Printf("  %s %s\n",
+			color.New(color.FgCyan).Sprint(issue.Parent.Identifier),
+			issue.Parent.Title)
+	}
+
+	// Show sub-issues if any
+	if issue.Children != nil && len(issue.Children.Nodes) > 0 {
+		fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Sub-issues:"))
+		for _, child := range issue.Children.Nodes {
+			stateIcon := "○"
+			if child.State != nil {
+				switch child.State.Type {
+				case "completed", "done":
+					stateIcon = color.New(color.FgGreen).Sprint("✓")
+				case "started", "in_progress":
+					stateIcon = color.New(color.FgBlue).Sprint("◐")
+				case "canceled":
+					stateIcon = color.New(color.FgRed).Sprint("✗")
+				}
+			}
+
+			assignee := "Unassigned"
+			if child.Assignee != nil {
+				assignee = child.Assignee.Name
+			}
+
+			fmt.Printf("  %s %s %s (%s)\n",
+				stateIcon,
+				color.New(color.FgCyan).Sprint(child.Identifier),
+				child.Title,
+				color.New(color.FgWhite, color.Faint).Sprint(assignee))
+		}
+	}
+
+	// Show attachments if any
+	if issue.Attachments != nil && len(issue.Attachments.Nodes) > 0 {
+		fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Attachments:"))
+		for _, attachment := range issue.Attachments.Nodes {
+			fmt.Printf("  📎 %s - %s\n",
+				attachment.Title,
+				color.New(color.FgBlue, color.Underline).Sprint(attachment.URL))
+		}
+	}
+
+	// Show recent comments if any
+	if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
+		fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Recent Comments:"))
+		for _, comment := range issue.Comments.Nodes {
+			authorName := "Unknown"
+			if comment.User != nil {
+				authorName = comment.User.Name
+			}
+			fmt.Printf("  💬 %s - %s\n",
+				color.New(color.FgCyan).Sprint(authorName),
+				color.New(color.FgWhite, color.Faint).Sprint(comment.CreatedAt.Format("2006-01-02 15:04")))
+			// Show first line of comment
+			lines := strings.Split(comment.Body, "\n")
+			if len(lines) > 0 && lines[0] != "" {
+				preview := lines[0]
+				if len(preview) > 60 {
+					preview = preview[:57] + "..."
+				}
+				fmt.Printf("     %s\n", preview)
+			}
+		}
+		fmt.Printf("\n  %s Use 'linctl comment list %s' to see all comments\n",
+			color.New(color.FgWhite, color.Faint).Sprint("→"),
+			issue.Identifier)
+	}
 }
 
 func buildIssueFilter(cmd *cobra.Command) map[string]interface{} {
@@ -687,201 +1209,2725 @@ func buildIssueFilter(cmd *cobra.Command) map[string]interface{} {
 		}
 	}
 
-	if team, _ := cmd.Flags().GetString("team"); team != "" {
-		filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": team}}
+	if team, _ := cmd.Flags().GetString("team"); team != "" {
+		filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": team}}
+	}
+
+	if priority, _ := cmd.Flags().GetInt("priority"); priority != -1 {
+		filter["priority"] = map[string]interface{}{"eq": priority}
+	}
+
+	// Handle since/until filter on updatedAt, which replaces newer-than
+	since, _ := cmd.Flags().GetString("since")
+	until, _ := cmd.Flags().GetString("until")
+	if since != "" || until != "" {
+		updatedAt, err := utils.DateRangeFilter(since, until)
+		if err != nil {
+			output.Error(err.Error(), viper.GetBool("plaintext"), viper.GetBool("json"))
+			os.Exit(exitCode(err))
+		}
+		filter["updatedAt"] = updatedAt
+
+		if since != "" && cmd.Flags().Changed("newer-than") {
+			fmt.Fprintln(os.Stderr, "Warning: --since overrides --newer-than; ignoring --newer-than")
+		}
+		if since != "" || !cmd.Flags().Changed("newer-than") {
+			return filter
+		}
+	}
+
+	// Handle newer-than filter
+	newerThan, _ := cmd.Flags().GetString("newer-than")
+	createdAt, err := utils.ParseTimeExpression(newerThan)
+	if err != nil {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	if createdAt != "" {
+		filter["createdAt"] = map[string]interface{}{"gte": createdAt}
+	}
+
+	return filter
+}
+
+// loadOutputTemplate parses the --output-template, --template or --template-file flag, if any is set
+func loadOutputTemplate(cmd *cobra.Command) (*template.Template, error) {
+	inline, _ := cmd.Flags().GetString("template")
+	file, _ := cmd.Flags().GetString("template-file")
+
+	if outputTemplate, _ := cmd.Flags().GetString("output-template"); outputTemplate != "" {
+		if inline != "" {
+			return nil, fmt.Errorf("--output-template and --template cannot be used together")
+		}
+		inline = outputTemplate
+	}
+
+	if inline != "" && file != "" {
+		return nil, fmt.Errorf("--template and --template-file cannot be used together")
+	}
+
+	if file != "" {
+		return output.ParseTemplateFile(file)
+	}
+
+	if inline != "" {
+		return output.ParseTemplate("template", inline)
+	}
+
+	return nil, nil
+}
+
+// userResolver is the subset of the API client used to resolve assignees
+type userResolver interface {
+	GetViewer(ctx context.Context) (*api.User, error)
+	FindUser(ctx context.Context, query string) (*api.User, error)
+}
+
+// resolveAssigneeID resolves "me", an email or a name to a user ID
+func resolveAssigneeID(ctx context.Context, client userResolver, assignee string) (string, error) {
+	user, err := resolveAssignee(ctx, client, assignee)
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// resolveAssignee resolves "me", an email or a name to a user
+func resolveAssignee(ctx context.Context, client userResolver, assignee string) (*api.User, error) {
+	if assignee == "me" {
+		viewer, err := client.GetViewer(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current user: %w", err)
+		}
+		return viewer, nil
+	}
+
+	return client.FindUser(ctx, assignee)
+}
+
+// issueRelationFetcher is the subset of the API client used to filter issues by dependency
+type issueRelationFetcher interface {
+	GetIssueRelationsByIDs(ctx context.Context, ids []string) ([]api.Issue, error)
+}
+
+// filterIssuesByDependency keeps the issues blocked by an incomplete issue
+// (blocked) and/or blocking an incomplete issue (blocking), fetching their
+// relations in batches
+func filterIssuesByDependency(ctx context.Context, client issueRelationFetcher, issues []api.Issue, blocked, blocking bool) ([]api.Issue, error) {
+	if len(issues) == 0 {
+		return issues, nil
+	}
+
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.ID
+	}
+	related, err := client.GetIssueRelationsByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch issue relations: %w", err)
+	}
+
+	matched := make([]api.Issue, 0, len(issues))
+	for i, issue := range issues {
+		if blocked && !isBlocked(related[i]) {
+			continue
+		}
+		if blocking && !isBlocking(related[i]) {
+			continue
+		}
+		matched = append(matched, issue)
+	}
+	return matched, nil
+}
+
+// isBlocked reports whether an incomplete issue blocks issue
+func isBlocked(issue api.Issue) bool {
+	if issue.InverseRelations == nil {
+		return false
+	}
+	for _, relation := range issue.InverseRelations.Nodes {
+		if relation.Type == api.IssueRelationBlocks && isIncomplete(relation.Issue) {
+			return true
+		}
+	}
+	return false
+}
+
+// isBlocking reports whether issue blocks an incomplete issue
+func isBlocking(issue api.Issue) bool {
+	if issue.Relations == nil {
+		return false
+	}
+	for _, relation := range issue.Relations.Nodes {
+		if relation.Type == api.IssueRelationBlocks && isIncomplete(relation.RelatedIssue) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIncomplete reports whether issue is neither completed nor canceled
+func isIncomplete(issue *api.Issue) bool {
+	if issue == nil {
+		return false
+	}
+	return issue.State == nil || (issue.State.Type != "completed" && issue.State.Type != "canceled")
+}
+
+// labelLister is the subset of the API client used to resolve label names
+type labelLister interface {
+	GetLabels(ctx context.Context, teamID string) (*api.Labels, error)
+}
+
+// resolveLabelIDs resolves label names (case-insensitive) to the IDs of a team's labels
+func resolveLabelIDs(ctx context.Context, client labelLister, teamID string, names []string) ([]string, error) {
+	labels, err := client.GetLabels(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, label := range labels.Nodes {
+			if strings.EqualFold(label.Name, name) {
+				ids = append(ids, label.ID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("label '%s' not found in team", name)
+		}
+	}
+	return ids, nil
+}
+
+// readTextInput reads a multi-line text value from flagName, from fileFlagName,
+// or from stdin when flagName is "-". The text is sanitized and validated.
+func readTextInput(cmd *cobra.Command, flagName, fileFlagName string, stdin io.Reader) (string, error) {
+	value, _ := cmd.Flags().GetString(flagName)
+	file, _ := cmd.Flags().GetString(fileFlagName)
+
+	if cmd.Flags().Changed(flagName) && file != "" {
+		return "", fmt.Errorf("--%s and --%s cannot be used together", flagName, fileFlagName)
+	}
+
+	switch {
+	case file != "":
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read --%s: %w", fileFlagName, err)
+		}
+		value = string(data)
+	case value == "-":
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read --%s from stdin: %w", flagName, err)
+		}
+		value = string(data)
+	}
+
+	if err := security.ValidateDescription(value); err != nil {
+		return "", err
+	}
+
+	return scanSecrets(flagName, security.SanitizeMultilineInput(value))
+}
+
+// scanSecrets applies LINCTL_SECRET_POLICY to text sent to Linear as field when
+// LINCTL_REDACT_SECRETS is set, warning on stderr about each redacted secret
+func scanSecrets(field, text string) (string, error) {
+	text, findings, err := security.ScanSecrets(field, text)
+	if err != nil {
+		return "", err
+	}
+	for _, finding := range findings {
+		fmt.Fprintf(os.Stderr, "Warning: redacted a possible %s on line %d of the %s\n", finding.Kind, finding.Line, field)
+	}
+	return text, nil
+}
+
+// checkArchived rejects archived issues unless they were explicitly requested
+func checkArchived(issue *api.Issue, includeArchived bool) error {
+	if issue.ArchivedAt != nil && !includeArchived {
+		return fmt.Errorf("Issue %s is archived. Use --include-archived to show it", issue.Identifier)
+	}
+	return nil
+}
+
+func priorityToString(priority int) string {
+	switch priority {
+	case 0:
+		return "None"
+	case 1:
+		return "Urgent"
+	case 2:
+		return "High"
+	case 3:
+		return "Normal"
+	case 4:
+		return "Low"
+	default:
+		return "Unknown"
+	}
+}
+
+// confirmAction asks the user a yes/no question on stdin, defaulting to no
+func confirmAction(prompt string) bool {
+	return confirmFrom(os.Stdin, prompt)
+}
+
+// confirmFrom asks a yes/no question, reading the answer from in. The prompt is
+// written to output.PromptWriter so it is still shown under --quiet.
+func confirmFrom(in io.Reader, prompt string) bool {
+	fmt.Fprintf(output.PromptWriter(), "%s [y/N]: ", prompt)
+	reader := bufio.NewReader(in)
+	answer, err := reader.ReadString('\n')
+	if err != nil {
+		return false
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// isNotFoundError reports whether an API error indicates a missing entity
+func isNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if api.ErrorCategoryOf(err) == api.CategoryNotFound {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "not found") ||
+		strings.Contains(msg, "could not find") ||
+		strings.Contains(msg, "entity_not_found")
+}
+
+// issueStateColor returns the color used for a workflow state type in tables
+func issueStateColor(stateType string) *color.Color {
+	switch stateType {
+	case "triage":
+		return color.New(color.FgMagenta)
+	case "backlog":
+		return color.New(color.FgCyan)
+	case "started":
+		return color.New(color.FgBlue)
+	case "completed":
+		return color.New(color.FgGreen)
+	case "canceled":
+		return color.New(color.FgRed)
+	default:
+		return color.New(color.FgWhite)
+	}
+}
+
+// capitalize upper-cases the first letter of s
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func truncateString(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen-3] + "..."
+}
+
+// issueSearchSnippetWidth is the length of the description excerpt shown in search results
+const issueSearchSnippetWidth = 80
+
+var issueSearchCmd = &cobra.Command{
+	Use:     "search QUERY",
+	Aliases: []string{"find"},
+	Short:   "Search issues by text",
+	Long: `Full-text search over issue titles, descriptions and identifiers.
+Results are ranked by relevance and can be narrowed with --team and --state.
+Archived issues are left out unless --include-archived is set.
+
+Examples:
+  linctl issue search "auth bug"
+  linctl issue search login --team ENG --state "In Progress"
+  linctl issue search "old outage" --include-archived
+  linctl issue search "rate limit" --limit 0 --json  # Every match`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		term := security.SanitizeInput(strings.Join(args, " "))
+		if term == "" {
+			output.Error("Search query cannot be empty", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		teamKey, _ := cmd.Flags().GetString("team")
+		if teamKey != "" {
+			if err := security.ValidateTeamKey(teamKey); err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		}
+		state, _ := cmd.Flags().GetString("state")
+		filter := buildIssueSearchFilter(teamKey, security.SanitizeInput(state))
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		limit, _ := cmd.Flags().GetInt("limit")
+		includeArchived, _ := cmd.Flags().GetBool("include-archived")
+
+		ctx, stop := paginationContext()
+		defer stop()
+
+		issues, more, err := fetchLimited(ctx, limit, issuePageSize, func(first int, after string) ([]api.Issue, api.PageInfo, error) {
+			page, err := client.SearchIssues(ctx, term, filter, first, after, includeArchived)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to search issues: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			if issues == nil {
+				issues = []api.Issue{}
+			}
+			output.JSON(issues)
+			return
+		}
+
+		if len(issues) == 0 {
+			output.Info(fmt.Sprintf("No issues found matching %q", term), plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Println("Identifier\tTitle\tState\tSnippet")
+			for _, issue := range issues {
+				stateName := ""
+				if issue.State != nil {
+					stateName = issue.State.Name
+				}
+				fmt.Printf("%s\t%s\t%s\t%s\n",
+					issue.Identifier,
+					issue.Title,
+					stateName,
+					searchSnippet(issue.Description, term, issueSearchSnippetWidth))
+			}
+			return
+		}
+
+		headers := []string{"ID", "Title", "State", "Snippet"}
+		rows := make([][]string, len(issues))
+		for i, issue := range issues {
+			stateName := ""
+			if issue.State != nil {
+				stateName = issueStateColor(issue.State.Type).Sprint(issue.State.Name)
+			}
+			rows[i] = []string{
+				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
+				truncateString(issue.Title, 50),
+				stateName,
+				color.New(color.FgWhite, color.Faint).Sprint(searchSnippet(issue.Description, term, issueSearchSnippetWidth)),
+			}
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		fmt.Printf("\n%s %d issues matching %q\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(issues),
+			term)
+		if more {
+			fmt.Printf("%s Use --limit to see more results\n",
+				color.New(color.FgYellow).Sprint("ℹ️"))
+		}
+	},
+}
+
+// buildIssueSearchFilter narrows a search to a team key and a state name
+func buildIssueSearchFilter(teamKey, state string) map[string]interface{} {
+	filter := make(map[string]interface{})
+	if teamKey != "" {
+		filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": teamKey}}
+	}
+	if state != "" {
+		filter["state"] = map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": state}}
+	}
+	return filter
+}
+
+// searchSnippet returns a single-line excerpt of description of at most width
+// characters, centred on the first word of term that appears in it
+func searchSnippet(description, term string, width int) string {
+	text := strings.Join(strings.Fields(description), " ")
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+
+	// Search the lowercased runes so the match index lines up with runes
+	lower := []rune(strings.ToLower(text))
+	match := -1
+	for _, word := range strings.Fields(strings.ToLower(term)) {
+		if i := indexRunes(lower, []rune(word)); i >= 0 {
+			match = i
+			break
+		}
+	}
+
+	start := 0
+	if match > width/3 {
+		start = match - width/3
+	}
+	if start+width > len(runes) {
+		start = len(runes) - width
+	}
+
+	end := start + width
+	if start > 0 {
+		start++ // room for the leading ellipsis
+	}
+	if end < len(runes) {
+		end-- // room for the trailing ellipsis
+	}
+
+	snippet := strings.TrimSpace(string(runes[start:end]))
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(runes) {
+		snippet += "…"
+	}
+	return snippet
+}
+
+// indexRunes returns the index of the first occurrence of sub in s, or -1
+func indexRunes(s, sub []rune) int {
+	if len(sub) == 0 {
+		return -1
+	}
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if string(s[i:i+len(sub)]) == string(sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+var issueAssignCmd = &cobra.Command{
+	Use:               "assign ISSUE-ID [USER]",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "Assign an issue to a user",
+	Long: `Assign an issue to a user, given by email, name or "me". Without a user the
+issue is assigned to you. Only the assignee is changed.
+
+Examples:
+  linctl issue assign LIN-123                       # Assign to yourself
+  linctl issue assign LIN-123 alice@example.com     # Assign by email
+  linctl issue assign LIN-123 "Alice Smith"         # Assign by name`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		assignee := "me"
+		if len(args) == 2 {
+			assignee = args[1]
+		}
+		runIssueAssign(cmd, args[0], assignee)
+	},
+}
+
+var issueUnassignCmd = &cobra.Command{
+	Use:               "unassign ISSUE-ID",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "Remove the assignee from an issue",
+	Long: `Remove the assignee from an issue. Only the assignee is changed.
+
+Examples:
+  linctl issue unassign LIN-123`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runIssueAssign(cmd, args[0], "")
+	},
+}
+
+// runIssueAssign assigns issueID to assignee, or unassigns it when assignee is empty
+func runIssueAssign(cmd *cobra.Command, issueID, assignee string) {
+	plaintext := viper.GetBool("plaintext")
+	jsonOut := viper.GetBool("json")
+
+	if err := security.ValidateIssueID(issueID); err != nil {
+		output.Error(fmt.Sprintf("Invalid issue ID: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	authHeader, err := auth.GetAuthHeader()
+	if err != nil {
+		output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+		os.Exit(exitAuth)
+	}
+
+	client := api.NewClient(authHeader)
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	client.SetDryRun(dryRun)
+
+	op := "issue.assign"
+	if assignee == "" {
+		op = "issue.unassign"
+	}
+	requireScope(authHeader, op, plaintext, jsonOut)
+
+	issue, user, err := assignIssue(commandContext(), client, issueID, assignee)
+	if printDryRun(err, plaintext, jsonOut) {
+		return
+	}
+	recordAudit(op, issueID, "", err)
+	if err != nil {
+		action := "assign"
+		if assignee == "" {
+			action = "unassign"
+		}
+		output.Error(fmt.Sprintf("Failed to %s issue: %v", action, err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(issue)
+		return
+	}
+
+	if user == nil {
+		if plaintext {
+			fmt.Printf("Unassigned %s\n", issue.Identifier)
+		} else {
+			fmt.Printf("%s Unassigned %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier))
+		}
+		return
+	}
+
+	if plaintext {
+		fmt.Printf("Assigned %s to %s\n", issue.Identifier, user.Name)
+	} else {
+		fmt.Printf("%s Assigned %s to %s\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
+			color.New(color.FgCyan).Sprint(user.Name))
+	}
+}
+
+// issueAssigner is the subset of the API client used by issue assign/unassign
+type issueAssigner interface {
+	userResolver
+	UpdateIssue(ctx context.Context, id string, input map[string]interface{}) (*api.Issue, error)
+}
+
+// assignIssue sets the assignee of issueID, and nothing else. An empty
+// assignee unassigns the issue, in which case the returned user is nil.
+func assignIssue(ctx context.Context, client issueAssigner, issueID, assignee string) (*api.Issue, *api.User, error) {
+	var user *api.User
+	input := map[string]interface{}{"assigneeId": nil}
+	if assignee != "" {
+		var err error
+		user, err = resolveAssignee(ctx, client, assignee)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not resolve assignee %q: %w", assignee, err)
+		}
+		input["assigneeId"] = user.ID
+	}
+
+	issue, err := client.UpdateIssue(ctx, issueID, input)
+	if err != nil {
+		return nil, user, err
+	}
+	return issue, user, nil
+}
+
+var issueDeleteCmd = &cobra.Command{
+	Use:               "delete [issue-id]",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Aliases:           []string{"archive", "rm"},
+	Short:             "Archive an issue",
+	Long: `Archive an issue. Archived issues are hidden from lists but can be restored with --unarchive.
+
+Examples:
+  linctl issue delete LIN-123              # Archive after confirmation
+  linctl issue delete LIN-123 --yes        # Archive without prompting
+  linctl issue delete LIN-123 --unarchive  # Restore an archived issue`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		issueID := strings.TrimSpace(args[0])
+
+		unarchive, _ := cmd.Flags().GetBool("unarchive")
+		yes, _ := cmd.Flags().GetBool("yes")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		action := "archive"
+		if unarchive {
+			action = "unarchive"
+		}
+
+		if err := security.ValidateIssueID(issueID); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		// JSON mode is non-interactive, so it never prompts
+		if !yes && !jsonOut && !dryRun {
+			if !confirmAction(fmt.Sprintf("Are you sure you want to %s %s?", action, issueID)) {
+				fmt.Println("Aborted")
+				return
+			}
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		client.SetDryRun(dryRun)
+		requireScope(authHeader, "issue."+action, plaintext, jsonOut)
+
+		var result *api.IssueArchivePayload
+		if unarchive {
+			result, err = client.UnarchiveIssue(commandContext(), issueID)
+		} else {
+			result, err = client.ArchiveIssue(commandContext(), issueID)
+		}
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+
+		if err == nil && !result.Success {
+			err = fmt.Errorf("the %s was not confirmed by Linear", action)
+		}
+		recordAudit("issue."+action, issueID, "", err)
+
+		if err != nil {
+			message := fmt.Sprintf("Failed to %s issue: %v", action, err)
+			if isNotFoundError(err) {
+				message = fmt.Sprintf("Issue %s not found", issueID)
+			}
+
+			if jsonOut {
+				output.JSON(map[string]interface{}{
+					"status":  "error",
+					"action":  action,
+					"issue":   issueID,
+					"message": message,
+				})
+			} else {
+				output.Error(message, plaintext, jsonOut)
+			}
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"status": "success",
+				"action": action,
+				"issue":  issueID,
+				"entity": result.Entity,
+			})
+		} else if plaintext {
+			fmt.Printf("%sd %s\n", capitalize(action), issueID)
+		} else {
+			fmt.Printf("%s %sd %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				capitalize(action),
+				color.New(color.FgCyan, color.Bold).Sprint(issueID))
+		}
+	},
+}
+
+// Kinds of link accepted by 'issue link'
+const (
+	linkBlocks    = "blocks"
+	linkBlockedBy = "blocked-by"
+	linkRelated   = "related"
+)
+
+// issueRelationResult is the JSON output of 'issue link' and 'issue unlink'
+type issueRelationResult struct {
+	Status       string             `json:"status"`
+	Action       string             `json:"action"`
+	Type         string             `json:"type"`
+	Issue        string             `json:"issue"`
+	RelatedIssue string             `json:"related_issue"`
+	Relation     *api.IssueRelation `json:"relation"`
+}
+
+var issueLinkCmd = &cobra.Command{
+	Use:               "link [issue-id]",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "Link an issue to another issue",
+	Long: `Create a blocks, blocked-by or related relationship between two issues.
+
+Examples:
+  linctl issue link LIN-1 --blocks LIN-2      # LIN-1 blocks LIN-2
+  linctl issue link LIN-1 --blocked-by LIN-2  # LIN-2 blocks LIN-1
+  linctl issue link LIN-1 --related LIN-3     # LIN-1 is related to LIN-3`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		issueID := strings.TrimSpace(args[0])
+
+		blocks, _ := cmd.Flags().GetString("blocks")
+		blockedBy, _ := cmd.Flags().GetString("blocked-by")
+		related, _ := cmd.Flags().GetString("related")
+
+		kind, otherID, err := parseLinkFlags(blocks, blockedBy, related)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		for _, id := range []string{issueID, otherID} {
+			if err := security.ValidateIssueID(id); err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
+
+		requireScope(authHeader, "issue.link", plaintext, jsonOut)
+
+		issue, other := getIssuePair(client, issueID, otherID, plaintext, jsonOut)
+
+		relation, err := client.CreateIssueRelation(commandContext(), newIssueRelationInput(issue, other, kind))
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		recordAudit("issue.link", issueID, "", err)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to link issues: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		printIssueRelation("link", kind, issue.Identifier, other.Identifier, relation, plaintext, jsonOut)
+	},
+}
+
+var issueUnlinkCmd = &cobra.Command{
+	Use:               "unlink [issue-id] [other-issue-id]",
+	ValidArgsFunction: completeIssuePair,
+	Short:             "Remove the relationship between two issues",
+	Long: `Remove the relationship between two issues, whichever direction it was created in.
+
+Examples:
+  linctl issue unlink LIN-1 LIN-2`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		issueID := strings.TrimSpace(args[0])
+		otherID := strings.TrimSpace(args[1])
+
+		for _, id := range []string{issueID, otherID} {
+			if err := security.ValidateIssueID(id); err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
+		requireScope(authHeader, "issue.unlink", plaintext, jsonOut)
+
+		issue, other := getIssuePair(client, issueID, otherID, plaintext, jsonOut)
+
+		relation := findIssueRelation(issue, other)
+		if relation == nil {
+			output.Error(fmt.Sprintf("No relationship found between %s and %s", issue.Identifier, other.Identifier), plaintext, jsonOut)
+			os.Exit(exitNotFound)
+		}
+
+		success, err := client.DeleteIssueRelation(commandContext(), relation.ID)
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		if err == nil && !success {
+			err = fmt.Errorf("the deletion was not confirmed by Linear")
+		}
+		recordAudit("issue.unlink", issueID, "", err)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to unlink issues: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		printIssueRelation("unlink", relation.Type, relation.Issue.Identifier, relation.RelatedIssue.Identifier, relation, plaintext, jsonOut)
+	},
+}
+
+// attachmentPageSize is the number of attachments requested per page by issue attachments
+const attachmentPageSize = 50
+
+var issueAttachCmd = &cobra.Command{
+	Use:               "attach [issue-id]",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "Attach a link to an issue",
+	Long: `Attach an external link, such as a pull request or design doc, to an issue.
+
+Links must use HTTPS unless --allow-http is set for internal links. The title
+defaults to the URL.
+
+Examples:
+  linctl issue attach LIN-123 --url https://github.com/org/repo/pull/42 --title "PR #42"
+  linctl issue attach LIN-123 --url http://wiki.internal/runbook --allow-http
+  linctl issue attach LIN-123 --url https://docs.example.com/spec --json  # Prints the attachment ID`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		issueID := strings.TrimSpace(args[0])
+
+		url, _ := cmd.Flags().GetString("url")
+		title, _ := cmd.Flags().GetString("title")
+		subtitle, _ := cmd.Flags().GetString("subtitle")
+		allowHTTP, _ := cmd.Flags().GetBool("allow-http")
+
+		url = strings.TrimSpace(url)
+		title = strings.TrimSpace(title)
+		if title == "" {
+			title = url
+		}
+
+		if err := security.ValidateIssueID(issueID); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if err := security.ValidateAttachmentURL(url, allowHTTP); err != nil {
+			message := err.Error()
+			if strings.HasPrefix(url, "http://") {
+				message += " (use --allow-http for internal links)"
+			}
+			output.Error(message, plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if err := security.ValidateTitle(title); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		input := api.AttachmentInput{
+			IssueID: issueID,
+			URL:     url,
+			Title:   security.SanitizeInput(title),
+		}
+		if subtitle = strings.TrimSpace(subtitle); subtitle != "" {
+			sanitized := security.SanitizeInput(subtitle)
+			input.Subtitle = &sanitized
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
+
+		requireScope(authHeader, "issue.attach", plaintext, jsonOut)
+
+		attachment, err := client.CreateAttachment(commandContext(), input)
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		recordAudit("issue.attach", issueID, "", err)
+		if err != nil {
+			message := fmt.Sprintf("Failed to attach link: %v", err)
+			if isNotFoundError(err) {
+				message = fmt.Sprintf("Issue %s not found", issueID)
+			}
+			output.Error(message, plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(attachment)
+		} else if plaintext {
+			fmt.Printf("Attached %s to %s\n", attachment.URL, issueID)
+			fmt.Printf("ID: %s\n", attachment.ID)
+		} else {
+			fmt.Printf("%s Attached %s to %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				attachment.Title,
+				color.New(color.FgCyan, color.Bold).Sprint(issueID))
+			fmt.Printf("  📎 %s\n", color.New(color.FgBlue, color.Underline).Sprint(attachment.URL))
+		}
+	},
+}
+
+var issueAttachmentsCmd = &cobra.Command{
+	Use:               "attachments [issue-id]",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "List the attachments on an issue",
+	Long: `List the links and files attached to an issue.
+
+Examples:
+  linctl issue attachments LIN-123
+  linctl issue attachments LIN-123 --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		issueID := strings.TrimSpace(args[0])
+
+		if err := security.ValidateIssueID(issueID); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		ctx, cancel := paginationContext()
+		defer cancel()
+
+		attachments, _, err := fetchLimited(ctx, limit, attachmentPageSize, func(first int, after string) ([]api.Attachment, api.PageInfo, error) {
+			page, err := client.GetIssueAttachments(ctx, issueID, first, after)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			if page.PageInfo == nil {
+				return page.Nodes, api.PageInfo{}, nil
+			}
+			return page.Nodes, *page.PageInfo, nil
+		})
+		if err != nil {
+			message := fmt.Sprintf("Failed to list attachments: %v", err)
+			if isNotFoundError(err) {
+				message = fmt.Sprintf("Issue %s not found", issueID)
+			}
+			output.Error(message, plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(attachments)
+			return
+		}
+		if len(attachments) == 0 {
+			output.Info(fmt.Sprintf("No attachments on %s", issueID), plaintext, jsonOut)
+			return
+		}
+
+		headers := []string{"Title", "URL", "Created", "ID"}
+		rows := make([][]string, len(attachments))
+		for i, attachment := range attachments {
+			rows[i] = []string{
+				truncateString(attachment.Title, 40),
+				attachment.URL,
+				attachment.CreatedAt.Format("2006-01-02"),
+				attachment.ID,
+			}
+			if !plaintext {
+				rows[i][1] = color.New(color.FgBlue, color.Underline).Sprint(attachment.URL)
+				rows[i][3] = color.New(color.FgWhite, color.Faint).Sprint(attachment.ID)
+			}
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+	},
+}
+
+// parseLinkFlags returns the kind of link requested and the issue to link to.
+// Exactly one of the link flags must be set.
+func parseLinkFlags(blocks, blockedBy, related string) (string, string, error) {
+	var kind, target string
+	count := 0
+	for _, flag := range []struct{ kind, value string }{
+		{linkBlocks, blocks},
+		{linkBlockedBy, blockedBy},
+		{linkRelated, related},
+	} {
+		if value := strings.TrimSpace(flag.value); value != "" {
+			kind, target = flag.kind, value
+			count++
+		}
+	}
+
+	if count != 1 {
+		return "", "", fmt.Errorf("exactly one of --blocks, --blocked-by or --related is required")
+	}
+	return kind, target, nil
+}
+
+// newIssueRelationInput builds the relation input for a link kind.
+// Linear has no blocked-by type, so it is created as the other issue blocking this one.
+func newIssueRelationInput(issue, other *api.Issue, kind string) api.IssueRelationInput {
+	switch kind {
+	case linkBlockedBy:
+		return api.IssueRelationInput{IssueID: other.ID, RelatedIssueID: issue.ID, Type: api.IssueRelationBlocks}
+	case linkRelated:
+		return api.IssueRelationInput{IssueID: issue.ID, RelatedIssueID: other.ID, Type: api.IssueRelationRelated}
+	default:
+		return api.IssueRelationInput{IssueID: issue.ID, RelatedIssueID: other.ID, Type: api.IssueRelationBlocks}
+	}
+}
+
+// findIssueRelation returns the relation between two issues in either direction,
+// filling in both ends so callers can report them
+func findIssueRelation(issue, other *api.Issue) *api.IssueRelation {
+	for _, pair := range [][2]*api.Issue{{issue, other}, {other, issue}} {
+		source, target := pair[0], pair[1]
+		if source.Relations == nil {
+			continue
+		}
+		for _, relation := range source.Relations.Nodes {
+			if relation.RelatedIssue != nil && relation.RelatedIssue.ID == target.ID {
+				relation := relation
+				relation.Issue = &api.Issue{ID: source.ID, Identifier: source.Identifier, Title: source.Title}
+				relation.RelatedIssue = &api.Issue{ID: target.ID, Identifier: target.Identifier, Title: target.Title}
+				return &relation
+			}
+		}
+	}
+	return nil
+}
+
+// getIssuePair resolves two issue identifiers, exiting if either cannot be found
+func getIssuePair(client *api.Client, issueID, otherID string, plaintext, jsonOut bool) (*api.Issue, *api.Issue) {
+	issues := make([]*api.Issue, 2)
+	for i, id := range []string{issueID, otherID} {
+		issue, err := client.GetIssue(commandContext(), id)
+		if err != nil {
+			message := fmt.Sprintf("Failed to get issue %s: %v", id, err)
+			if isNotFoundError(err) {
+				message = fmt.Sprintf("Issue %s not found", id)
+			}
+			output.Error(message, plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		issues[i] = issue
+	}
+	return issues[0], issues[1]
+}
+
+// printIssueRelation prints the result of linking or unlinking two issues
+func printIssueRelation(action, kind, issueID, relatedID string, relation *api.IssueRelation, plaintext, jsonOut bool) {
+	verb := "Linked"
+	if action == "unlink" {
+		verb = "Unlinked"
+	}
+
+	if jsonOut {
+		output.JSON(issueRelationResult{
+			Status:       "success",
+			Action:       action,
+			Type:         kind,
+			Issue:        issueID,
+			RelatedIssue: relatedID,
+			Relation:     relation,
+		})
+	} else if plaintext {
+		fmt.Printf("%s %s %s %s\n", verb, issueID, kind, relatedID)
+	} else {
+		fmt.Printf("%s %s %s %s %s\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			verb,
+			color.New(color.FgCyan, color.Bold).Sprint(issueID),
+			kind,
+			color.New(color.FgCyan, color.Bold).Sprint(relatedID))
+	}
+}
+
+// issueMoveResult is the JSON output of 'issue move'
+type issueMoveResult struct {
+	Status        string `json:"status"`
+	OldIdentifier string `json:"old_identifier"`
+	NewIdentifier string `json:"new_identifier"`
+	FromTeam      string `json:"from_team"`
+	ToTeam        string `json:"to_team"`
+	State         string `json:"state,omitempty"`
+}
+
+var issueMoveCmd = &cobra.Command{
+	Use:               "move [issue-id]",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "Move an issue to a different team",
+	Long: `Move an issue to a different team. Moving changes the issue identifier,
+so both the old and new identifiers are printed.
+
+With --remap-state the issue is put in the target team's workflow state with
+the same name as its current state, or failing that the first state of the
+same type (e.g. started).
+
+Examples:
+  linctl issue move LIN-123 --team DESIGN               # Move after confirmation
+  linctl issue move LIN-123 --team DESIGN --remap-state # Keep the equivalent state
+  linctl issue move LIN-123 --team DESIGN --yes         # Move without prompting`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		issueID := strings.TrimSpace(args[0])
+
+		teamKey, _ := cmd.Flags().GetString("team")
+		teamKey = strings.ToUpper(strings.TrimSpace(teamKey))
+		remapState, _ := cmd.Flags().GetBool("remap-state")
+		yes, _ := cmd.Flags().GetBool("yes")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if err := security.ValidateIssueID(issueID); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if err := security.ValidateTeamKey(teamKey); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		ctx := commandContext()
+
+		issue, err := client.GetIssue(ctx, issueID)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get issue %s: %v", issueID, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		fromTeam := ""
+		if issue.Team != nil {
+			fromTeam = issue.Team.Key
+		}
+		if fromTeam == teamKey {
+			output.Error(fmt.Sprintf("Issue %s is already in team %s", issue.Identifier, teamKey), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		team, err := client.GetTeam(ctx, teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		input := map[string]interface{}{
+			"teamId": team.ID,
+		}
+		if remapState && issue.State != nil {
+			states, err := client.GetTeamStates(ctx, teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get states for team %s: %v", teamKey, err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			state := matchWorkflowState(issue.State, states)
+			if state == nil {
+				output.Error(fmt.Sprintf("Team %s has no state equivalent to '%s'", teamKey, issue.State.Name), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			input["stateId"] = state.ID
+		}
+
+		// JSON mode is non-interactive, so it never prompts
+		if !yes && !jsonOut && !dryRun {
+			if !confirmAction(fmt.Sprintf("Move %s from %s to %s?", issue.Identifier, fromTeam, teamKey)) {
+				fmt.Println("Aborted")
+				return
+			}
+		}
+
+		client.SetDryRun(dryRun)
+		requireScope(authHeader, "issue.move", plaintext, jsonOut)
+		moved, err := client.UpdateIssue(ctx, issue.ID, input)
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		recordAudit("issue.move", issue.Identifier, "", err)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to move %s to %s: %s", issue.Identifier, teamKey, linearErrorMessage(err)), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		result := issueMoveResult{
+			Status:        "success",
+			OldIdentifier: issue.Identifier,
+			NewIdentifier: moved.Identifier,
+			FromTeam:      fromTeam,
+			ToTeam:        teamKey,
+		}
+		if moved.State != nil {
+			result.State = moved.State.Name
+		}
+
+		if jsonOut {
+			output.JSON(result)
+		} else if plaintext {
+			fmt.Printf("Moved %s to %s as %s\n", result.OldIdentifier, result.ToTeam, result.NewIdentifier)
+		} else {
+			fmt.Printf("%s Moved %s → %s (team %s)\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(result.OldIdentifier),
+				color.New(color.FgCyan, color.Bold).Sprint(result.NewIdentifier),
+				color.New(color.FgCyan).Sprint(result.ToTeam))
+			if result.State != "" {
+				fmt.Printf("  State: %s\n", result.State)
+			}
+		}
+	},
+}
+
+// matchWorkflowState finds the target team's equivalent of the current state:
+// the state with the same name, or else the first state of the same type by position
+func matchWorkflowState(current *api.State, states []api.WorkflowState) *api.WorkflowState {
+	for i := range states {
+		if strings.EqualFold(states[i].Name, current.Name) {
+			return &states[i]
+		}
+	}
+
+	var match *api.WorkflowState
+	for i := range states {
+		if states[i].Type == current.Type && (match == nil || states[i].Position < match.Position) {
+			match = &states[i]
+		}
+	}
+	return match
+}
+
+// linearErrorMessage returns the messages Linear reported for a GraphQL error,
+// falling back to the error text for other failures
+func linearErrorMessage(err error) string {
+	var gqlErrs api.GraphQLErrors
+	if !errors.As(err, &gqlErrs) || len(gqlErrs) == 0 {
+		return err.Error()
+	}
+
+	messages := make([]string, len(gqlErrs))
+	for i, gqlErr := range gqlErrs {
+		messages[i] = gqlErr.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+const (
+	exportFormatMarkdown = "markdown"
+	exportFormatJSON     = "json"
+)
+
+// issueExportEntry is one line of the export index
+type issueExportEntry struct {
+	Identifier string    `json:"identifier"`
+	Title      string    `json:"title"`
+	State      string    `json:"state,omitempty"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	File       string    `json:"file"`
+}
+
+// issueExporter writes one file per issue to dir and tracks what it wrote.
+// Files already present are skipped unless overwrite is set, so an
+// interrupted export can be resumed by running it again.
+type issueExporter struct {
+	dir       string
+	format    string
+	overwrite bool
+	exported  int
+	skipped   int
+	entries   []issueExportEntry
+}
+
+// newIssueExporter validates format and creates dir, owner-only since the
+// export contains private issue content
+func newIssueExporter(dir, format string, overwrite bool) (*issueExporter, error) {
+	switch format {
+	case "md":
+		format = exportFormatMarkdown
+	case exportFormatMarkdown, exportFormatJSON:
+	default:
+		return nil, fmt.Errorf("invalid --format %q: expected markdown or json", format)
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	return &issueExporter{dir: dir, format: format, overwrite: overwrite}, nil
+}
+
+// extension returns the file extension for the export format
+func (e *issueExporter) extension() string {
+	if e.format == exportFormatJSON {
+		return ".json"
+	}
+	return ".md"
+}
+
+// export writes issue to its file, or counts it as skipped if the file exists
+func (e *issueExporter) export(issue api.Issue) error {
+	name := issueExportFileName(issue) + e.extension()
+	entry := issueExportEntry{
+		Identifier: issue.Identifier,
+		Title:      issue.Title,
+		UpdatedAt:  issue.UpdatedAt,
+		File:       name,
+	}
+	if issue.State != nil {
+		entry.State = issue.State.Name
+	}
+	e.entries = append(e.entries, entry)
+
+	path := filepath.Join(e.dir, name)
+	if !e.overwrite {
+		if _, err := os.Stat(path); err == nil {
+			e.skipped++
+			return nil
+		}
+	}
+
+	var data []byte
+	if e.format == exportFormatJSON {
+		encoded, err := json.MarshalIndent(issue, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", issue.Identifier, err)
+		}
+		data = append(encoded, '\n')
+	} else {
+		md, err := output.IssueFrontMatterMarkdown(&issue)
+		if err != nil {
+			return fmt.Errorf("failed to render %s: %w", issue.Identifier, err)
+		}
+		data = []byte(md)
+	}
+
+	if err := writeFileAtomic(path, data); err != nil {
+		return err
+	}
+	e.exported++
+	return nil
+}
+
+// writeIndex writes index.md or index.json listing every issue in this export
+// and returns its path
+func (e *issueExporter) writeIndex() (string, error) {
+	path := filepath.Join(e.dir, "index"+e.extension())
+
+	var data []byte
+	if e.format == exportFormatJSON {
+		entries := e.entries
+		if entries == nil {
+			entries = []issueExportEntry{}
+		}
+		encoded, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to encode index: %w", err)
+		}
+		data = append(encoded, '\n')
+	} else {
+		var b strings.Builder
+		b.WriteString("# Issue Export\n\n")
+		fmt.Fprintf(&b, "%d issues, exported %s\n\n", len(e.entries), time.Now().UTC().Format("2006-01-02 15:04 MST"))
+		b.WriteString("| Issue | Title | State | Updated |\n")
+		b.WriteString("| --- | --- | --- | --- |\n")
+		for _, entry := range e.entries {
+			fmt.Fprintf(&b, "| [%s](%s) | %s | %s | %s |\n",
+				entry.Identifier, entry.File,
+				strings.ReplaceAll(output.EscapeMarkdown(entry.Title), "\n", " "),
+				output.EscapeMarkdown(entry.State),
+				entry.UpdatedAt.Format("2006-01-02"))
+		}
+		data = []byte(b.String())
+	}
+
+	if err := writeFileAtomic(path, data); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// issueExportFileName returns the file name, without extension, for an issue.
+// Identifiers are already safe; anything else is replaced so a name can never
+// leave the export directory.
+func issueExportFileName(issue api.Issue) string {
+	name := issue.Identifier
+	if name == "" {
+		name = issue.ID
+	}
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory and
+// renames it into place, so an interrupted export never leaves a partial file
+// that a resumed run would skip
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+var issueExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export issues to Markdown or JSON files",
+	Long: `Export issues to a directory, one file per issue plus an index, for offline
+backups. Markdown files carry the issue metadata as YAML front matter followed
+by the description; --format json writes the issue as returned by the API.
+
+Completed and canceled issues are included. Files that already exist are
+skipped, so an interrupted export can be resumed by running it again; use
+--overwrite to rewrite them.
+
+Examples:
+  linctl issue export --team ENG --out ./backup/
+  linctl issue export --team ENG --out ./backup/ --since 2024-01-01
+  linctl issue export --out ./backup/ --format json --overwrite`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		out, _ := cmd.Flags().GetString("out")
+		format, _ := cmd.Flags().GetString("format")
+		overwrite, _ := cmd.Flags().GetBool("overwrite")
+
+		// Build filter, scoping to LINEAR_DEFAULT_TEAM when --team is omitted
+		filter := make(map[string]interface{})
+		teamFlag, _ := cmd.Flags().GetString("team")
+		if team, err := oauth.LoadTeamFromEnvironment().GetTeam(teamFlag); err == nil {
+			filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": team}}
+		}
+		if since, _ := cmd.Flags().GetString("since"); since != "" {
+			updatedAt, err := utils.DateRangeFilter(since, "")
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			filter["updatedAt"] = updatedAt
+		}
+		includeArchived, _ := cmd.Flags().GetBool("include-archived")
+
+		exporter, err := newIssueExporter(out, format, overwrite)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+
+		ctx, stop := paginationContext()
+		defer stop()
+
+		// Write each page as it arrives, so an interrupted export keeps its progress
+		_, _, err = fetchLimited(ctx, 0, issuePageSize, func(first int, after string) ([]api.Issue, api.PageInfo, error) {
+			page, err := client.GetIssues(ctx, filter, first, after, "", includeArchived)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			for _, issue := range page.Nodes {
+				if err := exporter.export(issue); err != nil {
+					return nil, api.PageInfo{}, err
+				}
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to export issues after writing %d: %v. Run the command again to resume", exporter.exported, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		index, err := exporter.writeIndex()
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"dir":      out,
+				"format":   exporter.format,
+				"index":    index,
+				"total":    len(exporter.entries),
+				"exported": exporter.exported,
+				"skipped":  exporter.skipped,
+			})
+		} else if plaintext {
+			fmt.Printf("Exported %d issues to %s (%d skipped, already present)\n", exporter.exported, out, exporter.skipped)
+			fmt.Printf("Index: %s\n", index)
+		} else {
+			fmt.Printf("%s Exported %s issues to %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(exporter.exported),
+				out)
+			if exporter.skipped > 0 {
+				fmt.Printf("  %d skipped, already present (use --overwrite to rewrite them)\n", exporter.skipped)
+			}
+			fmt.Printf("  Index: %s\n", index)
+		}
+	},
+}
+
+var issueCommentCmd = &cobra.Command{
+	Use:               "comment ISSUE-ID [BODY]",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "Comment on an issue",
+	Long: `Add a comment to an issue. This is a shortcut for 'linctl comment create'
+that takes the body as an argument; without one it is read from --body-file,
+or from stdin when BODY is -.
+
+With --preview the rendered Markdown is shown and you are asked to confirm
+before the comment is posted. Add --yes to show the preview without asking.
+
+Examples:
+  linctl issue comment LIN-123 "LGTM"
+  linctl issue comment LIN-123 "**Fixed**, see the release notes" --preview
+  linctl issue comment LIN-123 --body-file notes.md --actor "AI Agent"
+  echo "Deployed" | linctl issue comment LIN-123 -`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		issueID := strings.ToUpper(strings.TrimSpace(args[0]))
+
+		body, err := readCommentBodyArg(cmd, args[1:], os.Stdin)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if body == "" {
+			output.Error("Comment body is required (BODY or --body-file)", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		runCommentCreate(cmd, issueID, body)
+	},
+}
+
+// readCommentBodyArg returns the comment body from the optional BODY argument,
+// falling back to --body-file. A BODY of - reads from stdin.
+func readCommentBodyArg(cmd *cobra.Command, args []string, stdin io.Reader) (string, error) {
+	if len(args) == 0 {
+		return readTextInput(cmd, "body", "body-file", stdin)
+	}
+
+	if file, _ := cmd.Flags().GetString("body-file"); file != "" {
+		return "", fmt.Errorf("BODY and --body-file cannot be used together")
+	}
+
+	value := args[0]
+	if value == "-" {
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read comment body from stdin: %w", err)
+		}
+		value = string(data)
+	}
+
+	if err := security.ValidateDescription(value); err != nil {
+		return "", err
+	}
+
+	return scanSecrets("body", security.SanitizeMultilineInput(value))
+}
+
+// subscribeConfirmThreshold is the number of matching issues above which
+// subscribe-matching asks for confirmation
+const subscribeConfirmThreshold = 10
+
+var issueSubscribeCmd = &cobra.Command{
+	Use:               "subscribe [issue-id]",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "Subscribe to an issue's notifications",
+	Long: `Subscribe yourself to notifications for an issue. Subscribing to an issue
+you already follow succeeds without changing anything.
+
+Examples:
+  linctl issue subscribe LIN-123
+  linctl issue subscribe LIN-123 --json  # {"issue":"LIN-123","subscribed":true}`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runIssueSubscription(args[0], true)
+	},
+}
+
+var issueUnsubscribeCmd = &cobra.Command{
+	Use:               "unsubscribe [issue-id]",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "Unsubscribe from an issue's notifications",
+	Long: `Stop receiving notifications for an issue. Unsubscribing from an issue you
+do not follow succeeds without changing anything.
+
+Examples:
+  linctl issue unsubscribe LIN-123
+  linctl issue unsubscribe LIN-123 --json  # {"issue":"LIN-123","subscribed":false}`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runIssueSubscription(args[0], false)
+	},
+}
+
+// runIssueSubscription handles issue subscribe and issue unsubscribe
+func runIssueSubscription(issueID string, subscribe bool) {
+	plaintext := viper.GetBool("plaintext")
+	jsonOut := viper.GetBool("json")
+	issueID = strings.ToUpper(strings.TrimSpace(issueID))
+
+	if err := security.ValidateIssueID(issueID); err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	authHeader, err := auth.GetAuthHeader()
+	if err != nil {
+		output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+		os.Exit(exitAuth)
+	}
+
+	client := api.NewClient(authHeader)
+
+	action := "subscribe"
+	if !subscribe {
+		action = "unsubscribe"
+	}
+	requireScope(authHeader, "issue."+action, plaintext, jsonOut)
+
+	changed, err := setIssueSubscription(commandContext(), client, issueID, subscribe)
+	if changed || err != nil {
+		recordAudit("issue."+action, issueID, "", err)
+	}
+	if err != nil {
+		message := fmt.Sprintf("Failed to %s: %v", action, err)
+		if isNotFoundError(err) {
+			message = fmt.Sprintf("Issue %s not found", issueID)
+		}
+		output.Error(message, plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"issue":      issueID,
+			"subscribed": subscribe,
+		})
+		return
+	}
+
+	message := fmt.Sprintf("Subscribed to %s", issueID)
+	switch {
+	case subscribe && !changed:
+		message = fmt.Sprintf("Already subscribed to %s", issueID)
+	case !subscribe && changed:
+		message = fmt.Sprintf("Unsubscribed from %s", issueID)
+	case !subscribe:
+		message = fmt.Sprintf("Not subscribed to %s", issueID)
+	}
+
+	if plaintext {
+		fmt.Println(message)
+	} else {
+		fmt.Printf("%s %s\n", color.New(color.FgGreen).Sprint("✓"), message)
+	}
+}
+
+// issueSubscriptionClient is the subset of the API client used by issue subscribe and unsubscribe
+type issueSubscriptionClient interface {
+	GetViewer(ctx context.Context) (*api.User, error)
+	IsSubscribedToIssue(ctx context.Context, issueID string, userID string) (bool, error)
+	SubscribeToIssue(ctx context.Context, id string) (*api.IssuePayload, error)
+	UnsubscribeFromIssue(ctx context.Context, id string) (*api.IssuePayload, error)
+}
+
+// setIssueSubscription subscribes or unsubscribes the viewer, reporting whether
+// anything changed. It is a no-op when the viewer is already in the requested state.
+func setIssueSubscription(ctx context.Context, client issueSubscriptionClient, issueID string, subscribe bool) (bool, error) {
+	viewer, err := client.GetViewer(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	subscribed, err := client.IsSubscribedToIssue(ctx, issueID, viewer.ID)
+	if err != nil {
+		return false, err
+	}
+	if subscribed == subscribe {
+		return false, nil
+	}
+
+	var payload *api.IssuePayload
+	if subscribe {
+		payload, err = client.SubscribeToIssue(ctx, issueID)
+	} else {
+		payload, err = client.UnsubscribeFromIssue(ctx, issueID)
+	}
+	if err != nil {
+		return false, err
+	}
+	if !payload.Success {
+		return false, fmt.Errorf("the change was not confirmed by Linear")
+	}
+	return true, nil
+}
+
+var issueSubscribeMatchingCmd = &cobra.Command{
+	Use:   "subscribe-matching",
+	Short: "Subscribe to all issues matching a filter",
+	Long: `Find issues matching a filter and subscribe yourself to all of them.
+
+Useful for reviewers who want notifications on a slice of work. Accepts the
+same filters as 'issue list'. Asks for confirmation when more than 10 issues match.
+
+Examples:
+  linctl issue subscribe-matching --state "In Review" --team ENG
+  linctl issue subscribe-matching --team ENG --priority 1 --dry-run
+  linctl issue subscribe-matching --assignee jane@company.com --yes`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		yes, _ := cmd.Flags().GetBool("yes")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		requireScope(authHeader, "issue.subscribe", plaintext, jsonOut)
+		ctx := commandContext()
+
+		issues, err := findMatchingIssues(ctx, client, buildIssueFilter(cmd), limit)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find matching issues: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if len(issues) == 0 {
+			if jsonOut {
+				output.JSON(subscribeMatchingPayload(nil, dryRun))
+			} else {
+				output.Info("No matching issues found", plaintext, jsonOut)
+			}
+			return
+		}
+
+		// JSON mode is non-interactive, so it never prompts
+		if !dryRun && !yes && !jsonOut && len(issues) > subscribeConfirmThreshold {
+			if !confirmAction(fmt.Sprintf("Subscribe to %d issues?", len(issues))) {
+				fmt.Println("Aborted")
+				return
+			}
+		}
+
+		results := subscribeToIssues(ctx, client, issues, batch.NewExecutor(nil, nil), dryRun)
+		payload := subscribeMatchingPayload(results, dryRun)
+		if !dryRun {
+			for _, result := range results {
+				var err error
+				if result.Error != "" {
+					err = errors.New(result.Error)
+				}
+				recordAudit("issue.subscribe", result.Issue, "", err)
+			}
+		}
+
+		if jsonOut {
+			output.JSON(payload)
+		} else {
+			for _, result := range results {
+				switch {
+				case result.Status == "failed" && plaintext:
+					fmt.Printf("FAILED %s: %s\n", result.Issue, result.Error)
+				case result.Status == "failed":
+					fmt.Printf("%s %s %s\n", color.New(color.FgRed).Sprint("✗"),
+						color.New(color.FgCyan, color.Bold).Sprint(result.Issue), result.Error)
+				case plaintext:
+					fmt.Printf("%s %s\n", result.Issue, result.Title)
+				default:
+					fmt.Printf("%s %s %s\n", color.New(color.FgGreen).Sprint("✓"),
+						color.New(color.FgCyan, color.Bold).Sprint(result.Issue), result.Title)
+				}
+			}
+
+			summary := fmt.Sprintf("Subscribed to %d of %d matching issues", payload["subscribed"], payload["matched"])
+			if dryRun {
+				summary = fmt.Sprintf("Dry run: would subscribe to %d matching issues", payload["matched"])
+			}
+			fmt.Println()
+			if plaintext {
+				fmt.Println(summary)
+			} else {
+				fmt.Println(color.New(color.Bold).Sprint(summary))
+			}
+		}
+
+		if payload["failed"].(int) > 0 {
+			if !jsonOut {
+				output.Error(fmt.Sprintf("Failed to subscribe to %d issues", payload["failed"]), plaintext, jsonOut)
+			}
+			os.Exit(1)
+		}
+	},
+}
+
+// issueSubscriber is the subset of the API client used by subscribe-matching
+type issueSubscriber interface {
+	GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool) (*api.Issues, error)
+	SubscribeToIssue(ctx context.Context, id string) (*api.IssuePayload, error)
+}
+
+// subscribeResult is the outcome of subscribing to a single issue
+type subscribeResult struct {
+	Issue  string `json:"issue"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// findMatchingIssues returns up to limit issues matching filter
+func findMatchingIssues(ctx context.Context, client issueSubscriber, filter map[string]interface{}, limit int) ([]api.Issue, error) {
+	issues, err := client.GetIssues(ctx, filter, limit, "", "", false)
+	if err != nil {
+		return nil, err
+	}
+	return issues.Nodes, nil
+}
+
+// subscribeToIssues subscribes to each issue concurrently, returning one result per issue in order.
+// In dry-run mode no subscriptions are made.
+func subscribeToIssues(ctx context.Context, client issueSubscriber, issues []api.Issue, executor *batch.Executor, dryRun bool) []subscribeResult {
+	results := make([]subscribeResult, len(issues))
+	for i, issue := range issues {
+		results[i] = subscribeResult{Issue: issue.Identifier, Title: issue.Title, Status: "would_subscribe"}
+	}
+
+	if dryRun {
+		return results
+	}
+
+	tasks := make([]batch.Task, len(issues))
+	for i, issue := range issues {
+		id := issue.ID
+		tasks[i] = func(ctx context.Context) error {
+			payload, err := client.SubscribeToIssue(ctx, id)
+			if err == nil && !payload.Success {
+				err = fmt.Errorf("the subscription was not confirmed by Linear")
+			}
+			return err
+		}
+	}
+
+	for _, outcome := range executor.Run(ctx, tasks) {
+		if outcome.Error != nil {
+			results[outcome.Index].Status = "failed"
+			results[outcome.Index].Error = outcome.Error.Error()
+		} else {
+			results[outcome.Index].Status = "subscribed"
+		}
+	}
+
+	return results
+}
+
+// subscribeMatchingPayload summarises subscribe-matching results for reporting
+func subscribeMatchingPayload(results []subscribeResult, dryRun bool) map[string]interface{} {
+	subscribed, failed := 0, 0
+	for _, result := range results {
+		switch result.Status {
+		case "subscribed":
+			subscribed++
+		case "failed":
+			failed++
+		}
+	}
+
+	status := "success"
+	if failed > 0 {
+		status = "partial"
+		if subscribed == 0 {
+			status = "error"
+		}
+	}
+
+	if results == nil {
+		results = []subscribeResult{}
+	}
+
+	return map[string]interface{}{
+		"status":     status,
+		"dry_run":    dryRun,
+		"matched":    len(results),
+		"subscribed": subscribed,
+		"failed":     failed,
+		"results":    results,
+	}
+}
+
+var issueCreateCmd = &cobra.Command{
+	Use:     "create",
+	Aliases: []string{"new"},
+	Short:   "Create a new issue",
+	Long:    `Create a new issue in Linear.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		if fromFile, _ := cmd.Flags().GetString("from-file"); fromFile != "" {
+			if cmd.Flags().Changed("idempotency-key") {
+				output.Error("--idempotency-key cannot be used with --from-file", plaintext, jsonOut)
+				os.Exit(exitValidation)
+			}
+			runBulkIssueCreate(cmd, fromFile, plaintext, jsonOut)
+			return
+		}
+
+		description, err := readTextInput(cmd, "description", "description-file", os.Stdin)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
+
+		// Get flags
+		title, _ := cmd.Flags().GetString("title")
+		teamKey, _ := cmd.Flags().GetString("team")
+		priority, _ := cmd.Flags().GetInt("priority")
+		assignToMe, _ := cmd.Flags().GetBool("assign-me")
+		assignee, _ := cmd.Flags().GetString("assignee")
+		actor, _ := cmd.Flags().GetString("actor")
+		avatarURL, _ := cmd.Flags().GetString("avatar-url")
+
+		if assignToMe && assignee != "" {
+			output.Error("--assign-me and --assignee cannot be used together", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		// A template fills in whatever the flags leave unset
+		var tmpl *issuetemplate.Template
+		if name, _ := cmd.Flags().GetString("template"); name != "" {
+			tmpl, err = issuetemplate.Load(name)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			if teamKey == "" {
+				teamKey = tmpl.Team
+			}
+			if actor == "" {
+				actor = tmpl.Actor
+			}
+			if !cmd.Flags().Changed("priority") && tmpl.Priority != nil {
+				priority = *tmpl.Priority
+			}
+		}
+
+		// Report every invalid field at once, before any API call
+		fields := map[string]interface{}{
+			"title":    title,
+			"priority": priority,
+		}
+		var extra []error
+		cycleFlag, _ := cmd.Flags().GetString("cycle")
+		teamKey, err = oauth.LoadTeamFromEnvironment().GetTeam(teamKey)
+		if err != nil {
+			extra = append(extra, security.ValidationError{Field: "team_key", Message: "team is required (--team or LINEAR_DEFAULT_TEAM)"})
+		} else if cycleFlag != "" {
+			// The key format is only enforced when --cycle looks cycles up by team key
+			fields["team_key"] = teamKey
+		}
+		if description != "" {
+			fields["description"] = description
+		}
+		if actor != "" {
+			fields["actor"] = actor
+		}
+		if avatarURL != "" {
+			fields["avatar_url"] = avatarURL
+		}
+		milestone, _ := cmd.Flags().GetString("milestone")
+		if milestone != "" {
+			extra = append(extra, security.ValidateMilestoneID(milestone))
+		}
+		exitOnValidationErrors(validateFields(fields, extra...), plaintext, jsonOut)
+
+		// Get team ID from key
+		team, err := client.GetTeam(commandContext(), teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		// Resolve actor parameters
+		actorParams := utils.ResolveActorParams(actor, avatarURL)
+
+		if tmpl != nil {
+			title = tmpl.Title(title, teamKey, actorParams.Actor)
+			if description == "" {
+				description = tmpl.ExpandDescription(teamKey, actorParams.Actor)
+			}
+		}
+
+		// Build input
+		input := api.IssueCreateInput{
+			Title:  title,
+			TeamID: team.ID,
+		}
+
+		if tmpl != nil && len(tmpl.Labels) > 0 {
+			labelIDs, err := resolveLabelIDs(commandContext(), client, team.ID, tmpl.Labels)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to apply template %q: %v", tmpl.Name, err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			input.LabelIDs = labelIDs
+		}
+
+		if description != "" {
+			input.Description = &description
+		}
+
+		input.Priority = &priority
+
+		if milestone != "" {
+			input.ProjectMilestoneID = &milestone
+		}
+
+		if cycleFlag != "" {
+			cycles, err := fetchTeamCycles(commandContext(), client, team.ID)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get cycles for team %s: %v", teamKey, err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			cycle, err := resolveCycle(cycles, cycleFlag, time.Now())
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to resolve cycle: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			input.CycleID = &cycle.ID
+		}
+
+		if assignToMe {
+			assignee = "me"
+		}
+		if assignee != "" {
+			assigneeID, err := resolveAssigneeID(commandContext(), client, assignee)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to resolve assignee: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			input.AssigneeID = &assigneeID
+		}
+
+		// Add actor parameters if available
+		input.CreateAsUser = actorParams.ToCreateAsUser()
+		input.DisplayIconURL = actorParams.ToDisplayIconURL()
+
+		commentBody, _ := cmd.Flags().GetString("comment")
+		commentBody, err = scanSecrets("comment", commentBody)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		// With an idempotency key, a repeat within the local window returns the earlier result
+		var guard *idempotencyGuard
+		var result *issueCreateResult
+		if idempotencyKey, _ := cmd.Flags().GetString("idempotency-key"); idempotencyKey != "" {
+			guard, err = openIdempotencyGuard("issue.create", idempotencyKey, input, commentBody)
+			if err != nil {
+				output.Error(fmt.Sprintf("Invalid --idempotency-key: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			input.ID = guard.entityID()
+
+			if !dryRun {
+				var cached issueCreateResult
+				replayed, err := guard.replay(&cached)
+				if err != nil {
+					output.Error(err.Error(), plaintext, jsonOut)
+					os.Exit(exitCode(err))
+				}
+				if replayed {
+					fmt.Fprintf(os.Stderr, "Note: returning issue %s created earlier with this idempotency key\n", cached.Issue.Identifier)
+					result = &cached
+				}
+			}
+		}
+
+		requireScope(authHeader, "issue.create", plaintext, jsonOut)
+		if commentBody != "" {
+			requireScope(authHeader, "comment.create", plaintext, jsonOut)
+		}
+
+		if result == nil {
+			// Create issue, then the initial comment if requested
+			result, err = createIssueWithComment(commandContext(), client, input, commentBody, actorParams)
+			if printDryRun(err, plaintext, jsonOut) {
+				return
+			}
+			if err != nil {
+				recordAudit("issue.create", teamKey, actorParams.Actor, err)
+				output.Error(fmt.Sprintf("Failed to create issue: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			recordAudit("issue.create", result.Issue.Identifier, actorParams.Actor, nil)
+			if commentBody != "" {
+				recordAudit("comment.create", result.Issue.Identifier, actorParams.Actor, result.CommentError)
+			}
+			if guard != nil && result.CommentError == nil {
+				guard.remember(result)
+			}
+		}
+
+		issue := result.Issue
+		if urlKey := workspaceURLKey(commandContext(), client); urlKey != "" {
+			issue.URL = issueWebURL(urlKey, issue.Identifier)
+			if result.Comment != nil {
+				result.Comment.URL = commentWebURL(urlKey, issue.Identifier, result.Comment.ID)
+			}
+		}
+
+		if jsonOut {
+			if commentBody == "" {
+				output.JSON(issue)
+			} else {
+				output.JSON(result.payload())
+			}
+		} else if plaintext {
+			fmt.Printf("Created issue %s: %s\n", issue.Identifier, issue.Title)
+			if issue.URL != "" {
+				fmt.Printf("URL: %s\n", issue.URL)
+			}
+			if result.Comment != nil {
+				fmt.Printf("Added comment %s\n", result.Comment.ID)
+			}
+		} else {
+			fmt.Printf("%s Created issue %s: %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
+				issue.Title)
+			if issue.Assignee != nil {
+				fmt.Printf("  Assigned to: %s\n", color.New(color.FgCyan).Sprint(issue.Assignee.Name))
+			}
+			if result.Comment != nil {
+				fmt.Printf("  Comment: %s\n", color.New(color.FgCyan).Sprint(result.Comment.ID))
+			}
+			if issue.URL != "" {
+				fmt.Printf("  URL: %s\n", color.New(color.FgBlue, color.Underline).Sprint(issue.URL))
+			}
+		}
+
+		if open, _ := cmd.Flags().GetBool("open"); open {
+			openWebURL(issue.URL)
+		}
+
+		if result.CommentError != nil {
+			if !jsonOut {
+				output.Error(fmt.Sprintf("Issue %s was created but adding the comment failed: %v", issue.Identifier, result.CommentError), plaintext, jsonOut)
+			}
+			os.Exit(1)
+		}
+	},
+}
+
+// issueCommentCreator is the subset of the API client used to create an issue with a comment
+type issueCommentCreator interface {
+	issueCreator
+	commentCreator
+}
+
+// issueCreateResult holds the outcome of creating an issue with an optional initial comment
+type issueCreateResult struct {
+	Issue        *api.Issue   `json:"issue"`
+	Comment      *api.Comment `json:"comment,omitempty"`
+	CommentError error        `json:"-"`
+}
+
+// createIssueWithComment creates an issue and, if commentBody is set, posts it as the first comment.
+// A comment failure is reported in the result rather than as an error, since the issue already exists.
+// When input.ID is set the comment ID is derived from it, so a retry does not post the comment twice.
+func createIssueWithComment(ctx context.Context, client issueCommentCreator, input api.IssueCreateInput, commentBody string, actorParams *utils.ActorParams) (*issueCreateResult, error) {
+	issue, err := createIssue(ctx, client, input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &issueCreateResult{Issue: issue}
+	if commentBody == "" {
+		return result, nil
 	}
 
-	if priority, _ := cmd.Flags().GetInt("priority"); priority != -1 {
-		filter["priority"] = map[string]interface{}{"eq": priority}
+	commentInput := api.CommentCreateInput{
+		IssueID:        issue.ID,
+		Body:           commentBody,
+		CreateAsUser:   actorParams.ToCreateAsUser(),
+		DisplayIconURL: actorParams.ToDisplayIconURL(),
+	}
+	if input.ID != nil {
+		commentID := idempotency.DeriveID("issue.create.comment", *input.ID)
+		commentInput.ID = &commentID
 	}
 
-	// Handle newer-than filter
-	newerThan, _ := cmd.Flags().GetString("newer-than")
-	createdAt, err := utils.ParseTimeExpression(newerThan)
+	comment, err := createComment(ctx, client, commentInput)
 	if err != nil {
-		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
-		output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
-		os.Exit(1)
-	}
-	if createdAt != "" {
-		filter["createdAt"] = map[string]interface{}{"gte": createdAt}
+		result.CommentError = err
+		return result, nil
 	}
 
-	return filter
+	result.Comment = comment
+	return result, nil
 }
 
-func priorityToString(priority int) string {
-	switch priority {
-	case 0:
-		return "None"
-	case 1:
-		return "Urgent"
-	case 2:
-		return "High"
-	case 3:
-		return "Normal"
-	case 4:
-		return "Low"
-	default:
-		return "Unknown"
+// payload returns the structured JSON report for an issue created with a comment
+func (r *issueCreateResult) payload() map[string]interface{} {
+	payload := map[string]interface{}{
+		"status": "success",
+		"issue":  r.Issue,
 	}
-}
 
-func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
+	if r.Comment != nil {
+		payload["comment"] = r.Comment
 	}
-	return s[:maxLen-3] + "..."
+
+	if r.CommentError != nil {
+		payload["status"] = "partial"
+		payload["error"] = fmt.Sprintf("issue created but comment failed: %v", r.CommentError)
+	}
+
+	return payload
 }
 
-var issueAssignCmd = &cobra.Command{
-	Use:   "assign [issue-id]",
-	Short: "Assign issue to yourself",
-	Long:  `Assign an issue to yourself.`,
-	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+// errBulkInvalidRows is returned when rows fail validation and --continue-on-error is not set
+var errBulkInvalidRows = errors.New("one or more rows are invalid; no issues were created (use --continue-on-error to create the valid rows)")
 
-		authHeader, err := auth.GetAuthHeader()
-		if err != nil {
-			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+// bulkIssueRow is one issue to create from a --from-file CSV or JSON file
+type bulkIssueRow struct {
+	Title       string `json:"title"`
+	Team        string `json:"team"`
+	Description string `json:"description"`
+	Priority    *int   `json:"priority"`
+	Assignee    string `json:"assignee"`
+}
+
+// bulkIssueResult is the outcome of a single row in a bulk create
+type bulkIssueResult struct {
+	Row        int                 `json:"row"`
+	Title      string              `json:"title"`
+	Status     string              `json:"status"`
+	ID         string              `json:"id,omitempty"`
+	Identifier string              `json:"identifier,omitempty"`
+	Error      string              `json:"error,omitempty"`
+	Request    *api.GraphQLRequest `json:"request,omitempty"`
+}
+
+// bulkIssueClient is the subset of the API client used for bulk issue creation
+type bulkIssueClient interface {
+	userResolver
+	GetTeam(ctx context.Context, key string) (*api.Team, error)
+	BulkCreateIssues(ctx context.Context, inputs []api.IssueCreateInput) []api.BulkCreateResult
+}
+
+// parseBulkIssueFile reads issue rows from a JSON array or a CSV file with a header row.
+// JSON is detected by a .json extension or a leading '['.
+func parseBulkIssueFile(path string) ([]bulkIssueRow, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read --from-file: %w", err)
+	}
+
+	trimmed := strings.TrimSpace(string(data))
+	if strings.EqualFold(filepath.Ext(path), ".json") || strings.HasPrefix(trimmed, "[") {
+		var rows []bulkIssueRow
+		if err := json.Unmarshal(data, &rows); err != nil {
+			return nil, fmt.Errorf("invalid JSON in %s: %w", path, err)
 		}
+		return rows, nil
+	}
 
-		client := api.NewClient(authHeader)
+	records, err := csv.NewReader(strings.NewReader(trimmed)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV in %s: %w", path, err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
 
-		// Get current user
-		viewer, err := client.GetViewer(context.Background())
-		if err != nil {
-			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "title", "team", "description", "priority", "assignee":
+			columns[name] = i
+		default:
+			return nil, fmt.Errorf("unknown CSV column %q (expected title, team, description, priority, assignee)", name)
 		}
+	}
 
-		// Update issue with assignee
-		input := map[string]interface{}{
-			"assigneeId": viewer.ID,
+	rows := make([]bulkIssueRow, 0, len(records)-1)
+	for line, record := range records[1:] {
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
 		}
 
-		issue, err := client.UpdateIssue(context.Background(), args[0], input)
-		if err != nil {
-			output.Error(fmt.Sprintf("Failed to assign issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+		row := bulkIssueRow{
+			Title:       field("title"),
+			Team:        field("team"),
+			Description: field("description"),
+			Assignee:    field("assignee"),
+		}
+		if value := field("priority"); value != "" {
+			priority, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid priority %q", line+1, value)
+			}
+			row.Priority = &priority
 		}
+		rows = append(rows, row)
+	}
 
-		if jsonOut {
-			output.JSON(issue)
-		} else if plaintext {
-			fmt.Printf("Assigned %s to %s\n", issue.Identifier, viewer.Name)
-		} else {
-			fmt.Printf("%s Assigned %s to %s\n",
-				color.New(color.FgGreen).Sprint("✓"),
-				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
-				color.New(color.FgCyan).Sprint(viewer.Name))
+	return rows, nil
+}
+
+// validateBulkIssueRow checks a row with the security validators
+func validateBulkIssueRow(row bulkIssueRow) error {
+	if err := security.ValidateTitle(row.Title); err != nil {
+		return err
+	}
+	if err := security.ValidateTeamKey(row.Team); err != nil {
+		return err
+	}
+	if err := security.ValidateDescription(row.Description); err != nil {
+		return err
+	}
+	if row.Priority != nil {
+		if err := security.ValidatePriority(*row.Priority); err != nil {
+			return err
 		}
-	},
+	}
+	return nil
 }
 
-var issueCreateCmd = &cobra.Command{
-	Use:     "create",
-	Aliases: []string{"new"},
-	Short:   "Create a new issue",
-	Long:    `Create a new issue in Linear.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+// buildBulkIssueInputs validates every row and resolves teams and assignees.
+// inputs[i] is nil when row i could not be prepared; its result carries the error.
+func buildBulkIssueInputs(ctx context.Context, client bulkIssueClient, rows []bulkIssueRow, actorParams *utils.ActorParams) ([]*api.IssueCreateInput, []bulkIssueResult) {
+	inputs := make([]*api.IssueCreateInput, len(rows))
+	results := make([]bulkIssueResult, len(rows))
 
-		authHeader, err := auth.GetAuthHeader()
-		if err != nil {
-			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
-		}
+	teams := make(map[string]*api.Team)
+	assignees := make(map[string]string)
 
-		client := api.NewClient(authHeader)
+	for i, row := range rows {
+		results[i] = bulkIssueResult{Row: i + 1, Title: row.Title}
 
-		// Get flags
-		title, _ := cmd.Flags().GetString("title")
-		description, _ := cmd.Flags().GetString("description")
-		teamKey, _ := cmd.Flags().GetString("team")
-		priority, _ := cmd.Flags().GetInt("priority")
-		assignToMe, _ := cmd.Flags().GetBool("assign-me")
-		actor, _ := cmd.Flags().GetString("actor")
-		avatarURL, _ := cmd.Flags().GetString("avatar-url")
+		fail := func(err error) {
+			results[i].Status = "invalid"
+			results[i].Error = err.Error()
+		}
 
-		if title == "" {
-			output.Error("Title is required (--title)", plaintext, jsonOut)
-			os.Exit(1)
+		if err := validateBulkIssueRow(row); err != nil {
+			fail(err)
+			continue
 		}
 
-		if teamKey == "" {
-			output.Error("Team is required (--team)", plaintext, jsonOut)
-			os.Exit(1)
+		team, ok := teams[row.Team]
+		if !ok {
+			t, err := client.GetTeam(ctx, row.Team)
+			if err != nil {
+				fail(fmt.Errorf("failed to find team '%s': %w", row.Team, err))
+				continue
+			}
+			team = t
+			teams[row.Team] = team
 		}
 
-		// Get team ID from key
-		team, err := client.GetTeam(context.Background(), teamKey)
+		input := &api.IssueCreateInput{
+			Title:          security.SanitizeInput(row.Title),
+			TeamID:         team.ID,
+			Priority:       row.Priority,
+			CreateAsUser:   actorParams.ToCreateAsUser(),
+			DisplayIconURL: actorParams.ToDisplayIconURL(),
+		}
+		description, err := scanSecrets("description", security.SanitizeMultilineInput(row.Description))
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-			os.Exit(1)
+			fail(err)
+			continue
+		}
+		if description != "" {
+			input.Description = &description
+		}
+		if row.Assignee != "" {
+			assigneeID, ok := assignees[row.Assignee]
+			if !ok {
+				id, err := resolveAssigneeID(ctx, client, row.Assignee)
+				if err != nil {
+					fail(err)
+					continue
+				}
+				assigneeID = id
+				assignees[row.Assignee] = assigneeID
+			}
+			input.AssigneeID = &assigneeID
 		}
 
-		// Resolve actor parameters
-		actorParams := utils.ResolveActorParams(actor, avatarURL)
+		inputs[i] = input
+	}
 
-		// Build input
-		input := api.IssueCreateInput{
-			Title:  title,
-			TeamID: team.ID,
+	return inputs, results
+}
+
+// createBulkIssues prepares and creates the rows. If any row is invalid and
+// continueOnError is false, nothing is created and errBulkInvalidRows is returned.
+func createBulkIssues(ctx context.Context, client bulkIssueClient, rows []bulkIssueRow, actorParams *utils.ActorParams, continueOnError bool) ([]bulkIssueResult, error) {
+	inputs, results := buildBulkIssueInputs(ctx, client, rows, actorParams)
+
+	var valid []api.IssueCreateInput
+	var indexes []int
+	for i, input := range inputs {
+		if input != nil {
+			valid = append(valid, *input)
+			indexes = append(indexes, i)
 		}
+	}
 
-		if description != "" {
-			input.Description = &description
+	if len(valid) < len(rows) && !continueOnError {
+		for _, i := range indexes {
+			results[i].Status = "skipped"
 		}
+		return results, errBulkInvalidRows
+	}
 
-		if priority >= 0 && priority <= 4 {
-			input.Priority = &priority
+	for j, created := range client.BulkCreateIssues(ctx, valid) {
+		result := &results[indexes[j]]
+		var dryRun *api.DryRunError
+		if errors.As(created.Error, &dryRun) {
+			result.Status = "dry-run"
+			result.Request = &dryRun.Request
+			continue
+		}
+		if created.Error != nil {
+			result.Status = "failed"
+			result.Error = created.Error.Error()
+			continue
 		}
+		result.Status = "created"
+		result.ID = created.Issue.ID
+		result.Identifier = created.Issue.Identifier
+	}
 
-		if assignToMe {
-			viewer, err := client.GetViewer(context.Background())
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-			input.AssigneeID = &viewer.ID
+	return results, nil
+}
+
+// bulkCreatePayload summarises a bulk create for JSON output
+func bulkCreatePayload(results []bulkIssueResult) map[string]interface{} {
+	created, failed, planned := 0, 0, 0
+	for _, result := range results {
+		switch result.Status {
+		case "created":
+			created++
+		case "dry-run":
+			planned++
+		case "invalid", "failed":
+			failed++
 		}
+	}
 
-		// Add actor parameters if available
-		input.CreateAsUser = actorParams.ToCreateAsUser()
-		input.DisplayIconURL = actorParams.ToDisplayIconURL()
+	status := "success"
+	if failed > 0 && created > 0 {
+		status = "partial"
+	} else if failed > 0 || created+planned == 0 {
+		status = "error"
+	}
 
-		// Create issue
-		issue, err := client.CreateIssue(context.Background(), input)
-		if err != nil {
-			output.Error(fmt.Sprintf("Failed to create issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+	payload := map[string]interface{}{
+		"status":  status,
+		"created": created,
+		"failed":  failed,
+		"results": results,
+	}
+	if planned > 0 {
+		payload["dry_run"] = planned
+	}
+	return payload
+}
+
+// runBulkIssueCreate handles issue create --from-file
+func runBulkIssueCreate(cmd *cobra.Command, path string, plaintext, jsonOut bool) {
+	for _, flag := range []string{"title", "team", "description", "description-file", "assignee", "assign-me", "comment", "template"} {
+		if cmd.Flags().Changed(flag) {
+			output.Error(fmt.Sprintf("--%s cannot be used with --from-file; set it per row instead", flag), plaintext, jsonOut)
+			os.Exit(exitValidation)
 		}
+	}
 
-		if jsonOut {
-			output.JSON(issue)
-		} else if plaintext {
-			fmt.Printf("Created issue %s: %s\n", issue.Identifier, issue.Title)
-		} else {
-			fmt.Printf("%s Created issue %s: %s\n",
-				color.New(color.FgGreen).Sprint("✓"),
-				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
-				issue.Title)
-			if issue.Assignee != nil {
-				fmt.Printf("  Assigned to: %s\n", color.New(color.FgCyan).Sprint(issue.Assignee.Name))
+	rows, err := parseBulkIssueFile(path)
+	if err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	if len(rows) == 0 {
+		output.Error(fmt.Sprintf("No issues found in %s", path), plaintext, jsonOut)
+		os.Exit(exitValidation)
+	}
+
+	authHeader, err := auth.GetAuthHeader()
+	if err != nil {
+		output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+		os.Exit(exitAuth)
+	}
+
+	requireScope(authHeader, "issue.create", plaintext, jsonOut)
+
+	client := api.NewClient(authHeader)
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	client.SetDryRun(dryRun)
+
+	actor, _ := cmd.Flags().GetString("actor")
+	avatarURL, _ := cmd.Flags().GetString("avatar-url")
+	continueOnError, _ := cmd.Flags().GetBool("continue-on-error")
+
+	actorParams := utils.ResolveActorParams(actor, avatarURL)
+	results, bulkErr := createBulkIssues(commandContext(), client, rows, actorParams, continueOnError)
+	payload := bulkCreatePayload(results)
+	for _, result := range results {
+		switch result.Status {
+		case "created":
+			recordAudit("issue.create", result.Identifier, actorParams.Actor, nil)
+		case "failed":
+			recordAudit("issue.create", fmt.Sprintf("row %d", result.Row), actorParams.Actor, errors.New(result.Error))
+		}
+	}
+
+	if jsonOut {
+		if bulkErr != nil {
+			payload["error"] = bulkErr.Error()
+		}
+		output.JSON(payload)
+	} else {
+		for _, result := range results {
+			switch {
+			case result.Status == "created" && plaintext:
+				fmt.Printf("Row %d: created %s: %s\n", result.Row, result.Identifier, result.Title)
+			case result.Status == "created":
+				fmt.Printf("%s Row %d: %s %s\n", color.New(color.FgGreen).Sprint("✓"), result.Row,
+					color.New(color.FgCyan, color.Bold).Sprint(result.Identifier), result.Title)
+			case result.Status == "dry-run" && plaintext:
+				fmt.Printf("Row %d: dry run: would create %s\n", result.Row, result.Title)
+			case result.Status == "dry-run":
+				fmt.Printf("%s Row %d: would create %s\n", color.New(color.FgYellow).Sprint("-"), result.Row, result.Title)
+			case result.Status == "skipped" && plaintext:
+				fmt.Printf("Row %d: skipped: %s\n", result.Row, result.Title)
+			case result.Status == "skipped":
+				fmt.Printf("%s Row %d: skipped %s\n", color.New(color.FgYellow).Sprint("-"), result.Row, result.Title)
+			case plaintext:
+				fmt.Printf("Row %d: %s: %s\n", result.Row, result.Status, result.Error)
+			default:
+				fmt.Printf("%s Row %d: %s\n", color.New(color.FgRed).Sprint("✗"), result.Row, result.Error)
 			}
 		}
-	},
+		if dryRun {
+			fmt.Printf("\nDry run: %v of %d issues would be created\n", payload["dry_run"], len(results))
+		} else {
+			fmt.Printf("\nCreated %v of %d issues\n", payload["created"], len(results))
+		}
+		if bulkErr != nil {
+			output.Error(bulkErr.Error(), plaintext, jsonOut)
+		}
+	}
+
+	if bulkErr != nil || payload["failed"].(int) > 0 {
+		os.Exit(1)
+	}
 }
 
 var issueUpdateCmd = &cobra.Command{
-	Use:   "update [issue-id]",
-	Short: "Update an issue",
+	Use:               "update [issue-id]",
+	ValidArgsFunction: completeIssueIdentifiers,
+	Short:             "Update an issue",
 	Long: `Update various fields of an issue.
 
 Examples:
@@ -889,7 +3935,9 @@ Examples:
   linctl issue update LIN-123 --description "Updated description"
   linctl issue update LIN-123 --assignee john.doe@company.com
   linctl issue update LIN-123 --state "In Progress"
+  linctl issue update LIN-123 --state done --strict  # Fail on a disallowed transition
   linctl issue update LIN-123 --priority 1
+  linctl issue update LIN-123 --estimate 3
   linctl issue update LIN-123 --due-date "2024-12-31"
   linctl issue update LIN-123 --title "New title" --assignee me --priority 2`,
 	Args: cobra.ExactArgs(1),
@@ -900,10 +3948,32 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
+		}
+
+		// Report every invalid field at once, before any API call
+		fields := map[string]interface{}{"issue_id": args[0]}
+		if cmd.Flags().Changed("title") {
+			fields["title"], _ = cmd.Flags().GetString("title")
+		}
+		if cmd.Flags().Changed("description") {
+			fields["description"], _ = cmd.Flags().GetString("description")
+		}
+		if cmd.Flags().Changed("priority") {
+			fields["priority"], _ = cmd.Flags().GetInt("priority")
+		}
+		var estimateErr, dueDateErr error
+		if estimate, _ := cmd.Flags().GetFloat64("estimate"); estimate < 0 {
+			estimateErr = security.ValidationError{Field: "estimate", Value: strconv.FormatFloat(estimate, 'f', -1, 64), Message: "estimate cannot be negative"}
+		}
+		if dueDate, _ := cmd.Flags().GetString("due-date"); dueDate != "" {
+			dueDateErr = security.ValidateDate("due_date", dueDate)
 		}
+		exitOnValidationErrors(validateFields(fields, estimateErr, dueDateErr), plaintext, jsonOut)
 
 		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
 
 		// Build update input
 		input := make(map[string]interface{})
@@ -917,6 +3987,11 @@ Examples:
 		// Handle description update
 		if cmd.Flags().Changed("description") {
 			description, _ := cmd.Flags().GetString("description")
+			description, err = scanSecrets("description", description)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
 			input["description"] = description
 		}
 
@@ -924,38 +3999,15 @@ Examples:
 		if cmd.Flags().Changed("assignee") {
 			assignee, _ := cmd.Flags().GetString("assignee")
 			switch assignee {
-			case "me":
-				// Get current user
-				viewer, err := client.GetViewer(context.Background())
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				input["assigneeId"] = viewer.ID
 			case "unassigned", "":
 				input["assigneeId"] = nil
 			default:
-				// Look up user by email
-				users, err := client.GetUsers(context.Background(), 100, "", "")
+				assigneeID, err := resolveAssigneeID(commandContext(), client, assignee)
 				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				var foundUser *api.User
-				for _, user := range users.Nodes {
-					if user.Email == assignee || user.Name == assignee {
-						foundUser = &user
-						break
-					}
-				}
-
-				if foundUser == nil {
-					output.Error(fmt.Sprintf("User not found: %s", assignee), plaintext, jsonOut)
-					os.Exit(1)
+					output.Error(fmt.Sprintf("Failed to resolve assignee: %v", err), plaintext, jsonOut)
+					os.Exit(exitCode(err))
 				}
-
-				input["assigneeId"] = foundUser.ID
+				input["assigneeId"] = assigneeID
 			}
 		}
 
@@ -964,45 +4016,41 @@ Examples:
 			stateName, _ := cmd.Flags().GetString("state")
 
 			// First, get the issue to know which team it belongs to
-			issue, err := client.GetIssue(context.Background(), args[0])
+			issue, err := client.GetIssue(commandContext(), args[0])
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 
 			// Get available states for the team
-			states, err := client.GetTeamStates(context.Background(), issue.Team.Key)
+			states, err := client.GetWorkflowStates(commandContext(), issue.Team.ID)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to get team states: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 
-			// Find the state by name (case-insensitive)
-			var stateID string
-			for _, state := range states {
-				if strings.EqualFold(state.Name, stateName) {
-					stateID = state.ID
-					break
-				}
+			state, err := resolveWorkflowState(states, stateName)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
 			}
 
-			if stateID == "" {
-				// Show available states
-				var stateNames []string
-				for _, state := range states {
-					stateNames = append(stateNames, state.Name)
+			// Linear accepts any transition, so the policy is checked here
+			if err := checkStateTransition(issue.State, state); err != nil {
+				if strict, _ := cmd.Flags().GetBool("strict"); strict {
+					output.Error(fmt.Sprintf("%v (drop --strict to allow it)", err), plaintext, jsonOut)
+					os.Exit(exitCode(err))
 				}
-				output.Error(fmt.Sprintf("State '%s' not found. Available states: %s", stateName, strings.Join(stateNames, ", ")), plaintext, jsonOut)
-				os.Exit(1)
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
 			}
 
-			input["stateId"] = stateID
+			input["stateId"] = state.ID
 		}
 
-		// Handle priority update
-		if cmd.Flags().Changed("priority") {
-			priority, _ := cmd.Flags().GetInt("priority")
-			input["priority"] = priority
+		// Handle priority and estimate updates
+		if err := addPriorityAndEstimate(cmd, input); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle due date update
@@ -1018,14 +4066,20 @@ Examples:
 		// Check if any updates were specified
 		if len(input) == 0 {
 			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitValidation)
 		}
 
+		requireScope(authHeader, "issue.update", plaintext, jsonOut)
+
 		// Update the issue
-		issue, err := client.UpdateIssue(context.Background(), args[0], input)
+		issue, err := client.UpdateIssue(commandContext(), args[0], input)
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		recordAudit("issue.update", args[0], "", err)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -1038,40 +4092,210 @@ Examples:
 	},
 }
 
+// validateFields checks fields with security.SanitizeAndValidateAll and
+// returns its failures together with any of extra that are validation errors
+func validateFields(fields map[string]interface{}, extra ...error) []security.ValidationError {
+	_, failures := security.SanitizeAndValidateAll(fields)
+	for _, err := range extra {
+		var validationErr security.ValidationError
+		if errors.As(err, &validationErr) {
+			failures = append(failures, validationErr)
+		}
+	}
+	return failures
+}
+
+// addPriorityAndEstimate adds the --priority and --estimate flags to the update
+// input, but only when they were explicitly set so existing values are kept
+func addPriorityAndEstimate(cmd *cobra.Command, input map[string]interface{}) error {
+	if cmd.Flags().Changed("priority") {
+		priority, _ := cmd.Flags().GetInt("priority")
+		if err := security.ValidatePriority(priority); err != nil {
+			return err
+		}
+		input["priority"] = priority
+	}
+
+	if cmd.Flags().Changed("estimate") {
+		estimate, _ := cmd.Flags().GetFloat64("estimate")
+		if estimate < 0 {
+			return fmt.Errorf("estimate cannot be negative")
+		}
+		input["estimate"] = estimate
+	}
+
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(issueCmd)
 	issueCmd.AddCommand(issueListCmd)
 	issueCmd.AddCommand(issueGetCmd)
 	issueCmd.AddCommand(issueAssignCmd)
+	issueCmd.AddCommand(issueUnassignCmd)
 	issueCmd.AddCommand(issueCreateCmd)
 	issueCmd.AddCommand(issueUpdateCmd)
+	issueCmd.AddCommand(issueDeleteCmd)
+	issueCmd.AddCommand(issueCommentCmd)
+	issueCmd.AddCommand(issueExportCmd)
+	issueCmd.AddCommand(issueSubscribeCmd)
+	issueCmd.AddCommand(issueUnsubscribeCmd)
+	issueCmd.AddCommand(issueSubscribeMatchingCmd)
+	issueCmd.AddCommand(issueLinkCmd)
+	issueCmd.AddCommand(issueUnlinkCmd)
+	issueCmd.AddCommand(issueAttachCmd)
+	issueCmd.AddCommand(issueAttachmentsCmd)
+	issueCmd.AddCommand(issueMoveCmd)
+	issueCmd.AddCommand(issueSearchCmd)
+	issueCmd.AddCommand(issueMineCmd)
+	issueCmd.AddCommand(issueCreatedCmd)
+
+	addViewerIssueListFlags(issueMineCmd)
+	addViewerIssueListFlags(issueCreatedCmd)
 
 	// Issue list flags
 	issueListCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
 	issueListCmd.Flags().StringP("state", "s", "", "Filter by state name")
-	issueListCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	issueListCmd.Flags().StringP("team", "t", "", "Filter by team key (uses LINEAR_DEFAULT_TEAM if not specified)")
+	_ = issueListCmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
 	issueListCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueListCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
+	issueListCmd.Flags().Bool("all", false, "Fetch every matching issue, ignoring --limit")
+	issueListCmd.Flags().Bool("blocked", false, "Only show issues with an incomplete blocker (slower: fetches relations)")
+	issueListCmd.Flags().Bool("blocking", false, "Only show issues blocking an incomplete issue (slower: fetches relations)")
 	issueListCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
-	issueListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
+	issueListCmd.Flags().Bool("include-archived", false, "Include archived issues")
+	issueListCmd.Flags().String("fields", "", "Comma-separated fields to show: "+strings.Join(issueFieldNames, ","))
+	issueListCmd.Flags().String("template", "", "Go template used to render each issue")
+	issueListCmd.Flags().String("template-file", "", "Path to a Go template file used to render each issue")
+	issueListCmd.Flags().String("output-template", "", "Go template used to render each issue (alias for --template)")
+	issueListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated, priority, title")
+	_ = issueListCmd.RegisterFlagCompletionFunc("sort", cobra.FixedCompletions(issueSortNames, cobra.ShellCompDirectiveNoFileComp))
+	issueListCmd.Flags().Bool("reverse", false, "Reverse the --sort order")
 	issueListCmd.Flags().StringP("newer-than", "n", "", "Show issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+	issueListCmd.Flags().String("since", "", "Show issues updated on or after this date (YYYY-MM-DD or RFC3339); overrides --newer-than")
+	issueListCmd.Flags().String("until", "", "Show issues updated on or before this date (YYYY-MM-DD or RFC3339)")
+
+	// Issue search flags
+	issueSearchCmd.Flags().StringP("team", "t", "", "Only search issues in this team")
+	_ = issueSearchCmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
+	issueSearchCmd.Flags().StringP("state", "s", "", "Only search issues in this state (case-insensitive)")
+	issueSearchCmd.Flags().IntP("limit", "l", 25, "Maximum number of results (0 for all)")
+	issueSearchCmd.Flags().Bool("include-archived", false, "Include archived issues")
+
+	// Issue get flags
+	issueGetCmd.Flags().Bool("markdown", false, "Render the issue as a Markdown document")
+	issueGetCmd.Flags().StringP("output", "o", "", "Output format: md")
+	issueGetCmd.Flags().Bool("include-archived", false, "Show the issue even if it is archived")
+	issueGetCmd.Flags().String("template", "", "Go template used to render the issue")
+	issueGetCmd.Flags().String("template-file", "", "Path to a Go template file used to render the issue")
+	issueGetCmd.Flags().String("output-template", "", "Go template used to render the issue (alias for --template)")
+	issueGetCmd.Flags().BoolP("watch", "w", false, "Poll for changes and reprint the issue when it is updated")
+	issueGetCmd.Flags().Duration("interval", 10*time.Second, "Polling interval for --watch (minimum 2s)")
 
 	// Issue create flags
-	issueCreateCmd.Flags().StringP("title", "", "", "Issue title (required)")
-	issueCreateCmd.Flags().StringP("description", "d", "", "Issue description")
-	issueCreateCmd.Flags().StringP("team", "t", "", "Team key (required)")
+	issueCreateCmd.Flags().StringP("title", "", "", "Issue title (required unless --from-file is used)")
+	issueCreateCmd.Flags().StringP("description", "d", "", "Issue description (use - to read from stdin)")
+	issueCreateCmd.Flags().String("description-file", "", "Read the issue description from a file")
+	issueCreateCmd.Flags().String("from-file", "", "Create issues from a CSV or JSON file (columns: title, team, description, priority, assignee)")
+	issueCreateCmd.Flags().Bool("continue-on-error", false, "With --from-file, create the valid rows even if some rows are invalid")
+	issueCreateCmd.Flags().StringP("team", "t", "", "Team key (uses LINEAR_DEFAULT_TEAM if not specified)")
+	_ = issueCreateCmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
 	issueCreateCmd.Flags().Int("priority", 3, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueCreateCmd.Flags().BoolP("assign-me", "m", false, "Assign to yourself")
+	issueCreateCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name, or 'me')")
 	issueCreateCmd.Flags().String("actor", "", "Actor name for attribution (uses LINEAR_DEFAULT_ACTOR if not specified)")
 	issueCreateCmd.Flags().String("avatar-url", "", "Avatar URL for actor (uses LINEAR_DEFAULT_AVATAR_URL if not specified)")
-	_ = issueCreateCmd.MarkFlagRequired("title")
-	_ = issueCreateCmd.MarkFlagRequired("team")
+	issueCreateCmd.Flags().String("comment", "", "Initial comment to add after creating the issue")
+	issueCreateCmd.Flags().String("cycle", "", "Cycle to add the issue to: current, next, or a cycle number")
+	issueCreateCmd.Flags().String("milestone", "", "Project milestone ID to add the issue to")
+	issueCreateCmd.Flags().String("template", "", "Issue template from ~/.linctl/templates to fill in unset fields")
+	issueCreateCmd.Flags().Bool("dry-run", false, "Print the API request without creating anything")
+	issueCreateCmd.Flags().String("idempotency-key", "", "Unique key (e.g. a UUID) that makes retrying this create safe")
+	issueCreateCmd.Flags().Bool("open", false, "Open the created issue in the default browser")
+
+	// Issue delete flags
+	issueDeleteCmd.Flags().Bool("unarchive", false, "Restore an archived issue instead of archiving it")
+	issueDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	issueDeleteCmd.Flags().Bool("dry-run", false, "Print the API request without archiving")
+
+	// Issue link flags
+	issueLinkCmd.Flags().String("blocks", "", "Issue that this issue blocks")
+	issueLinkCmd.Flags().String("blocked-by", "", "Issue that blocks this issue")
+	issueLinkCmd.Flags().String("related", "", "Issue that is related to this issue")
+	issueLinkCmd.Flags().Bool("dry-run", false, "Print the API request without linking the issues")
+	for _, flag := range []string{"blocks", "blocked-by", "related"} {
+		_ = issueLinkCmd.RegisterFlagCompletionFunc(flag, completeIssueFlag)
+	}
+
+	// Issue unlink flags
+	issueUnlinkCmd.Flags().Bool("dry-run", false, "Print the API request without unlinking the issues")
+
+	// Attach command flags
+	issueAttachCmd.Flags().String("url", "", "URL to attach (required)")
+	issueAttachCmd.Flags().String("title", "", "Title of the attachment (defaults to the URL)")
+	issueAttachCmd.Flags().String("subtitle", "", "Subtitle shown under the title")
+	issueAttachCmd.Flags().Bool("allow-http", false, "Allow plain http:// URLs for internal links")
+	issueAttachCmd.Flags().Bool("dry-run", false, "Print the API request without attaching the link")
+	_ = issueAttachCmd.MarkFlagRequired("url")
+
+	// Attachments command flags
+	issueAttachmentsCmd.Flags().IntP("limit", "l", 50, "Maximum number of attachments to return (0 for all)")
+
+	// Issue move flags
+	issueMoveCmd.Flags().StringP("team", "t", "", "Key of the team to move the issue to (required)")
+	_ = issueMoveCmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
+	issueMoveCmd.Flags().Bool("remap-state", false, "Move the issue to the target team's equivalent workflow state")
+	issueMoveCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	issueMoveCmd.Flags().Bool("dry-run", false, "Print the API request without moving the issue")
+	_ = issueMoveCmd.MarkFlagRequired("team")
+
+	// Issue subscribe-matching flags
+	issueSubscribeMatchingCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
+	issueSubscribeMatchingCmd.Flags().StringP("state", "s", "", "Filter by state name")
+	issueSubscribeMatchingCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	_ = issueSubscribeMatchingCmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
+	issueSubscribeMatchingCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	issueSubscribeMatchingCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to subscribe to")
+	issueSubscribeMatchingCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
+	issueSubscribeMatchingCmd.Flags().StringP("newer-than", "n", "", "Match issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+	issueSubscribeMatchingCmd.Flags().Bool("dry-run", false, "Show matching issues without subscribing")
+	issueSubscribeMatchingCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+
+	// Issue export flags
+	issueExportCmd.Flags().StringP("team", "t", "", "Team key to export (uses LINEAR_DEFAULT_TEAM if not specified)")
+	issueExportCmd.Flags().StringP("out", "o", "", "Directory to write the export to")
+	issueExportCmd.Flags().StringP("format", "f", exportFormatMarkdown, "File format: markdown or json")
+	issueExportCmd.Flags().String("since", "", "Only export issues updated on or after this date (YYYY-MM-DD or RFC3339)")
+	issueExportCmd.Flags().Bool("include-archived", false, "Include archived issues")
+	issueExportCmd.Flags().Bool("overwrite", false, "Rewrite files that already exist instead of skipping them")
+	_ = issueExportCmd.MarkFlagRequired("out")
+	_ = issueExportCmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
+	_ = issueExportCmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions([]string{exportFormatMarkdown, exportFormatJSON}, cobra.ShellCompDirectiveNoFileComp))
+
+	// Issue comment flags
+	issueCommentCmd.Flags().String("body-file", "", "Read the comment body from a file")
+	issueCommentCmd.Flags().String("actor", "", "Actor name for attribution (uses LINEAR_DEFAULT_ACTOR if not specified)")
+	issueCommentCmd.Flags().String("avatar-url", "", "Avatar URL for actor (uses LINEAR_DEFAULT_AVATAR_URL if not specified)")
+	issueCommentCmd.Flags().String("reply-to", "", "ID of the comment to reply to")
+	issueCommentCmd.Flags().String("idempotency-key", "", "Unique key (e.g. a UUID) that makes retrying this create safe")
+	issueCommentCmd.Flags().Bool("preview", false, "Show the rendered comment and confirm before posting")
+	issueCommentCmd.Flags().BoolP("yes", "y", false, "Post without confirming when used with --preview")
+	issueCommentCmd.Flags().Bool("dry-run", false, "Print the API request without creating the comment")
+	issueCommentCmd.Flags().Bool("open", false, "Open the created comment in the default browser")
 
 	// Issue update flags
 	issueUpdateCmd.Flags().String("title", "", "New title for the issue")
 	issueUpdateCmd.Flags().StringP("description", "d", "", "New description for the issue")
 	issueUpdateCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name, 'me', or 'unassigned')")
 	issueUpdateCmd.Flags().StringP("state", "s", "", "State name (e.g., 'Todo', 'In Progress', 'Done')")
+	issueUpdateCmd.Flags().Bool("strict", false, "Fail instead of warning when --state is not an allowed transition")
 	issueUpdateCmd.Flags().Int("priority", -1, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	issueUpdateCmd.Flags().Float64("estimate", 0, "Estimate in points")
 	issueUpdateCmd.Flags().String("due-date", "", "Due date (YYYY-MM-DD format, or empty to remove)")
+	issueUpdateCmd.Flags().Bool("dry-run", false, "Print the API request without updating the issue")
+
+	// Issue assign flags
+	issueAssignCmd.Flags().Bool("dry-run", false, "Print the API request without assigning the issue")
+	issueUnassignCmd.Flags().Bool("dry-run", false, "Print the API request without unassigning the issue")
 }
@@ -1,10 +1,22 @@
 package cmd
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/batch"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/security"
+	"github.com/nicholls-inc/linctl/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -374,3 +386,1735 @@ func TestIssueCommand_Examples(t *testing.T) {
 		t.Error("Examples should contain actor create example")
 	}
 }
+
+func TestIssueDeleteCommand_Flags(t *testing.T) {
+	for _, name := range []string{"unarchive", "yes"} {
+		flag := issueDeleteCmd.Flags().Lookup(name)
+		if flag == nil {
+			t.Errorf("Expected --%s flag on issue delete", name)
+			continue
+		}
+		if flag.DefValue != "false" {
+			t.Errorf("Expected --%s to default to false, got %s", name, flag.DefValue)
+		}
+	}
+
+	if issueDeleteCmd.Flags().ShorthandLookup("y") == nil {
+		t.Error("Expected -y shorthand for --yes")
+	}
+}
+
+func TestConfirmFrom_QuietMode(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Failed to create pipe: %v", err)
+	}
+	original := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = original }()
+
+	if err := output.SetQuiet(true); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	confirmed := confirmFrom(strings.NewReader("y\n"), "Delete comment abc?")
+
+	// previewComment asks on stdin, so answer it through a pipe
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Failed to create pipe: %v", err)
+	}
+	stdin := os.Stdin
+	os.Stdin = stdinR
+	defer func() { os.Stdin = stdin }()
+	_, _ = stdinW.WriteString("n\n")
+	stdinW.Close()
+	posted := previewComment("ENG-1", "Looks good", true, true)
+
+	if err := output.SetQuiet(false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	w.Close()
+	data, _ := io.ReadAll(r)
+
+	if !confirmed || posted {
+		t.Errorf("Expected y to confirm and n to decline, got %v and %v", confirmed, posted)
+	}
+	if !strings.Contains(string(data), "Delete comment abc? [y/N]: ") {
+		t.Errorf("Expected the prompt to be shown under --quiet, got %q", data)
+	}
+	if !strings.Contains(string(data), "Looks good") {
+		t.Errorf("Expected a preview being confirmed to be shown under --quiet, got %q", data)
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"entity not found", errors.New("GraphQL errors: [{Entity not found [] []}]"), true},
+		{"could not find", errors.New("Could not find referenced Issue"), true},
+		{"other", errors.New("rate limited"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isNotFoundError(tt.err); got != tt.expected {
+				t.Errorf("isNotFoundError(%v) = %v, expected %v", tt.err, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckArchived(t *testing.T) {
+	archivedAt := time.Now()
+
+	active := &api.Issue{Identifier: "ENG-1"}
+	archived := &api.Issue{Identifier: "ENG-2", ArchivedAt: &archivedAt}
+
+	if err := checkArchived(active, false); err != nil {
+		t.Errorf("Expected active issue to be shown by default, got %v", err)
+	}
+
+	if err := checkArchived(archived, false); err == nil {
+		t.Error("Expected archived issue to be excluded by default")
+	} else if !strings.Contains(err.Error(), "--include-archived") {
+		t.Errorf("Expected error to mention --include-archived, got %v", err)
+	}
+
+	if err := checkArchived(archived, true); err != nil {
+		t.Errorf("Expected archived issue to be shown with --include-archived, got %v", err)
+	}
+}
+
+func TestIssueArchivedFlags(t *testing.T) {
+	for _, cmd := range []*cobra.Command{issueListCmd, issueGetCmd} {
+		flag := cmd.Flags().Lookup("include-archived")
+		if flag == nil {
+			t.Errorf("Expected --include-archived flag on issue %s", cmd.Name())
+			continue
+		}
+		if flag.DefValue != "false" {
+			t.Errorf("Expected --include-archived to default to false on issue %s", cmd.Name())
+		}
+	}
+}
+
+func TestLoadOutputTemplate(t *testing.T) {
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{Use: "list"}
+		cmd.Flags().String("template", "", "")
+		cmd.Flags().String("template-file", "", "")
+		cmd.Flags().String("output-template", "", "")
+		return cmd
+	}
+
+	dir := t.TempDir()
+	validFile := filepath.Join(dir, "report.tmpl")
+	if err := os.WriteFile(validFile, []byte("{{.Identifier}}"), 0644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+	brokenFile := filepath.Join(dir, "broken.tmpl")
+	if err := os.WriteFile(brokenFile, []byte("{{.Identifier"), 0644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		expectTmpl  bool
+		expectError bool
+	}{
+		{"no template", []string{}, false, false},
+		{"inline template", []string{"--template", "{{.Title}}"}, true, false},
+		{"template file", []string{"--template-file", validFile}, true, false},
+		{"broken template file", []string{"--template-file", brokenFile}, false, true},
+		{"both flags", []string{"--template", "{{.Title}}", "--template-file", validFile}, false, true},
+		{"output template", []string{"--output-template", "{{.Identifier}} {{.Title}}"}, true, false},
+		{"broken output template", []string{"--output-template", "{{.Identifier"}, false, true},
+		{"output template with template", []string{"--output-template", "{{.Title}}", "--template", "{{.Title}}"}, false, true},
+		{"output template with file", []string{"--output-template", "{{.Title}}", "--template-file", validFile}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newCmd()
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			tmpl, err := loadOutputTemplate(cmd)
+			if tt.expectError != (err != nil) {
+				t.Fatalf("Expected error=%v, got %v", tt.expectError, err)
+			}
+			if tt.expectTmpl != (tmpl != nil) {
+				t.Errorf("Expected template=%v, got %v", tt.expectTmpl, tmpl != nil)
+			}
+		})
+	}
+}
+
+func TestPollIssue_RendersOnlyOnChange(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	initial := &api.Issue{Identifier: "ENG-1", UpdatedAt: base}
+
+	// Two unchanged polls, one change, then another unchanged poll
+	responses := []*api.Issue{
+		{Identifier: "ENG-1", UpdatedAt: base},
+		{Identifier: "ENG-1", UpdatedAt: base},
+		{Identifier: "ENG-1", UpdatedAt: base.Add(time.Minute), Title: "changed"},
+		{Identifier: "ENG-1", UpdatedAt: base.Add(time.Minute), Title: "changed"},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	fetch := func(ctx context.Context) (*api.Issue, error) {
+		if calls >= len(responses) {
+			cancel()
+			return nil, ctx.Err()
+		}
+		issue := responses[calls]
+		calls++
+		return issue, nil
+	}
+
+	var rendered []*api.Issue
+	err := pollIssue(ctx, initial, time.Millisecond, fetch, func(issue *api.Issue) {
+		rendered = append(rendered, issue)
+	})
+
+	if err != nil {
+		t.Fatalf("Expected nil error on cancellation, got %v", err)
+	}
+	if len(rendered) != 2 {
+		t.Fatalf("Expected 2 renders (initial + one change), got %d", len(rendered))
+	}
+	if rendered[1].Title != "changed" {
+		t.Errorf("Expected second render to be the changed issue, got %q", rendered[1].Title)
+	}
+}
+
+func TestPollIssue_StopsWhenArchived(t *testing.T) {
+	archivedAt := time.Now()
+	initial := &api.Issue{Identifier: "ENG-1"}
+
+	fetch := func(ctx context.Context) (*api.Issue, error) {
+		return &api.Issue{Identifier: "ENG-1", ArchivedAt: &archivedAt}, nil
+	}
+
+	err := pollIssue(context.Background(), initial, time.Millisecond, fetch, func(*api.Issue) {})
+	if err == nil || !strings.Contains(err.Error(), "archived") {
+		t.Errorf("Expected archived error, got %v", err)
+	}
+}
+
+func TestIssueGetCommand_WatchFlags(t *testing.T) {
+	interval := issueGetCmd.Flags().Lookup("interval")
+	if interval == nil {
+		t.Fatal("Expected --interval flag on issue get")
+	}
+	if interval.DefValue != "10s" {
+		t.Errorf("Expected default interval 10s, got %s", interval.DefValue)
+	}
+	if issueGetCmd.Flags().Lookup("watch") == nil {
+		t.Error("Expected --watch flag on issue get")
+	}
+}
+
+// fakeIssueCommentCreator records calls and returns configured results
+type fakeIssueCommentCreator struct {
+	commentErr   error
+	commentInput *api.CommentCreateInput
+}
+
+func (f *fakeIssueCommentCreator) CreateIssue(ctx context.Context, input api.IssueCreateInput) (*api.Issue, error) {
+	return &api.Issue{ID: "issue-uuid", Identifier: "ENG-42", Title: input.Title}, nil
+}
+
+func (f *fakeIssueCommentCreator) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	return nil, errors.New("Entity not found")
+}
+
+func (f *fakeIssueCommentCreator) GetComment(ctx context.Context, id string) (*api.Comment, error) {
+	return nil, errors.New("Entity not found")
+}
+
+func (f *fakeIssueCommentCreator) CreateComment(ctx context.Context, input api.CommentCreateInput) (*api.Comment, error) {
+	f.commentInput = &input
+	if f.commentErr != nil {
+		return nil, f.commentErr
+	}
+	return &api.Comment{ID: "comment-uuid", Body: input.Body}, nil
+}
+
+func TestCreateIssueWithComment_Success(t *testing.T) {
+	client := &fakeIssueCommentCreator{}
+	actor := &utils.ActorParams{Actor: "Bot", AvatarURL: "https://example.com/bot.png"}
+
+	result, err := createIssueWithComment(context.Background(), client, api.IssueCreateInput{Title: "Bug"}, "Initial context", actor)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Comment == nil || result.Comment.ID != "comment-uuid" {
+		t.Fatalf("Expected comment to be created, got %+v", result.Comment)
+	}
+	if client.commentInput.IssueID != "issue-uuid" {
+		t.Errorf("Expected comment on issue-uuid, got %s", client.commentInput.IssueID)
+	}
+	if client.commentInput.CreateAsUser == nil || *client.commentInput.CreateAsUser != "Bot" {
+		t.Error("Expected actor attribution on comment")
+	}
+
+	payload := result.payload()
+	if payload["status"] != "success" {
+		t.Errorf("Expected status success, got %v", payload["status"])
+	}
+}
+
+func TestCreateIssueWithComment_PartialFailure(t *testing.T) {
+	client := &fakeIssueCommentCreator{commentErr: errors.New("rate limited")}
+
+	result, err := createIssueWithComment(context.Background(), client, api.IssueCreateInput{Title: "Bug"}, "Initial context", &utils.ActorParams{})
+	if err != nil {
+		t.Fatalf("Expected issue creation to succeed, got %v", err)
+	}
+
+	if result.Issue == nil || result.Issue.Identifier != "ENG-42" {
+		t.Fatalf("Expected created issue to be reported, got %+v", result.Issue)
+	}
+	if result.CommentError == nil {
+		t.Fatal("Expected comment error to be reported")
+	}
+
+	payload := result.payload()
+	if payload["status"] != "partial" {
+		t.Errorf("Expected status partial, got %v", payload["status"])
+	}
+	if _, ok := payload["comment"]; ok {
+		t.Error("Expected no comment in partial payload")
+	}
+	if msg, _ := payload["error"].(string); !strings.Contains(msg, "rate limited") {
+		t.Errorf("Expected error to mention cause, got %q", msg)
+	}
+}
+
+func TestCreateIssueWithComment_NoComment(t *testing.T) {
+	client := &fakeIssueCommentCreator{}
+
+	result, err := createIssueWithComment(context.Background(), client, api.IssueCreateInput{Title: "Bug"}, "", &utils.ActorParams{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if client.commentInput != nil {
+		t.Error("Expected no comment to be created")
+	}
+	if result.Comment != nil || result.CommentError != nil {
+		t.Error("Expected empty comment result")
+	}
+}
+
+// fakeIssueSubscriber returns fixed issues and fails subscriptions for selected IDs
+type fakeIssueSubscriber struct {
+	mu         sync.Mutex
+	issues     []api.Issue
+	failIDs    map[string]bool
+	filter     map[string]interface{}
+	subscribed []string
+}
+
+func (f *fakeIssueSubscriber) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool) (*api.Issues, error) {
+	f.filter = filter
+	return &api.Issues{Nodes: f.issues}, nil
+}
+
+func (f *fakeIssueSubscriber) SubscribeToIssue(ctx context.Context, id string) (*api.IssuePayload, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.failIDs[id] {
+		return nil, errors.New("permission denied")
+	}
+	f.subscribed = append(f.subscribed, id)
+	return &api.IssuePayload{Success: true}, nil
+}
+
+func newFakeIssueSubscriber() *fakeIssueSubscriber {
+	return &fakeIssueSubscriber{
+		issues: []api.Issue{
+			{ID: "id-1", Identifier: "ENG-1", Title: "First"},
+			{ID: "id-2", Identifier: "ENG-2", Title: "Second"},
+			{ID: "id-3", Identifier: "ENG-3", Title: "Third"},
+		},
+		failIDs: map[string]bool{},
+	}
+}
+
+func TestSubscribeMatching_MatchThenSubscribe(t *testing.T) {
+	client := newFakeIssueSubscriber()
+	client.failIDs["id-2"] = true
+	filter := map[string]interface{}{"team": map[string]interface{}{"key": map[string]interface{}{"eq": "ENG"}}}
+
+	issues, err := findMatchingIssues(context.Background(), client, filter, 50)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.filter["team"] == nil {
+		t.Error("Expected filter to be passed to GetIssues")
+	}
+
+	results := subscribeToIssues(context.Background(), client, issues, batch.NewExecutor(nil, nil), false)
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+
+	expected := []string{"subscribed", "failed", "subscribed"}
+	for i, result := range results {
+		if result.Issue != issues[i].Identifier {
+			t.Errorf("Expected results in issue order, got %s at %d", result.Issue, i)
+		}
+		if result.Status != expected[i] {
+			t.Errorf("Expected %s for %s, got %s", expected[i], result.Issue, result.Status)
+		}
+	}
+	if results[1].Error != "permission denied" {
+		t.Errorf("Expected failure reason, got %q", results[1].Error)
+	}
+	if len(client.subscribed) != 2 {
+		t.Errorf("Expected 2 subscriptions, got %d", len(client.subscribed))
+	}
+
+	payload := subscribeMatchingPayload(results, false)
+	if payload["status"] != "partial" || payload["matched"] != 3 || payload["subscribed"] != 2 || payload["failed"] != 1 {
+		t.Errorf("Unexpected payload counts: %v", payload)
+	}
+}
+
+func TestSubscribeMatching_DryRun(t *testing.T) {
+	client := newFakeIssueSubscriber()
+
+	results := subscribeToIssues(context.Background(), client, client.issues, batch.NewExecutor(nil, nil), true)
+	if len(client.subscribed) != 0 {
+		t.Errorf("Expected no subscriptions in dry-run, got %v", client.subscribed)
+	}
+	for _, result := range results {
+		if result.Status != "would_subscribe" {
+			t.Errorf("Expected would_subscribe for %s, got %s", result.Issue, result.Status)
+		}
+	}
+
+	payload := subscribeMatchingPayload(results, true)
+	if payload["status"] != "success" || payload["dry_run"] != true || payload["matched"] != 3 || payload["subscribed"] != 0 {
+		t.Errorf("Unexpected dry-run payload: %v", payload)
+	}
+}
+
+func TestSubscribeMatchingPayload_Empty(t *testing.T) {
+	payload := subscribeMatchingPayload(nil, false)
+	if payload["matched"] != 0 || payload["status"] != "success" {
+		t.Errorf("Unexpected empty payload: %v", payload)
+	}
+	if results, ok := payload["results"].([]subscribeResult); !ok || results == nil {
+		t.Error("Expected empty results slice rather than nil")
+	}
+}
+
+// fakeSubscriptionClient tracks whether the viewer follows a single issue
+type fakeSubscriptionClient struct {
+	subscribed bool
+	calls      []string
+}
+
+func (f *fakeSubscriptionClient) GetViewer(ctx context.Context) (*api.User, error) {
+	return &api.User{ID: "user-1"}, nil
+}
+
+func (f *fakeSubscriptionClient) IsSubscribedToIssue(ctx context.Context, issueID string, userID string) (bool, error) {
+	if userID != "user-1" {
+		return false, fmt.Errorf("unexpected user %s", userID)
+	}
+	return f.subscribed, nil
+}
+
+func (f *fakeSubscriptionClient) SubscribeToIssue(ctx context.Context, id string) (*api.IssuePayload, error) {
+	f.calls = append(f.calls, "subscribe")
+	f.subscribed = true
+	return &api.IssuePayload{Success: true}, nil
+}
+
+func (f *fakeSubscriptionClient) UnsubscribeFromIssue(ctx context.Context, id string) (*api.IssuePayload, error) {
+	f.calls = append(f.calls, "unsubscribe")
+	f.subscribed = false
+	return &api.IssuePayload{Success: true}, nil
+}
+
+func TestSetIssueSubscription(t *testing.T) {
+	tests := []struct {
+		name          string
+		subscribed    bool
+		subscribe     bool
+		expectChanged bool
+	}{
+		{name: "subscribe", subscribed: false, subscribe: true, expectChanged: true},
+		{name: "already subscribed", subscribed: true, subscribe: true},
+		{name: "unsubscribe", subscribed: true, subscribe: false, expectChanged: true},
+		{name: "not subscribed", subscribed: false, subscribe: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeSubscriptionClient{subscribed: tt.subscribed}
+
+			changed, err := setIssueSubscription(context.Background(), client, "ENG-1", tt.subscribe)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if changed != tt.expectChanged {
+				t.Errorf("Expected changed %v, got %v", tt.expectChanged, changed)
+			}
+			if client.subscribed != tt.subscribe {
+				t.Errorf("Expected subscribed %v afterwards, got %v", tt.subscribe, client.subscribed)
+			}
+			if !tt.expectChanged && len(client.calls) != 0 {
+				t.Errorf("Expected no mutation when already in the requested state, got %v", client.calls)
+			}
+		})
+	}
+}
+
+func newTextInputCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "create"}
+	cmd.Flags().StringP("description", "d", "", "")
+	cmd.Flags().String("description-file", "", "")
+	return cmd
+}
+
+func TestReadTextInput(t *testing.T) {
+	dir := t.TempDir()
+	bodyPath := filepath.Join(dir, "body.md")
+	if err := os.WriteFile(bodyPath, []byte("# Summary\r\n\n  - indented item\n"), 0600); err != nil {
+		t.Fatalf("Failed to write body file: %v", err)
+	}
+	hugePath := filepath.Join(dir, "huge.md")
+	if err := os.WriteFile(hugePath, []byte(strings.Repeat("a", 100001)), 0600); err != nil {
+		t.Fatalf("Failed to write body file: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		stdin       string
+		expected    string
+		expectError string
+	}{
+		{
+			name:     "inline description",
+			args:     []string{"--description", "Inline text"},
+			expected: "Inline text",
+		},
+		{
+			name:     "description from file keeps markdown structure",
+			args:     []string{"--description-file", bodyPath},
+			expected: "# Summary\n\n  - indented item",
+		},
+		{
+			name:     "description from stdin",
+			args:     []string{"--description", "-"},
+			stdin:    "line one\nline two\n",
+			expected: "line one\nline two",
+		},
+		{
+			name:        "both flags",
+			args:        []string{"--description", "text", "--description-file", bodyPath},
+			expectError: "--description and --description-file cannot be used together",
+		},
+		{
+			name:        "missing file",
+			args:        []string{"--description-file", filepath.Join(dir, "missing.md")},
+			expectError: "failed to read --description-file",
+		},
+		{
+			name:        "description too long",
+			args:        []string{"--description-file", hugePath},
+			expectError: "too long",
+		},
+		{
+			name:     "no description",
+			args:     []string{},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newTextInputCommand()
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			got, err := readTextInput(cmd, "description", "description-file", strings.NewReader(tt.stdin))
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestReadTextInput_SecretPolicy(t *testing.T) {
+	t.Setenv("LINCTL_REDACT_SECRETS", "true")
+
+	cmd := newTextInputCommand()
+	if err := cmd.ParseFlags([]string{"--description", "token lin_api_0123456789abcdefghijABCDEFGHIJ0123456789"}); err != nil {
+		t.Fatalf("Failed to parse flags: %v", err)
+	}
+
+	got, err := readTextInput(cmd, "description", "description-file", strings.NewReader(""))
+	if err != nil || got != "token ***REDACTED***" {
+		t.Errorf("Expected the key to be redacted, got %q, %v", got, err)
+	}
+
+	t.Setenv("LINCTL_SECRET_POLICY", "reject")
+	if _, err := readTextInput(cmd, "description", "description-file", strings.NewReader("")); err == nil {
+		t.Error("Expected the reject policy to refuse the description")
+	}
+}
+
+func TestReadCommentBodyArg(t *testing.T) {
+	bodyPath := filepath.Join(t.TempDir(), "comment.md")
+	if err := os.WriteFile(bodyPath, []byte("From a file\n"), 0600); err != nil {
+		t.Fatalf("Failed to write body file: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		flags       []string
+		stdin       string
+		expected    string
+		expectError string
+	}{
+		{
+			name:     "positional body",
+			args:     []string{"LGTM"},
+			expected: "LGTM",
+		},
+		{
+			name:     "positional body from stdin",
+			args:     []string{"-"},
+			stdin:    "Deployed\n",
+			expected: "Deployed",
+		},
+		{
+			name:     "body file without positional body",
+			flags:    []string{"--body-file", bodyPath},
+			expected: "From a file",
+		},
+		{
+			name:        "positional body and body file",
+			args:        []string{"LGTM"},
+			flags:       []string{"--body-file", bodyPath},
+			expectError: "BODY and --body-file cannot be used together",
+		},
+		{
+			name:        "positional body too long",
+			args:        []string{strings.Repeat("a", 100001)},
+			expectError: "too long",
+		},
+		{
+			name:     "no body",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "comment"}
+			cmd.Flags().String("body-file", "", "")
+			if err := cmd.ParseFlags(tt.flags); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			got, err := readCommentBodyArg(cmd, tt.args, strings.NewReader(tt.stdin))
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+type fakeBulkIssueClient struct {
+	failTitles map[string]bool
+	dryRun     bool
+	created    []api.IssueCreateInput
+}
+
+func (f *fakeBulkIssueClient) GetTeam(ctx context.Context, key string) (*api.Team, error) {
+	if key == "NOPE" {
+		return nil, errors.New("Entity not found")
+	}
+	return &api.Team{ID: "team-" + key, Key: key}, nil
+}
+
+func (f *fakeBulkIssueClient) GetViewer(ctx context.Context) (*api.User, error) {
+	return &api.User{ID: "user-me", Email: "me@example.com"}, nil
+}
+
+func (f *fakeBulkIssueClient) FindUser(ctx context.Context, query string) (*api.User, error) {
+	if query == "jane@example.com" {
+		return &api.User{ID: "user-jane", Name: "Jane", Email: "jane@example.com"}, nil
+	}
+	return nil, api.ErrUserNotFound
+}
+
+func (f *fakeBulkIssueClient) BulkCreateIssues(ctx context.Context, inputs []api.IssueCreateInput) []api.BulkCreateResult {
+	results := make([]api.BulkCreateResult, len(inputs))
+	for i, input := range inputs {
+		f.created = append(f.created, input)
+		if f.dryRun {
+			results[i].Error = &api.DryRunError{
+				Operation: "CreateIssue",
+				Request:   api.GraphQLRequest{Variables: map[string]interface{}{"input": input}},
+			}
+			continue
+		}
+		if f.failTitles[input.Title] {
+			results[i].Error = errors.New("server error")
+			continue
+		}
+		results[i].Issue = &api.Issue{ID: fmt.Sprintf("id-%d", len(f.created)), Identifier: fmt.Sprintf("ENG-%d", len(f.created)), Title: input.Title}
+	}
+	return results
+}
+
+func TestParseBulkIssueFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+		return path
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		path := write("issues.csv", "title,team,description,priority,assignee\n"+
+			"Fix login,ENG,\"Multi\nline\",1,me\n"+
+			"Add docs,DOC,,,\n")
+
+		rows, err := parseBulkIssueFile(path)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(rows) != 2 {
+			t.Fatalf("Expected 2 rows, got %d", len(rows))
+		}
+		if rows[0].Title != "Fix login" || rows[0].Team != "ENG" || rows[0].Description != "Multi\nline" || rows[0].Assignee != "me" {
+			t.Errorf("Unexpected first row: %+v", rows[0])
+		}
+		if rows[0].Priority == nil || *rows[0].Priority != 1 {
+			t.Errorf("Expected priority 1, got %v", rows[0].Priority)
+		}
+		if rows[1].Priority != nil {
+			t.Errorf("Expected no priority for empty column, got %v", *rows[1].Priority)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		path := write("issues.json", `[{"title": "Fix login", "team": "ENG", "priority": 2}]`)
+
+		rows, err := parseBulkIssueFile(path)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(rows) != 1 || rows[0].Title != "Fix login" || *rows[0].Priority != 2 {
+			t.Errorf("Unexpected rows: %+v", rows)
+		}
+	})
+
+	t.Run("unknown csv column", func(t *testing.T) {
+		path := write("bad.csv", "title,owner\nFix login,jane\n")
+
+		if _, err := parseBulkIssueFile(path); err == nil || !strings.Contains(err.Error(), "owner") {
+			t.Errorf("Expected unknown column error, got %v", err)
+		}
+	})
+
+	t.Run("invalid priority", func(t *testing.T) {
+		path := write("priority.csv", "title,team,priority\nFix login,ENG,high\n")
+
+		if _, err := parseBulkIssueFile(path); err == nil || !strings.Contains(err.Error(), "row 1") {
+			t.Errorf("Expected row error, got %v", err)
+		}
+	})
+}
+
+func TestCreateBulkIssues(t *testing.T) {
+	priority := 2
+	badPriority := 9
+	rows := []bulkIssueRow{
+		{Title: "Fix login bug", Team: "ENG", Priority: &priority, Assignee: "me"},
+		{Title: "Write docs", Team: "ENG", Assignee: "jane@example.com"},
+		{Title: "No", Team: "ENG"},
+		{Title: "Bad priority", Team: "ENG", Priority: &badPriority},
+		{Title: "Unknown team", Team: "NOPE"},
+	}
+
+	t.Run("aborts when rows are invalid", func(t *testing.T) {
+		client := &fakeBulkIssueClient{}
+
+		results, err := createBulkIssues(context.Background(), client, rows, &utils.ActorParams{}, false)
+		if !errors.Is(err, errBulkInvalidRows) {
+			t.Fatalf("Expected errBulkInvalidRows, got %v", err)
+		}
+		if len(client.created) != 0 {
+			t.Errorf("Expected no issues to be created, got %d", len(client.created))
+		}
+
+		statuses := []string{}
+		for _, result := range results {
+			statuses = append(statuses, result.Status)
+		}
+		if strings.Join(statuses, ",") != "skipped,skipped,invalid,invalid,invalid" {
+			t.Errorf("Unexpected statuses: %v", statuses)
+		}
+	})
+
+	t.Run("continue on error creates valid rows", func(t *testing.T) {
+		client := &fakeBulkIssueClient{failTitles: map[string]bool{"Write docs": true}}
+
+		results, err := createBulkIssues(context.Background(), client, rows, &utils.ActorParams{}, true)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(client.created) != 2 {
+			t.Fatalf("Expected 2 create attempts, got %d", len(client.created))
+		}
+		if client.created[0].TeamID != "team-ENG" || *client.created[0].AssigneeID != "user-me" || *client.created[0].Priority != 2 {
+			t.Errorf("Unexpected first input: %+v", client.created[0])
+		}
+		if *client.created[1].AssigneeID != "user-jane" {
+			t.Errorf("Expected assignee resolved by email, got %v", *client.created[1].AssigneeID)
+		}
+
+		if results[0].Status != "created" || results[0].Identifier != "ENG-1" || results[0].Row != 1 {
+			t.Errorf("Unexpected first result: %+v", results[0])
+		}
+		if results[1].Status != "failed" || results[1].Error != "server error" {
+			t.Errorf("Unexpected second result: %+v", results[1])
+		}
+		if !strings.Contains(results[4].Error, "failed to find team 'NOPE'") {
+			t.Errorf("Unexpected team error: %+v", results[4])
+		}
+
+		payload := bulkCreatePayload(results)
+		if payload["status"] != "partial" || payload["created"] != 1 || payload["failed"] != 4 {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+	})
+
+	t.Run("dry run reports prepared requests", func(t *testing.T) {
+		client := &fakeBulkIssueClient{dryRun: true}
+
+		results, err := createBulkIssues(context.Background(), client, rows[:2], &utils.ActorParams{}, false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		for _, result := range results {
+			if result.Status != "dry-run" || result.Request == nil {
+				t.Errorf("Expected dry-run result with request, got %+v", result)
+			}
+		}
+
+		payload := bulkCreatePayload(results)
+		if payload["status"] != "success" || payload["dry_run"] != 2 || payload["created"] != 0 {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+	})
+}
+
+func TestIssueCreateCommand_FromFile(t *testing.T) {
+	dir := t.TempDir()
+	responses := map[string]string{
+		"Team.json":        `{"data": {"team": {"id": "team-1", "key": "ENG", "name": "Engineering"}}}`,
+		"CreateIssue.json": `{"data": {"issueCreate": {"issue": {"id": "issue-1", "identifier": "ENG-1", "title": "Created"}}}}`,
+	}
+	for name, body := range responses {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := filepath.Join(dir, "issues.csv")
+	if err := os.WriteFile(path, []byte("title,team\nFix login bug,ENG\nWrite docs,ENG\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	env := []string{api.MockDirEnvVar + "=" + dir}
+
+	stdout, stderr, code := runLinctl(t, env, "--mock", "--json", "issue", "create", "--from-file", path)
+	if code != 0 {
+		t.Fatalf("Expected issue create --from-file to succeed, exited %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, `"created": 2`) {
+		t.Errorf("Expected both rows to be created, got %s", stdout)
+	}
+
+	stdout, _, code = runLinctl(t, env, "--mock", "--json", "issue", "create", "--from-file", path, "--title", "x")
+	if code != exitValidation || !strings.Contains(stdout, "--title cannot be used with --from-file") {
+		t.Errorf("Expected --title to be rejected with --from-file, exited %d: %s", code, stdout)
+	}
+
+	_, _, code = runLinctl(t, env, "--mock", "--json", "issue", "create", "--team", "ENG")
+	if code != exitValidation {
+		t.Errorf("Expected a single issue without --title to fail validation, exited %d", code)
+	}
+}
+
+func TestIssueCreateCommand_Minimal(t *testing.T) {
+	dir := t.TempDir()
+	responses := map[string]string{
+		"Team.json":        `{"data": {"team": {"id": "team-1", "key": "ENG", "name": "Engineering"}}}`,
+		"CreateIssue.json": `{"data": {"issueCreate": {"issue": {"id": "issue-1", "identifier": "ENG-1", "title": "Hello"}}}}`,
+	}
+	for name, body := range responses {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	env := []string{api.MockDirEnvVar + "=" + dir}
+
+	for _, team := range []string{"ENG", "eng"} {
+		stdout, stderr, code := runLinctl(t, env, "--mock", "--json", "issue", "create", "--title", "Hello", "--team", team)
+		if code != 0 {
+			t.Fatalf("Expected issue create --team %s to succeed, exited %d: %s%s", team, code, stdout, stderr)
+		}
+		if !strings.Contains(stdout, `"ENG-1"`) {
+			t.Errorf("Expected the created issue, got %s", stdout)
+		}
+	}
+
+	stdout, _, code := runLinctl(t, env, "--mock", "--json", "issue", "create", "--title", "Hello", "--team", "ENG", "--milestone", "not-a-uuid")
+	if code != exitValidation || !strings.Contains(stdout, "milestone_id") {
+		t.Errorf("Expected an invalid --milestone to be rejected, exited %d: %s", code, stdout)
+	}
+}
+
+func TestIssueCommentCommand_IdempotencyKey(t *testing.T) {
+	if issueCommentCmd.Flags().Lookup("idempotency-key") == nil {
+		t.Fatal("Expected --idempotency-key flag on issue comment")
+	}
+
+	dir := t.TempDir()
+	response := `{"data": {"commentCreate": {"comment": {"id": "comment-1", "body": "Deployed"}}}}`
+	if err := os.WriteFile(filepath.Join(dir, "CreateComment.json"), []byte(response), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// Both runs share a home directory, and so the local idempotency cache
+	env := []string{api.MockDirEnvVar + "=" + dir, "HOME=" + t.TempDir()}
+
+	_, stderr, code := runLinctl(t, env, "--mock", "--json", "issue", "comment", "ENG-1", "Deployed", "--idempotency-key", "deploy-42")
+	if code != 0 {
+		t.Fatalf("Expected issue comment to succeed, exited %d: %s", code, stderr)
+	}
+
+	_, stderr, code = runLinctl(t, env, "--mock", "--json", "issue", "comment", "ENG-1", "Deployed", "--idempotency-key", "deploy-42")
+	if code != 0 || !strings.Contains(stderr, "returning comment comment-1 created earlier") {
+		t.Errorf("Expected the retry to return the earlier comment, exited %d: %s", code, stderr)
+	}
+
+	stdout, _, code := runLinctl(t, env, "--mock", "--json", "issue", "comment", "ENG-1", "Rolled back", "--idempotency-key", "deploy-42")
+	if code == 0 {
+		t.Errorf("Expected a different body with the same key to be rejected, got %s", stdout)
+	}
+}
+
+func TestResolveAssigneeID(t *testing.T) {
+	client := &fakeBulkIssueClient{}
+
+	tests := []struct {
+		assignee    string
+		expected    string
+		expectError error
+	}{
+		{assignee: "me", expected: "user-me"},
+		{assignee: "jane@example.com", expected: "user-jane"},
+		{assignee: "nobody", expectError: api.ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.assignee, func(t *testing.T) {
+			id, err := resolveAssigneeID(context.Background(), client, tt.assignee)
+			if tt.expectError != nil {
+				if !errors.Is(err, tt.expectError) {
+					t.Fatalf("Expected %v, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if id != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, id)
+			}
+		})
+	}
+}
+
+// fakeIssueAssigner records the update input sent by assignIssue
+type fakeIssueAssigner struct {
+	fakeBulkIssueClient
+	input map[string]interface{}
+}
+
+func (f *fakeIssueAssigner) UpdateIssue(ctx context.Context, id string, input map[string]interface{}) (*api.Issue, error) {
+	f.input = input
+	return &api.Issue{Identifier: id}, nil
+}
+
+func TestAssignIssue(t *testing.T) {
+	tests := []struct {
+		name       string
+		assignee   string
+		expectedID interface{}
+		expectUser bool
+	}{
+		{name: "me", assignee: "me", expectedID: "user-me", expectUser: true},
+		{name: "email", assignee: "jane@example.com", expectedID: "user-jane", expectUser: true},
+		{name: "unassign", assignee: "", expectedID: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeIssueAssigner{}
+			issue, user, err := assignIssue(context.Background(), client, "ENG-1", tt.assignee)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if issue.Identifier != "ENG-1" {
+				t.Errorf("Expected ENG-1, got %s", issue.Identifier)
+			}
+			if (user != nil) != tt.expectUser {
+				t.Errorf("Expected user %v, got %+v", tt.expectUser, user)
+			}
+			if len(client.input) != 1 || client.input["assigneeId"] != tt.expectedID {
+				t.Errorf("Expected only assigneeId=%v, got %v", tt.expectedID, client.input)
+			}
+		})
+	}
+
+	t.Run("unknown user", func(t *testing.T) {
+		client := &fakeIssueAssigner{}
+		_, _, err := assignIssue(context.Background(), client, "ENG-1", "nobody")
+		if !errors.Is(err, api.ErrUserNotFound) {
+			t.Fatalf("Expected ErrUserNotFound, got %v", err)
+		}
+		if client.input != nil {
+			t.Error("Expected no update when the user cannot be resolved")
+		}
+	})
+}
+
+// fakeLabelLister returns a fixed set of team labels
+type fakeLabelLister struct{}
+
+func (fakeLabelLister) GetLabels(ctx context.Context, teamID string) (*api.Labels, error) {
+	return &api.Labels{Nodes: []api.Label{
+		{ID: "label-bug", Name: "Bug"},
+		{ID: "label-ui", Name: "UI"},
+	}}, nil
+}
+
+func TestResolveLabelIDs(t *testing.T) {
+	ids, err := resolveLabelIDs(context.Background(), fakeLabelLister{}, "team-1", []string{"bug", "UI"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Join(ids, ",") != "label-bug,label-ui" {
+		t.Errorf("Expected label-bug,label-ui, got %v", ids)
+	}
+
+	if _, err := resolveLabelIDs(context.Background(), fakeLabelLister{}, "team-1", []string{"missing"}); err == nil {
+		t.Error("Expected an unknown label to be rejected")
+	}
+}
+
+// fakeRelationFetcher serves issue relations from a map keyed by issue ID
+type fakeRelationFetcher map[string]api.Issue
+
+func (f fakeRelationFetcher) GetIssueRelationsByIDs(ctx context.Context, ids []string) ([]api.Issue, error) {
+	issues := make([]api.Issue, len(ids))
+	for i, id := range ids {
+		issues[i] = f[id]
+		issues[i].ID = id
+	}
+	return issues, nil
+}
+
+// blocksRelation builds a "blocks" relation to or from an issue in the given state
+func blocksRelation(stateType string) api.IssueRelation {
+	other := &api.Issue{ID: "other", State: &api.State{Type: stateType}}
+	return api.IssueRelation{Type: api.IssueRelationBlocks, Issue: other, RelatedIssue: other}
+}
+
+func TestFilterIssuesByDependency(t *testing.T) {
+	relations := fakeRelationFetcher{
+		"blocked":         {InverseRelations: &api.IssueRelations{Nodes: []api.IssueRelation{blocksRelation("started")}}},
+		"blocked-by-done": {InverseRelations: &api.IssueRelations{Nodes: []api.IssueRelation{blocksRelation("completed")}}},
+		"blocking":        {Relations: &api.IssueRelations{Nodes: []api.IssueRelation{blocksRelation("unstarted")}}},
+		"related":         {Relations: &api.IssueRelations{Nodes: []api.IssueRelation{{Type: api.IssueRelationRelated, RelatedIssue: &api.Issue{}}}}},
+		"both": {
+			Relations:        &api.IssueRelations{Nodes: []api.IssueRelation{blocksRelation("backlog")}},
+			InverseRelations: &api.IssueRelations{Nodes: []api.IssueRelation{blocksRelation("started")}},
+		},
+	}
+	issues := []api.Issue{{ID: "blocked"}, {ID: "blocked-by-done"}, {ID: "blocking"}, {ID: "related"}, {ID: "both"}}
+
+	tests := []struct {
+		name     string
+		blocked  bool
+		blocking bool
+		want     string
+	}{
+		{name: "blocked", blocked: true, want: "blocked,both"},
+		{name: "blocking", blocking: true, want: "blocking,both"},
+		{name: "blocked and blocking", blocked: true, blocking: true, want: "both"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, err := filterIssuesByDependency(context.Background(), relations, issues, tt.blocked, tt.blocking)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			ids := make([]string, len(matched))
+			for i, issue := range matched {
+				ids[i] = issue.ID
+			}
+			if got := strings.Join(ids, ","); got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func newIssueFilterCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "list"}
+	cmd.Flags().String("newer-than", "", "")
+	cmd.Flags().String("since", "", "")
+	cmd.Flags().String("until", "", "")
+	cmd.Flags().Int("priority", -1, "")
+	cmd.Flags().Bool("include-completed", false, "")
+	return cmd
+}
+
+func TestBuildIssueFilter_SinceUntil(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            []string
+		expectUpdatedAt bool
+		expectCreatedAt bool
+	}{
+		{name: "default newer-than", args: nil, expectCreatedAt: true},
+		{name: "since replaces default", args: []string{"--since", "2024-01-01"}, expectUpdatedAt: true},
+		{name: "since wins over newer-than", args: []string{"--since", "2024-01-01", "--newer-than", "1_week_ago"}, expectUpdatedAt: true},
+		{name: "until combines with explicit newer-than", args: []string{"--until", "2024-03-31", "--newer-than", "all_time"}, expectUpdatedAt: true},
+		{name: "until with newer-than date", args: []string{"--until", "2024-03-31", "--newer-than", "2024-01-01"}, expectUpdatedAt: true, expectCreatedAt: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newIssueFilterCommand()
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			filter := buildIssueFilter(cmd)
+			if _, ok := filter["updatedAt"]; ok != tt.expectUpdatedAt {
+				t.Errorf("Expected updatedAt filter %v, got %v", tt.expectUpdatedAt, filter["updatedAt"])
+			}
+			if _, ok := filter["createdAt"]; ok != tt.expectCreatedAt {
+				t.Errorf("Expected createdAt filter %v, got %v", tt.expectCreatedAt, filter["createdAt"])
+			}
+			if _, ok := filter["state"]; !ok {
+				t.Error("Expected state filter to be kept")
+			}
+		})
+	}
+}
+
+func TestBuildIssueSort(t *testing.T) {
+	tests := []struct {
+		name      string
+		sortBy    string
+		reverse   bool
+		field     string
+		order     string
+		expectErr bool
+	}{
+		{name: "linear default", sortBy: "linear"},
+		{name: "empty", sortBy: ""},
+		{name: "created", sortBy: "created", field: "createdAt", order: "Descending"},
+		{name: "createdAt alias", sortBy: "createdAt", field: "createdAt", order: "Descending"},
+		{name: "updated reversed", sortBy: "updated", reverse: true, field: "updatedAt", order: "Ascending"},
+		{name: "priority", sortBy: "priority", field: "priority", order: "Ascending"},
+		{name: "title reversed", sortBy: "title", reverse: true, field: "title", order: "Descending"},
+		{name: "unknown key", sortBy: "estimate", expectErr: true},
+		{name: "reverse without sort", sortBy: "linear", reverse: true, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort, err := buildIssueSort(tt.sortBy, tt.reverse)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Expected error for --sort %q --reverse=%v", tt.sortBy, tt.reverse)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if tt.field == "" {
+				if sort != nil {
+					t.Errorf("Expected Linear's default order, got %v", sort)
+				}
+				return
+			}
+			if len(sort) != 1 {
+				t.Fatalf("Expected one sort entry, got %v", sort)
+			}
+			key, ok := sort[0][tt.field].(map[string]interface{})
+			if !ok {
+				t.Fatalf("Expected sort on %s, got %v", tt.field, sort[0])
+			}
+			if key["order"] != tt.order {
+				t.Errorf("Expected %s order, got %v", tt.order, key["order"])
+			}
+		})
+	}
+}
+
+func TestParseIssueFields(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		expected  []string
+		expectErr bool
+	}{
+		{name: "default", value: "", expected: nil},
+		{name: "selection", value: "id,title,state,assignee", expected: []string{"id", "title", "state", "assignee"}},
+		{name: "spaces case and duplicates", value: " ID, Title ,id", expected: []string{"id", "title"}},
+		{name: "unknown field", value: "id,colour", expectErr: true},
+		{name: "only commas", value: ",,", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := parseIssueFields(tt.value)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("Expected error for %q, got %v", tt.value, fields)
+				}
+				if !strings.Contains(err.Error(), "assignee") {
+					t.Errorf("Expected the error to list valid fields, got %q", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("Expected %v, got %v", tt.expected, fields)
+			}
+		})
+	}
+}
+
+func TestIssueFields(t *testing.T) {
+	for _, name := range issueFieldNames {
+		if _, ok := issueFields[name]; !ok {
+			t.Errorf("Field %s is listed but has no accessor", name)
+		}
+	}
+	if len(issueFieldNames) != len(issueFields) {
+		t.Errorf("Expected every field to be listed, got %d names for %d fields", len(issueFieldNames), len(issueFields))
+	}
+
+	estimate := 3.0
+	issue := api.Issue{
+		Identifier: "ENG-1",
+		Title:      "Fix login",
+		Estimate:   &estimate,
+		State:      &api.State{Name: "In Progress"},
+		Labels:     &api.Labels{Nodes: []api.Label{{Name: "bug"}, {Name: "ui"}}},
+	}
+
+	if got := issueFields["id"].value(issue); got != "ENG-1" {
+		t.Errorf("Expected id to be the identifier, got %v", got)
+	}
+	if got := issueFields["state"].text(issue); got != "In Progress" {
+		t.Errorf("Expected state name, got %q", got)
+	}
+	if got := issueFields["assignee"].value(issue); got != nil {
+		t.Errorf("Expected null assignee in JSON, got %v", got)
+	}
+	if got := issueFields["assignee"].text(issue); got != "Unassigned" {
+		t.Errorf("Expected Unassigned in tables, got %q", got)
+	}
+	if got := issueFields["labels"].text(issue); got != "bug, ui" {
+		t.Errorf("Expected joined labels, got %q", got)
+	}
+	if got := issueFields["estimate"].text(issue); got != "3" {
+		t.Errorf("Expected estimate 3, got %q", got)
+	}
+}
+
+func TestParseLinkFlags(t *testing.T) {
+	tests := []struct {
+		name       string
+		blocks     string
+		blockedBy  string
+		related    string
+		wantKind   string
+		wantTarget string
+		wantErr    bool
+	}{
+		{name: "blocks", blocks: "LIN-2", wantKind: linkBlocks, wantTarget: "LIN-2"},
+		{name: "blocked by", blockedBy: "LIN-2", wantKind: linkBlockedBy, wantTarget: "LIN-2"},
+		{name: "related", related: " LIN-3 ", wantKind: linkRelated, wantTarget: "LIN-3"},
+		{name: "none", wantErr: true},
+		{name: "several", blocks: "LIN-2", related: "LIN-3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, target, err := parseLinkFlags(tt.blocks, tt.blockedBy, tt.related)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if kind != tt.wantKind || target != tt.wantTarget {
+				t.Errorf("Expected %s %s, got %s %s", tt.wantKind, tt.wantTarget, kind, target)
+			}
+		})
+	}
+}
+
+func TestNewIssueRelationInput(t *testing.T) {
+	issue := &api.Issue{ID: "issue-1", Identifier: "LIN-1"}
+	other := &api.Issue{ID: "issue-2", Identifier: "LIN-2"}
+
+	tests := []struct {
+		kind string
+		want api.IssueRelationInput
+	}{
+		{linkBlocks, api.IssueRelationInput{IssueID: "issue-1", RelatedIssueID: "issue-2", Type: api.IssueRelationBlocks}},
+		{linkBlockedBy, api.IssueRelationInput{IssueID: "issue-2", RelatedIssueID: "issue-1", Type: api.IssueRelationBlocks}},
+		{linkRelated, api.IssueRelationInput{IssueID: "issue-1", RelatedIssueID: "issue-2", Type: api.IssueRelationRelated}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			if got := newIssueRelationInput(issue, other, tt.kind); got != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFindIssueRelation(t *testing.T) {
+	issue := &api.Issue{ID: "issue-1", Identifier: "LIN-1"}
+	blocker := &api.Issue{
+		ID:         "issue-2",
+		Identifier: "LIN-2",
+		Relations: &api.IssueRelations{Nodes: []api.IssueRelation{
+			{ID: "relation-1", Type: "blocks", RelatedIssue: &api.Issue{ID: "issue-1", Identifier: "LIN-1"}},
+		}},
+	}
+	unrelated := &api.Issue{ID: "issue-3", Identifier: "LIN-3"}
+
+	relation := findIssueRelation(issue, blocker)
+	if relation == nil {
+		t.Fatal("Expected relation stored on the other issue to be found")
+	}
+	if relation.ID != "relation-1" || relation.Issue.Identifier != "LIN-2" || relation.RelatedIssue.Identifier != "LIN-1" {
+		t.Errorf("Expected LIN-2 blocks LIN-1, got %+v", relation)
+	}
+
+	if relation := findIssueRelation(issue, unrelated); relation != nil {
+		t.Errorf("Expected no relation, got %+v", relation)
+	}
+}
+
+func TestAddPriorityAndEstimate(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected map[string]interface{}
+		wantErr  bool
+	}{
+		{name: "unset flags are omitted", args: []string{}, expected: map[string]interface{}{}},
+		{name: "priority", args: []string{"--priority", "1"}, expected: map[string]interface{}{"priority": 1}},
+		{name: "priority none", args: []string{"--priority", "0"}, expected: map[string]interface{}{"priority": 0}},
+		{name: "estimate", args: []string{"--estimate", "2.5"}, expected: map[string]interface{}{"estimate": 2.5}},
+		{name: "both", args: []string{"--priority", "2", "--estimate", "0"}, expected: map[string]interface{}{"priority": 2, "estimate": 0.0}},
+		{name: "invalid priority", args: []string{"--priority", "5"}, wantErr: true},
+		{name: "negative estimate", args: []string{"--estimate", "-1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "update"}
+			cmd.Flags().Int("priority", -1, "")
+			cmd.Flags().Float64("estimate", 0, "")
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			input := map[string]interface{}{}
+			err := addPriorityAndEstimate(cmd, input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(input) != len(tt.expected) {
+				t.Fatalf("Expected input %v, got %v", tt.expected, input)
+			}
+			for key, value := range tt.expected {
+				if input[key] != value {
+					t.Errorf("Expected %s=%v, got %v", key, value, input[key])
+				}
+			}
+		})
+	}
+}
+
+func TestMatchWorkflowState(t *testing.T) {
+	states := []api.WorkflowState{
+		{ID: "s-backlog", Name: "Backlog", Type: "backlog", Position: 0},
+		{ID: "s-review", Name: "In Review", Type: "started", Position: 3},
+		{ID: "s-doing", Name: "Doing", Type: "started", Position: 2},
+		{ID: "s-done", Name: "Done", Type: "completed", Position: 4},
+	}
+
+	tests := []struct {
+		name     string
+		current  api.State
+		expected string
+	}{
+		{name: "same name wins", current: api.State{Name: "in review", Type: "started"}, expected: "s-review"},
+		{name: "falls back to type by position", current: api.State{Name: "In Progress", Type: "started"}, expected: "s-doing"},
+		{name: "no equivalent", current: api.State{Name: "Triage", Type: "triage"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := matchWorkflowState(&tt.current, states)
+			if tt.expected == "" {
+				if state != nil {
+					t.Errorf("Expected no match, got %s", state.ID)
+				}
+				return
+			}
+			if state == nil || state.ID != tt.expected {
+				t.Errorf("Expected %s, got %+v", tt.expected, state)
+			}
+		})
+	}
+}
+
+func TestLinearErrorMessage(t *testing.T) {
+	gqlErr := fmt.Errorf("request failed: %w", api.GraphQLErrors{
+		{Message: "Issue cannot be moved to a team you are not a member of"},
+		{Message: "Second problem"},
+	})
+	if got := linearErrorMessage(gqlErr); got != "Issue cannot be moved to a team you are not a member of; Second problem" {
+		t.Errorf("Unexpected message: %q", got)
+	}
+
+	if got := linearErrorMessage(errors.New("network down")); got != "network down" {
+		t.Errorf("Expected plain error text, got %q", got)
+	}
+}
+
+func TestBuildIssueSearchFilter(t *testing.T) {
+	if filter := buildIssueSearchFilter("", ""); len(filter) != 0 {
+		t.Errorf("Expected empty filter, got %v", filter)
+	}
+
+	filter := buildIssueSearchFilter("ENG", "in progress")
+	team := filter["team"].(map[string]interface{})["key"].(map[string]interface{})
+	if team["eq"] != "ENG" {
+		t.Errorf("Expected team key filter, got %v", filter["team"])
+	}
+	state := filter["state"].(map[string]interface{})["name"].(map[string]interface{})
+	if state["eqIgnoreCase"] != "in progress" {
+		t.Errorf("Expected case-insensitive state filter, got %v", filter["state"])
+	}
+}
+
+func TestIssueSearchCommand_IncludeArchivedFlag(t *testing.T) {
+	flag := issueSearchCmd.Flags().Lookup("include-archived")
+	if flag == nil {
+		t.Fatal("Expected --include-archived flag on issue search")
+	}
+	if flag.DefValue != "false" {
+		t.Errorf("Expected archived issues to be left out by default, got %s", flag.DefValue)
+	}
+}
+
+func TestSearchSnippet(t *testing.T) {
+	long := strings.Repeat("filler ", 20) + "the auth bug appears on login " + strings.Repeat("more ", 20)
+
+	tests := []struct {
+		name        string
+		description string
+		term        string
+		want        func(string) bool
+	}{
+		{
+			name:        "short description is returned flattened",
+			description: "Login\n\nfails   on Safari",
+			term:        "login",
+			want:        func(s string) bool { return s == "Login fails on Safari" },
+		},
+		{
+			name:        "window centres on the match",
+			description: long,
+			term:        "AUTH bug",
+			want: func(s string) bool {
+				return strings.Contains(s, "auth bug") && strings.HasPrefix(s, "…") && strings.HasSuffix(s, "…")
+			},
+		},
+		{
+			name:        "no match starts at the beginning",
+			description: long,
+			term:        "missing",
+			want: func(s string) bool {
+				return strings.HasPrefix(s, "filler") && strings.HasSuffix(s, "…")
+			},
+		},
+		{
+			name:        "empty description",
+			description: "",
+			term:        "auth",
+			want:        func(s string) bool { return s == "" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := searchSnippet(tt.description, tt.term, 40)
+			if !tt.want(got) {
+				t.Errorf("Unexpected snippet %q", got)
+			}
+			if n := len([]rune(got)); n > 40 {
+				t.Errorf("Expected at most 40 characters, got %d", n)
+			}
+		})
+	}
+}
+
+func TestIssueExporter(t *testing.T) {
+	updated := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+	issues := []api.Issue{
+		{ID: "uuid-1", Identifier: "ENG-1", Title: "First", Description: "Body one", UpdatedAt: updated, State: &api.State{Name: "Todo"}},
+		{ID: "uuid-2", Identifier: "ENG-2", Title: "Second | piped", UpdatedAt: updated},
+	}
+
+	dir := filepath.Join(t.TempDir(), "backup")
+	exporter, err := newIssueExporter(dir, "markdown", false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// ENG-2 was written by an earlier, interrupted run
+	if err := os.WriteFile(filepath.Join(dir, "ENG-2.md"), []byte("earlier"), 0600); err != nil {
+		t.Fatalf("Failed to write existing file: %v", err)
+	}
+
+	for _, issue := range issues {
+		if err := exporter.export(issue); err != nil {
+			t.Fatalf("Unexpected export error: %v", err)
+		}
+	}
+	index, err := exporter.writeIndex()
+	if err != nil {
+		t.Fatalf("Unexpected index error: %v", err)
+	}
+
+	if exporter.exported != 1 || exporter.skipped != 1 {
+		t.Errorf("Expected 1 exported and 1 skipped, got %d and %d", exporter.exported, exporter.skipped)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "ENG-1.md"))
+	if err != nil {
+		t.Fatalf("Expected ENG-1.md to be written: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "---\nid: uuid-1\nidentifier: ENG-1\n") || !strings.Contains(string(data), "Body one") {
+		t.Errorf("Unexpected issue file:\n%s", data)
+	}
+
+	if existing, _ := os.ReadFile(filepath.Join(dir, "ENG-2.md")); string(existing) != "earlier" {
+		t.Errorf("Expected existing file to be kept, got %q", existing)
+	}
+
+	indexData, _ := os.ReadFile(index)
+	for _, want := range []string{"| [ENG-1](ENG-1.md) | First | Todo | 2024-05-01 |", "| [ENG-2](ENG-2.md) | Second \\| piped |  | 2024-05-01 |"} {
+		if !strings.Contains(string(indexData), want) {
+			t.Errorf("Expected index to contain %q, got:\n%s", want, indexData)
+		}
+	}
+
+	// No temporary files are left behind
+	files, _ := os.ReadDir(dir)
+	if len(files) != 3 {
+		t.Errorf("Expected 2 issue files and an index, got %d entries", len(files))
+	}
+}
+
+func TestIssueExporter_OverwriteJSON(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "ENG-1.json")
+	if err := os.WriteFile(path, []byte("stale"), 0600); err != nil {
+		t.Fatalf("Failed to write existing file: %v", err)
+	}
+
+	exporter, err := newIssueExporter(dir, "json", true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := exporter.export(api.Issue{ID: "uuid-1", Identifier: "ENG-1", Title: "First"}); err != nil {
+		t.Fatalf("Unexpected export error: %v", err)
+	}
+	index, err := exporter.writeIndex()
+	if err != nil {
+		t.Fatalf("Unexpected index error: %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), `"identifier": "ENG-1"`) {
+		t.Errorf("Expected the file to be overwritten with JSON, got %q", data)
+	}
+	if exporter.exported != 1 || exporter.skipped != 0 {
+		t.Errorf("Expected 1 exported and 0 skipped, got %d and %d", exporter.exported, exporter.skipped)
+	}
+	if filepath.Base(index) != "index.json" {
+		t.Errorf("Expected index.json, got %s", index)
+	}
+}
+
+func TestNewIssueExporter_InvalidFormat(t *testing.T) {
+	_, err := newIssueExporter(t.TempDir(), "csv", false)
+	if err == nil || !strings.Contains(err.Error(), "expected markdown or json") {
+		t.Errorf("Expected invalid format error, got %v", err)
+	}
+}
+
+func TestIssueExportFileName(t *testing.T) {
+	tests := []struct {
+		issue    api.Issue
+		expected string
+	}{
+		{api.Issue{ID: "uuid-1", Identifier: "ENG-123"}, "ENG-123"},
+		{api.Issue{ID: "uuid-1"}, "uuid-1"},
+		{api.Issue{Identifier: "../etc/passwd"}, "___etc_passwd"},
+	}
+
+	for _, tt := range tests {
+		if got := issueExportFileName(tt.issue); got != tt.expected {
+			t.Errorf("issueExportFileName(%+v) = %q, expected %q", tt.issue, got, tt.expected)
+		}
+	}
+}
+
+func TestIssueArgOrPick_UsesArgument(t *testing.T) {
+	client := &fakeIssueIdentifierLister{}
+	id, err := issueArgOrPick(context.Background(), client, []string{"ENG-7"})
+	if err != nil || id != "ENG-7" {
+		t.Fatalf("Expected ENG-7, got %q (%v)", id, err)
+	}
+	if client.called {
+		t.Error("Expected no lookup when the issue ID is given")
+	}
+}
+
+func TestCanPickIssue_ScriptedOutput(t *testing.T) {
+	if canPickIssue(false, true) || canPickIssue(true, false) {
+		t.Error("Expected the picker to be disabled for --json and --plaintext")
+	}
+}
+
+type fakeIssueIdentifierLister struct {
+	called bool
+}
+
+func (f *fakeIssueIdentifierLister) RecentIssueIdentifiers(ctx context.Context, prefix string) ([]string, error) {
+	f.called = true
+	return []string{"ENG-1"}, nil
+}
+
+func TestValidateFields(t *testing.T) {
+	failures := validateFields(map[string]interface{}{
+		"title":    "ok",
+		"team_key": "ENG",
+		"priority": 9,
+	}, nil, errors.New("not a validation error"), security.ValidationError{Field: "estimate", Message: "estimate cannot be negative"})
+
+	var fields []string
+	for _, failure := range failures {
+		fields = append(fields, failure.Field)
+	}
+	if strings.Join(fields, ",") != "priority,title,estimate" {
+		t.Errorf("Expected priority, title and estimate failures, got %v", fields)
+	}
+
+	if failures := validateFields(map[string]interface{}{"title": "Fix login", "team_key": "ENG"}); len(failures) != 0 {
+		t.Errorf("Expected valid fields to pass, got %v", failures)
+	}
+}
+
+func TestViewerIssueCommands_Filter(t *testing.T) {
+	for _, cmd := range []*cobra.Command{issueMineCmd, issueCreatedCmd} {
+		t.Run(cmd.Name(), func(t *testing.T) {
+			for _, name := range []string{"state", "sort", "reverse", "all", "limit", "include-completed"} {
+				if cmd.Flags().Lookup(name) == nil {
+					t.Errorf("Expected issue %s to have --%s", cmd.Name(), name)
+				}
+			}
+
+			filter := buildIssueFilter(cmd)
+			if _, ok := filter["priority"]; ok {
+				t.Errorf("Expected no priority filter by default, got %v", filter)
+			}
+			if _, ok := filter["assignee"]; ok {
+				t.Errorf("Expected the viewer scope to be left to the API client, got %v", filter)
+			}
+			if _, ok := filter["state"]; !ok {
+				t.Errorf("Expected completed issues to be hidden by default, got %v", filter)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/security"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// labelCmd represents the label command
+var labelCmd = &cobra.Command{
+	Use:   "label",
+	Short: "Manage Linear issue labels",
+	Long: `Manage issue labels for a team.
+
+Examples:
+  linctl label list --team ENG                                # List team labels
+  linctl label create --team ENG --name bug --color "#ff0000" # Create a label`,
+}
+
+var labelListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List labels",
+	Long:    `List the issue labels available to a team.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamKey, _ := cmd.Flags().GetString("team")
+		if teamKey == "" {
+			output.Error("Team is required (--team)", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+
+		labels, err := client.GetLabels(commandContext(), teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list labels: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(labels.Nodes)
+		} else if plaintext {
+			fmt.Println("Name\tColor\tID")
+			for _, label := range labels.Nodes {
+				fmt.Printf("%s\t%s\t%s\n", label.Name, label.Color, label.ID)
+			}
+		} else {
+			headers := []string{"Name", "Color", "ID"}
+			rows := [][]string{}
+			for _, label := range labels.Nodes {
+				rows = append(rows, []string{
+					color.New(color.FgCyan, color.Bold).Sprint(label.Name),
+					label.Color,
+					color.New(color.FgWhite, color.Faint).Sprint(label.ID),
+				})
+			}
+
+			output.Table(output.TableData{
+				Headers: headers,
+				Rows:    rows,
+			}, plaintext, jsonOut)
+
+			fmt.Printf("\n%s %d labels in team %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				len(labels.Nodes),
+				color.New(color.FgCyan).Sprint(teamKey))
+		}
+	},
+}
+
+var labelCreateCmd = &cobra.Command{
+	Use:     "create",
+	Aliases: []string{"new"},
+	Short:   "Create a label",
+	Long:    `Create a new issue label for a team.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamKey, _ := cmd.Flags().GetString("team")
+		name, _ := cmd.Flags().GetString("name")
+		labelColor, _ := cmd.Flags().GetString("color")
+		description, _ := cmd.Flags().GetString("description")
+
+		if teamKey == "" {
+			output.Error("Team is required (--team)", plaintext, jsonOut)
+			os.Exit(exitValidation)
+		}
+
+		input, err := newLabelCreateInput(name, labelColor, description)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		requireScope(authHeader, "label.create", plaintext, jsonOut)
+
+		team, err := client.GetTeam(commandContext(), teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		input.TeamID = team.ID
+
+		label, err := client.CreateLabel(commandContext(), input)
+		recordAudit("label.create", teamKey, "", err)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to create label: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(label)
+		} else if plaintext {
+			fmt.Printf("Created label %s\n", label.Name)
+			fmt.Printf("Color: %s\n", label.Color)
+			fmt.Printf("ID: %s\n", label.ID)
+		} else {
+			fmt.Printf("%s Created label %s in team %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(label.Name),
+				color.New(color.FgCyan).Sprint(teamKey))
+			fmt.Printf("  Color: %s\n", label.Color)
+			fmt.Printf("  ID:    %s\n", label.ID)
+		}
+	},
+}
+
+// newLabelCreateInput validates the label flags and builds the create input
+func newLabelCreateInput(name, labelColor, description string) (api.LabelCreateInput, error) {
+	name = security.SanitizeInput(name)
+	if err := security.ValidateLabelName(name); err != nil {
+		return api.LabelCreateInput{}, err
+	}
+
+	labelColor = strings.TrimSpace(labelColor)
+	if err := security.ValidateColor(labelColor); err != nil {
+		return api.LabelCreateInput{}, err
+	}
+
+	input := api.LabelCreateInput{
+		Name:  name,
+		Color: strings.ToLower(labelColor),
+	}
+	if description != "" {
+		input.Description = &description
+	}
+
+	return input, nil
+}
+
+func init() {
+	rootCmd.AddCommand(labelCmd)
+	labelCmd.AddCommand(labelListCmd)
+	labelCmd.AddCommand(labelCreateCmd)
+
+	// List command flags
+	labelListCmd.Flags().StringP("team", "t", "", "Team key (required)")
+	_ = labelListCmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
+
+	// Create command flags
+	labelCreateCmd.Flags().StringP("team", "t", "", "Team key (required)")
+	labelCreateCmd.Flags().StringP("name", "n", "", "Label name (required)")
+	labelCreateCmd.Flags().StringP("color", "c", "", "Label color as a hex string (e.g., #ff0000)")
+	labelCreateCmd.Flags().StringP("description", "d", "", "Label description")
+	_ = labelCreateCmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
+}
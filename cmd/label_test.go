@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nicholls-inc/linctl/pkg/security"
+)
+
+func TestNewLabelCreateInput(t *testing.T) {
+	tests := []struct {
+		name        string
+		labelName   string
+		color       string
+		description string
+		wantColor   string
+		wantErr     bool
+	}{
+		{name: "name and color", labelName: "bug", color: "#FF0000", wantColor: "#ff0000"},
+		{name: "name only", labelName: "feature"},
+		{name: "with description", labelName: "bug", description: "Something is broken"},
+		{name: "missing name", labelName: "", wantErr: true},
+		{name: "invalid color", labelName: "bug", color: "red", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := newLabelCreateInput(tt.labelName, tt.color, tt.description)
+			if tt.wantErr {
+				var validationErr security.ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Expected a validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if input.Name != tt.labelName {
+				t.Errorf("Expected name %q, got %q", tt.labelName, input.Name)
+			}
+			if input.Color != tt.wantColor {
+				t.Errorf("Expected color %q, got %q", tt.wantColor, input.Color)
+			}
+			if (input.Description != nil) != (tt.description != "") {
+				t.Errorf("Expected description set only when provided, got %v", input.Description)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/config"
+	"github.com/nicholls-inc/linctl/pkg/oauth"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// orgCmd represents the org command
+var orgCmd = &cobra.Command{
+	Use:     "org",
+	Aliases: []string{"workspace", "organization"},
+	Short:   "Show the Linear workspace your credentials point at",
+	Long: `Show the organization (workspace) of the authenticated user: its name, URL key,
+plan and user count, with the profile and endpoints linctl is using. Use it to
+confirm which workspace a profile belongs to.
+
+Examples:
+  linctl org                      # Show the current workspace
+  linctl org --profile work       # Check which workspace a profile points at
+  linctl workspace --json         # Machine-readable output`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		org, err := client.GetOrganization(commandContext())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get organization: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		renderOrgInfo(newOrgInfo(org, config.ActiveProfile(), oauthBaseURL()), plaintext, jsonOut)
+	},
+}
+
+// orgInfo is the organization together with the connection settings in use
+type orgInfo struct {
+	*api.Organization
+	Plan         string `json:"plan"`
+	URL          string `json:"url"`
+	Profile      string `json:"profile"`
+	APIEndpoint  string `json:"apiEndpoint"`
+	OAuthBaseURL string `json:"oauthBaseUrl"`
+}
+
+// newOrgInfo combines an organization with the profile and OAuth base URL in use
+func newOrgInfo(org *api.Organization, profile, oauthBase string) orgInfo {
+	if profile == "" {
+		profile = config.DefaultProfile
+	}
+	return orgInfo{
+		Organization: org,
+		Plan:         org.Plan(),
+		URL:          workspaceWebURL(org.URLKey),
+		Profile:      profile,
+		APIEndpoint:  api.Endpoint(),
+		OAuthBaseURL: oauthBase,
+	}
+}
+
+// oauthBaseURL returns the OAuth base URL in use (--api-url, LINEAR_BASE_URL or the default)
+func oauthBaseURL() string {
+	return oauth.BaseURL()
+}
+
+// renderOrgInfo prints the organization details
+func renderOrgInfo(info orgInfo, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(info)
+		return
+	}
+
+	if plaintext {
+		fmt.Printf("Name: %s\n", info.Name)
+		fmt.Printf("URL Key: %s\n", info.URLKey)
+		fmt.Printf("URL: %s\n", info.URL)
+		fmt.Printf("Plan: %s\n", info.Plan)
+		fmt.Printf("Users: %d\n", info.UserCount)
+		fmt.Printf("ID: %s\n", info.ID)
+		fmt.Printf("Profile: %s\n", info.Profile)
+		fmt.Printf("API Endpoint: %s\n", info.APIEndpoint)
+		if info.OAuthBaseURL != "" {
+			fmt.Printf("OAuth Base URL: %s\n", info.OAuthBaseURL)
+		}
+		return
+	}
+
+	bold := color.New(color.Bold)
+	fmt.Println()
+	fmt.Printf("%s %s\n", color.New(color.FgCyan, color.Bold).Sprint("🏢 Workspace:"), info.Name)
+	fmt.Println(strings.Repeat("─", 50))
+	fmt.Printf("\n%s %s\n", bold.Sprint("URL:"), color.New(color.FgBlue).Sprint(info.URL))
+	fmt.Printf("%s %s\n", bold.Sprint("URL Key:"), info.URLKey)
+	fmt.Printf("%s %s\n", bold.Sprint("Plan:"), info.Plan)
+	fmt.Printf("%s %d\n", bold.Sprint("Users:"), info.UserCount)
+	fmt.Printf("%s %s\n", bold.Sprint("ID:"), info.ID)
+
+	fmt.Printf("\n%s %s\n", bold.Sprint("Profile:"), info.Profile)
+	fmt.Printf("%s %s\n", bold.Sprint("API Endpoint:"), info.APIEndpoint)
+	if info.OAuthBaseURL != "" {
+		fmt.Printf("%s %s\n", bold.Sprint("OAuth Base URL:"), info.OAuthBaseURL)
+	}
+	fmt.Println()
+}
+
+func init() {
+	rootCmd.AddCommand(orgCmd)
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+func TestNewOrgInfo(t *testing.T) {
+	org := &api.Organization{ID: "org-1", Name: "Acme", URLKey: "acme", UserCount: 42}
+
+	info := newOrgInfo(org, "", "https://api.linear.app")
+
+	if info.Profile != "default" || info.Plan != "free" || info.URL != "https://linear.app/acme" || info.APIEndpoint != api.BaseURL {
+		t.Errorf("Unexpected org info %+v", info)
+	}
+
+	data, err := json.Marshal(newOrgInfo(org, "work", ""))
+	if err != nil {
+		t.Fatalf("Failed to marshal org info: %v", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to decode org info: %v", err)
+	}
+	if decoded["name"] != "Acme" || decoded["urlKey"] != "acme" || decoded["profile"] != "work" || decoded["apiEndpoint"] != api.BaseURL {
+		t.Errorf("Expected organization and connection fields in JSON, got %v", decoded)
+	}
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+// paginationContext returns a context cancelled by Ctrl-C, so long listings stop between pages
+func paginationContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(commandContext(), os.Interrupt, syscall.SIGTERM)
+}
+
+// fetchLimited pages through a list query with api.Paginate, requesting pageSize
+// items at a time until limit items are fetched. A limit of 0 or less fetches
+// every page. more reports whether further items exist beyond those returned.
+// Hitting the page cap prints a warning and returns the items fetched so far.
+func fetchLimited[T any](ctx context.Context, limit, pageSize int, fetch func(first int, after string) ([]T, api.PageInfo, error)) (items []T, more bool, err error) {
+	fetched := 0
+	items, err = api.Paginate(ctx, func(cursor string) ([]T, string, bool, error) {
+		size := pageSize
+		if limit > 0 && limit-fetched < size {
+			size = limit - fetched
+		}
+
+		nodes, pageInfo, err := fetch(size, cursor)
+		if err != nil {
+			return nil, "", false, err
+		}
+		fetched += len(nodes)
+
+		hasNext := pageInfo.HasNextPage
+		if limit > 0 && fetched >= limit {
+			more = hasNext
+			hasNext = false
+		}
+		return nodes, pageInfo.EndCursor, hasNext, nil
+	})
+
+	if errors.Is(err, api.ErrMaxPagesReached) {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		return items, true, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return items, more, nil
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+func TestFetchLimited(t *testing.T) {
+	fetch := func(requests *[]int) func(first int, after string) ([]int, api.PageInfo, error) {
+		return func(first int, after string) ([]int, api.PageInfo, error) {
+			*requests = append(*requests, first)
+			start := 0
+			if after != "" {
+				fmt.Sscanf(after, "cursor-%d", &start)
+			}
+			end := start + first
+			if end > 30 {
+				end = 30
+			}
+			items := make([]int, end-start)
+			return items, api.PageInfo{HasNextPage: end < 30, EndCursor: fmt.Sprintf("cursor-%d", end)}, nil
+		}
+	}
+
+	tests := []struct {
+		name             string
+		limit            int
+		expectedCount    int
+		expectedMore     bool
+		expectedRequests []int
+	}{
+		{name: "all pages", limit: 0, expectedCount: 30, expectedRequests: []int{10, 10, 10}},
+		{name: "limit with more remaining", limit: 15, expectedCount: 15, expectedMore: true, expectedRequests: []int{10, 5}},
+		{name: "limit equal to total", limit: 30, expectedCount: 30, expectedRequests: []int{10, 10, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []int
+			items, more, err := fetchLimited(context.Background(), tt.limit, 10, fetch(&requests))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(items) != tt.expectedCount || more != tt.expectedMore {
+				t.Errorf("Expected %d items (more=%v), got %d (more=%v)", tt.expectedCount, tt.expectedMore, len(items), more)
+			}
+			if fmt.Sprint(requests) != fmt.Sprint(tt.expectedRequests) {
+				t.Errorf("Expected page sizes %v, got %v", tt.expectedRequests, requests)
+			}
+		})
+	}
+}
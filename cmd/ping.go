@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/config"
+	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// pingQuery is the smallest authenticated query Linear answers
+const pingQuery = `query Ping { viewer { id } }`
+
+var pingCmd = &cobra.Command{
+	Use:   "ping",
+	Short: "Check that Linear is reachable with the current credentials",
+	Long: `Make a minimal authenticated request to Linear and report the round-trip time.
+
+Retries and rate limiting apply as for any other command, so the latency covers
+the whole call. Exits 0 when the request succeeds and with the usual exit codes
+otherwise (3 for rejected credentials, 6 when --wait runs out), which makes it
+suitable as an uptime probe that also validates credentials end to end.
+
+Examples:
+  linctl ping             # Print the round-trip time
+  linctl ping --wait 5s   # Fail if Linear has not answered within 5 seconds
+  linctl ping --json      # {"ok":true,"latency_ms":42,"method":"oauth"}`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		wait, _ := cmd.Flags().GetDuration("wait")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			reportPing(pingResult{Method: "none", Error: err.Error()}, plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		clientConfig := api.DefaultEnhancedClientConfig()
+		if prodConfig, err := config.LoadProductionConfig(); err == nil {
+			clientConfig.RetryConfig = prodConfig.Retry
+			clientConfig.RateLimitConfig = prodConfig.RateLimit
+			clientConfig.CircuitBreaker = prodConfig.CircuitBreaker
+		}
+		clientConfig.Logger = logging.NewNoOpLogger()
+		client := api.NewEnhancedClient(authHeader, clientConfig)
+
+		ctx, cancel := context.WithTimeout(commandContext(), wait)
+		defer cancel()
+
+		result := ping(ctx, client, authMethodFromHeader(authHeader))
+		reportPing(result, plaintext, jsonOut)
+		if !result.OK {
+			os.Exit(exitCode(result.err))
+		}
+	},
+}
+
+// pingResult is the outcome of a ping
+type pingResult struct {
+	OK        bool   `json:"ok"`
+	LatencyMS int64  `json:"latency_ms"`
+	Method    string `json:"method"`
+	Error     string `json:"error,omitempty"`
+
+	// err is the failure behind Error, kept to choose the exit code
+	err error
+}
+
+// pingExecutor is the subset of the API client used to ping Linear
+type pingExecutor interface {
+	Execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error
+}
+
+// ping runs the ping query and measures its round-trip time
+func ping(ctx context.Context, client pingExecutor, method string) pingResult {
+	start := time.Now()
+	err := client.Execute(ctx, pingQuery, nil, nil)
+	result := pingResult{
+		OK:        err == nil,
+		LatencyMS: time.Since(start).Milliseconds(),
+		Method:    method,
+	}
+	if err != nil {
+		result.Error = err.Error()
+		result.err = err
+	}
+	return result
+}
+
+// authMethodFromHeader names the authentication method behind an auth header
+func authMethodFromHeader(authHeader string) string {
+	switch {
+	case authHeader == api.MockAuthHeader:
+		return "mock"
+	case strings.HasPrefix(authHeader, "Bearer "):
+		return "oauth"
+	default:
+		return "api_key"
+	}
+}
+
+// reportPing prints a ping result. Failures go to stderr except in JSON mode,
+// where the result object carries the error.
+func reportPing(result pingResult, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(result)
+		return
+	}
+
+	if !result.OK {
+		output.Error(fmt.Sprintf("Ping failed: %s", result.Error), plaintext, jsonOut)
+		return
+	}
+
+	if plaintext {
+		fmt.Printf("ok %dms via %s\n", result.LatencyMS, result.Method)
+		return
+	}
+
+	fmt.Printf("%s Linear responded in %s via %s\n",
+		color.New(color.FgGreen).Sprint("✅"),
+		color.New(color.FgCyan, color.Bold).Sprintf("%dms", result.LatencyMS),
+		result.Method)
+}
+
+func init() {
+	rootCmd.AddCommand(pingCmd)
+	pingCmd.Flags().Duration("wait", 30*time.Second, "Give up if Linear has not answered within this time, including retries")
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+// fakePingExecutor records the query it is asked to run
+type fakePingExecutor struct {
+	query string
+	err   error
+}
+
+func (f *fakePingExecutor) Execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	f.query = query
+	return f.err
+}
+
+func TestPing(t *testing.T) {
+	client := &fakePingExecutor{}
+	result := ping(context.Background(), client, "oauth")
+	if !result.OK || result.Method != "oauth" || result.Error != "" {
+		t.Errorf("Expected successful ping, got %+v", result)
+	}
+	if client.query != pingQuery {
+		t.Errorf("Expected the ping query, got %q", client.query)
+	}
+
+	result = ping(context.Background(), &fakePingExecutor{err: errors.New("connection refused")}, "api_key")
+	if result.OK || result.Error != "connection refused" {
+		t.Errorf("Expected failed ping with error, got %+v", result)
+	}
+
+	unauthorized := &api.APIError{Category: api.CategoryUnauthorized, StatusCode: 401}
+	if result = ping(context.Background(), &fakePingExecutor{err: unauthorized}, "api_key"); exitCode(result.err) != exitAuth {
+		t.Errorf("Expected a rejected key to exit %d, got %d", exitAuth, exitCode(result.err))
+	}
+	timedOut := fmt.Errorf("request failed: %w", context.DeadlineExceeded)
+	if result = ping(context.Background(), &fakePingExecutor{err: timedOut}, "api_key"); exitCode(result.err) != exitRateLimited {
+		t.Errorf("Expected running out of --wait to exit %d, got %d", exitRateLimited, exitCode(result.err))
+	}
+}
+
+func TestAuthMethodFromHeader(t *testing.T) {
+	tests := map[string]string{
+		"Bearer token":     "oauth",
+		"lin_api_key":      "api_key",
+		api.MockAuthHeader: "mock",
+	}
+	for header, expected := range tests {
+		if got := authMethodFromHeader(header); got != expected {
+			t.Errorf("authMethodFromHeader(%q) = %q, expected %q", header, got, expected)
+		}
+	}
+}
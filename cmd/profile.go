@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/config"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// profileCmd represents the profile command
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage credential profiles",
+	Long: `Manage named credential profiles for working across several Linear workspaces.
+
+Each named profile keeps its API key and OAuth token under ~/.linctl/profiles/<name>/.
+Select a profile with --profile or LINCTL_PROFILE; without one the default profile is used.
+
+Examples:
+  linctl --profile work auth login --oauth  # Log in to the work profile
+  linctl profile list                       # Show profiles and the active one
+  LINCTL_PROFILE=work linctl issue list     # Use the work profile`,
+}
+
+var profileListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List profiles",
+	Long:    `List the configured credential profiles and show which one is active.`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		names, err := config.ListProfiles()
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list profiles: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		profiles := collectProfiles(config.ActiveProfile(), names)
+
+		if jsonOut {
+			output.JSON(profiles)
+		} else if plaintext {
+			fmt.Println("Name\tActive\tPath")
+			for _, p := range profiles {
+				fmt.Printf("%s\t%v\t%s\n", p.Name, p.Active, p.Path)
+			}
+		} else {
+			for _, p := range profiles {
+				marker := "  "
+				name := p.Name
+				if p.Active {
+					marker = color.New(color.FgGreen).Sprint("* ")
+					name = color.New(color.FgCyan, color.Bold).Sprint(p.Name)
+				}
+				fmt.Printf("%s%s %s\n", marker, name, color.New(color.FgWhite, color.Faint).Sprint(p.Path))
+			}
+		}
+	},
+}
+
+// profileInfo describes a credential profile
+type profileInfo struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+	Path   string `json:"path"`
+}
+
+// collectProfiles lists the default profile followed by the named profiles.
+// The active profile is included even if nothing has been stored for it yet.
+func collectProfiles(active string, names []string) []profileInfo {
+	if active != "" {
+		found := false
+		for _, name := range names {
+			if name == active {
+				found = true
+				break
+			}
+		}
+		if !found {
+			names = append(names, active)
+		}
+	}
+
+	profiles := []profileInfo{{Name: config.DefaultProfile, Active: active == "", Path: "~"}}
+	for _, name := range names {
+		path, err := config.ProfileDir(name)
+		if err != nil {
+			continue
+		}
+		profiles = append(profiles, profileInfo{Name: name, Active: name == active, Path: path})
+	}
+
+	return profiles
+}
+
+func init() {
+	rootCmd.AddCommand(profileCmd)
+	profileCmd.AddCommand(profileListCmd)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func TestCollectProfiles(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	tests := []struct {
+		name         string
+		active       string
+		names        []string
+		expectNames  []string
+		expectActive string
+	}{
+		{
+			name:         "default active",
+			names:        []string{"personal", "work"},
+			expectNames:  []string{"default", "personal", "work"},
+			expectActive: "default",
+		},
+		{
+			name:         "named profile active",
+			active:       "work",
+			names:        []string{"personal", "work"},
+			expectNames:  []string{"default", "personal", "work"},
+			expectActive: "work",
+		},
+		{
+			name:         "active profile without credentials yet",
+			active:       "new",
+			names:        []string{"work"},
+			expectNames:  []string{"default", "work", "new"},
+			expectActive: "new",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles := collectProfiles(tt.active, tt.names)
+			if len(profiles) != len(tt.expectNames) {
+				t.Fatalf("Expected %d profiles, got %+v", len(tt.expectNames), profiles)
+			}
+			for i, p := range profiles {
+				if p.Name != tt.expectNames[i] {
+					t.Errorf("Expected profile %d to be %s, got %s", i, tt.expectNames[i], p.Name)
+				}
+				if p.Active != (p.Name == tt.expectActive) {
+					t.Errorf("Expected only %s to be active, got %+v", tt.expectActive, p)
+				}
+			}
+		})
+	}
+}
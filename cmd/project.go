@@ -10,6 +10,7 @@ import (
 	"github.com/nicholls-inc/linctl/pkg/api"
 	"github.com/nicholls-inc/linctl/pkg/auth"
 	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/security"
 	"github.com/nicholls-inc/linctl/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -33,6 +34,27 @@ func constructProjectURL(projectID string, originalURL string) string {
 	return originalURL
 }
 
+// projectPageSize is the number of projects requested per page
+const projectPageSize = 50
+
+// projectLister is the subset of the API client used to list projects
+type projectLister interface {
+	GetProjects(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*api.Projects, error)
+}
+
+// fetchAllProjects pages through projects until limit is reached or no pages
+// remain. A limit of 0 or less returns every matching project.
+func fetchAllProjects(ctx context.Context, client projectLister, filter map[string]interface{}, limit int, orderBy string) ([]api.Project, error) {
+	projects, _, err := fetchLimited(ctx, limit, projectPageSize, func(first int, after string) ([]api.Project, api.PageInfo, error) {
+		page, err := client.GetProjects(ctx, filter, first, after, orderBy)
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		return page.Nodes, page.PageInfo, nil
+	})
+	return projects, err
+}
+
 // projectCmd represents the project command
 var projectCmd = &cobra.Command{
 	Use:   "project",
@@ -60,7 +82,7 @@ var projectListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		// Create API client
@@ -76,10 +98,10 @@ var projectListCmd = &cobra.Command{
 		filter := make(map[string]interface{})
 		if teamKey != "" {
 			// Get team ID from key
-			team, err := client.GetTeam(context.Background(), teamKey)
+			team, err := client.GetTeam(commandContext(), teamKey)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			filter["team"] = map[string]interface{}{"id": team.ID}
 		}
@@ -97,7 +119,7 @@ var projectListCmd = &cobra.Command{
 		createdAt, err := utils.ParseTimeExpression(newerThan)
 		if err != nil {
 			output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 		if createdAt != "" {
 			filter["createdAt"] = map[string]interface{}{"gte": createdAt}
@@ -117,16 +139,20 @@ var projectListCmd = &cobra.Command{
 				orderBy = ""
 			default:
 				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitValidation)
 			}
 		}
 
-		// Get projects
-		projects, err := client.GetProjects(context.Background(), filter, limit, "", orderBy)
+		// Get projects, following pagination
+		ctx, stop := paginationContext()
+		defer stop()
+
+		nodes, err := fetchAllProjects(ctx, client, filter, limit, orderBy)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list projects: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
+		projects := &api.Projects{Nodes: nodes}
 
 		// Handle output
 		if jsonOut {
@@ -172,7 +198,7 @@ var projectListCmd = &cobra.Command{
 			return
 		} else {
 			// Table output
-			headers := []string{"Name", "State", "Lead", "Teams", "Created", "Updated", "URL"}
+			headers := []string{"Name", "State", "Progress", "Target", "Lead", "Teams", "Created", "Updated", "URL"}
 			rows := [][]string{}
 
 			for _, project := range projects.Nodes {
@@ -205,9 +231,16 @@ var projectListCmd = &cobra.Command{
 					stateColor = color.New(color.FgRed)
 				}
 
+				targetDate := "-"
+				if project.TargetDate != nil && *project.TargetDate != "" {
+					targetDate = *project.TargetDate
+				}
+
 				rows = append(rows, []string{
 					truncateString(project.Name, 25),
 					stateColor.Sprint(project.State),
+					fmt.Sprintf("%.0f%%", project.Progress*100),
+					targetDate,
 					lead,
 					teams,
 					project.CreatedAt.Format("2006-01-02"),
@@ -245,17 +278,17 @@ var projectGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
 		// Get project details
-		project, err := client.GetProject(context.Background(), projectID)
+		project, err := client.GetProject(commandContext(), projectID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -586,16 +619,219 @@ var projectGetCmd = &cobra.Command{
 	},
 }
 
+// milestonePageSize is the number of milestones requested per page
+const milestonePageSize = 50
+
+// milestoneLister is the subset of the API client used to list project milestones
+type milestoneLister interface {
+	GetProjectMilestones(ctx context.Context, projectID string, first int, after string) (*api.ProjectMilestones, error)
+}
+
+// fetchProjectMilestones pages through a project's milestones until limit is
+// reached or no pages remain. A limit of 0 or less returns every milestone.
+func fetchProjectMilestones(ctx context.Context, client milestoneLister, projectID string, limit int) ([]api.ProjectMilestone, error) {
+	milestones, _, err := fetchLimited(ctx, limit, milestonePageSize, func(first int, after string) ([]api.ProjectMilestone, api.PageInfo, error) {
+		page, err := client.GetProjectMilestones(ctx, projectID, first, after)
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		return page.Nodes, page.PageInfo, nil
+	})
+	return milestones, err
+}
+
+// newMilestoneCreateInput validates milestone flags and builds the create input
+func newMilestoneCreateInput(projectID, name, targetDate, description string) (api.ProjectMilestoneCreateInput, error) {
+	projectID = strings.TrimSpace(projectID)
+	if err := security.ValidateProjectID(projectID); err != nil {
+		return api.ProjectMilestoneCreateInput{}, err
+	}
+
+	name = security.SanitizeInput(name)
+	if err := security.ValidateMilestoneName(name); err != nil {
+		return api.ProjectMilestoneCreateInput{}, err
+	}
+
+	targetDate = strings.TrimSpace(targetDate)
+	if err := security.ValidateDate("target_date", targetDate); err != nil {
+		return api.ProjectMilestoneCreateInput{}, err
+	}
+
+	input := api.ProjectMilestoneCreateInput{
+		ProjectID: projectID,
+		Name:      name,
+	}
+	if targetDate != "" {
+		input.TargetDate = &targetDate
+	}
+	if description != "" {
+		if err := security.ValidateDescription(description); err != nil {
+			return api.ProjectMilestoneCreateInput{}, err
+		}
+		input.Description = &description
+	}
+
+	return input, nil
+}
+
+var projectMilestonesCmd = &cobra.Command{
+	Use:   "milestones PROJECT-ID",
+	Short: "List project milestones",
+	Long:  `List the milestones of a project.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+
+		ctx, stop := paginationContext()
+		defer stop()
+
+		milestones, err := fetchProjectMilestones(ctx, client, args[0], limit)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list milestones: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			if milestones == nil {
+				milestones = []api.ProjectMilestone{}
+			}
+			output.JSON(milestones)
+			return
+		}
+
+		rows := make([][]string, 0, len(milestones))
+		for _, milestone := range milestones {
+			targetDate := "-"
+			if milestone.TargetDate != nil && *milestone.TargetDate != "" {
+				targetDate = *milestone.TargetDate
+			}
+			name := milestone.Name
+			id := milestone.ID
+			if !plaintext {
+				name = color.New(color.FgCyan, color.Bold).Sprint(truncateString(name, 40))
+				id = color.New(color.FgWhite, color.Faint).Sprint(id)
+			}
+			rows = append(rows, []string{name, targetDate, id})
+		}
+
+		output.Table(output.TableData{
+			Headers: []string{"Name", "Target", "ID"},
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		if !plaintext {
+			fmt.Printf("\n%s %d milestones\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				len(milestones))
+		}
+	},
+}
+
+// projectMilestoneCmd groups commands that change project milestones
+var projectMilestoneCmd = &cobra.Command{
+	Use:   "milestone",
+	Short: "Manage project milestones",
+	Long: `Manage the milestones of a project.
+
+Examples:
+  linctl project milestones PROJECT-ID                                              # List milestones
+  linctl project milestone create PROJECT-ID --name "Beta" --target-date 2024-05-01 # Create a milestone
+  linctl issue create --title "Ship beta" --milestone MILESTONE-ID                  # Add an issue to a milestone`,
+}
+
+var projectMilestoneCreateCmd = &cobra.Command{
+	Use:     "create PROJECT-ID",
+	Aliases: []string{"new"},
+	Short:   "Create a milestone",
+	Long:    `Create a milestone within a project.`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		name, _ := cmd.Flags().GetString("name")
+		targetDate, _ := cmd.Flags().GetString("target-date")
+		description, _ := cmd.Flags().GetString("description")
+
+		input, err := newMilestoneCreateInput(args[0], name, targetDate, description)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		client.SetDryRun(dryRun)
+		requireScope(authHeader, "milestone.create", plaintext, jsonOut)
+
+		milestone, err := client.CreateProjectMilestone(commandContext(), input)
+		if printDryRun(err, plaintext, jsonOut) {
+			return
+		}
+		recordAudit("milestone.create", input.ProjectID, "", err)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to create milestone: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(milestone)
+		} else if plaintext {
+			fmt.Printf("Created milestone %s\n", milestone.Name)
+			fmt.Printf("ID: %s\n", milestone.ID)
+			if milestone.TargetDate != nil {
+				fmt.Printf("Target: %s\n", *milestone.TargetDate)
+			}
+		} else {
+			fmt.Printf("%s Created milestone %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(milestone.Name))
+			fmt.Printf("  ID: %s\n", milestone.ID)
+			if milestone.TargetDate != nil {
+				fmt.Printf("  Target: %s\n", *milestone.TargetDate)
+			}
+		}
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(projectCmd)
 	projectCmd.AddCommand(projectListCmd)
 	projectCmd.AddCommand(projectGetCmd)
+	projectCmd.AddCommand(projectMilestonesCmd)
+	projectCmd.AddCommand(projectMilestoneCmd)
+	projectMilestoneCmd.AddCommand(projectMilestoneCreateCmd)
 
 	// List command flags
 	projectListCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	_ = projectListCmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
 	projectListCmd.Flags().StringP("state", "s", "", "Filter by state (planned, started, paused, completed, canceled)")
-	projectListCmd.Flags().IntP("limit", "l", 50, "Maximum number of projects to return")
+	projectListCmd.Flags().IntP("limit", "l", 0, "Maximum number of projects to return (0 for all)")
 	projectListCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled projects")
 	projectListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 	projectListCmd.Flags().StringP("newer-than", "n", "", "Show projects created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+
+	// Milestone command flags
+	projectMilestonesCmd.Flags().IntP("limit", "l", 0, "Maximum number of milestones to return (0 for all)")
+	projectMilestoneCreateCmd.Flags().String("name", "", "Milestone name (required)")
+	projectMilestoneCreateCmd.Flags().String("target-date", "", "Target date (YYYY-MM-DD)")
+	projectMilestoneCreateCmd.Flags().StringP("description", "d", "", "Milestone description")
+	projectMilestoneCreateCmd.Flags().Bool("dry-run", false, "Print the API request without creating the milestone")
+	_ = projectMilestoneCreateCmd.MarkFlagRequired("name")
 }
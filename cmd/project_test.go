@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/security"
+)
+
+type fakeProjectLister struct {
+	projects []api.Project
+	err      error
+	requests []int
+}
+
+func (f *fakeProjectLister) GetProjects(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*api.Projects, error) {
+	f.requests = append(f.requests, first)
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	start := 0
+	if after != "" {
+		fmt.Sscanf(after, "cursor-%d", &start)
+	}
+	end := start + first
+	if end > len(f.projects) {
+		end = len(f.projects)
+	}
+
+	page := &api.Projects{Nodes: f.projects[start:end]}
+	if end < len(f.projects) {
+		page.PageInfo = api.PageInfo{HasNextPage: true, EndCursor: fmt.Sprintf("cursor-%d", end)}
+	}
+	return page, nil
+}
+
+func newFakeProjectLister(count int) *fakeProjectLister {
+	projects := make([]api.Project, count)
+	for i := range projects {
+		projects[i] = api.Project{ID: fmt.Sprintf("project-%d", i), Name: fmt.Sprintf("Project %d", i)}
+	}
+	return &fakeProjectLister{projects: projects}
+}
+
+func TestFetchAllProjects(t *testing.T) {
+	tests := []struct {
+		name             string
+		total            int
+		limit            int
+		expectedCount    int
+		expectedRequests []int
+	}{
+		{
+			name:             "single page",
+			total:            10,
+			limit:            0,
+			expectedCount:    10,
+			expectedRequests: []int{projectPageSize},
+		},
+		{
+			name:             "all pages without limit",
+			total:            120,
+			limit:            0,
+			expectedCount:    120,
+			expectedRequests: []int{projectPageSize, projectPageSize, projectPageSize},
+		},
+		{
+			name:             "limit spanning pages",
+			total:            120,
+			limit:            70,
+			expectedCount:    70,
+			expectedRequests: []int{projectPageSize, 20},
+		},
+		{
+			name:             "limit larger than total",
+			total:            30,
+			limit:            100,
+			expectedCount:    30,
+			expectedRequests: []int{projectPageSize},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeProjectLister(tt.total)
+
+			projects, err := fetchAllProjects(context.Background(), client, nil, tt.limit, "")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(projects) != tt.expectedCount {
+				t.Errorf("Expected %d projects, got %d", tt.expectedCount, len(projects))
+			}
+			if fmt.Sprint(client.requests) != fmt.Sprint(tt.expectedRequests) {
+				t.Errorf("Expected page sizes %v, got %v", tt.expectedRequests, client.requests)
+			}
+			for i, project := range projects {
+				if project.ID != fmt.Sprintf("project-%d", i) {
+					t.Fatalf("Expected projects in order, got %s at %d", project.ID, i)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchAllProjects_Error(t *testing.T) {
+	client := &fakeProjectLister{err: errors.New("boom")}
+
+	if _, err := fetchAllProjects(context.Background(), client, nil, 0, ""); err == nil {
+		t.Fatal("Expected error to be returned")
+	}
+}
+
+// fakeMilestoneLister serves milestones two per page
+type fakeMilestoneLister struct {
+	milestones []api.ProjectMilestone
+	cursors    []string
+}
+
+func (f *fakeMilestoneLister) GetProjectMilestones(ctx context.Context, projectID string, first int, after string) (*api.ProjectMilestones, error) {
+	f.cursors = append(f.cursors, after)
+	start := 0
+	if after != "" {
+		fmt.Sscanf(after, "cursor-%d", &start)
+	}
+	end := start + 2
+	if end > len(f.milestones) {
+		end = len(f.milestones)
+	}
+	return &api.ProjectMilestones{
+		Nodes:    f.milestones[start:end],
+		PageInfo: api.PageInfo{HasNextPage: end < len(f.milestones), EndCursor: fmt.Sprintf("cursor-%d", end)},
+	}, nil
+}
+
+func TestFetchProjectMilestones(t *testing.T) {
+	client := &fakeMilestoneLister{milestones: []api.ProjectMilestone{
+		{ID: "m1", Name: "Alpha"}, {ID: "m2", Name: "Beta"}, {ID: "m3", Name: "GA"},
+	}}
+
+	milestones, err := fetchProjectMilestones(context.Background(), client, "project-1", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(milestones) != 3 || milestones[2].Name != "GA" {
+		t.Errorf("Expected all three milestones, got %+v", milestones)
+	}
+	if len(client.cursors) != 2 || client.cursors[1] != "cursor-2" {
+		t.Errorf("Expected two pages, got cursors %v", client.cursors)
+	}
+}
+
+func TestNewMilestoneCreateInput(t *testing.T) {
+	tests := []struct {
+		name       string
+		milestone  string
+		targetDate string
+		wantErr    bool
+	}{
+		{name: "name and date", milestone: "Beta", targetDate: "2024-05-01"},
+		{name: "name only", milestone: "Beta"},
+		{name: "missing name", milestone: " ", wantErr: true},
+		{name: "invalid date", milestone: "Beta", targetDate: "May 1st", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := newMilestoneCreateInput("project-1", tt.milestone, tt.targetDate, "")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected a validation error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if input.ProjectID != "project-1" || input.Name != tt.milestone {
+				t.Errorf("Unexpected input %+v", input)
+			}
+			if (input.TargetDate != nil) != (tt.targetDate != "") {
+				t.Errorf("Expected target date set only when provided, got %v", input.TargetDate)
+			}
+			if input.Description != nil {
+				t.Error("Expected description to be omitted when unset")
+			}
+		})
+	}
+
+	_, err := newMilestoneCreateInput("project 1; drop", "Beta", "", "")
+	var validationErr security.ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "project_id" {
+		t.Errorf("Expected the project ID to be rejected before any request, got %v", err)
+	}
+}
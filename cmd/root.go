@@ -1,20 +1,61 @@
 package cmd
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/config"
+	"github.com/nicholls-inc/linctl/pkg/httpclient"
+	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/oauth"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+	"github.com/nicholls-inc/linctl/pkg/security"
+	"github.com/nicholls-inc/linctl/pkg/security/audit"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/term"
 )
 
 var (
 	cfgFile   string
+	profile   string
 	plaintext bool
 	jsonOut   bool
-	version   = "0.1.0" // Default version, can be overridden at build time
+	quiet     bool
+	colorMode string
+	mockMode  bool
+	maxWait   time.Duration
+	// tableWidth and noTruncate control how rich tables fit the terminal
+	tableWidth int
+	noTruncate bool
+	apiURL     string
+	// envFile and noEnvFile select the dotenv file loaded before configuration
+	envFile   string
+	noEnvFile bool
+	// tokenFile overrides where the OAuth token is stored
+	tokenFile string
+	// correlationID is attached to log lines and API requests
+	correlationID string
+	// commandTimeout bounds the whole command; 0 is no deadline
+	commandTimeout time.Duration
+	// showExitCodes prints the exit code table instead of running a command
+	showExitCodes bool
+	version       = "0.1.0" // Default version, can be overridden at build time
+)
+
+// Values accepted by --color
+const (
+	colorAuto   = "auto"
+	colorAlways = "always"
+	colorNever  = "never"
 )
 
 // generateHeader creates a nice header box with proper Unicode box drawing
@@ -65,15 +106,35 @@ func generateHeader() string {
 var rootCmd = &cobra.Command{
 	Use:     "linctl",
 	Short:   "A comprehensive Linear CLI tool",
-	Long:    color.New(color.FgCyan).Sprintf("%s\nA comprehensive CLI tool for Linear's API featuring:\n• Issue management (create, list, update, archive)\n• Project tracking and collaboration  \n• Team and user management\n• Comments and attachments\n• Webhook configuration\n• Table/plaintext/JSON output formats\n", generateHeader()),
+	Long:    color.New(color.FgCyan).Sprintf("%s\nA comprehensive CLI tool for Linear's API featuring:\n• Issue management (create, list, update, archive)\n• Project tracking and collaboration  \n• Team and user management\n• Comments and attachments\n• Webhook configuration\n• Table/plaintext/JSON output formats\n", generateHeader()) + exitCodeHelp(),
 	Version: version,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		cancel, err := startCommandContext(cmd.Context(), commandTimeout)
+		cobra.CheckErr(err)
+		cancelCommand = cancel
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		if showExitCodes {
+			printExitCodeMap(viper.GetBool("plaintext"), viper.GetBool("json"))
+			return
+		}
+		_ = cmd.Help()
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 func Execute() {
 	err := rootCmd.Execute()
 	if err != nil {
-		os.Exit(1)
+		// Cobra only returns errors for unknown commands, flags and arguments
+		os.Exit(exitValidation)
+	}
+	cancelCommand()
+
+	// Commands that stop quietly when cancelled, such as --watch, still fail
+	if err := commandTimedOut(); err != nil {
+		output.Error(err.Error(), viper.GetBool("plaintext"), viper.GetBool("json"))
+		os.Exit(exitRateLimited)
 	}
 }
 
@@ -87,16 +148,117 @@ func init() {
 
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.linctl.yaml)")
+	rootCmd.PersistentFlags().StringVar(&profile, "profile", "", "credential profile to use (default is $LINCTL_PROFILE or the default profile)")
 	rootCmd.PersistentFlags().BoolVarP(&plaintext, "plaintext", "p", false, "plaintext output (non-interactive)")
 	rootCmd.PersistentFlags().BoolVarP(&jsonOut, "json", "j", false, "JSON output")
+	rootCmd.PersistentFlags().BoolVar(&quiet, "quiet", false, "suppress all non-error output; only the exit code reports success")
+	rootCmd.PersistentFlags().StringVar(&colorMode, "color", colorAuto, "colorize output: auto, always or never (NO_COLOR disables auto)")
+	rootCmd.PersistentFlags().BoolVar(&mockMode, "mock", false, "serve API responses from $LINCTL_MOCK_DIR instead of Linear (for testing integrations)")
+	rootCmd.PersistentFlags().IntVar(&tableWidth, "width", 0, "fit tables to this many columns (default: the terminal width, or 120 when not a terminal)")
+	rootCmd.PersistentFlags().BoolVar(&noTruncate, "no-truncate", false, "wrap table cells that do not fit instead of truncating them")
+	rootCmd.PersistentFlags().StringVar(&apiURL, "api-url", "", "Linear API base URL for this invocation, overriding LINEAR_BASE_URL (https, or http for localhost)")
+	rootCmd.PersistentFlags().DurationVar(&maxWait, "max-wait", 0, "fail instead of waiting longer than this for the rate limiter, e.g. 5s (default: wait as long as needed)")
+	rootCmd.PersistentFlags().DurationVar(&commandTimeout, "timeout", 0, "abort the whole command if it runs longer than this, e.g. 60s (default: no limit)")
+	rootCmd.PersistentFlags().StringVar(&tokenFile, "token-file", "", "store and load the OAuth token in this file, e.g. a job-scoped temp dir (default is $"+oauth.TokenFileEnvVar+" or the profile's location)")
+	rootCmd.PersistentFlags().StringVar(&correlationID, "correlation-id", "", "ID attached to every log line and sent as X-Correlation-ID, e.g. an orchestrator's trace ID (default is $"+logging.CorrelationIDEnvVar+")")
+	rootCmd.PersistentFlags().StringVar(&envFile, "env-file", "", "load KEY=VALUE settings from this dotenv file; set variables win (default is ./"+config.DefaultEnvFile+" if present)")
+	rootCmd.PersistentFlags().BoolVar(&noEnvFile, "no-env-file", false, "do not load ./"+config.DefaultEnvFile)
+	rootCmd.Flags().BoolVar(&showExitCodes, "exit-code-map", false, "print the exit codes linctl uses and what each means")
+	_ = rootCmd.RegisterFlagCompletionFunc("color", cobra.FixedCompletions([]string{colorAuto, colorAlways, colorNever}, cobra.ShellCompDirectiveNoFileComp))
 
 	// Bind flags to viper
 	_ = viper.BindPFlag("plaintext", rootCmd.PersistentFlags().Lookup("plaintext"))
 	_ = viper.BindPFlag("json", rootCmd.PersistentFlags().Lookup("json"))
+	_ = viper.BindEnv("output_format", "LINCTL_OUTPUT_FORMAT")
+}
+
+// recordAudit appends an audit record for a mutating operation. A failure to
+// write the audit log is printed as a warning and never fails the command.
+func recordAudit(operation, target, actor string, opErr error) {
+	event := audit.Event{
+		Operation: operation,
+		Target:    target,
+		Actor:     actor,
+		Success:   opErr == nil,
+	}
+	if opErr != nil {
+		event.Error = opErr.Error()
+	}
+
+	if err := audit.Record(event); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to write audit log: %v\n", err)
+	}
+}
+
+// requireScope exits before a mutation is sent when the OAuth token was not
+// granted the scope operation needs
+func requireScope(authHeader, operation string, plaintext, jsonOut bool) {
+	if err := auth.CheckScope(authHeader, operation); err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		os.Exit(exitPermission)
+	}
+}
+
+// exitOnValidationErrors reports every failure and exits with exitValidation
+// when there are any. JSON mode prints {"success": false, "validation_errors": [...]}.
+func exitOnValidationErrors(failures []security.ValidationError, plaintext, jsonOut bool) {
+	if len(failures) == 0 {
+		return
+	}
+
+	lines := make([]string, len(failures))
+	for i, failure := range failures {
+		lines[i] = "  - " + failure.Error()
+	}
+	message := fmt.Sprintf("Invalid input (%d problems):\n%s", len(failures), strings.Join(lines, "\n"))
+	if len(failures) == 1 {
+		message = failures[0].Error()
+	}
+
+	output.ErrorDetails(message, map[string]interface{}{
+		"success":           false,
+		"validation_errors": failures,
+	}, plaintext, jsonOut)
+	os.Exit(exitValidation)
+}
+
+// printDryRun prints the request captured by a dry-run mutation and reports
+// whether err was one. JSON mode prints the request payload so it can be diffed.
+func printDryRun(err error, plaintext, jsonOut bool) bool {
+	var dryRun *api.DryRunError
+	if !errors.As(err, &dryRun) {
+		return false
+	}
+
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"dry_run":   true,
+			"operation": dryRun.Operation,
+			"query":     dryRun.Request.Query,
+			"variables": dryRun.Request.Variables,
+		})
+		return true
+	}
+
+	variables, _ := json.MarshalIndent(dryRun.Request.Variables, "", "  ")
+	if plaintext {
+		fmt.Printf("Dry run: would execute %s\n", dryRun.Operation)
+		fmt.Printf("Variables:\n%s\n", variables)
+		return true
+	}
+
+	fmt.Printf("%s Dry run: would execute %s\n",
+		color.New(color.FgYellow).Sprint("⚠️"),
+		color.New(color.FgCyan, color.Bold).Sprint(dryRun.Operation))
+	fmt.Printf("\n%s\n", variables)
+	return true
 }
 
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
+	// Env file settings must be in place before anything reads the environment
+	cobra.CheckErr(applyEnvFile(envFile, noEnvFile))
+
 	if cfgFile != "" {
 		// Use config file from the flag.
 		viper.SetConfigFile(cfgFile)
@@ -113,10 +275,235 @@ func initConfig() {
 
 	viper.AutomaticEnv() // read in environment variables that match
 
+	// Select the credential profile before any command loads credentials
+	if profile != "" {
+		config.SetActiveProfile(profile)
+	}
+	if name := config.ActiveProfile(); name != "" {
+		cobra.CheckErr(config.ValidateProfileName(name))
+	}
+
+	cobra.CheckErr(output.SetQuiet(quiet))
+	cobra.CheckErr(applyTableLayout(tableWidth, noTruncate))
+	applyColorMode()
+	applyMockMode()
+	cobra.CheckErr(applyMaxWait(maxWait))
+	applyRateLimitConfig()
+	cobra.CheckErr(applyAPIURL(apiURL))
+	cobra.CheckErr(applyTokenFile(tokenFile))
+	cobra.CheckErr(applyCorrelationID(correlationID))
+
+	// Reject a malformed LINCTL_EXTRA_HEADERS before any request is built
+	_, err := httpclient.ExtraHeadersFromEnv()
+	cobra.CheckErr(err)
+	_, err = api.OperationTimeoutFromEnv()
+	cobra.CheckErr(err)
+	_, err = security.SecretPolicyFromEnv()
+	cobra.CheckErr(err)
+	_, err = oauth.ClockSkewFromEnv()
+	cobra.CheckErr(err)
+
 	// If a config file is found, read it in.
 	if err := viper.ReadInConfig(); err == nil {
-		if !plaintext && !jsonOut {
+		if !plaintext && !jsonOut && !quiet {
 			fmt.Fprintln(os.Stderr, color.New(color.FgGreen).Sprintf("✅ Using config file: %s", viper.ConfigFileUsed()))
 		}
 	}
+
+	resolveOutputFormat()
+	// Apply again now that the config file may have selected a JSON or CSV format
+	applyColorMode()
+}
+
+// applyEnvFile loads --env-file, or ./.linctl.env when present unless
+// --no-env-file is given. Variables already set in the environment are kept.
+// Loading the implicit file is announced on stderr, since it comes from
+// whatever directory linctl runs in.
+func applyEnvFile(path string, disabled bool) error {
+	if disabled {
+		if path != "" {
+			return fmt.Errorf("--env-file and --no-env-file cannot be used together")
+		}
+		return nil
+	}
+
+	if path != "" {
+		_, err := config.LoadEnvFile(path, true)
+		return err
+	}
+
+	if _, err := os.Stat(config.DefaultEnvFile); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if _, err := config.LoadEnvFile(config.DefaultEnvFile, false); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Note: loaded settings from ./%s (use --no-env-file to skip it)\n", config.DefaultEnvFile)
+	return nil
+}
+
+// applyRateLimitConfig creates the process-wide rate limiter from the
+// LINCTL_RATE_LIMIT_* settings before any command waits on it, so paging and
+// batched requests honour the same limits as everything else
+func applyRateLimitConfig() {
+	if prodConfig, err := config.LoadProductionConfig(); err == nil {
+		ratelimit.SharedWithConfig(prodConfig.RateLimit, nil)
+	}
+}
+
+// applyMaxWait bounds rate limiter waits by --max-wait, so interactive commands
+// fail with a "try again in Xs" error instead of appearing to hang
+func applyMaxWait(d time.Duration) error {
+	if d < 0 {
+		return fmt.Errorf("--max-wait must not be negative")
+	}
+	api.SetMaxRateLimitWait(d)
+	return nil
+}
+
+// applyMockMode enables mock mode. It needs both --mock and LINCTL_MOCK_DIR, so a
+// stray environment variable can never replace Linear with canned responses.
+func applyMockMode() {
+	dir := os.Getenv(api.MockDirEnvVar)
+	if !mockMode {
+		if dir != "" {
+			fmt.Fprintf(os.Stderr, "Warning: %s is set but ignored without --mock\n", api.MockDirEnvVar)
+		}
+		return
+	}
+
+	cobra.CheckErr(validateMockDir(dir))
+	api.EnableMockMode()
+	fmt.Fprintf(os.Stderr, "Mock mode: serving API responses from %s\n", dir)
+}
+
+// validateMockDir checks that --mock has a directory of responses to serve
+func validateMockDir(dir string) error {
+	if dir == "" {
+		return fmt.Errorf("--mock requires %s to name a directory of mock responses", api.MockDirEnvVar)
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("%s: %w", api.MockDirEnvVar, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s %q is not a directory", api.MockDirEnvVar, dir)
+	}
+	return nil
+}
+
+// applyAPIURL points the API and OAuth clients at the --api-url base URL
+func applyAPIURL(url string) error {
+	if url == "" {
+		return nil
+	}
+	if err := security.ValidateAPIURL(url); err != nil {
+		return fmt.Errorf("invalid --api-url: %w", err)
+	}
+	api.SetBaseURL(url)
+	oauth.SetBaseURL(url)
+	return nil
+}
+
+// applyTokenFile stores the OAuth token in the --token-file file, or the
+// LINCTL_TOKEN_FILE one, after checking its directory can be written
+func applyTokenFile(path string) error {
+	if path != "" {
+		oauth.SetTokenFile(path)
+	}
+	if err := oauth.PrepareTokenFile(oauth.TokenFile()); err != nil {
+		return fmt.Errorf("invalid token file: %w", err)
+	}
+	return nil
+}
+
+// applyCorrelationID uses the --correlation-id ID, or the LINCTL_CORRELATION_ID
+// one, for this process after checking it can be sent as a header
+func applyCorrelationID(id string) error {
+	if id != "" {
+		logging.SetCorrelationID(id)
+	}
+	if err := logging.ValidateCorrelationID(logging.CorrelationID()); err != nil {
+		return fmt.Errorf("invalid correlation ID: %w", err)
+	}
+	return nil
+}
+
+// applyTableLayout fits rich tables to width, falling back to the terminal
+// width, or output.DefaultTableWidth when stdout is not a terminal
+func applyTableLayout(width int, wrap bool) error {
+	if width < 0 {
+		return fmt.Errorf("invalid --width %d: must be positive", width)
+	}
+	if width == 0 {
+		width = output.DefaultTableWidth
+		if fd := int(os.Stdout.Fd()); term.IsTerminal(fd) {
+			if termWidth, _, err := term.GetSize(fd); err == nil && termWidth > 0 {
+				width = termWidth
+			}
+		}
+	}
+	output.SetTableWidth(width)
+	output.SetWrap(wrap)
+	return nil
+}
+
+// applyColorMode sets color.NoColor from --color, NO_COLOR and the output format
+func applyColorMode() {
+	enabled, err := colorEnabled(colorMode, os.Getenv("NO_COLOR") != "",
+		term.IsTerminal(int(os.Stdout.Fd())), viper.GetBool("plaintext"), viper.GetBool("json"))
+	cobra.CheckErr(err)
+	color.NoColor = !enabled
+}
+
+// colorEnabled reports whether output should be colorized. JSON and plaintext
+// output are never colorized; otherwise "always" and "never" win, and "auto"
+// colorizes only when stdout is a terminal and NO_COLOR is unset.
+func colorEnabled(mode string, noColorEnv, stdoutTTY, plaintext, jsonOut bool) (bool, error) {
+	switch mode {
+	case colorAuto, colorAlways, colorNever:
+	default:
+		return false, fmt.Errorf("invalid --color value %q: use %s, %s or %s", mode, colorAuto, colorAlways, colorNever)
+	}
+
+	if plaintext || jsonOut {
+		return false, nil
+	}
+
+	switch mode {
+	case colorAlways:
+		return true, nil
+	case colorNever:
+		return false, nil
+	}
+	return stdoutTTY && !noColorEnv, nil
+}
+
+// resolveOutputFormat determines the effective output format once for the whole command.
+// Explicit --json/--plaintext flags win over LINCTL_OUTPUT_FORMAT or the output_format config key.
+func resolveOutputFormat() {
+	flags := rootCmd.PersistentFlags()
+	if flags.Changed("json") {
+		output.SetFormat(output.FormatJSON)
+		return
+	}
+	if flags.Changed("plaintext") {
+		output.SetFormat(output.FormatText)
+		return
+	}
+
+	format, err := output.ParseFormat(viper.GetString("output_format"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v, using text\n", err)
+		format = output.FormatText
+	}
+
+	switch format {
+	case output.FormatJSON, output.FormatYAML:
+		viper.Set("json", true)
+	case output.FormatCSV:
+		viper.Set("plaintext", true)
+	}
+
+	output.SetFormat(format)
 }
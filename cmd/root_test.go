@@ -0,0 +1,412 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/config"
+	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/oauth"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+	"github.com/spf13/viper"
+)
+
+// linctlProcessEnvVar marks the child process started by runLinctl
+const linctlProcessEnvVar = "LINCTL_TEST_PROCESS"
+
+// TestLinctlProcess is not a real test: runLinctl runs it in a child process
+// to execute the CLI with the arguments after "--"
+func TestLinctlProcess(t *testing.T) {
+	if os.Getenv(linctlProcessEnvVar) != "1" {
+		return
+	}
+
+	args := os.Args
+	for i, arg := range args {
+		if arg == "--" {
+			args = args[i+1:]
+			break
+		}
+	}
+	rootCmd.SetArgs(args)
+	Execute()
+	os.Exit(0)
+}
+
+// runLinctl runs linctl with args in a child process of the test binary, with
+// HOME in a temp directory and env added to the environment. Commands run end
+// to end, through cobra's flag parsing and os.Exit, and the child's output and
+// exit code are returned.
+func runLinctl(t *testing.T, env []string, args ...string) (stdout, stderr string, code int) {
+	t.Helper()
+
+	child := exec.Command(os.Args[0], append([]string{"-test.run=^TestLinctlProcess$", "--"}, args...)...)
+	child.Env = append(os.Environ(), linctlProcessEnvVar+"=1", "HOME="+t.TempDir())
+	child.Env = append(child.Env, env...)
+	var out, errOut bytes.Buffer
+	child.Stdout = &out
+	child.Stderr = &errOut
+
+	err := child.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return out.String(), errOut.String(), exitErr.ExitCode()
+	}
+	if err != nil {
+		t.Fatalf("Failed to run linctl: %v", err)
+	}
+	return out.String(), errOut.String(), 0
+}
+
+// resetOutputFormat clears global output state touched by resolveOutputFormat
+func resetOutputFormat(t *testing.T) {
+	t.Helper()
+
+	reset := func() {
+		for _, name := range []string{"json", "plaintext"} {
+			flag := rootCmd.PersistentFlags().Lookup(name)
+			_ = flag.Value.Set("false")
+			flag.Changed = false
+			// A nil override lets viper fall back to the bound flag
+			viper.Set(name, nil)
+		}
+		output.SetFormat(output.FormatText)
+	}
+
+	reset()
+	t.Cleanup(reset)
+}
+
+func TestResolveOutputFormat_EnvDefault(t *testing.T) {
+	tests := []struct {
+		name          string
+		env           string
+		expected      output.Format
+		wantJSON      bool
+		wantPlaintext bool
+	}{
+		{"json", "json", output.FormatJSON, true, false},
+		{"yaml", "yaml", output.FormatYAML, true, false},
+		{"csv", "csv", output.FormatCSV, false, true},
+		{"text", "text", output.FormatText, false, false},
+		{"invalid falls back to text", "xml", output.FormatText, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetOutputFormat(t)
+			t.Setenv("LINCTL_OUTPUT_FORMAT", tt.env)
+
+			resolveOutputFormat()
+
+			if output.CurrentFormat() != tt.expected {
+				t.Errorf("Expected format %s, got %s", tt.expected, output.CurrentFormat())
+			}
+			if viper.GetBool("json") != tt.wantJSON {
+				t.Errorf("Expected json=%v, got %v", tt.wantJSON, viper.GetBool("json"))
+			}
+			if viper.GetBool("plaintext") != tt.wantPlaintext {
+				t.Errorf("Expected plaintext=%v, got %v", tt.wantPlaintext, viper.GetBool("plaintext"))
+			}
+		})
+	}
+}
+
+func TestResolveOutputFormat_FlagOverridesEnv(t *testing.T) {
+	resetOutputFormat(t)
+	t.Setenv("LINCTL_OUTPUT_FORMAT", "json")
+
+	if err := rootCmd.PersistentFlags().Set("plaintext", "true"); err != nil {
+		t.Fatalf("Failed to set plaintext flag: %v", err)
+	}
+
+	resolveOutputFormat()
+
+	if output.CurrentFormat() != output.FormatText {
+		t.Errorf("Expected explicit flag to win, got format %s", output.CurrentFormat())
+	}
+	if viper.GetBool("json") {
+		t.Error("Expected json to stay disabled when --plaintext is passed")
+	}
+	if !viper.GetBool("plaintext") {
+		t.Error("Expected plaintext to be enabled by the flag")
+	}
+}
+
+func TestResolveOutputFormat_NoEnv(t *testing.T) {
+	resetOutputFormat(t)
+	// t.Setenv restores any value from the environment after the test
+	t.Setenv("LINCTL_OUTPUT_FORMAT", "")
+	os.Unsetenv("LINCTL_OUTPUT_FORMAT")
+
+	resolveOutputFormat()
+
+	if output.CurrentFormat() != output.FormatText {
+		t.Errorf("Expected default text format, got %s", output.CurrentFormat())
+	}
+	if viper.GetBool("json") || viper.GetBool("plaintext") {
+		t.Error("Expected no format flags to be enabled by default")
+	}
+}
+
+func TestColorEnabled(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      string
+		noColor   bool
+		tty       bool
+		plaintext bool
+		jsonOut   bool
+		expected  bool
+	}{
+		{"auto on terminal", colorAuto, false, true, false, false, true},
+		{"auto when piped", colorAuto, false, false, false, false, false},
+		{"auto with NO_COLOR", colorAuto, true, true, false, false, false},
+		{"always when piped", colorAlways, false, false, false, false, true},
+		{"always overrides NO_COLOR", colorAlways, true, false, false, false, true},
+		{"never on terminal", colorNever, false, true, false, false, false},
+		{"json implies never", colorAlways, false, true, false, true, false},
+		{"plaintext implies never", colorAlways, false, true, true, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enabled, err := colorEnabled(tt.mode, tt.noColor, tt.tty, tt.plaintext, tt.jsonOut)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if enabled != tt.expected {
+				t.Errorf("Expected enabled=%v, got %v", tt.expected, enabled)
+			}
+		})
+	}
+}
+
+func TestColorEnabled_InvalidMode(t *testing.T) {
+	if _, err := colorEnabled("sometimes", false, true, false, false); err == nil {
+		t.Error("Expected an error for an invalid --color value")
+	}
+	// Invalid values are rejected even when JSON output would disable color anyway
+	if _, err := colorEnabled("sometimes", false, true, false, true); err == nil {
+		t.Error("Expected an error for an invalid --color value with --json")
+	}
+}
+
+func TestValidateMockDir(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "Issues.json")
+	if err := os.WriteFile(file, []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := validateMockDir(dir); err != nil {
+		t.Errorf("Expected a directory to be accepted, got %v", err)
+	}
+	for name, value := range map[string]string{
+		"unset":   "",
+		"missing": filepath.Join(dir, "missing"),
+		"file":    file,
+	} {
+		if err := validateMockDir(value); err == nil {
+			t.Errorf("Expected %s mock dir to be rejected", name)
+		}
+	}
+}
+
+func TestApplyMaxWait(t *testing.T) {
+	defer api.SetMaxRateLimitWait(0)
+
+	if err := applyMaxWait(5 * time.Second); err != nil {
+		t.Errorf("Expected a positive --max-wait to be accepted, got %v", err)
+	}
+	if err := applyMaxWait(0); err != nil {
+		t.Errorf("Expected 0 to mean no maximum, got %v", err)
+	}
+	if err := applyMaxWait(-time.Second); err == nil {
+		t.Error("Expected a negative --max-wait to be rejected")
+	}
+}
+
+func TestApplyEnvFile(t *testing.T) {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	if err := os.WriteFile(config.DefaultEnvFile, []byte("LINCTL_TEST_DEFAULT_ENV=default\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("LINCTL_TEST_DEFAULT_ENV", "")
+	os.Unsetenv("LINCTL_TEST_DEFAULT_ENV")
+
+	if err := applyEnvFile("", true); err != nil || os.Getenv("LINCTL_TEST_DEFAULT_ENV") != "" {
+		t.Errorf("Expected --no-env-file to skip ./%s, got %v", config.DefaultEnvFile, err)
+	}
+	// Loading the implicit file is announced on stderr
+	stderr := os.Stderr
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Stderr = w
+	err = applyEnvFile("", false)
+	w.Close()
+	os.Stderr = stderr
+	notice, _ := io.ReadAll(r)
+	if err != nil || os.Getenv("LINCTL_TEST_DEFAULT_ENV") != "default" {
+		t.Errorf("Expected ./%s to be loaded, got %v", config.DefaultEnvFile, err)
+	}
+	if !strings.Contains(string(notice), "loaded settings from ./"+config.DefaultEnvFile) {
+		t.Errorf("Expected a notice that ./%s was loaded, got %q", config.DefaultEnvFile, notice)
+	}
+
+	// Endpoint and transport settings need --env-file
+	if err := os.WriteFile(config.DefaultEnvFile, []byte("LINEAR_BASE_URL=https://attacker.example\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyEnvFile("", false); err == nil || !strings.Contains(err.Error(), "LINEAR_BASE_URL") {
+		t.Errorf("Expected LINEAR_BASE_URL to be refused from ./%s, got %v", config.DefaultEnvFile, err)
+	}
+	if err := applyEnvFile("missing.env", false); err == nil {
+		t.Error("Expected a missing --env-file to fail")
+	}
+	if err := applyEnvFile("other.env", true); err == nil {
+		t.Error("Expected --env-file with --no-env-file to fail")
+	}
+}
+
+func TestApplyTokenFile(t *testing.T) {
+	defer oauth.SetTokenFile("")
+	t.Setenv(oauth.TokenFileEnvVar, "")
+
+	if err := applyTokenFile(""); err != nil || oauth.TokenFile() != "" {
+		t.Errorf("Expected no token file by default, got %q (err %v)", oauth.TokenFile(), err)
+	}
+
+	path := filepath.Join(t.TempDir(), "job", "token.json")
+	if err := applyTokenFile(path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if oauth.TokenFile() != path {
+		t.Errorf("Expected --token-file to set the token file, got %q", oauth.TokenFile())
+	}
+	if _, err := os.Stat(filepath.Dir(path)); err != nil {
+		t.Errorf("Expected the token file directory to be created: %v", err)
+	}
+
+	if err := applyTokenFile(t.TempDir()); err == nil {
+		t.Error("Expected a directory to be rejected as the token file")
+	}
+}
+
+func TestApplyCorrelationID(t *testing.T) {
+	defer logging.SetCorrelationID("")
+	t.Setenv(logging.CorrelationIDEnvVar, "from-env")
+
+	if err := applyCorrelationID(""); err != nil || logging.CorrelationID() != "from-env" {
+		t.Errorf("Expected the %s ID by default, got %q (err %v)", logging.CorrelationIDEnvVar, logging.CorrelationID(), err)
+	}
+	if err := applyCorrelationID("from-flag"); err != nil || logging.CorrelationID() != "from-flag" {
+		t.Errorf("Expected --correlation-id to win, got %q (err %v)", logging.CorrelationID(), err)
+	}
+
+	logging.SetCorrelationID("")
+	t.Setenv(logging.CorrelationIDEnvVar, "bad\nid")
+	if err := applyCorrelationID(""); err == nil {
+		t.Error("Expected an ID that cannot be sent as a header to be rejected")
+	}
+}
+
+func TestApplyRateLimitConfig(t *testing.T) {
+	ratelimit.ResetShared()
+	t.Cleanup(ratelimit.ResetShared)
+	t.Setenv("LINCTL_RATE_LIMIT_RPS", "2")
+	t.Setenv("LINCTL_RATE_LIMIT_BURST", "3")
+
+	applyRateLimitConfig()
+	status := ratelimit.Shared().GetStatus()
+	if status["requests_per_second"] != 2.0 || status["burst"] != 3 {
+		t.Errorf("Expected the shared limiter to use LINCTL_RATE_LIMIT_*, got %v", status)
+	}
+}
+
+func TestApplyTableLayout(t *testing.T) {
+	t.Cleanup(func() {
+		output.SetTableWidth(0)
+		output.SetWrap(false)
+	})
+
+	if err := applyTableLayout(-1, false); err == nil {
+		t.Error("Expected a negative --width to be rejected")
+	}
+	if err := applyTableLayout(80, true); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	// Tests do not run on a terminal, so the default width applies
+	if err := applyTableLayout(0, false); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestStartCommandContext(t *testing.T) {
+	defer func() { commandCtx = context.Background() }()
+
+	cancel, err := startCommandContext(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("Expected no timeout to be accepted, got %v", err)
+	}
+	cancel()
+	if _, ok := commandContext().Deadline(); ok {
+		t.Error("Expected no deadline without --timeout")
+	}
+
+	if _, err := startCommandContext(context.Background(), -time.Second); err == nil {
+		t.Error("Expected a negative --timeout to be rejected")
+	}
+
+	cancel, err = startCommandContext(context.Background(), time.Millisecond)
+	if err != nil {
+		t.Fatalf("Expected a positive --timeout to be accepted, got %v", err)
+	}
+	defer cancel()
+	<-commandContext().Done()
+
+	err = commandTimedOut()
+	if err == nil {
+		t.Fatal("Expected the command to report a timeout")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "--timeout 1ms") {
+		t.Errorf("Unexpected timeout error %v", err)
+	}
+	if code := exitCode(err); code != exitRateLimited {
+		t.Errorf("Expected a timeout to exit with %d, got %d", exitRateLimited, code)
+	}
+}
+
+func TestCommandTimedOut_Cancelled(t *testing.T) {
+	defer func() { commandCtx = context.Background() }()
+
+	cancel, err := startCommandContext(context.Background(), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	if err := commandTimedOut(); err != nil {
+		t.Errorf("Expected a command that finished in time not to report a timeout, got %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+// allowedStateTransitions maps a workflow state type to the types an issue may
+// move to from it. Linear does not expose per-workspace transition rules, so
+// this is the policy issue update checks against: nothing moves back into
+// triage, and closed issues are reopened before being closed the other way.
+// Moves between states of the same type are always allowed.
+var allowedStateTransitions = map[string][]string{
+	"triage":    {"backlog", "unstarted", "started", "completed", "canceled"},
+	"backlog":   {"unstarted", "started", "completed", "canceled"},
+	"unstarted": {"backlog", "started", "completed", "canceled"},
+	"started":   {"backlog", "unstarted", "completed", "canceled"},
+	"completed": {"backlog", "unstarted", "started"},
+	"canceled":  {"backlog", "unstarted", "started"},
+}
+
+// resolveWorkflowState maps a state name to one of the team's states. Names
+// match case-insensitively, exactly first and then by unique prefix, so
+// "progress" does not match but "in prog" matches "In Progress".
+func resolveWorkflowState(states []api.WorkflowState, name string) (*api.WorkflowState, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("state name cannot be empty")
+	}
+
+	var exact, prefix []*api.WorkflowState
+	for i := range states {
+		switch {
+		case strings.EqualFold(states[i].Name, name):
+			exact = append(exact, &states[i])
+		case len(states[i].Name) > len(name) && strings.EqualFold(states[i].Name[:len(name)], name):
+			prefix = append(prefix, &states[i])
+		}
+	}
+
+	candidates := exact
+	if len(candidates) == 0 {
+		candidates = prefix
+	}
+
+	switch len(candidates) {
+	case 1:
+		return candidates[0], nil
+	case 0:
+		names := make([]string, len(states))
+		for i, state := range states {
+			names[i] = state.Name
+		}
+		return nil, fmt.Errorf("state '%s' not found. Available states: %s", name, strings.Join(names, ", "))
+	default:
+		names := make([]string, len(candidates))
+		for i, state := range candidates {
+			names[i] = fmt.Sprintf("%s (%s)", state.Name, state.Type)
+		}
+		return nil, fmt.Errorf("state '%s' is ambiguous, it matches: %s", name, strings.Join(names, ", "))
+	}
+}
+
+// checkStateTransition returns an error describing why moving an issue from
+// current to target is not an allowed transition, or nil if it is
+func checkStateTransition(current *api.State, target *api.WorkflowState) error {
+	if current == nil || current.Type == target.Type {
+		return nil
+	}
+
+	allowed, known := allowedStateTransitions[current.Type]
+	if !known {
+		return nil
+	}
+	for _, stateType := range allowed {
+		if stateType == target.Type {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("moving from %s (%s) to %s (%s) is not an allowed transition; allowed from %s: %s",
+		current.Name, current.Type, target.Name, target.Type, current.Type, strings.Join(allowed, ", "))
+}
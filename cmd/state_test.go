@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+func TestResolveWorkflowState(t *testing.T) {
+	states := []api.WorkflowState{
+		{ID: "s-todo", Name: "Todo", Type: "unstarted"},
+		{ID: "s-progress", Name: "In Progress", Type: "started"},
+		{ID: "s-review", Name: "In Review", Type: "started"},
+		{ID: "s-done", Name: "Done", Type: "completed"},
+		{ID: "s-done-dup", Name: "Done Elsewhere", Type: "completed"},
+	}
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr string
+	}{
+		{name: "done", want: "s-done"},
+		{name: "  IN PROGRESS ", want: "s-progress"},
+		{name: "in r", want: "s-review"},
+		{name: "in", wantErr: "ambiguous, it matches: In Progress (started), In Review (started)"},
+		{name: "progress", wantErr: "Available states: Todo, In Progress"},
+		{name: "", wantErr: "cannot be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, err := resolveWorkflowState(states, tt.name)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if state.ID != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, state.ID)
+			}
+		})
+	}
+}
+
+func TestCheckStateTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		allowed  bool
+	}{
+		{from: "unstarted", to: "started", allowed: true},
+		{from: "started", to: "started", allowed: true},
+		{from: "completed", to: "started", allowed: true},
+		{from: "triage", to: "backlog", allowed: true},
+		{from: "started", to: "triage", allowed: false},
+		{from: "completed", to: "canceled", allowed: false},
+		{from: "canceled", to: "completed", allowed: false},
+		{from: "custom", to: "triage", allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			current := &api.State{Name: "From", Type: tt.from}
+			target := &api.WorkflowState{Name: "To", Type: tt.to}
+			err := checkStateTransition(current, target)
+			if tt.allowed && err != nil {
+				t.Errorf("Expected transition to be allowed, got %v", err)
+			}
+			if !tt.allowed && err == nil {
+				t.Error("Expected transition to be rejected")
+			}
+		})
+	}
+
+	if err := checkStateTransition(nil, &api.WorkflowState{Type: "triage"}); err != nil {
+		t.Errorf("Expected an issue without a state to move anywhere, got %v", err)
+	}
+}
@@ -0,0 +1,266 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/config"
+	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// statusProbeTimeout bounds the reachability check
+const statusProbeTimeout = 10 * time.Second
+
+// rootStatusCmd represents the top-level status command
+var rootStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show an operational overview of linctl",
+	Long: `Show a concise overview of whether linctl is ready to use.
+
+Reports:
+- Authentication method and identity
+- Linear API reachability and latency
+- Remaining rate limit quota
+- Configured retry, rate limit and circuit breaker settings
+
+Exits non-zero when not authenticated or when the API is unreachable.
+For detailed authentication guidance use 'linctl auth status'.
+
+Examples:
+  linctl status          # Human-readable summary
+  linctl status --json   # Structured output for agents`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		report := collectStatus(commandContext(), defaultStatusChecks())
+
+		if jsonOut {
+			output.JSON(report)
+		} else {
+			printStatusReport(report, plaintext)
+		}
+
+		if !report.ok() {
+			os.Exit(1)
+		}
+	},
+}
+
+// statusChecks are the checks aggregated by the status command
+type statusChecks struct {
+	authStatus func() (*auth.AuthStatus, error)
+	probe      func(ctx context.Context) (*apiProbe, error)
+	config     func() (*config.ProductionConfig, error)
+}
+
+// apiProbe is the result of a reachability check against the Linear API
+type apiProbe struct {
+	Latency   time.Duration
+	RateLimit map[string]interface{}
+}
+
+// statusReport is the aggregated output of the status command
+type statusReport struct {
+	Status    string                 `json:"status"`
+	Auth      statusAuth             `json:"auth"`
+	API       statusAPI              `json:"api"`
+	RateLimit map[string]interface{} `json:"rate_limit,omitempty"`
+	Config    map[string]interface{} `json:"config,omitempty"`
+	Timestamp string                 `json:"timestamp"`
+}
+
+// statusAuth summarises authentication state
+type statusAuth struct {
+	Authenticated bool       `json:"authenticated"`
+	Method        string     `json:"method"`
+	User          *auth.User `json:"user,omitempty"`
+	TokenExpiry   *string    `json:"token_expires_at,omitempty"`
+	Error         string     `json:"error,omitempty"`
+}
+
+// statusAPI summarises API reachability
+type statusAPI struct {
+	Reachable bool   `json:"reachable"`
+	Checked   bool   `json:"checked"`
+	LatencyMS int64  `json:"latency_ms,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// defaultStatusChecks returns the checks used by the status command
+func defaultStatusChecks() statusChecks {
+	return statusChecks{
+		authStatus: auth.GetAuthStatus,
+		probe:      probeAPI,
+		config:     config.LoadProductionConfig,
+	}
+}
+
+// collectStatus runs the status checks and aggregates them into a report.
+// The API is only probed once authentication has succeeded.
+func collectStatus(ctx context.Context, checks statusChecks) *statusReport {
+	report := &statusReport{
+		Status:    "ok",
+		Auth:      statusAuth{Method: "none"},
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	authStatus, err := checks.authStatus()
+	if err != nil {
+		report.Auth.Error = err.Error()
+	} else {
+		report.Auth.Authenticated = authStatus.Authenticated
+		report.Auth.Method = authStatus.Method
+		report.Auth.User = authStatus.User
+		report.Auth.TokenExpiry = authStatus.TokenExpiry
+	}
+
+	if report.Auth.Authenticated {
+		report.API.Checked = true
+		probe, err := checks.probe(ctx)
+		if err != nil {
+			report.API.Error = err.Error()
+		} else {
+			report.API.Reachable = true
+			report.API.LatencyMS = probe.Latency.Milliseconds()
+			report.RateLimit = probe.RateLimit
+		}
+	}
+
+	if prodConfig, err := checks.config(); err == nil {
+		report.Config = map[string]interface{}{
+			"retry": map[string]interface{}{
+				"max_attempts":  prodConfig.Retry.MaxAttempts,
+				"initial_delay": prodConfig.Retry.InitialDelay.String(),
+				"max_delay":     prodConfig.Retry.MaxDelay.String(),
+			},
+			"rate_limit": map[string]interface{}{
+				"enabled":             prodConfig.RateLimit.Enabled,
+				"requests_per_second": prodConfig.RateLimit.RequestsPerSecond,
+				"burst":               prodConfig.RateLimit.Burst,
+				"adaptive_mode":       prodConfig.RateLimit.AdaptiveMode,
+			},
+			"circuit_breaker": map[string]interface{}{
+				"enabled":   prodConfig.CircuitBreaker.Enabled,
+				"threshold": prodConfig.CircuitBreaker.Threshold,
+			},
+		}
+	}
+
+	switch {
+	case !report.Auth.Authenticated:
+		report.Status = "unauthenticated"
+	case !report.API.Reachable:
+		report.Status = "unreachable"
+	}
+
+	return report
+}
+
+// ok reports whether linctl is ready to use
+func (r *statusReport) ok() bool {
+	return r.Status == "ok"
+}
+
+// probeAPI makes a minimal request through the enhanced client to check
+// reachability and capture the current rate limit quota
+func probeAPI(ctx context.Context) (*apiProbe, error) {
+	authHeader, err := auth.GetAuthHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	clientConfig := api.DefaultEnhancedClientConfig()
+	if prodConfig, err := config.LoadProductionConfig(); err == nil {
+		clientConfig.RetryConfig = prodConfig.Retry
+		clientConfig.RateLimitConfig = prodConfig.RateLimit
+	}
+	// A single attempt keeps the check quick when the API is down
+	clientConfig.RetryConfig.MaxAttempts = 1
+	clientConfig.Logger = logging.NewNoOpLogger()
+	client := api.NewEnhancedClient(authHeader, clientConfig)
+
+	ctx, cancel := context.WithTimeout(ctx, statusProbeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := client.Execute(ctx, `query StatusProbe { viewer { id } }`, nil, nil); err != nil {
+		return nil, err
+	}
+
+	return &apiProbe{
+		Latency:   time.Since(start),
+		RateLimit: client.GetRateLimitStatus(),
+	}, nil
+}
+
+// printStatusReport prints the report in plaintext or rich format
+func printStatusReport(report *statusReport, plaintext bool) {
+	identity := "-"
+	if report.Auth.User != nil {
+		identity = fmt.Sprintf("%s (%s)", report.Auth.User.Name, report.Auth.User.Email)
+	}
+
+	apiLine := "not checked"
+	if report.API.Checked {
+		if report.API.Reachable {
+			apiLine = fmt.Sprintf("reachable (%dms)", report.API.LatencyMS)
+		} else {
+			apiLine = fmt.Sprintf("unreachable: %s", report.API.Error)
+		}
+	}
+
+	quota := "unknown"
+	if remaining, ok := report.RateLimit["linear_remaining"]; ok {
+		quota = fmt.Sprintf("%v of %v remaining", remaining, report.RateLimit["linear_limit"])
+	}
+
+	settings := "-"
+	if retry, ok := report.Config["retry"].(map[string]interface{}); ok {
+		rateLimit := report.Config["rate_limit"].(map[string]interface{})
+		settings = fmt.Sprintf("%v retries, %v req/s (burst %v)",
+			retry["max_attempts"], rateLimit["requests_per_second"], rateLimit["burst"])
+		if breaker, ok := report.Config["circuit_breaker"].(map[string]interface{}); ok && breaker["enabled"] == true {
+			settings += fmt.Sprintf(", circuit breaker after %v failures", breaker["threshold"])
+		}
+	}
+
+	if plaintext {
+		fmt.Printf("Status: %s\n", report.Status)
+		fmt.Printf("Auth: %s via %s\n", identity, report.Auth.Method)
+		fmt.Printf("API: %s\n", apiLine)
+		fmt.Printf("Rate limit: %s\n", quota)
+		fmt.Printf("Settings: %s\n", settings)
+		return
+	}
+
+	if report.ok() {
+		fmt.Println(color.New(color.FgGreen, color.Bold).Sprint("✅ Ready"))
+	} else {
+		fmt.Println(color.New(color.FgRed, color.Bold).Sprintf("❌ %s", capitalize(report.Status)))
+	}
+	fmt.Println()
+
+	label := color.New(color.Bold).SprintFunc()
+	if report.Auth.Authenticated {
+		fmt.Printf("%s   %s via %s\n", label("Auth:"), color.New(color.FgCyan).Sprint(identity), report.Auth.Method)
+	} else {
+		fmt.Printf("%s   %s\n", label("Auth:"), color.New(color.FgRed).Sprint("not authenticated (run 'linctl auth')"))
+	}
+	fmt.Printf("%s    %s\n", label("API:"), apiLine)
+	fmt.Printf("%s  %s\n", label("Quota:"), quota)
+	fmt.Printf("%s %s\n", label("Config:"), settings)
+}
+
+func init() {
+	rootCmd.AddCommand(rootStatusCmd)
+}
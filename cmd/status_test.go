@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/config"
+)
+
+func testStatusChecks(authenticated bool, probeErr error) (statusChecks, *bool) {
+	probed := false
+	checks := statusChecks{
+		authStatus: func() (*auth.AuthStatus, error) {
+			if !authenticated {
+				return &auth.AuthStatus{Method: "none"}, nil
+			}
+			return &auth.AuthStatus{
+				Authenticated: true,
+				Method:        "api_key",
+				User:          &auth.User{Name: "Jane", Email: "jane@example.com"},
+			}, nil
+		},
+		probe: func(ctx context.Context) (*apiProbe, error) {
+			probed = true
+			if probeErr != nil {
+				return nil, probeErr
+			}
+			return &apiProbe{
+				Latency:   42 * time.Millisecond,
+				RateLimit: map[string]interface{}{"linear_remaining": 1400, "linear_limit": 1500},
+			}, nil
+		},
+		config: config.LoadProductionConfig,
+	}
+	return checks, &probed
+}
+
+func TestCollectStatus_Authenticated(t *testing.T) {
+	checks, _ := testStatusChecks(true, nil)
+	report := collectStatus(context.Background(), checks)
+
+	if !report.ok() || report.Status != "ok" {
+		t.Errorf("Expected ok status, got %s", report.Status)
+	}
+	if report.Auth.Method != "api_key" || report.Auth.User == nil || report.Auth.User.Email != "jane@example.com" {
+		t.Errorf("Expected auth identity in report, got %+v", report.Auth)
+	}
+	if !report.API.Reachable || report.API.LatencyMS != 42 {
+		t.Errorf("Expected reachable API with latency, got %+v", report.API)
+	}
+	if report.RateLimit["linear_remaining"] != 1400 {
+		t.Errorf("Expected rate limit quota in report, got %v", report.RateLimit)
+	}
+	for _, section := range []string{"retry", "rate_limit", "circuit_breaker"} {
+		if _, ok := report.Config[section]; !ok {
+			t.Errorf("Expected config section %s", section)
+		}
+	}
+	if report.Timestamp == "" {
+		t.Error("Expected timestamp")
+	}
+}
+
+func TestCollectStatus_Unauthenticated(t *testing.T) {
+	checks, probed := testStatusChecks(false, nil)
+	report := collectStatus(context.Background(), checks)
+
+	if report.ok() {
+		t.Error("Expected status to fail when unauthenticated")
+	}
+	if report.Status != "unauthenticated" {
+		t.Errorf("Expected unauthenticated status, got %s", report.Status)
+	}
+	if *probed || report.API.Checked {
+		t.Error("Expected API not to be probed without authentication")
+	}
+	if report.Config == nil {
+		t.Error("Expected config to be reported even when unauthenticated")
+	}
+}
+
+func TestCollectStatus_Unreachable(t *testing.T) {
+	checks, _ := testStatusChecks(true, errors.New("connection refused"))
+	report := collectStatus(context.Background(), checks)
+
+	if report.ok() || report.Status != "unreachable" {
+		t.Errorf("Expected unreachable status, got %s", report.Status)
+	}
+	if report.API.Error != "connection refused" {
+		t.Errorf("Expected probe error in report, got %q", report.API.Error)
+	}
+}
+
+func TestCollectStatus_AuthError(t *testing.T) {
+	checks, _ := testStatusChecks(true, nil)
+	checks.authStatus = func() (*auth.AuthStatus, error) {
+		return nil, errors.New("config unreadable")
+	}
+
+	report := collectStatus(context.Background(), checks)
+	if report.Status != "unauthenticated" || report.Auth.Error != "config unreadable" {
+		t.Errorf("Expected auth error to be reported, got %+v", report.Auth)
+	}
+}
@@ -26,6 +26,27 @@ Examples:
   linctl team members ENG       # List team members`,
 }
 
+// teamPageSize is the number of teams requested per page
+const teamPageSize = 50
+
+// teamLister is the subset of the API client used to list teams
+type teamLister interface {
+	GetTeams(ctx context.Context, first int, after string, orderBy string) (*api.Teams, error)
+}
+
+// fetchAllTeams pages through teams until limit is reached or no pages
+// remain. A limit of 0 or less returns every team.
+func fetchAllTeams(ctx context.Context, client teamLister, limit int, orderBy string) ([]api.Team, error) {
+	teams, _, err := fetchLimited(ctx, limit, teamPageSize, func(first int, after string) ([]api.Team, api.PageInfo, error) {
+		page, err := client.GetTeams(ctx, first, after, orderBy)
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		return page.Nodes, page.PageInfo, nil
+	})
+	return teams, err
+}
+
 var teamListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
@@ -39,7 +60,7 @@ var teamListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		// Create API client
@@ -62,23 +83,26 @@ var teamListCmd = &cobra.Command{
 				orderBy = ""
 			default:
 				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitValidation)
 			}
 		}
 
 		// Get teams
-		teams, err := client.GetTeams(context.Background(), limit, "", orderBy)
+		ctx, stop := paginationContext()
+		defer stop()
+
+		teams, err := fetchAllTeams(ctx, client, limit, orderBy)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list teams: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
 		if jsonOut {
-			output.JSON(teams.Nodes)
+			output.JSON(teams)
 		} else if plaintext {
 			fmt.Println("Key\tName\tDescription\tPrivate\tIssues")
-			for _, team := range teams.Nodes {
+			for _, team := range teams {
 				description := team.Description
 				if len(description) > 50 {
 					description = description[:47] + "..."
@@ -96,7 +120,7 @@ var teamListCmd = &cobra.Command{
 			headers := []string{"Key", "Name", "Description", "Private", "Issues"}
 			rows := [][]string{}
 
-			for _, team := range teams.Nodes {
+			for _, team := range teams {
 				description := team.Description
 				if len(description) > 40 {
 					description = description[:37] + "..."
@@ -126,18 +150,19 @@ var teamListCmd = &cobra.Command{
 			if !plaintext && !jsonOut {
 				fmt.Printf("\n%s %d teams\n",
 					color.New(color.FgGreen).Sprint("✓"),
-					len(teams.Nodes))
+					len(teams))
 			}
 		}
 	},
 }
 
 var teamGetCmd = &cobra.Command{
-	Use:     "get TEAM-KEY",
-	Aliases: []string{"show"},
-	Short:   "Get team details",
-	Long:    `Get detailed information about a specific team.`,
-	Args:    cobra.ExactArgs(1),
+	Use:               "get TEAM-KEY",
+	ValidArgsFunction: completeTeamKeyArg,
+	Aliases:           []string{"show"},
+	Short:             "Get team details",
+	Long:              `Get detailed information about a specific team.`,
+	Args:              cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -147,17 +172,17 @@ var teamGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
 		// Get team details
-		team, err := client.GetTeam(context.Background(), teamKey)
+		team, err := client.GetTeam(commandContext(), teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -198,10 +223,11 @@ var teamGetCmd = &cobra.Command{
 }
 
 var teamMembersCmd = &cobra.Command{
-	Use:   "members TEAM-KEY",
-	Short: "List team members",
-	Long:  `List all members of a specific team.`,
-	Args:  cobra.ExactArgs(1),
+	Use:               "members TEAM-KEY",
+	ValidArgsFunction: completeTeamKeyArg,
+	Short:             "List team members",
+	Long:              `List all members of a specific team.`,
+	Args:              cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -211,17 +237,17 @@ var teamMembersCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
 		// Get team members
-		members, err := client.GetTeamMembers(context.Background(), teamKey)
+		members, err := client.GetTeamMembers(commandContext(), teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team members: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -293,6 +319,6 @@ func init() {
 	teamCmd.AddCommand(teamMembersCmd)
 
 	// List command flags
-	teamListCmd.Flags().IntP("limit", "l", 50, "Maximum number of teams to return")
+	teamListCmd.Flags().IntP("limit", "l", 50, "Maximum number of teams to return (0 for all)")
 	teamListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 }
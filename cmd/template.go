@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	issuetemplate "github.com/nicholls-inc/linctl/pkg/template"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// templateCmd represents the template command
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Manage issue templates",
+	Long: `Manage the issue templates used by 'issue create --template'.
+
+Templates are YAML files in ~/.linctl/templates/<name>.yaml with the fields
+title_prefix, description, team, actor, labels and priority. The title prefix
+and description may reference {{team}} and {{actor}}.
+
+Examples:
+  linctl template list                                  # Show available templates
+  linctl issue create --template bug --title "Crash"    # Create an issue from a template`,
+}
+
+var templateListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List templates",
+	Long:    `List the issue templates in ~/.linctl/templates.`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		templates, err := issuetemplate.List()
+		// Invalid templates are reported, but the valid ones are still listed
+		if err != nil && templates == nil {
+			output.Error(fmt.Sprintf("Failed to list templates: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+
+		if jsonOut {
+			if templates == nil {
+				templates = []*issuetemplate.Template{}
+			}
+			output.JSON(templates)
+			return
+		}
+
+		if len(templates) == 0 {
+			dir, _ := issuetemplate.Dir()
+			output.Info(fmt.Sprintf("No templates found in %s", dir), plaintext, jsonOut)
+			return
+		}
+
+		rows := make([][]string, len(templates))
+		for i, t := range templates {
+			rows[i] = []string{t.Name, t.TitlePrefix, t.Team, strings.Join(t.Labels, ", "), templatePriority(t)}
+		}
+		if !plaintext {
+			for _, row := range rows {
+				row[0] = color.New(color.FgCyan, color.Bold).Sprint(row[0])
+			}
+		}
+
+		output.Table(output.TableData{
+			Headers: []string{"Name", "Title Prefix", "Team", "Labels", "Priority"},
+			Rows:    rows,
+		}, plaintext, jsonOut)
+	},
+}
+
+// templatePriority describes a template's default priority for the list table
+func templatePriority(t *issuetemplate.Template) string {
+	if t.Priority == nil {
+		return ""
+	}
+	return priorityToString(*t.Priority)
+}
+
+func init() {
+	rootCmd.AddCommand(templateCmd)
+	templateCmd.AddCommand(templateListCmd)
+}
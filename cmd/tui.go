@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nicholls-inc/linctl/cmd/internal/picker"
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/auth"
+	"github.com/nicholls-inc/linctl/pkg/output"
+	"github.com/nicholls-inc/linctl/pkg/tui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// tuiCmd represents the tui command
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Browse issues in an interactive terminal UI",
+	Long: `Browse issues in an interactive terminal UI.
+
+List and filter issues, open an issue to view its details and comments,
+and change its workflow state without leaving the terminal.
+
+Requires an interactive terminal. For scripts and pipes use 'linctl issue list'.
+
+Keys:
+  ↑/↓ or j/k   Move selection
+  enter        Open issue / apply state
+  /            Filter by title or identifier
+  s            Change state (issue view)
+  r            Refresh
+  esc          Back
+  q            Quit
+
+Examples:
+  linctl tui                   # Browse your open issues
+  linctl tui --team ENG        # Browse open issues for a team
+  linctl tui --assignee all    # Browse all open issues`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		if jsonOut || plaintext || !tui.IsTerminal() {
+			output.Error("linctl tui requires an interactive terminal. Use 'linctl issue list' for scripted output.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		// Get auth header
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(exitAuth)
+		}
+
+		client := api.NewClient(authHeader)
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		options := tui.Options{
+			Filter:  buildTUIFilter(cmd),
+			Limit:   limit,
+			OrderBy: "updatedAt",
+		}
+
+		if err := tui.Run(client, options); err != nil {
+			output.Error(fmt.Sprintf("Terminal UI failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+	},
+}
+
+// canPickIssue reports whether an omitted issue ID can be picked interactively:
+// only on a terminal, and never for --json or --plaintext output
+func canPickIssue(plaintext, jsonOut bool) bool {
+	return !plaintext && !jsonOut && tui.IsTerminal()
+}
+
+// issueIdentifierLister is the subset of the API client used by the issue picker
+type issueIdentifierLister interface {
+	RecentIssueIdentifiers(ctx context.Context, prefix string) ([]string, error)
+}
+
+// issueArgOrPick returns the issue ID in args, or lets the user pick one of the
+// recently updated issues when it was omitted. picker.ErrCancelled means the
+// user quit without choosing.
+func issueArgOrPick(ctx context.Context, client issueIdentifierLister, args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+
+	identifiers, err := client.RecentIssueIdentifiers(ctx, "")
+	if err != nil {
+		return "", err
+	}
+	return picker.Pick("Issue:", identifiers)
+}
+
+// buildTUIFilter builds the issue filter for the browser from the command flags
+func buildTUIFilter(cmd *cobra.Command) map[string]interface{} {
+	filter := map[string]interface{}{
+		"state": map[string]interface{}{
+			"type": map[string]interface{}{
+				"nin": []string{"completed", "canceled"},
+			},
+		},
+	}
+
+	switch assignee, _ := cmd.Flags().GetString("assignee"); assignee {
+	case "", "all":
+	case "me":
+		filter["assignee"] = map[string]interface{}{"isMe": map[string]interface{}{"eq": true}}
+	default:
+		filter["assignee"] = map[string]interface{}{"email": map[string]interface{}{"eq": assignee}}
+	}
+
+	if team, _ := cmd.Flags().GetString("team"); team != "" {
+		filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": team}}
+	}
+
+	return filter
+}
+
+func init() {
+	rootCmd.AddCommand(tuiCmd)
+
+	tuiCmd.Flags().StringP("team", "t", "", "Team key to browse")
+	_ = tuiCmd.RegisterFlagCompletionFunc("team", completeTeamKeys)
+	tuiCmd.Flags().StringP("assignee", "a", "me", "Assignee email, 'me', or 'all'")
+	tuiCmd.Flags().IntP("limit", "l", 100, "Maximum issues to load")
+}
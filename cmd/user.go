@@ -14,6 +14,27 @@ import (
 	"github.com/spf13/viper"
 )
 
+// userPageSize is the number of users requested per page
+const userPageSize = 50
+
+// userLister is the subset of the API client used to list users
+type userLister interface {
+	GetUsers(ctx context.Context, first int, after string, orderBy string) (*api.Users, error)
+}
+
+// fetchAllUsers pages through users until limit is reached or no pages
+// remain. A limit of 0 or less returns every user in the workspace.
+func fetchAllUsers(ctx context.Context, client userLister, limit int, orderBy string) ([]api.User, error) {
+	users, _, err := fetchLimited(ctx, limit, userPageSize, func(first int, after string) ([]api.User, api.PageInfo, error) {
+		page, err := client.GetUsers(ctx, first, after, orderBy)
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		return page.Nodes, page.PageInfo, nil
+	})
+	return users, err
+}
+
 // userCmd represents the user command
 var userCmd = &cobra.Command{
 	Use:   "user",
@@ -39,7 +60,7 @@ var userListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		// Create API client
@@ -63,22 +84,25 @@ var userListCmd = &cobra.Command{
 				orderBy = ""
 			default:
 				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitValidation)
 			}
 		}
 
 		// Get users
-		users, err := client.GetUsers(context.Background(), limit, "", orderBy)
+		ctx, stop := paginationContext()
+		defer stop()
+
+		users, err := fetchAllUsers(ctx, client, limit, orderBy)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list users: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Filter active users if requested
-		filteredUsers := users.Nodes
+		filteredUsers := users
 		if activeOnly {
 			var activeUsers []api.User
-			for _, user := range users.Nodes {
+			for _, user := range users {
 				if user.Active {
 					activeUsers = append(activeUsers, user)
 				}
@@ -162,17 +186,17 @@ var userGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
 		// Get user details
-		user, err := client.GetUser(context.Background(), email)
+		user, err := client.GetUser(commandContext(), email)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -238,17 +262,17 @@ var userMeCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitAuth)
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
 		// Get current user
-		user, err := client.GetViewer(context.Background())
+		user, err := client.GetViewer(commandContext())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -305,7 +329,7 @@ func init() {
 	userCmd.AddCommand(userMeCmd)
 
 	// List command flags
-	userListCmd.Flags().IntP("limit", "l", 50, "Maximum number of users to return")
+	userListCmd.Flags().IntP("limit", "l", 50, "Maximum number of users to return (0 for all)")
 	userListCmd.Flags().BoolP("active", "a", false, "Show only active users")
 	userListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 }
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+type fakeUserLister struct {
+	users    []api.User
+	err      error
+	requests []int
+}
+
+func (f *fakeUserLister) GetUsers(ctx context.Context, first int, after string, orderBy string) (*api.Users, error) {
+	f.requests = append(f.requests, first)
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	start := 0
+	if after != "" {
+		fmt.Sscanf(after, "cursor-%d", &start)
+	}
+	end := start + first
+	if end > len(f.users) {
+		end = len(f.users)
+	}
+
+	page := &api.Users{Nodes: f.users[start:end]}
+	if end < len(f.users) {
+		page.PageInfo = api.PageInfo{HasNextPage: true, EndCursor: fmt.Sprintf("cursor-%d", end)}
+	}
+	return page, nil
+}
+
+func TestFetchAllUsers(t *testing.T) {
+	tests := []struct {
+		name             string
+		total            int
+		limit            int
+		expectedCount    int
+		expectedRequests []int
+	}{
+		{name: "single page", total: 10, limit: 50, expectedCount: 10, expectedRequests: []int{userPageSize}},
+		{name: "all pages without limit", total: 110, limit: 0, expectedCount: 110, expectedRequests: []int{userPageSize, userPageSize, userPageSize}},
+		{name: "limit spanning pages", total: 120, limit: 75, expectedCount: 75, expectedRequests: []int{userPageSize, 25}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := make([]api.User, tt.total)
+			for i := range users {
+				users[i] = api.User{ID: fmt.Sprintf("user-%d", i)}
+			}
+			client := &fakeUserLister{users: users}
+
+			result, err := fetchAllUsers(context.Background(), client, tt.limit, "")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(result) != tt.expectedCount {
+				t.Errorf("Expected %d users, got %d", tt.expectedCount, len(result))
+			}
+			if fmt.Sprint(client.requests) != fmt.Sprint(tt.expectedRequests) {
+				t.Errorf("Expected page sizes %v, got %v", tt.expectedRequests, client.requests)
+			}
+		})
+	}
+}
+
+func TestFetchAllUsers_Error(t *testing.T) {
+	client := &fakeUserLister{err: errors.New("boom")}
+
+	if _, err := fetchAllUsers(context.Background(), client, 0, ""); err == nil {
+		t.Fatal("Expected error to be returned")
+	}
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/tui"
+)
+
+// linearAppURL is the base URL of the Linear web app
+const linearAppURL = "https://linear.app"
+
+// workspaceWebURL returns the web URL of the workspace with urlKey
+func workspaceWebURL(urlKey string) string {
+	return linearAppURL + "/" + urlKey
+}
+
+// issueWebURL returns the web URL of an issue, or "" without a workspace URL key
+func issueWebURL(urlKey, identifier string) string {
+	if urlKey == "" || identifier == "" {
+		return ""
+	}
+	return workspaceWebURL(urlKey) + "/issue/" + identifier
+}
+
+// commentWebURL returns the web URL of a comment, which Linear anchors on the
+// first eight characters of the comment ID
+func commentWebURL(urlKey, identifier, commentID string) string {
+	issueURL := issueWebURL(urlKey, identifier)
+	if issueURL == "" || commentID == "" {
+		return issueURL
+	}
+	anchor := commentID
+	if len(anchor) > 8 {
+		anchor = anchor[:8]
+	}
+	return issueURL + "#comment-" + anchor
+}
+
+// organizationGetter is the subset of the API client used to look up the workspace
+type organizationGetter interface {
+	GetOrganization(ctx context.Context) (*api.Organization, error)
+}
+
+// workspaceURLKey returns the URL key of the workspace, or "" if it cannot be
+// fetched. A missing URL only costs the link, so the error is not fatal.
+func workspaceURLKey(ctx context.Context, client organizationGetter) string {
+	org, err := client.GetOrganization(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not look up the workspace URL: %v\n", err)
+		return ""
+	}
+	return org.URLKey
+}
+
+// browserCommand returns the command that opens url in the default browser on goos
+func browserCommand(goos, url string) (string, []string) {
+	switch goos {
+	case "darwin":
+		return "open", []string{url}
+	case "windows":
+		return "cmd", []string{"/c", "start", "", url}
+	default:
+		return "xdg-open", []string{url}
+	}
+}
+
+// canOpenBrowser reports whether --open should launch a browser: never in CI
+// or when linctl is not attached to a terminal
+func canOpenBrowser() bool {
+	return os.Getenv("CI") == "" && tui.IsTerminal()
+}
+
+// openWebURL opens url in the default browser for --open. It only warns when
+// the browser cannot be opened, since the entity has already been created.
+func openWebURL(url string) {
+	if url == "" {
+		fmt.Fprintln(os.Stderr, "Warning: --open ignored, the web URL is unknown")
+		return
+	}
+	if !canOpenBrowser() {
+		fmt.Fprintln(os.Stderr, "Warning: --open ignored outside an interactive terminal")
+		return
+	}
+
+	name, args := browserCommand(runtime.GOOS, url)
+	if err := exec.Command(name, args...).Start(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to open %s: %v\n", url, err)
+	}
+}
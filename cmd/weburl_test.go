@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+type fakeOrganizationGetter struct {
+	org *api.Organization
+	err error
+}
+
+func (f fakeOrganizationGetter) GetOrganization(ctx context.Context) (*api.Organization, error) {
+	return f.org, f.err
+}
+
+func TestWebURLs(t *testing.T) {
+	if got := issueWebURL("acme", "LIN-123"); got != "https://linear.app/acme/issue/LIN-123" {
+		t.Errorf("Unexpected issue URL %q", got)
+	}
+	if got := issueWebURL("", "LIN-123"); got != "" {
+		t.Errorf("Expected no issue URL without a URL key, got %q", got)
+	}
+
+	got := commentWebURL("acme", "LIN-123", "3f2b8c1e-7a54-4d3b-9d0e-2c6f1a8b9e47")
+	if got != "https://linear.app/acme/issue/LIN-123#comment-3f2b8c1e" {
+		t.Errorf("Unexpected comment URL %q", got)
+	}
+	if got := commentWebURL("", "LIN-123", "3f2b8c1e"); got != "" {
+		t.Errorf("Expected no comment URL without a URL key, got %q", got)
+	}
+}
+
+func TestWorkspaceURLKey(t *testing.T) {
+	client := fakeOrganizationGetter{org: &api.Organization{URLKey: "acme"}}
+	if got := workspaceURLKey(context.Background(), client); got != "acme" {
+		t.Errorf("Expected acme, got %q", got)
+	}
+
+	client = fakeOrganizationGetter{err: errors.New("network down")}
+	if got := workspaceURLKey(context.Background(), client); got != "" {
+		t.Errorf("Expected no URL key on error, got %q", got)
+	}
+}
+
+func TestBrowserCommand(t *testing.T) {
+	url := "https://linear.app/acme/issue/LIN-1"
+	tests := map[string]string{
+		"darwin":  "open " + url,
+		"linux":   "xdg-open " + url,
+		"freebsd": "xdg-open " + url,
+		"windows": "cmd /c start  " + url,
+	}
+	for goos, want := range tests {
+		name, args := browserCommand(goos, url)
+		if got := name + " " + strings.Join(args, " "); got != want {
+			t.Errorf("%s: expected %q, got %q", goos, want, got)
+		}
+	}
+}
+
+func TestCanOpenBrowserInCI(t *testing.T) {
+	t.Setenv("CI", "true")
+	if canOpenBrowser() {
+		t.Error("Expected --open to be disabled in CI")
+	}
+}
@@ -2,14 +2,17 @@ package agent
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/nicholls-inc/linctl/pkg/api"
 	"github.com/nicholls-inc/linctl/pkg/auth"
 	"github.com/nicholls-inc/linctl/pkg/oauth"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
 )
 
 // AgentConfig represents configuration optimized for agent workflows
@@ -25,10 +28,14 @@ type AgentConfig struct {
 	// Actor configuration
 	DefaultActor     string
 	DefaultAvatarURL string
+	// Team used when --team is omitted
+	DefaultTeam string
 }
 
 // AgentResponse represents a standardized response for agent operations
 type AgentResponse struct {
+	// Version is the response format version, see ResponseVersion
+	Version   string                 `json:"version"`
 	Success   bool                   `json:"success"`
 	Data      interface{}            `json:"data,omitempty"`
 	Error     *AgentError            `json:"error,omitempty"`
@@ -54,6 +61,7 @@ func LoadAgentConfig() *AgentConfig {
 		RetryAttempts:    getIntEnv("LINEAR_AGENT_RETRY_ATTEMPTS", 3),
 		DefaultActor:     os.Getenv("LINEAR_DEFAULT_ACTOR"),
 		DefaultAvatarURL: os.Getenv("LINEAR_DEFAULT_AVATAR_URL"),
+		DefaultTeam:      oauth.LoadTeamFromEnvironment().DefaultTeam,
 	}
 
 	return config
@@ -62,6 +70,7 @@ func LoadAgentConfig() *AgentConfig {
 // ValidateAgentEnvironment validates that the environment is properly configured for agent workflows
 func ValidateAgentEnvironment() *AgentResponse {
 	response := &AgentResponse{
+		Version:   ResponseVersion,
 		Success:   false,
 		Timestamp: time.Now().UTC().Format(time.RFC3339),
 		Metadata:  make(map[string]interface{}),
@@ -133,6 +142,7 @@ func ValidateAgentEnvironment() *AgentResponse {
 // GetAgentStatus returns comprehensive status information for agents
 func GetAgentStatus() *AgentResponse {
 	response := &AgentResponse{
+		Version:   ResponseVersion,
 		Success:   true,
 		Timestamp: time.Now().UTC().Format(time.RFC3339),
 		Metadata:  make(map[string]interface{}),
@@ -168,6 +178,7 @@ func GetAgentStatus() *AgentResponse {
 	response.Metadata["auth_method"] = authStatus.Method
 	response.Metadata["oauth_configured"] = oauthConfig["oauth_configured"]
 	response.Metadata["actor_configured"] = oauthConfig["actor_configured"]
+	response.Metadata["team_configured"] = oauthConfig["team_configured"]
 
 	return response
 }
@@ -175,6 +186,7 @@ func GetAgentStatus() *AgentResponse {
 // CreateStandardResponse creates a standardized response for agent operations
 func CreateStandardResponse(success bool, data interface{}, err error) *AgentResponse {
 	response := &AgentResponse{
+		Version:   ResponseVersion,
 		Success:   success,
 		Data:      data,
 		Timestamp: time.Now().UTC().Format(time.RFC3339),
@@ -183,18 +195,50 @@ func CreateStandardResponse(success bool, data interface{}, err error) *AgentRes
 
 	if err != nil {
 		response.Error = &AgentError{
-			Code:      "OPERATION_ERROR",
+			Code:      errorCode(err),
 			Message:   err.Error(),
 			Retryable: isRetryableError(err),
 		}
+
+		var apiErr *api.APIError
+		if errors.As(err, &apiErr) {
+			response.Error.Details = map[string]interface{}{"category": apiErr.Category}
+			if apiErr.StatusCode != 0 {
+				response.Error.Details["status_code"] = apiErr.StatusCode
+			}
+		}
 	}
 
 	return response
 }
 
+// errorCode maps an API error category to the codes ExitWithResponse understands
+func errorCode(err error) string {
+	var waitErr *ratelimit.ErrRateLimitWait
+	if errors.As(err, &waitErr) {
+		return "RATE_LIMITED"
+	}
+
+	switch api.ErrorCategoryOf(err) {
+	case api.CategoryNotFound:
+		return "NOT_FOUND"
+	case api.CategoryUnauthorized:
+		return "NOT_AUTHENTICATED"
+	case api.CategoryForbidden:
+		return "PERMISSION_DENIED"
+	case api.CategoryRateLimited:
+		return "RATE_LIMITED"
+	case api.CategoryValidation:
+		return "VALIDATION_ERROR"
+	default:
+		return "OPERATION_ERROR"
+	}
+}
+
 // CreateErrorResponse creates a standardized error response for agents
 func CreateErrorResponse(code, message string, retryable bool, suggestions ...string) *AgentResponse {
 	return &AgentResponse{
+		Version:   ResponseVersion,
 		Success:   false,
 		Timestamp: time.Now().UTC().Format(time.RFC3339),
 		Error: &AgentError{
@@ -233,6 +277,10 @@ func FormatForAgent(data interface{}, jsonMode bool) (string, error) {
 
 // ExitWithResponse exits with appropriate code and formatted output for agents
 func ExitWithResponse(response *AgentResponse, jsonMode bool) {
+	if response.Version == "" {
+		response.Version = ResponseVersion
+	}
+
 	output, err := FormatForAgent(response, jsonMode)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error formatting output: %v\n", err)
@@ -300,9 +348,22 @@ func isRetryableError(err error) bool {
 		return false
 	}
 
+	// A wait cut short by --max-wait succeeds once the limit resets
+	var waitErr *ratelimit.ErrRateLimitWait
+	if errors.As(err, &waitErr) {
+		return true
+	}
+
+	// Errors reported by Linear carry a category
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Retryable()
+	}
+
 	errStr := strings.ToLower(err.Error())
 
-	// Network-related errors are typically retryable
+	// Network-related errors are typically retryable, as are rate limits and
+	// server errors from clients that do not return an APIError
 	retryablePatterns := []string{
 		"timeout",
 		"connection",
@@ -329,6 +390,7 @@ func getEnvironmentSummary() map[string]interface{} {
 		"LINEAR_CLIENT_SECRET":      os.Getenv("LINEAR_CLIENT_SECRET") != "",
 		"LINEAR_DEFAULT_ACTOR":      os.Getenv("LINEAR_DEFAULT_ACTOR"),
 		"LINEAR_DEFAULT_AVATAR_URL": os.Getenv("LINEAR_DEFAULT_AVATAR_URL"),
+		"LINEAR_DEFAULT_TEAM":       os.Getenv("LINEAR_DEFAULT_TEAM"),
 		"LINEAR_AGENT_SILENT":       getBoolEnv("LINEAR_AGENT_SILENT", false),
 		"LINEAR_AGENT_JSON":         getBoolEnv("LINEAR_AGENT_JSON", false),
 		"LINEAR_AGENT_TIMEOUT":      getIntEnv("LINEAR_AGENT_TIMEOUT", 30),
@@ -0,0 +1,145 @@
+package agent
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+)
+
+func TestCreateStandardResponse_APIErrorCategories(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		code      string
+		retryable bool
+	}{
+		{"not found", &api.APIError{Category: api.CategoryNotFound}, "NOT_FOUND", false},
+		{"unauthorized", &api.APIError{Category: api.CategoryUnauthorized, StatusCode: 401}, "NOT_AUTHENTICATED", false},
+		{"forbidden", &api.APIError{Category: api.CategoryForbidden}, "PERMISSION_DENIED", false},
+		{"rate limited", &api.APIError{Category: api.CategoryRateLimited}, "RATE_LIMITED", true},
+		{"validation", &api.APIError{Category: api.CategoryValidation}, "VALIDATION_ERROR", false},
+		{"server error", &api.APIError{Category: api.CategoryUnknown, StatusCode: 503}, "OPERATION_ERROR", true},
+		{"wrapped", fmt.Errorf("failed to get issue: %w", &api.APIError{Category: api.CategoryNotFound}), "NOT_FOUND", false},
+		{"network error", errors.New("request failed: connection refused"), "OPERATION_ERROR", true},
+		{"plain error", errors.New("something broke"), "OPERATION_ERROR", false},
+		{"rate limit message", errors.New("Rate limit exceeded, try again later"), "OPERATION_ERROR", true},
+		{"503 message", errors.New("HTTP 503: Service Unavailable"), "OPERATION_ERROR", true},
+		{"502 message", errors.New("HTTP 502: Bad Gateway"), "OPERATION_ERROR", true},
+		{"500 message", errors.New("HTTP 500: Internal Server Error"), "OPERATION_ERROR", true},
+		{"typed error wins over message", &api.APIError{Category: api.CategoryValidation, Errors: api.GraphQLErrors{{Message: "Title exceeds 500 characters"}}}, "VALIDATION_ERROR", false},
+		{"max wait exceeded", fmt.Errorf("failed to list issues: %w", &ratelimit.ErrRateLimitWait{Wait: 30 * time.Second}), "RATE_LIMITED", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := CreateStandardResponse(false, nil, tt.err)
+
+			if response.Error.Code != tt.code {
+				t.Errorf("Expected code %s, got %s", tt.code, response.Error.Code)
+			}
+			if response.Error.Retryable != tt.retryable {
+				t.Errorf("Expected retryable %v, got %v", tt.retryable, response.Error.Retryable)
+			}
+		})
+	}
+}
+
+func TestCreateStandardResponse_APIErrorDetails(t *testing.T) {
+	response := CreateStandardResponse(false, nil, &api.APIError{Category: api.CategoryRateLimited, StatusCode: 429})
+
+	if response.Error.Details["category"] != api.CategoryRateLimited {
+		t.Errorf("Expected category detail, got %v", response.Error.Details)
+	}
+	if response.Error.Details["status_code"] != 429 {
+		t.Errorf("Expected status_code detail, got %v", response.Error.Details)
+	}
+
+	if plain := CreateStandardResponse(false, nil, errors.New("boom")); plain.Error.Details != nil {
+		t.Errorf("Expected no details for a plain error, got %v", plain.Error.Details)
+	}
+}
+
+// jsonFieldNames returns the JSON names of a struct's fields and which are always present
+func jsonFieldNames(t reflect.Type) (names []string, required []string) {
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		name, options, _ := strings.Cut(tag, ",")
+		names = append(names, name)
+		if !strings.Contains(options, "omitempty") {
+			required = append(required, name)
+		}
+	}
+	sort.Strings(names)
+	sort.Strings(required)
+	return names, required
+}
+
+// schemaObject is the subset of a JSON Schema object definition checked here
+type schemaObject struct {
+	Required   []string                   `json:"required"`
+	Properties map[string]json.RawMessage `json:"properties"`
+}
+
+func TestResponseSchema(t *testing.T) {
+	var schema struct {
+		schemaObject
+		Defs map[string]schemaObject `json:"$defs"`
+	}
+	if err := json.Unmarshal(ResponseSchema(), &schema); err != nil {
+		t.Fatalf("Expected the schema to be valid JSON: %v", err)
+	}
+
+	checks := []struct {
+		name   string
+		typ    reflect.Type
+		object schemaObject
+	}{
+		{"AgentResponse", reflect.TypeOf(AgentResponse{}), schema.schemaObject},
+		{"AgentError", reflect.TypeOf(AgentError{}), schema.Defs["AgentError"]},
+	}
+	for _, check := range checks {
+		names, required := jsonFieldNames(check.typ)
+
+		var described []string
+		for name := range check.object.Properties {
+			described = append(described, name)
+		}
+		sort.Strings(described)
+		if !reflect.DeepEqual(described, names) {
+			t.Errorf("%s schema describes %v, struct has %v", check.name, described, names)
+		}
+
+		sort.Strings(check.object.Required)
+		if !reflect.DeepEqual(check.object.Required, required) {
+			t.Errorf("%s schema requires %v, struct always emits %v", check.name, check.object.Required, required)
+		}
+	}
+
+	var version struct {
+		Const string `json:"const"`
+	}
+	json.Unmarshal(schema.Properties["version"], &version)
+	if version.Const != ResponseVersion {
+		t.Errorf("Expected schema version %s, got %s", ResponseVersion, version.Const)
+	}
+}
+
+func TestResponseVersion(t *testing.T) {
+	responses := []*AgentResponse{
+		CreateStandardResponse(true, nil, nil),
+		CreateErrorResponse("NOT_FOUND", "missing", false),
+	}
+	for _, response := range responses {
+		if response.Version != ResponseVersion {
+			t.Errorf("Expected version %s, got %q", ResponseVersion, response.Version)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package agent
+
+// ResponseVersion is the AgentResponse format version. Bump the minor version
+// for additive changes and the major version when fields are removed or
+// change meaning, so consumers can detect which format they are parsing.
+const ResponseVersion = "1.0"
+
+// responseSchema is the JSON Schema (draft 2020-12) for AgentResponse and
+// AgentError. Keep it in step with the struct tags; TestResponseSchema checks
+// that every field is described.
+const responseSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "https://github.com/nicholls-inc/linctl/schemas/agent-response.json",
+  "title": "AgentResponse",
+  "description": "Response printed by linctl agent commands and agent-mode operations",
+  "type": "object",
+  "required": ["version", "success", "timestamp"],
+  "properties": {
+    "version": {
+      "type": "string",
+      "description": "Response format version (major.minor)",
+      "const": "1.0"
+    },
+    "success": {
+      "type": "boolean",
+      "description": "Whether the operation succeeded"
+    },
+    "data": {
+      "description": "Operation result; its shape depends on the command"
+    },
+    "error": {
+      "$ref": "#/$defs/AgentError"
+    },
+    "metadata": {
+      "type": "object",
+      "description": "Extra context such as auth_method or actor_configured",
+      "additionalProperties": true
+    },
+    "timestamp": {
+      "type": "string",
+      "format": "date-time",
+      "description": "When the response was created (RFC 3339, UTC)"
+    }
+  },
+  "$defs": {
+    "AgentError": {
+      "type": "object",
+      "required": ["code", "message", "retryable"],
+      "properties": {
+        "code": {
+          "type": "string",
+          "description": "Machine-readable error code, e.g. NOT_AUTHENTICATED, NOT_FOUND, RATE_LIMITED"
+        },
+        "message": {
+          "type": "string",
+          "description": "Human-readable error message"
+        },
+        "details": {
+          "type": "object",
+          "description": "Extra context such as the API error category and status_code",
+          "additionalProperties": true
+        },
+        "suggestions": {
+          "type": "array",
+          "items": {"type": "string"},
+          "description": "Steps that may resolve the error"
+        },
+        "retryable": {
+          "type": "boolean",
+          "description": "Whether retrying the same operation may succeed"
+        }
+      }
+    }
+  }
+}
+`
+
+// ResponseSchema returns the JSON Schema describing AgentResponse
+func ResponseSchema() []byte {
+	return []byte(responseSchema)
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// QueryAllowlistEnvVar names a file of operation names the raw query command may run
+const QueryAllowlistEnvVar = "LINCTL_QUERY_ALLOWLIST"
+
+// ErrQueryNotAllowed is returned when a document contains an operation missing from the allowlist
+var ErrQueryNotAllowed = errors.New("query not allowed")
+
+// QueryAllowlist restricts raw GraphQL documents to named operations
+type QueryAllowlist struct {
+	names map[string]bool
+}
+
+// LoadQueryAllowlistFromEnv loads the allowlist named by LINCTL_QUERY_ALLOWLIST.
+// It returns nil when the variable is unset, meaning every query is allowed.
+func LoadQueryAllowlistFromEnv() (*QueryAllowlist, error) {
+	path := os.Getenv(QueryAllowlistEnvVar)
+	if path == "" {
+		return nil, nil
+	}
+	return LoadQueryAllowlist(path)
+}
+
+// LoadQueryAllowlist reads an allowlist file with one operation name per line.
+// Blank lines and lines starting with # are ignored.
+func LoadQueryAllowlist(path string) (*QueryAllowlist, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open query allowlist: %w", err)
+	}
+	defer file.Close()
+
+	allowlist := &QueryAllowlist{names: make(map[string]bool)}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		allowlist.names[line] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read query allowlist: %w", err)
+	}
+
+	return allowlist, nil
+}
+
+// Check returns ErrQueryNotAllowed unless every top-level operation in the
+// document is named and listed. Anonymous operations are always rejected.
+func (a *QueryAllowlist) Check(query string) error {
+	operations := parseOperations(query)
+	if len(operations) == 0 {
+		return fmt.Errorf("%w: no operation found in document", ErrQueryNotAllowed)
+	}
+
+	for _, operation := range operations {
+		if operation.Name == "" {
+			return fmt.Errorf("%w: anonymous %s operations are not permitted; name the operation and add it to %s",
+				ErrQueryNotAllowed, operation.Type, QueryAllowlistEnvVar)
+		}
+		if !a.names[operation.Name] {
+			return fmt.Errorf("%w: %s %s is not in the allowlist (%s)",
+				ErrQueryNotAllowed, operation.Type, operation.Name, QueryAllowlistEnvVar)
+		}
+	}
+
+	return nil
+}
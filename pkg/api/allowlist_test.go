@@ -0,0 +1,62 @@
+package api
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeAllowlist(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "allowlist")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write allowlist: %v", err)
+	}
+	return path
+}
+
+func TestQueryAllowlist_Check(t *testing.T) {
+	allowlist, err := LoadQueryAllowlist(writeAllowlist(t, "# allowed operations\nMe\n\n  TeamIssues  \n"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		allowed bool
+	}{
+		{name: "listed query", query: "query Me { viewer { id } }", allowed: true},
+		{name: "listed with whitespace trimmed", query: "query TeamIssues { issues { nodes { id } } }", allowed: true},
+		{name: "unlisted mutation", query: "mutation DeleteAll { issueDelete(id: \"x\") { success } }"},
+		{name: "anonymous query", query: "{ viewer { id } }"},
+		{name: "unlisted second operation", query: "query Me { viewer { id } } mutation Sneaky { x }"},
+		{name: "no operation", query: "fragment F on User { id }"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := allowlist.Check(tt.query)
+			if tt.allowed && err != nil {
+				t.Errorf("Expected query to be allowed, got %v", err)
+			}
+			if !tt.allowed && !errors.Is(err, ErrQueryNotAllowed) {
+				t.Errorf("Expected ErrQueryNotAllowed, got %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadQueryAllowlistFromEnv(t *testing.T) {
+	t.Setenv(QueryAllowlistEnvVar, "")
+	allowlist, err := LoadQueryAllowlistFromEnv()
+	if err != nil || allowlist != nil {
+		t.Fatalf("Expected no allowlist when unset, got %v, %v", allowlist, err)
+	}
+
+	t.Setenv(QueryAllowlistEnvVar, filepath.Join(t.TempDir(), "missing"))
+	if _, err := LoadQueryAllowlistFromEnv(); err == nil {
+		t.Error("Expected error for a missing allowlist file")
+	}
+}
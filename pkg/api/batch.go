@@ -0,0 +1,224 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+)
+
+// IssueBatchSize is the most issues fetched in one aliased query, keeping each
+// document well inside Linear's query complexity limit
+const IssueBatchSize = 20
+
+// issueBatchFields is the selection set fetched for each issue in a batch
+const issueBatchFields = `
+			id
+			identifier
+			title
+			description
+			priority
+			estimate
+			createdAt
+			updatedAt
+			dueDate
+			url
+			archivedAt
+			state {
+				id
+				name
+				type
+				color
+			}
+			assignee {
+				id
+				name
+				email
+			}
+			team {
+				id
+				key
+				name
+			}
+			labels {
+				nodes {
+					id
+					name
+					color
+				}
+			}`
+
+// issueRelationBatchFields is the selection set fetched for each issue by
+// GetIssueRelationsByIDs: the issue and both directions of its relations
+const issueRelationBatchFields = `
+			id
+			identifier
+			relations {
+				nodes {
+					id
+					type
+					relatedIssue {
+						id
+						identifier
+						state {
+							type
+						}
+					}
+				}
+			}
+			inverseRelations {
+				nodes {
+					id
+					type
+					issue {
+						id
+						identifier
+						state {
+							type
+						}
+					}
+				}
+			}`
+
+// projectBatchFields is the selection set fetched for each project by GetProjectsByIDs
+const projectBatchFields = `
+			id
+			slugId
+			name
+			state
+			progress
+			targetDate
+			url`
+
+// GetIssuesByIDs fetches issues by ID or identifier with one request per
+// IssueBatchSize issues, aliasing each lookup (i0: issue(id: $i0) ...) in a
+// single document. Batches run one after another under the process-wide rate
+// limiter (ratelimit.Shared). The issues are returned in the order of ids; if
+// any issue cannot be fetched the error names it and no issues are returned.
+func (c *Client) GetIssuesByIDs(ctx context.Context, ids []string) ([]Issue, error) {
+	return c.getIssuesBatched(ctx, ids, "IssuesByIDs", issueBatchFields)
+}
+
+// GetIssueRelationsByIDs fetches the relations and inverse relations of issues
+// in batches, like GetIssuesByIDs. Only the ID, identifier and relations of
+// each issue are populated, with the state type of every related issue.
+func (c *Client) GetIssueRelationsByIDs(ctx context.Context, ids []string) ([]Issue, error) {
+	return c.getIssuesBatched(ctx, ids, "IssueRelationsByIDs", issueRelationBatchFields)
+}
+
+// GetProjectsByIDs fetches projects by ID or slug ID in batches, like
+// GetIssuesByIDs. Only the ID, slug ID, name, state, progress, target date and
+// URL of each project are populated.
+func (c *Client) GetProjectsByIDs(ctx context.Context, ids []string) ([]Project, error) {
+	return getBatched[Project](ctx, c, "project", ids, "ProjectsByIDs", projectBatchFields)
+}
+
+// getIssuesBatched fetches ids in IssueBatchSize chunks, selecting fields for
+// each issue in a query named operation
+func (c *Client) getIssuesBatched(ctx context.Context, ids []string, operation, fields string) ([]Issue, error) {
+	return getBatched[Issue](ctx, c, "issue", ids, operation, fields)
+}
+
+// getBatched fetches entities of one kind (issue or project) by ID in
+// IssueBatchSize chunks, selecting fields for each in a query named operation
+func getBatched[T any](ctx context.Context, c *Client, entity string, ids []string, operation, fields string) ([]T, error) {
+	items := make([]T, 0, len(ids))
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	limiter := ratelimit.Shared()
+	for start := 0; start < len(ids); start += IssueBatchSize {
+		end := start + IssueBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		if err := WaitForRateLimit(ctx, limiter); err != nil {
+			return nil, err
+		}
+
+		chunk, err := getBatch[T](ctx, c, limiter, entity, ids[start:end], operation, fields)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, chunk...)
+	}
+
+	return items, nil
+}
+
+// getBatch fetches up to IssueBatchSize entities in one aliased query, feeding
+// the response's rate limit headers to limiter
+func getBatch[T any](ctx context.Context, c *Client, limiter *ratelimit.RateLimiter, entity string, ids []string, operation, fields string) ([]T, error) {
+	query, variables := buildBatchQuery(entity, ids, operation, fields)
+
+	var response map[string]json.RawMessage
+	if err := c.execute(ctx, query, variables, &response, limiter.UpdateFromResponse); err != nil {
+		if id, ok := batchErrorID(err, ids); ok {
+			return nil, fmt.Errorf("failed to get %s %s: %w", entity, id, err)
+		}
+		return nil, err
+	}
+
+	items := make([]T, len(ids))
+	for i, id := range ids {
+		data, ok := response[issueBatchAlias(i)]
+		if !ok || string(data) == "null" {
+			return nil, fmt.Errorf("%s %s missing from batch response", entity, id)
+		}
+		if err := json.Unmarshal(data, &items[i]); err != nil {
+			return nil, fmt.Errorf("failed to parse %s %s: %w", entity, id, err)
+		}
+	}
+	return items, nil
+}
+
+// buildBatchQuery aliases one lookup of entity (the GraphQL root field, e.g.
+// issue or project) per ID, selecting fields for each. IDs are passed as
+// variables rather than spliced into the document.
+func buildBatchQuery(entity string, ids []string, operation, fields string) (string, map[string]interface{}) {
+	var params, selections strings.Builder
+	variables := make(map[string]interface{}, len(ids))
+
+	for i, id := range ids {
+		alias := issueBatchAlias(i)
+		if i > 0 {
+			params.WriteString(", ")
+		}
+		fmt.Fprintf(&params, "$%s: String!", alias)
+		fmt.Fprintf(&selections, "\n\t\t%s: %s(id: $%s) {%s\n\t\t}", alias, entity, alias, fields)
+		variables[alias] = id
+	}
+
+	query := fmt.Sprintf("\n\tquery %s(%s) {%s\n\t}\n", operation, params.String(), selections.String())
+	return query, variables
+}
+
+// issueBatchAlias names the i-th lookup in a batch
+func issueBatchAlias(i int) string {
+	return fmt.Sprintf("i%d", i)
+}
+
+// batchErrorID finds the ID whose alias a GraphQL error path points at
+func batchErrorID(err error, ids []string) (string, bool) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return "", false
+	}
+	for _, gqlErr := range apiErr.Errors {
+		if len(gqlErr.Path) == 0 {
+			continue
+		}
+		alias, _ := gqlErr.Path[0].(string)
+		for i, id := range ids {
+			if alias == issueBatchAlias(i) {
+				return id, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,225 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+)
+
+func TestGetIssuesByIDs(t *testing.T) {
+	var batchSizes []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+		if !strings.Contains(req.Query, "query IssuesByIDs(") {
+			t.Errorf("Expected a named IssuesByIDs query, got %s", req.Query)
+		}
+		batchSizes = append(batchSizes, len(req.Variables))
+
+		data := map[string]interface{}{}
+		for alias, id := range req.Variables {
+			if !strings.Contains(req.Query, alias+": issue(id: $"+alias+")") {
+				t.Errorf("Expected alias %s in query", alias)
+			}
+			data[alias] = map[string]interface{}{
+				"id":         id,
+				"identifier": "TEST-" + id.(string),
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	ids := make([]string, IssueBatchSize+5)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("%d", i)
+	}
+
+	issues, err := client.GetIssuesByIDs(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("GetIssuesByIDs failed: %v", err)
+	}
+
+	if len(batchSizes) != 2 || batchSizes[0] != IssueBatchSize || batchSizes[1] != 5 {
+		t.Errorf("Expected batches of %d and 5, got %v", IssueBatchSize, batchSizes)
+	}
+	if len(issues) != len(ids) {
+		t.Fatalf("Expected %d issues, got %d", len(ids), len(issues))
+	}
+	for i, issue := range issues {
+		if issue.ID != ids[i] || issue.Identifier != "TEST-"+ids[i] {
+			t.Errorf("Expected issue %s at position %d, got %s", ids[i], i, issue.ID)
+		}
+	}
+}
+
+func TestGetIssueRelationsByIDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+		if !strings.Contains(req.Query, "query IssueRelationsByIDs(") || !strings.Contains(req.Query, "inverseRelations") {
+			t.Errorf("Expected a named IssueRelationsByIDs query selecting inverse relations, got %s", req.Query)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"i0":{"id":"1","identifier":"TEST-1",
+			"relations":{"nodes":[]},
+			"inverseRelations":{"nodes":[{"id":"r1","type":"blocks","issue":{"id":"2","identifier":"TEST-2","state":{"type":"started"}}}]}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	issues, err := client.GetIssueRelationsByIDs(context.Background(), []string{"1"})
+	if err != nil {
+		t.Fatalf("GetIssueRelationsByIDs failed: %v", err)
+	}
+	if len(issues) != 1 || issues[0].InverseRelations == nil || len(issues[0].InverseRelations.Nodes) != 1 {
+		t.Fatalf("Expected one inverse relation, got %+v", issues)
+	}
+	blocker := issues[0].InverseRelations.Nodes[0].Issue
+	if blocker == nil || blocker.Identifier != "TEST-2" || blocker.State.Type != "started" {
+		t.Errorf("Unexpected blocker %+v", blocker)
+	}
+}
+
+func TestGetIssuesByIDs_Empty(t *testing.T) {
+	client := NewClientWithURL("http://127.0.0.1:0", "test-auth-header")
+
+	issues, err := client.GetIssuesByIDs(context.Background(), nil)
+	if err != nil || len(issues) != 0 {
+		t.Errorf("Expected no issues and no request, got %v / %v", issues, err)
+	}
+}
+
+func TestGetIssuesByIDs_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		response map[string]interface{}
+		wantErr  string
+	}{
+		{
+			name: "error path names the issue",
+			response: map[string]interface{}{
+				"errors": []map[string]interface{}{{"message": "Entity not found", "path": []string{"i1"}}},
+			},
+			wantErr: "failed to get issue TEST-2",
+		},
+		{
+			name: "null issue",
+			response: map[string]interface{}{
+				"data": map[string]interface{}{"i0": map[string]interface{}{"id": "a"}, "i1": nil},
+			},
+			wantErr: "issue TEST-2 missing from batch response",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(tt.response)
+			}))
+			defer server.Close()
+
+			client := NewClientWithURL(server.URL, "test-auth-header")
+			issues, err := client.GetIssuesByIDs(context.Background(), []string{"TEST-1", "TEST-2"})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if issues != nil {
+				t.Errorf("Expected no issues on error, got %v", issues)
+			}
+		})
+	}
+}
+
+func TestGetProjectsByIDs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+		if !strings.Contains(req.Query, "query ProjectsByIDs(") || !strings.Contains(req.Query, "i0: project(id: $i0)") {
+			t.Errorf("Expected a named ProjectsByIDs query aliasing project lookups, got %s", req.Query)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"i0":{"id":"p1","name":"Roadmap","state":"started"},"i1":{"id":"p2","name":"Launch","state":"planned"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+	projects, err := client.GetProjectsByIDs(context.Background(), []string{"p1", "p2"})
+	if err != nil {
+		t.Fatalf("GetProjectsByIDs failed: %v", err)
+	}
+	if len(projects) != 2 || projects[0].Name != "Roadmap" || projects[1].State != "planned" {
+		t.Errorf("Unexpected projects: %+v", projects)
+	}
+}
+
+func TestGetIssuesByIDs_SharedLimiter(t *testing.T) {
+	ratelimit.ResetShared()
+	t.Cleanup(ratelimit.ResetShared)
+	defer SetMaxRateLimitWait(0)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		data := map[string]interface{}{}
+		for alias, id := range req.Variables {
+			data[alias] = map[string]interface{}{"id": id}
+		}
+
+		// Linear reports the quota is used up until a minute from now
+		w.Header().Set("X-RateLimit-Limit", "1500")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+	if _, err := client.GetIssuesByIDs(context.Background(), []string{"1"}); err != nil {
+		t.Fatalf("GetIssuesByIDs failed: %v", err)
+	}
+	if info := ratelimit.Shared().LastRateInfo(); info == nil || info.Remaining != 0 {
+		t.Fatalf("Expected the response to update the shared limiter, got %+v", info)
+	}
+
+	// Every helper now sees the exhausted quota
+	SetMaxRateLimitWait(time.Second)
+	_, err := client.GetIssuesByIDs(context.Background(), []string{"2"})
+	var waitErr *ratelimit.ErrRateLimitWait
+	if !errors.As(err, &waitErr) {
+		t.Errorf("Expected batches to wait on the shared limiter, got %v", err)
+	}
+	results := client.BroadcastComment(context.Background(), []string{"issue-1"}, "Heads up", nil)
+	if !errors.As(results[0].Error, &waitErr) {
+		t.Errorf("Expected broadcasts to wait on the shared limiter, got %v", results[0].Error)
+	}
+	if requests != 1 {
+		t.Errorf("Expected no requests while the quota is exhausted, got %d", requests)
+	}
+}
@@ -7,17 +7,58 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/httpclient"
+	"github.com/nicholls-inc/linctl/pkg/logging"
 )
 
 const (
 	BaseURL = "https://api.linear.app/graphql"
 )
 
+// CorrelationIDHeader carries the caller's correlation ID on every request
+const CorrelationIDHeader = "X-Correlation-ID"
+
+// endpointOverride replaces BaseURL for every new client, see SetBaseURL
+var endpointOverride string
+
+// SetBaseURL points new clients at the GraphQL endpoint of another API base
+// URL (the --api-url flag), e.g. a mock gateway at http://localhost:8080 is
+// reached at http://localhost:8080/graphql. An empty base restores BaseURL.
+func SetBaseURL(base string) {
+	if base == "" {
+		endpointOverride = ""
+		return
+	}
+	endpointOverride = strings.TrimRight(base, "/") + "/graphql"
+}
+
+// Endpoint returns the GraphQL endpoint new clients use
+func Endpoint() string {
+	if endpointOverride != "" {
+		return endpointOverride
+	}
+	return BaseURL
+}
+
 type Client struct {
 	httpClient *http.Client
 	authHeader string
 	baseURL    string
+
+	// dryRun short-circuits mutations, see SetDryRun
+	dryRun bool
+
+	// mock serves requests from LINCTL_MOCK_DIR in mock mode, see EnableMockMode
+	mock *mockTransport
+
+	// extraHeaders come from LINCTL_EXTRA_HEADERS and are sent with every request
+	extraHeaders http.Header
+
+	// viewerID caches the authenticated user's ID for the viewer issue queries
+	viewerID string
 }
 
 type GraphQLRequest struct {
@@ -31,9 +72,10 @@ type GraphQLResponse struct {
 }
 
 type GraphQLError struct {
-	Message   string                 `json:"message"`
-	Locations []GraphQLErrorLocation `json:"locations,omitempty"`
-	Path      []interface{}          `json:"path,omitempty"`
+	Message    string                 `json:"message"`
+	Locations  []GraphQLErrorLocation `json:"locations,omitempty"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
 }
 
 type GraphQLErrorLocation struct {
@@ -41,24 +83,50 @@ type GraphQLErrorLocation struct {
 	Column int `json:"column"`
 }
 
+// GraphQLErrors is returned when a response contains GraphQL errors
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	return fmt.Sprintf("GraphQL errors: %v", []GraphQLError(e))
+}
+
 // NewClient creates a new Linear API client
 func NewClient(authHeader string) *Client {
-	return NewClientWithURL(BaseURL, authHeader)
+	return NewClientWithURL(Endpoint(), authHeader)
 }
 
 // NewClientWithURL creates a new Linear API client with custom URL
 func NewClientWithURL(baseURL, authHeader string) *Client {
+	// A malformed LINCTL_EXTRA_HEADERS is reported when the CLI starts
+	extraHeaders, _ := httpclient.ExtraHeadersFromEnv()
+
 	return &Client{
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-		authHeader: authHeader,
-		baseURL:    baseURL,
+		httpClient:   httpclient.New(httpclient.ConfigFromEnv()),
+		authHeader:   authHeader,
+		baseURL:      baseURL,
+		mock:         mockFromEnv(),
+		extraHeaders: extraHeaders,
 	}
 }
 
 // Execute performs a GraphQL request
 func (c *Client) Execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	return c.execute(ctx, query, variables, result, nil)
+}
+
+// execute is Execute, passing the HTTP response to observe, when it is not
+// nil, before the response is parsed. Helpers that send a run of requests use
+// it to feed Linear's rate limit headers to the limiter they wait on.
+func (c *Client) execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}, observe func(*http.Response)) error {
+	if c.dryRun {
+		if err := dryRunMutation(query, variables); err != nil {
+			return err
+		}
+	}
+	if c.mock != nil {
+		return c.mock.execute(query, variables, result)
+	}
+
 	reqBody := GraphQLRequest{
 		Query:     query,
 		Variables: variables,
@@ -77,20 +145,33 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", c.authHeader)
 	req.Header.Set("User-Agent", "linctl/0.1.0")
+	if correlationID := logging.CorrelationIDFromContext(ctx); correlationID != "" {
+		req.Header.Set(CorrelationIDHeader, correlationID)
+	}
+	httpclient.SetExtraHeaders(req, c.extraHeaders)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		// Report why the caller gave up, such as a command deadline, rather
+		// than a bare "context deadline exceeded"
+		if ctx.Err() != nil {
+			return fmt.Errorf("request failed: %w", context.Cause(ctx))
+		}
 		return fmt.Errorf("request failed: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if observe != nil {
+		observe(resp)
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return fmt.Errorf("failed to read response: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return newHTTPError(resp.StatusCode, body)
 	}
 
 	var gqlResp GraphQLResponse
@@ -99,7 +180,7 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 	}
 
 	if len(gqlResp.Errors) > 0 {
-		return fmt.Errorf("GraphQL errors: %v", gqlResp.Errors)
+		return newGraphQLError(gqlResp.Errors, gqlResp.Data)
 	}
 
 	if result != nil {
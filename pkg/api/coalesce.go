@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// coalesceKey identifies a read request by its query and variables, so
+// concurrent identical reads can share one network call. ok is false for
+// mutations and subscriptions, which are never coalesced.
+func coalesceKey(query string, variables map[string]interface{}) (key string, ok bool) {
+	if parseOperation(query).Type != "query" {
+		return "", false
+	}
+
+	// Map keys are marshaled in sorted order, so equal variables give equal keys
+	vars, err := json.Marshal(variables)
+	if err != nil {
+		return "", false
+	}
+
+	sum := sha256.New()
+	sum.Write([]byte(query))
+	sum.Write([]byte{0})
+	sum.Write(vars)
+	return hex.EncodeToString(sum.Sum(nil)), true
+}
+
+// executeCoalesced runs a read through the in-flight group: the first caller
+// makes the request and every concurrent caller with the same key receives a
+// copy of its response data (or its error). The shared request keeps the first
+// caller's context values but not its cancellation or deadline, so a first
+// caller that gives up does not fail the others; it is bounded by the
+// operation timeout instead. Any caller whose own context ends returns early.
+func (c *EnhancedClient) executeCoalesced(ctx context.Context, key, query string, variables map[string]interface{}, result interface{}) error {
+	shared := context.WithoutCancel(ctx)
+	ch := c.inflight.DoChan(key, func() (interface{}, error) {
+		var data json.RawMessage
+		err := c.executeWithTimeout(shared, query, variables, &data)
+		return data, err
+	})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return res.Err
+		}
+		data, _ := res.Val.(json.RawMessage)
+		if result == nil || len(data) == 0 {
+			return nil
+		}
+		if err := json.Unmarshal(data, result); err != nil {
+			return fmt.Errorf("failed to unmarshal data: %w", err)
+		}
+		return nil
+	}
+}
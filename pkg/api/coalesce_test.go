@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/logging"
+)
+
+// newCoalesceTestClient returns a client for a server that holds every request
+// until release is closed, counting the requests it receives
+func newCoalesceTestClient(t *testing.T) (*EnhancedClient, *int32, chan struct{}) {
+	t.Helper()
+	var requests int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"issue":{"id":"issue-1","identifier":"LIN-1"}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	config := DefaultEnhancedClientConfig()
+	config.BaseURL = server.URL
+	config.Logger = logging.NewNoOpLogger()
+	config.RateLimitConfig.Enabled = false
+	return NewEnhancedClient("test-auth", config), &requests, release
+}
+
+// executeConcurrently runs n identical Execute calls, releasing the server
+// once they have all started, and returns their results
+func executeConcurrently(t *testing.T, client *EnhancedClient, release chan struct{}, n int, query string, variables func(i int) map[string]interface{}) []map[string]interface{} {
+	t.Helper()
+	results := make([]map[string]interface{}, n)
+	errs := make([]error, n)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = client.Execute(context.Background(), query, variables(i), &results[i])
+		}(i)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("Execute %d failed: %v", i, err)
+		}
+	}
+	return results
+}
+
+func TestEnhancedClient_CoalescesIdenticalReads(t *testing.T) {
+	client, requests, release := newCoalesceTestClient(t)
+
+	const n = 10
+	query := `query GetIssue($id: String!) { issue(id: $id) { id identifier } }`
+	results := executeConcurrently(t, client, release, n, query, func(int) map[string]interface{} {
+		return map[string]interface{}{"id": "LIN-1"}
+	})
+
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("Expected the server to see 1 request, got %d", got)
+	}
+	for i, result := range results {
+		issue, _ := result["issue"].(map[string]interface{})
+		if issue["identifier"] != "LIN-1" {
+			t.Errorf("Result %d: expected LIN-1, got %v", i, result)
+		}
+	}
+
+	// Each caller gets its own copy of the data
+	results[0]["issue"].(map[string]interface{})["identifier"] = "changed"
+	if results[1]["issue"].(map[string]interface{})["identifier"] != "LIN-1" {
+		t.Error("Expected callers not to share result values")
+	}
+}
+
+func TestEnhancedClient_CoalescedReadSurvivesLeaderCancel(t *testing.T) {
+	client, requests, release := newCoalesceTestClient(t)
+	query := `query GetIssue($id: String!) { issue(id: $id) { id identifier } }`
+	variables := map[string]interface{}{"id": "LIN-1"}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		leaderErr <- client.Execute(leaderCtx, query, variables, nil)
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	var result map[string]interface{}
+	followerErr := make(chan error, 1)
+	go func() {
+		followerErr <- client.Execute(context.Background(), query, variables, &result)
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// The leader gives up while its request is in flight
+	cancelLeader()
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the leader to see its own cancellation, got %v", err)
+	}
+
+	close(release)
+	if err := <-followerErr; err != nil {
+		t.Fatalf("Expected the follower to get the shared response, got %v", err)
+	}
+	issue, _ := result["issue"].(map[string]interface{})
+	if issue["identifier"] != "LIN-1" {
+		t.Errorf("Expected LIN-1, got %v", result)
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("Expected the server to see 1 request, got %d", got)
+	}
+}
+
+func TestEnhancedClient_DoesNotCoalesceDifferentVariables(t *testing.T) {
+	client, requests, release := newCoalesceTestClient(t)
+
+	query := `query GetIssue($id: String!) { issue(id: $id) { id identifier } }`
+	executeConcurrently(t, client, release, 3, query, func(i int) map[string]interface{} {
+		return map[string]interface{}{"id": []string{"LIN-1", "LIN-2", "LIN-3"}[i]}
+	})
+
+	if got := atomic.LoadInt32(requests); got != 3 {
+		t.Errorf("Expected 3 requests for 3 different issues, got %d", got)
+	}
+}
+
+func TestEnhancedClient_DoesNotCoalesceMutations(t *testing.T) {
+	client, requests, release := newCoalesceTestClient(t)
+
+	query := `mutation UpdateIssue($id: String!) { issueUpdate(id: $id) { success } }`
+	executeConcurrently(t, client, release, 3, query, func(int) map[string]interface{} {
+		return map[string]interface{}{"id": "LIN-1"}
+	})
+
+	if got := atomic.LoadInt32(requests); got != 3 {
+		t.Errorf("Expected every mutation to be sent, got %d requests", got)
+	}
+}
+
+func TestCoalesceKey(t *testing.T) {
+	query := `query GetIssue($id: String!) { issue(id: $id) { id } }`
+
+	a, ok := coalesceKey(query, map[string]interface{}{"id": "LIN-1", "first": 10})
+	b, _ := coalesceKey(query, map[string]interface{}{"first": 10, "id": "LIN-1"})
+	if !ok || a != b {
+		t.Error("Expected equal keys for equal variables")
+	}
+	if c, _ := coalesceKey(query, map[string]interface{}{"id": "LIN-2"}); c == a {
+		t.Error("Expected different keys for different variables")
+	}
+	if _, ok := coalesceKey(`mutation { issueArchive(id: "x") { success } }`, nil); ok {
+		t.Error("Expected mutations not to be coalesced")
+	}
+}
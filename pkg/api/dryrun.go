@@ -0,0 +1,58 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DryRunError is returned in place of executing a mutation while dry-run mode
+// is enabled. It carries the request that would have been sent.
+type DryRunError struct {
+	Operation string
+	Request   GraphQLRequest
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("dry run: %s was not sent", e.Operation)
+}
+
+// SetDryRun enables or disables dry-run mode. In dry-run mode mutations are
+// not sent and return a *DryRunError; queries are still executed so inputs
+// such as team and assignee IDs can be resolved.
+func (c *Client) SetDryRun(enabled bool) {
+	c.dryRun = enabled
+}
+
+// dryRunMutation returns a *DryRunError for documents containing a mutation,
+// or nil for other operations. Comments and fragments before the mutation are
+// skipped, so it cannot slip through and be sent.
+func dryRunMutation(query string, variables map[string]interface{}) error {
+	var mutation *Operation
+	for _, operation := range parseOperations(query) {
+		if operation.Type == "mutation" {
+			mutation = &operation
+			break
+		}
+	}
+	if mutation == nil {
+		return nil
+	}
+
+	operation := "mutation"
+	if mutation.Name != "" {
+		operation = mutation.Name
+	}
+
+	return &DryRunError{
+		Operation: operation,
+		Request: GraphQLRequest{
+			Query:     strings.TrimSpace(query),
+			Variables: variables,
+		},
+	}
+}
+
+// SetDryRun enables or disables dry-run mode, see Client.SetDryRun
+func (c *EnhancedClient) SetDryRun(enabled bool) {
+	c.baseClient.SetDryRun(enabled)
+}
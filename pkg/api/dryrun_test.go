@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"team":{"id":"team-123","key":"ENG"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+	client.SetDryRun(true)
+
+	// Queries still run so inputs can be resolved
+	team, err := client.GetTeam(context.Background(), "ENG")
+	if err != nil || team.ID != "team-123" {
+		t.Fatalf("Expected query to execute in dry-run mode, got %+v, %v", team, err)
+	}
+
+	title := "Dry run issue"
+	_, err = client.CreateIssue(context.Background(), IssueCreateInput{Title: title, TeamID: team.ID})
+
+	var dryRun *DryRunError
+	if !errors.As(err, &dryRun) {
+		t.Fatalf("Expected DryRunError, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected the mutation not to be sent, got %d requests", requests)
+	}
+	if dryRun.Operation != "CreateIssue" {
+		t.Errorf("Expected operation CreateIssue, got %s", dryRun.Operation)
+	}
+	input, ok := dryRun.Request.Variables["input"].(IssueCreateInput)
+	if !ok || input.Title != title || input.TeamID != "team-123" {
+		t.Errorf("Expected captured input, got %+v", dryRun.Request.Variables)
+	}
+}
+
+func TestEnhancedClient_DryRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request in dry-run mode")
+	}))
+	defer server.Close()
+
+	config := DefaultEnhancedClientConfig()
+	config.BaseURL = server.URL
+	client := NewEnhancedClient("test-auth-header", config)
+	client.SetDryRun(true)
+
+	err := client.Execute(context.Background(), `
+		mutation ArchiveIssue($id: String!) { issueArchive(id: $id) { success } }`,
+		map[string]interface{}{"id": "issue-1"}, nil)
+
+	var dryRun *DryRunError
+	if !errors.As(err, &dryRun) || dryRun.Operation != "ArchiveIssue" {
+		t.Fatalf("Expected DryRunError for ArchiveIssue, got %v", err)
+	}
+	if dryRun.Request.Variables["id"] != "issue-1" {
+		t.Errorf("Expected captured variables, got %v", dryRun.Request.Variables)
+	}
+}
+
+func TestDryRunMutation(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		operation string
+	}{
+		{"named mutation", `mutation CreateIssue { issueCreate { success } }`, "CreateIssue"},
+		{"leading comment", "# Archive the issue\nmutation ArchiveIssue { issueArchive(id: \"1\") { success } }", "ArchiveIssue"},
+		{"anonymous mutation", `mutation { issueArchive(id: "1") { success } }`, "mutation"},
+		{"fragment first", "fragment F on Issue { id }\nmutation UpdateIssue { issueUpdate { issue { ...F } } }", "UpdateIssue"},
+		{"query", `query Viewer { viewer { id } }`, ""},
+		{"mutation in a comment", "# mutation Nope\n{ viewer { id } }", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := dryRunMutation(tt.query, nil)
+			if tt.operation == "" {
+				if err != nil {
+					t.Errorf("Expected the query to run, got %v", err)
+				}
+				return
+			}
+
+			var dryRun *DryRunError
+			if !errors.As(err, &dryRun) || dryRun.Operation != tt.operation {
+				t.Errorf("Expected DryRunError for %s, got %v", tt.operation, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package api
+
+import "testing"
+
+func TestSetBaseURL(t *testing.T) {
+	t.Cleanup(func() { SetBaseURL("") })
+
+	if got := Endpoint(); got != BaseURL {
+		t.Errorf("Expected %s by default, got %s", BaseURL, got)
+	}
+
+	SetBaseURL("http://localhost:8080/")
+	if got := Endpoint(); got != "http://localhost:8080/graphql" {
+		t.Errorf("Expected the override endpoint, got %s", got)
+	}
+	if got := NewClient("token").baseURL; got != "http://localhost:8080/graphql" {
+		t.Errorf("Expected new clients to use the override, got %s", got)
+	}
+
+	SetBaseURL("")
+	if got := Endpoint(); got != BaseURL {
+		t.Errorf("Expected %s after clearing the override, got %s", BaseURL, got)
+	}
+}
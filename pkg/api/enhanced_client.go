@@ -4,14 +4,19 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"sync"
 	"time"
 
+	"github.com/nicholls-inc/linctl/pkg/httpclient"
 	"github.com/nicholls-inc/linctl/pkg/logging"
 	"github.com/nicholls-inc/linctl/pkg/ratelimit"
 	"github.com/nicholls-inc/linctl/pkg/resilience"
+	"golang.org/x/sync/singleflight"
 )
 
 // EnhancedClient is a production-ready API client with retry logic and rate limiting
@@ -19,9 +24,16 @@ type EnhancedClient struct {
 	baseClient  *Client
 	retryClient *resilience.RetryableClient
 	rateLimiter *ratelimit.RateLimiter
+	breaker     *resilience.CircuitBreaker
 	logger      logging.Logger
 	requestID   string
+	metricsMu   sync.Mutex
 	metrics     *ClientMetrics
+	tracer      *tracer
+	timeout     time.Duration
+	opTimeout   time.Duration
+	// inflight coalesces concurrent identical reads, see Execute
+	inflight singleflight.Group
 }
 
 // ClientMetrics tracks client performance metrics
@@ -31,25 +43,38 @@ type ClientMetrics struct {
 	RateLimitHits   int64         `json:"rate_limit_hits"`
 	TotalDuration   time.Duration `json:"total_duration"`
 	AverageDuration time.Duration `json:"average_duration"`
+	RetryCount      int64         `json:"retry_count"`
+	RetryWaitTotal  time.Duration `json:"retry_wait_total"`
+	CircuitState    string        `json:"circuit_state,omitempty"`
 }
 
 // EnhancedClientConfig configures the enhanced client
 type EnhancedClientConfig struct {
 	RetryConfig     resilience.RetryConfig    `json:"retry_config"`
 	RateLimitConfig ratelimit.RateLimitConfig `json:"rate_limit_config"`
-	Logger          logging.Logger            `json:"-"`
-	BaseURL         string                    `json:"base_url"`
-	Timeout         time.Duration             `json:"timeout"`
+	// CircuitBreaker is disabled by default; set Enabled to stop requests during outages
+	CircuitBreaker resilience.CircuitBreakerConfig `json:"circuit_breaker"`
+	Logger         logging.Logger                  `json:"-"`
+	BaseURL        string                          `json:"base_url"`
+	// Timeout bounds each HTTP request
+	Timeout time.Duration `json:"timeout"`
+	// OperationTimeout bounds a whole Execute call, including retries; 0 is unbounded
+	OperationTimeout time.Duration `json:"operation_timeout"`
 }
 
-// DefaultEnhancedClientConfig returns a production-ready configuration
+// DefaultEnhancedClientConfig returns a production-ready configuration.
+// An invalid LINCTL_OPERATION_TIMEOUT is rejected when the CLI starts, so it
+// is treated as unset here.
 func DefaultEnhancedClientConfig() EnhancedClientConfig {
+	opTimeout, _ := OperationTimeoutFromEnv()
 	return EnhancedClientConfig{
-		RetryConfig:     resilience.DefaultRetryConfig(),
-		RateLimitConfig: ratelimit.DefaultRateLimitConfig(),
-		Logger:          logging.NewLogger(),
-		BaseURL:         BaseURL,
-		Timeout:         30 * time.Second,
+		RetryConfig:      resilience.DefaultRetryConfig(),
+		RateLimitConfig:  ratelimit.DefaultRateLimitConfig(),
+		CircuitBreaker:   resilience.DefaultCircuitBreakerConfig(),
+		Logger:           logging.NewLogger(),
+		BaseURL:          Endpoint(),
+		Timeout:          httpclient.ConfigFromEnv().Timeout,
+		OperationTimeout: opTimeout,
 	}
 }
 
@@ -59,46 +84,148 @@ func NewEnhancedClient(authHeader string, config EnhancedClientConfig) *Enhanced
 		config.Logger = logging.NewLogger()
 	}
 
-	// Create base HTTP client
-	httpClient := &http.Client{
-		Timeout: config.Timeout,
-	}
+	// Create base HTTP client; proxy and TLS settings come from the environment
+	httpConfig := httpclient.ConfigFromEnv()
+	httpConfig.Timeout = config.Timeout
+	httpClient := httpclient.New(httpConfig)
 
 	// Create retryable client
 	retryClient := resilience.NewRetryableClient(httpClient, config.RetryConfig, config.Logger)
 
-	// Create rate limiter
-	rateLimiter := ratelimit.NewRateLimiter(config.RateLimitConfig, config.Logger)
+	// Create rate limiter, or join the process-wide one when LINCTL_GLOBAL_RATE_LIMIT is set
+	var rateLimiter *ratelimit.RateLimiter
+	if config.RateLimitConfig.Enabled && ratelimit.GlobalEnabled() {
+		rateLimiter = ratelimit.SharedWithConfig(config.RateLimitConfig, config.Logger)
+	} else {
+		rateLimiter = ratelimit.NewRateLimiter(config.RateLimitConfig, config.Logger)
+	}
+
+	// Create circuit breaker if enabled
+	var breaker *resilience.CircuitBreaker
+	if config.CircuitBreaker.Enabled {
+		breaker = resilience.NewCircuitBreaker(config.CircuitBreaker, config.Logger)
+	}
 
 	// Create base client
 	baseClient := NewClientWithURL(config.BaseURL, authHeader)
 
-	return &EnhancedClient{
+	client := &EnhancedClient{
 		baseClient:  baseClient,
 		retryClient: retryClient,
 		rateLimiter: rateLimiter,
+		breaker:     breaker,
 		logger:      config.Logger,
 		requestID:   generateRequestID(),
 		metrics:     &ClientMetrics{},
+		tracer:      tracerFromEnv(),
+		timeout:     config.Timeout,
+		opTimeout:   config.OperationTimeout,
 	}
+	retryClient.OnRetry(func(attempt int, delay time.Duration) {
+		client.recordRetry(delay)
+	})
+
+	return client
 }
 
 // Execute performs a GraphQL request with retry logic and rate limiting
+// When LINCTL_TRACE_FILE is set each request is appended to the trace file.
+// In mock mode requests are answered from LINCTL_MOCK_DIR without network calls.
+// With an operation timeout the whole call, retries included, must finish in
+// time or fail with an *OperationTimeoutError. Concurrent identical queries
+// (same query and variables) share one request; mutations are always sent.
 func (c *EnhancedClient) Execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	if key, ok := coalesceKey(query, variables); ok {
+		return c.executeCoalesced(ctx, key, query, variables, result)
+	}
+	return c.executeWithTimeout(ctx, query, variables, result)
+}
+
+// executeWithTimeout performs one GraphQL operation under the operation timeout, if any
+func (c *EnhancedClient) executeWithTimeout(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	if c.opTimeout <= 0 {
+		return c.execute(ctx, query, variables, result)
+	}
+
+	opCtx, cancel := context.WithTimeout(ctx, c.opTimeout)
+	defer cancel()
+
+	err := c.execute(opCtx, query, variables, result)
+	if err != nil && errors.Is(opCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		return &OperationTimeoutError{Timeout: c.opTimeout, Operation: parseOperation(query).Name}
+	}
+	return err
+}
+
+// execute performs one GraphQL operation under ctx
+func (c *EnhancedClient) execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) (err error) {
+	if c.baseClient.dryRun {
+		if err := dryRunMutation(query, variables); err != nil {
+			return err
+		}
+	}
+	if c.baseClient.mock != nil {
+		return c.baseClient.mock.execute(query, variables, result)
+	}
+
 	start := time.Now()
 
-	// Generate request ID for tracing
+	// Generate request ID for tracing. The correlation ID comes from the
+	// caller and stays the same across retries.
 	requestID := generateRequestID()
+	correlationID := logging.CorrelationIDFromContext(ctx)
 	logger := c.logger.With(logging.String("request_id", requestID))
+	if correlationID != "" {
+		logger = logger.With(logging.String(logging.CorrelationIDField, correlationID))
+	}
+
+	var statusCode int
+	var headers http.Header
+	if c.tracer != nil {
+		operation := parseOperation(query)
+		retriesBefore := c.retryCount()
+		defer func() {
+			record := TraceRecord{
+				Timestamp:     start.UTC(),
+				RequestID:     requestID,
+				CorrelationID: correlationID,
+				QueryType:     operation.Type,
+				Operation:     operation.Name,
+				DurationMS:    time.Since(start).Milliseconds(),
+				StatusCode:    statusCode,
+				Retries:       c.retryCount() - retriesBefore,
+			}
+			if headers != nil {
+				record.Headers = traceHeaders(headers)
+			}
+			if err != nil {
+				record.Error = err.Error()
+			}
+			c.tracer.record(record)
+		}()
+	}
 
 	logger.Debug("Starting GraphQL request",
 		logging.String("query_type", extractQueryType(query)),
 	)
 
-	// Wait for rate limiter
-	if err := c.rateLimiter.Wait(ctx); err != nil {
+	// Fail fast while the circuit breaker is open
+	if c.breaker != nil {
+		if err := c.breaker.Allow(); err != nil {
+			logger.Warn("Circuit breaker open, rejecting request")
+			return err
+		}
+	}
+
+	// Wait for rate limiter, failing fast past --max-wait
+	if err := WaitForRateLimit(ctx, c.rateLimiter); err != nil {
+		c.breakerAbandon()
 		c.recordError()
 		logger.Error("Rate limiter wait failed", logging.Error(err))
+		var waitErr *ratelimit.ErrRateLimitWait
+		if errors.As(err, &waitErr) {
+			return err
+		}
 		return fmt.Errorf("rate limit error: %w", err)
 	}
 
@@ -110,6 +237,7 @@ func (c *EnhancedClient) Execute(ctx context.Context, query string, variables ma
 
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
+		c.breakerAbandon()
 		c.recordError()
 		logger.Error("Failed to marshal request", logging.Error(err))
 		return fmt.Errorf("failed to marshal request: %w", err)
@@ -117,6 +245,7 @@ func (c *EnhancedClient) Execute(ctx context.Context, query string, variables ma
 
 	req, err := http.NewRequestWithContext(ctx, "POST", c.baseClient.baseURL, bytes.NewBuffer(jsonBody))
 	if err != nil {
+		c.breakerAbandon()
 		c.recordError()
 		logger.Error("Failed to create request", logging.Error(err))
 		return fmt.Errorf("failed to create request: %w", err)
@@ -127,23 +256,46 @@ func (c *EnhancedClient) Execute(ctx context.Context, query string, variables ma
 	req.Header.Set("Authorization", c.baseClient.authHeader)
 	req.Header.Set("User-Agent", "linctl/1.0.0")
 	req.Header.Set("X-Request-ID", requestID)
+	if correlationID != "" {
+		req.Header.Set(CorrelationIDHeader, correlationID)
+	}
+	httpclient.SetExtraHeaders(req, c.baseClient.extraHeaders)
+	headers = req.Header
 
 	// Execute with retry logic
 	resp, err := c.retryClient.DoWithRetry(ctx, req)
 	if err != nil {
+		if ctx.Err() != nil {
+			c.breakerAbandon()
+		} else {
+			c.breakerFailure()
+		}
 		c.recordError()
 		duration := time.Since(start)
 		logger.Error("Request failed after retries",
 			logging.Error(err),
 			logging.Duration("total_duration", duration),
 		)
+		var netErr net.Error
+		if ctx.Err() == nil && errors.As(err, &netErr) && netErr.Timeout() {
+			return fmt.Errorf("request failed: per-request HTTP timeout of %s exceeded (%s): %w", c.timeout, httpclient.TimeoutEnvVar, err)
+		}
 		return fmt.Errorf("request failed: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
+	statusCode = resp.StatusCode
 
 	// Update rate limiter with response headers
 	c.rateLimiter.UpdateFromResponse(resp)
 
+	// Only server errors count against the circuit breaker; any other
+	// response means the API is reachable
+	if resp.StatusCode >= http.StatusInternalServerError {
+		c.breakerFailure()
+	} else {
+		c.breakerSuccess()
+	}
+
 	// Handle rate limiting
 	if resp.StatusCode == http.StatusTooManyRequests {
 		c.recordRateLimit()
@@ -155,12 +307,13 @@ func (c *EnhancedClient) Execute(ctx context.Context, query string, variables ma
 		)
 
 		// Wait for the specified delay
+		c.recordRetry(delay)
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-time.After(delay):
-			// Retry the request
-			return c.Execute(ctx, query, variables, result)
+			// Retry the request within the same operation deadline
+			return c.execute(ctx, query, variables, result)
 		}
 	}
 
@@ -179,7 +332,7 @@ func (c *EnhancedClient) Execute(ctx context.Context, query string, variables ma
 			logging.Int("status_code", resp.StatusCode),
 			logging.String("response_body", string(body)),
 		)
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return newHTTPError(resp.StatusCode, body)
 	}
 
 	// Parse GraphQL response
@@ -204,7 +357,7 @@ func (c *EnhancedClient) Execute(ctx context.Context, query string, variables ma
 			)
 		}
 
-		return fmt.Errorf("GraphQL errors: %v", gqlResp.Errors)
+		return newGraphQLError(gqlResp.Errors, gqlResp.Data)
 	}
 
 	// Unmarshal result
@@ -230,10 +383,15 @@ func (c *EnhancedClient) Execute(ctx context.Context, query string, variables ma
 
 // GetMetrics returns current client metrics
 func (c *EnhancedClient) GetMetrics() ClientMetrics {
+	c.metricsMu.Lock()
 	metrics := *c.metrics
+	c.metricsMu.Unlock()
 	if metrics.RequestCount > 0 {
 		metrics.AverageDuration = time.Duration(int64(metrics.TotalDuration) / metrics.RequestCount)
 	}
+	if c.breaker != nil {
+		metrics.CircuitState = c.breaker.State().String()
+	}
 	return metrics
 }
 
@@ -242,23 +400,73 @@ func (c *EnhancedClient) GetRateLimitStatus() map[string]interface{} {
 	return c.rateLimiter.GetStatus()
 }
 
+// GetCircuitBreakerStatus returns current circuit breaker status
+func (c *EnhancedClient) GetCircuitBreakerStatus() map[string]interface{} {
+	if c.breaker == nil {
+		return map[string]interface{}{"enabled": false}
+	}
+	return c.breaker.GetStatus()
+}
+
+// breakerSuccess records a successful request with the circuit breaker, if enabled
+func (c *EnhancedClient) breakerSuccess() {
+	if c.breaker != nil {
+		c.breaker.RecordSuccess()
+	}
+}
+
+// breakerFailure records a failed request with the circuit breaker, if enabled
+func (c *EnhancedClient) breakerFailure() {
+	if c.breaker != nil {
+		c.breaker.RecordFailure()
+	}
+}
+
+// breakerAbandon releases a circuit breaker trial that never reached the API, if enabled
+func (c *EnhancedClient) breakerAbandon() {
+	if c.breaker != nil {
+		c.breaker.Abandon()
+	}
+}
+
 // recordSuccess records a successful request
 func (c *EnhancedClient) recordSuccess(duration time.Duration) {
+	c.metricsMu.Lock()
+	defer c.metricsMu.Unlock()
 	c.metrics.RequestCount++
 	c.metrics.TotalDuration += duration
 }
 
 // recordError records a failed request
 func (c *EnhancedClient) recordError() {
+	c.metricsMu.Lock()
+	defer c.metricsMu.Unlock()
 	c.metrics.RequestCount++
 	c.metrics.ErrorCount++
 }
 
 // recordRateLimit records a rate limit hit
 func (c *EnhancedClient) recordRateLimit() {
+	c.metricsMu.Lock()
+	defer c.metricsMu.Unlock()
 	c.metrics.RateLimitHits++
 }
 
+// recordRetry records a retry and the delay waited before it
+func (c *EnhancedClient) recordRetry(delay time.Duration) {
+	c.metricsMu.Lock()
+	defer c.metricsMu.Unlock()
+	c.metrics.RetryCount++
+	c.metrics.RetryWaitTotal += delay
+}
+
+// retryCount returns the number of retries recorded so far
+func (c *EnhancedClient) retryCount() int64 {
+	c.metricsMu.Lock()
+	defer c.metricsMu.Unlock()
+	return c.metrics.RetryCount
+}
+
 // generateRequestID generates a unique request ID for tracing
 func generateRequestID() string {
 	return fmt.Sprintf("req_%d", time.Now().UnixNano())
@@ -266,16 +474,7 @@ func generateRequestID() string {
 
 // extractQueryType extracts the operation type from a GraphQL query
 func extractQueryType(query string) string {
-	// Simple heuristic to determine query type
-	if len(query) > 20 {
-		prefix := query[:20]
-		if contains(prefix, "mutation") {
-			return "mutation"
-		} else if contains(prefix, "subscription") {
-			return "subscription"
-		}
-	}
-	return "query"
+	return parseOperation(query).Type
 }
 
 // contains checks if a string contains a substring (case-insensitive)
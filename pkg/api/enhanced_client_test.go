@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
 	"github.com/nicholls-inc/linctl/pkg/resilience"
 )
 
@@ -42,6 +44,26 @@ func TestNewEnhancedClient(t *testing.T) {
 	}
 }
 
+func TestNewEnhancedClient_GlobalRateLimit(t *testing.T) {
+	ratelimit.ResetShared()
+	t.Cleanup(ratelimit.ResetShared)
+
+	config := DefaultEnhancedClientConfig()
+
+	first := NewEnhancedClient("test-auth", config)
+	second := NewEnhancedClient("test-auth", config)
+	if first.rateLimiter == second.rateLimiter {
+		t.Error("Expected independent limiters without LINCTL_GLOBAL_RATE_LIMIT")
+	}
+
+	t.Setenv(ratelimit.GlobalRateLimitEnvVar, "true")
+	first = NewEnhancedClient("test-auth", config)
+	second = NewEnhancedClient("test-auth", config)
+	if first.rateLimiter != second.rateLimiter || first.rateLimiter != ratelimit.Shared() {
+		t.Error("Expected clients to share ratelimit.Shared() with LINCTL_GLOBAL_RATE_LIMIT=true")
+	}
+}
+
 func TestNewEnhancedClientWithNilLogger(t *testing.T) {
 	config := DefaultEnhancedClientConfig()
 	config.Logger = nil
@@ -81,6 +103,14 @@ func TestDefaultEnhancedClientConfig(t *testing.T) {
 	}
 }
 
+func TestDefaultEnhancedClientConfig_TimeoutFromEnv(t *testing.T) {
+	t.Setenv("LINCTL_HTTP_TIMEOUT", "90s")
+
+	if timeout := DefaultEnhancedClientConfig().Timeout; timeout != 90*time.Second {
+		t.Errorf("Expected LINCTL_HTTP_TIMEOUT to set the timeout, got %v", timeout)
+	}
+}
+
 func TestEnhancedClient_ExecuteSuccess(t *testing.T) {
 	// Create a test server that returns successful GraphQL response
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -141,6 +171,38 @@ func TestEnhancedClient_ExecuteSuccess(t *testing.T) {
 	}
 }
 
+func TestEnhancedClient_ExtraHeaders(t *testing.T) {
+	t.Setenv("LINCTL_EXTRA_HEADERS", "X-Team: platform, X-Env: prod")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Team"); got != "platform" {
+			t.Errorf("Expected X-Team platform, got %q", got)
+		}
+		if got := r.Header.Get("X-Env"); got != "prod" {
+			t.Errorf("Expected X-Env prod, got %q", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "test-auth" {
+			t.Errorf("Expected Authorization test-auth, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"viewer":{"id":"123"}}}`))
+	}))
+	defer server.Close()
+
+	config := DefaultEnhancedClientConfig()
+	config.BaseURL = server.URL
+	config.Logger = logging.NewNoOpLogger()
+
+	var result map[string]interface{}
+	if err := NewEnhancedClient("test-auth", config).Execute(context.Background(), `query { viewer { id } }`, nil, &result); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if err := NewClientWithURL(server.URL, "test-auth").Execute(context.Background(), `query { viewer { id } }`, nil, &result); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+}
+
 func TestEnhancedClient_ExecuteGraphQLError(t *testing.T) {
 	// Create a test server that returns GraphQL errors
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -174,6 +236,14 @@ func TestEnhancedClient_ExecuteGraphQLError(t *testing.T) {
 	if !strings.Contains(err.Error(), "GraphQL errors") {
 		t.Errorf("Expected GraphQL errors in error message, got: %v", err)
 	}
+
+	var gqlErrs GraphQLErrors
+	if !errors.As(err, &gqlErrs) {
+		t.Fatalf("Expected GraphQLErrors, got %T", err)
+	}
+	if len(gqlErrs) != 2 || gqlErrs[0].Message != "Test error 1" {
+		t.Errorf("Expected both GraphQL errors to be preserved, got %v", gqlErrs)
+	}
 }
 
 func TestEnhancedClient_ExecuteHTTPError(t *testing.T) {
@@ -313,6 +383,14 @@ func TestEnhancedClient_ExecuteRetry(t *testing.T) {
 		t.Errorf("Expected 3 attempts (2 failures then success), got %d", attempts)
 	}
 
+	metrics := client.GetMetrics()
+	if metrics.RetryCount != 2 {
+		t.Errorf("Expected 2 retries in metrics, got %d", metrics.RetryCount)
+	}
+	if metrics.RetryWaitTotal != 30*time.Millisecond {
+		t.Errorf("Expected 30ms retry wait (10ms + 20ms), got %v", metrics.RetryWaitTotal)
+	}
+
 	// Verify result
 	viewer, ok := result["viewer"].(map[string]interface{})
 	if !ok {
@@ -324,6 +402,74 @@ func TestEnhancedClient_ExecuteRetry(t *testing.T) {
 	}
 }
 
+func TestEnhancedClient_CorrelationID(t *testing.T) {
+	var correlationIDs, requestIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		correlationIDs = append(correlationIDs, r.Header.Get(CorrelationIDHeader))
+		requestIDs = append(requestIDs, r.Header.Get("X-Request-ID"))
+		if len(correlationIDs) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(GraphQLResponse{Data: json.RawMessage(`{"viewer":{"id":"123"}}`)})
+	}))
+	defer server.Close()
+
+	var logs strings.Builder
+	config := DefaultEnhancedClientConfig()
+	config.BaseURL = server.URL
+	config.Logger = logging.NewLoggerWithConfig(logging.DebugLevel, "json", &logs)
+	config.RetryConfig = resilience.RetryConfig{MaxAttempts: 2, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond, Multiplier: 1}
+
+	client := NewEnhancedClient("test-auth", config)
+	ctx := logging.WithCorrelationID(context.Background(), "trace-123")
+	var result map[string]interface{}
+	if err := client.Execute(ctx, `query { viewer { id } }`, nil, &result); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+
+	if len(correlationIDs) != 2 {
+		t.Fatalf("Expected a retry, got %d attempts", len(correlationIDs))
+	}
+	for i, id := range correlationIDs {
+		if id != "trace-123" {
+			t.Errorf("Attempt %d: expected X-Correlation-ID trace-123, got %q", i+1, id)
+		}
+	}
+	if requestIDs[0] == "" || requestIDs[0] != requestIDs[1] {
+		t.Errorf("Expected retries to keep the X-Request-ID, got %v", requestIDs)
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		if strings.Contains(line, "request") && !strings.Contains(line, `"correlation_id":"trace-123"`) {
+			t.Errorf("Expected the correlation ID on every request log line, got %s", line)
+		}
+	}
+}
+
+func TestClient_CorrelationIDHeader(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(CorrelationIDHeader)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(GraphQLResponse{Data: json.RawMessage(`{}`)})
+	}))
+	defer server.Close()
+
+	logging.SetCorrelationID("run-42")
+	defer logging.SetCorrelationID("")
+
+	var result map[string]interface{}
+	if err := NewClientWithURL(server.URL, "test-auth").Execute(context.Background(), `query { viewer { id } }`, nil, &result); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if got != "run-42" {
+		t.Errorf("Expected the process correlation ID in X-Correlation-ID, got %q", got)
+	}
+}
+
 func TestEnhancedClient_ExecuteContextCancellation(t *testing.T) {
 	// Create a test server that takes a long time to respond
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -356,6 +502,112 @@ func TestEnhancedClient_ExecuteContextCancellation(t *testing.T) {
 	}
 }
 
+func TestEnhancedClient_OperationTimeout(t *testing.T) {
+	// The server is slower than the operation timeout but well within the transport timeout
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(500 * time.Millisecond):
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := DefaultEnhancedClientConfig()
+	config.BaseURL = server.URL
+	config.Logger = logging.NewNoOpLogger()
+	config.Timeout = 5 * time.Second
+	config.OperationTimeout = 50 * time.Millisecond
+
+	client := NewEnhancedClient("test-auth", config)
+
+	start := time.Now()
+	err := client.Execute(context.Background(), `query Slow { viewer { id } }`, nil, nil)
+	if elapsed := time.Since(start); elapsed > 400*time.Millisecond {
+		t.Errorf("Expected the operation timeout to end the call early, took %v", elapsed)
+	}
+
+	var opErr *OperationTimeoutError
+	if !errors.As(err, &opErr) {
+		t.Fatalf("Expected *OperationTimeoutError, got %T: %v", err, err)
+	}
+	if opErr.Timeout != 50*time.Millisecond || opErr.Operation != "Slow" {
+		t.Errorf("Unexpected operation timeout error: %+v", opErr)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Error("Expected the error to match context.DeadlineExceeded")
+	}
+	if !strings.Contains(err.Error(), "LINCTL_OPERATION_TIMEOUT") || !strings.Contains(err.Error(), "not the per-request HTTP timeout") {
+		t.Errorf("Expected the error to name the operation timeout, got %q", err.Error())
+	}
+}
+
+func TestEnhancedClient_TransportTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(500 * time.Millisecond):
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := DefaultEnhancedClientConfig()
+	config.BaseURL = server.URL
+	config.Logger = logging.NewNoOpLogger()
+	config.Timeout = 50 * time.Millisecond
+	config.OperationTimeout = 5 * time.Second
+	config.RetryConfig.MaxAttempts = 1
+
+	client := NewEnhancedClient("test-auth", config)
+
+	err := client.Execute(context.Background(), `query { viewer { id } }`, nil, nil)
+	if err == nil {
+		t.Fatal("Expected a transport timeout")
+	}
+
+	var opErr *OperationTimeoutError
+	if errors.As(err, &opErr) {
+		t.Fatalf("Expected a transport timeout, not an operation timeout: %v", err)
+	}
+	if !strings.Contains(err.Error(), "per-request HTTP timeout") {
+		t.Errorf("Expected the error to name the per-request HTTP timeout, got %q", err.Error())
+	}
+}
+
+func TestParseOperationTimeout(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "", want: 0},
+		{value: "90s", want: 90 * time.Second},
+		{value: "2m", want: 2 * time.Minute},
+		{value: "0s", wantErr: true},
+		{value: "-1s", wantErr: true},
+		{value: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseOperationTimeout(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseOperationTimeout(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ParseOperationTimeout(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultEnhancedClientConfig_OperationTimeoutFromEnv(t *testing.T) {
+	t.Setenv("LINCTL_OPERATION_TIMEOUT", "2m")
+
+	if timeout := DefaultEnhancedClientConfig().OperationTimeout; timeout != 2*time.Minute {
+		t.Errorf("Expected LINCTL_OPERATION_TIMEOUT to set the operation timeout, got %v", timeout)
+	}
+}
+
 func TestEnhancedClient_GetMetrics(t *testing.T) {
 	config := DefaultEnhancedClientConfig()
 	config.Logger = logging.NewNoOpLogger()
@@ -706,3 +958,55 @@ func BenchmarkGenerateRequestID(b *testing.B) {
 		generateRequestID()
 	}
 }
+
+func TestEnhancedClient_CircuitBreaker(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	config := DefaultEnhancedClientConfig()
+	config.BaseURL = server.URL
+	config.Logger = logging.NewNoOpLogger()
+	config.RetryConfig.MaxAttempts = 1
+	config.CircuitBreaker.Enabled = true
+	config.CircuitBreaker.Threshold = 2
+	config.CircuitBreaker.Cooldown = time.Hour
+
+	client := NewEnhancedClient("test-auth", config)
+	query := `query { viewer { id } }`
+
+	for i := 0; i < 2; i++ {
+		if err := client.Execute(context.Background(), query, nil, nil); err == nil {
+			t.Fatal("Expected server error")
+		}
+	}
+
+	err := client.Execute(context.Background(), query, nil, nil)
+	if !errors.Is(err, resilience.ErrCircuitOpen) {
+		t.Fatalf("Expected ErrCircuitOpen, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected open breaker to skip the request, server saw %d calls", calls)
+	}
+
+	if state := client.GetMetrics().CircuitState; state != "open" {
+		t.Errorf("Expected circuit_state open in metrics, got %q", state)
+	}
+	if status := client.GetCircuitBreakerStatus(); status["state"] != "open" {
+		t.Errorf("Expected open state in status, got %v", status["state"])
+	}
+}
+
+func TestEnhancedClient_CircuitBreakerDisabledByDefault(t *testing.T) {
+	client := NewEnhancedClient("test-auth", DefaultEnhancedClientConfig())
+
+	if client.GetMetrics().CircuitState != "" {
+		t.Error("Expected no circuit state when breaker is disabled")
+	}
+	if status := client.GetCircuitBreakerStatus(); status["enabled"] != false {
+		t.Errorf("Expected disabled breaker status, got %v", status)
+	}
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrorCategory classifies an API failure for programmatic handling
+type ErrorCategory string
+
+const (
+	CategoryNotFound     ErrorCategory = "not_found"
+	CategoryUnauthorized ErrorCategory = "unauthorized"
+	CategoryForbidden    ErrorCategory = "forbidden"
+	CategoryRateLimited  ErrorCategory = "rate_limited"
+	CategoryValidation   ErrorCategory = "validation"
+	CategoryUnknown      ErrorCategory = "unknown"
+)
+
+// APIError is returned by Execute when Linear rejects a request, either with a
+// non-200 status or with GraphQL errors in the response
+type APIError struct {
+	// Category is derived from the HTTP status, error extensions and messages
+	Category ErrorCategory
+	// StatusCode is the HTTP status; GraphQL errors usually arrive with 200
+	StatusCode int
+	// Errors holds the GraphQL errors from the response body, if any
+	Errors GraphQLErrors
+	// Body is the raw response body of a non-200 response
+	Body string
+	// Data is the partial data returned alongside GraphQL errors, if any
+	Data json.RawMessage
+}
+
+// Error keeps the messages of the plain errors Execute used to return
+func (e *APIError) Error() string {
+	if e.StatusCode != 0 && e.StatusCode != http.StatusOK {
+		return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+	}
+	return e.Errors.Error()
+}
+
+// Unwrap exposes the GraphQL errors so errors.As(err, &GraphQLErrors{}) keeps working
+func (e *APIError) Unwrap() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e.Errors
+}
+
+// Retryable reports whether repeating the request may succeed
+func (e *APIError) Retryable() bool {
+	return e.Category == CategoryRateLimited || e.StatusCode >= 500
+}
+
+// ErrorCategoryOf returns the category of err, or CategoryUnknown when it is not an APIError
+func ErrorCategoryOf(err error) ErrorCategory {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Category
+	}
+	return CategoryUnknown
+}
+
+// newHTTPError builds the APIError for a non-200 response. Linear reports most
+// failures as GraphQL errors even then, so the body is parsed for them.
+func newHTTPError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode, Body: string(body)}
+
+	var gqlResp GraphQLResponse
+	if json.Unmarshal(body, &gqlResp) == nil && len(gqlResp.Errors) > 0 {
+		apiErr.Errors = GraphQLErrors(gqlResp.Errors)
+	}
+
+	apiErr.Category = categorizeError(statusCode, apiErr.Errors)
+	return apiErr
+}
+
+// newGraphQLError builds the APIError for GraphQL errors in a 200 response,
+// keeping any partial data that came with them
+func newGraphQLError(errs []GraphQLError, data json.RawMessage) *APIError {
+	apiErr := &APIError{
+		Category: categorizeError(http.StatusOK, errs),
+		Errors:   GraphQLErrors(errs),
+	}
+	if len(data) > 0 && string(data) != "null" {
+		apiErr.Data = data
+	}
+	return apiErr
+}
+
+// categorizeError derives a category from the error extensions, then the
+// messages, then the HTTP status
+func categorizeError(statusCode int, errs []GraphQLError) ErrorCategory {
+	for _, gqlErr := range errs {
+		if category := categoryFromExtensions(gqlErr.Extensions); category != CategoryUnknown {
+			return category
+		}
+	}
+	for _, gqlErr := range errs {
+		if category := categoryFromMessage(gqlErr.Message); category != CategoryUnknown {
+			return category
+		}
+	}
+
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return CategoryUnauthorized
+	case http.StatusForbidden:
+		return CategoryForbidden
+	case http.StatusNotFound:
+		return CategoryNotFound
+	case http.StatusTooManyRequests:
+		return CategoryRateLimited
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return CategoryValidation
+	}
+	return CategoryUnknown
+}
+
+// categoryFromExtensions maps Linear's extensions.code and extensions.type values
+func categoryFromExtensions(extensions map[string]interface{}) ErrorCategory {
+	for _, field := range []string{"code", "type"} {
+		value, _ := extensions[field].(string)
+		switch strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_").Replace(value)) {
+		case "":
+		case "RATELIMITED", "RATE_LIMITED":
+			return CategoryRateLimited
+		case "AUTHENTICATION_ERROR", "UNAUTHENTICATED", "UNAUTHORIZED":
+			return CategoryUnauthorized
+		case "FORBIDDEN":
+			return CategoryForbidden
+		case "ENTITY_NOT_FOUND", "NOT_FOUND":
+			return CategoryNotFound
+		case "INVALID_INPUT", "INPUT_ERROR", "BAD_USER_INPUT", "GRAPHQL_VALIDATION_FAILED", "GRAPHQL_PARSE_FAILED":
+			return CategoryValidation
+		}
+	}
+	return CategoryUnknown
+}
+
+// categoryFromMessage falls back to the wording of the message
+func categoryFromMessage(message string) ErrorCategory {
+	message = strings.ToLower(message)
+	switch {
+	case strings.Contains(message, "rate limit"), strings.Contains(message, "ratelimit"):
+		return CategoryRateLimited
+	case strings.Contains(message, "forbidden"):
+		return CategoryForbidden
+	case strings.Contains(message, "not authenticated"), strings.Contains(message, "authentication"),
+		strings.Contains(message, "unauthorized"):
+		return CategoryUnauthorized
+	case strings.Contains(message, "not found"), strings.Contains(message, "could not find"):
+		return CategoryNotFound
+	case strings.Contains(message, "invalid"), strings.Contains(message, "validation"),
+		strings.Contains(message, "argument"), strings.Contains(message, "must be"):
+		return CategoryValidation
+	}
+	return CategoryUnknown
+}
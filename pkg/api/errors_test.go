@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCategorizeError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		errs       []GraphQLError
+		expected   ErrorCategory
+	}{
+		{"extension code", 200, []GraphQLError{{Message: "Something", Extensions: map[string]interface{}{"code": "RATELIMITED"}}}, CategoryRateLimited},
+		{"extension type", 200, []GraphQLError{{Message: "Something", Extensions: map[string]interface{}{"type": "authentication error"}}}, CategoryUnauthorized},
+		{"input error", 200, []GraphQLError{{Message: "Argument Validation Error", Extensions: map[string]interface{}{"code": "INPUT_ERROR"}}}, CategoryValidation},
+		{"entity not found message", 200, []GraphQLError{{Message: "Entity not found"}}, CategoryNotFound},
+		{"could not find message", 200, []GraphQLError{{Message: "Could not find referenced Issue."}}, CategoryNotFound},
+		{"rate limit message", 200, []GraphQLError{{Message: "Rate limit exceeded"}}, CategoryRateLimited},
+		{"invalid message", 200, []GraphQLError{{Message: "Variable \"$id\" got invalid value"}}, CategoryValidation},
+		{"extensions win over message", 200, []GraphQLError{{Message: "not found", Extensions: map[string]interface{}{"code": "FORBIDDEN"}}}, CategoryForbidden},
+		{"forbidden message", 200, []GraphQLError{{Message: "Forbidden: you do not have access to this team"}}, CategoryForbidden},
+		{"status 401", 401, nil, CategoryUnauthorized},
+		{"status 403", 403, nil, CategoryForbidden},
+		{"status 429", 429, nil, CategoryRateLimited},
+		{"status 400", 400, nil, CategoryValidation},
+		{"status 500", 500, nil, CategoryUnknown},
+		{"unrecognised", 200, []GraphQLError{{Message: "Something went wrong"}}, CategoryUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := categorizeError(tt.statusCode, tt.errs); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestExecuteReturnsAPIError(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         map[string]interface{}
+		category     ErrorCategory
+		retryable    bool
+		messagePart  string
+		wantGraphQL  bool
+		wantHTTPCode int
+	}{
+		{
+			name:        "graphql not found",
+			status:      http.StatusOK,
+			body:        map[string]interface{}{"errors": []map[string]interface{}{{"message": "Entity not found"}}},
+			category:    CategoryNotFound,
+			messagePart: "GraphQL errors",
+			wantGraphQL: true,
+		},
+		{
+			name:   "rate limited with status",
+			status: http.StatusBadRequest,
+			body: map[string]interface{}{"errors": []map[string]interface{}{{
+				"message":    "Rate limit exceeded",
+				"extensions": map[string]interface{}{"code": "RATELIMITED"},
+			}}},
+			category:     CategoryRateLimited,
+			retryable:    true,
+			messagePart:  "API request failed with status 400",
+			wantGraphQL:  true,
+			wantHTTPCode: http.StatusBadRequest,
+		},
+		{
+			name:         "server error",
+			status:       http.StatusBadGateway,
+			body:         map[string]interface{}{"message": "bad gateway"},
+			category:     CategoryUnknown,
+			retryable:    true,
+			messagePart:  "API request failed with status 502",
+			wantHTTPCode: http.StatusBadGateway,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				json.NewEncoder(w).Encode(tt.body)
+			}))
+			defer server.Close()
+
+			client := NewClientWithURL(server.URL, "test-auth-header")
+			_, err := client.GetIssue(context.Background(), "ENG-1")
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("Expected *APIError, got %T: %v", err, err)
+			}
+			if apiErr.Category != tt.category || ErrorCategoryOf(err) != tt.category {
+				t.Errorf("Expected category %s, got %s", tt.category, apiErr.Category)
+			}
+			if apiErr.Retryable() != tt.retryable {
+				t.Errorf("Expected retryable %v, got %v", tt.retryable, apiErr.Retryable())
+			}
+			if apiErr.StatusCode != tt.wantHTTPCode && tt.wantHTTPCode != 0 {
+				t.Errorf("Expected status %d, got %d", tt.wantHTTPCode, apiErr.StatusCode)
+			}
+			if !strings.Contains(err.Error(), tt.messagePart) {
+				t.Errorf("Expected message to contain %q, got %q", tt.messagePart, err.Error())
+			}
+
+			var gqlErrs GraphQLErrors
+			if errors.As(err, &gqlErrs) != tt.wantGraphQL {
+				t.Errorf("Expected errors.As GraphQLErrors = %v", tt.wantGraphQL)
+			}
+		})
+	}
+}
+
+func TestErrorCategoryOfOtherErrors(t *testing.T) {
+	if got := ErrorCategoryOf(errors.New("request failed: timeout")); got != CategoryUnknown {
+		t.Errorf("Expected unknown for non-API errors, got %s", got)
+	}
+	if got := ErrorCategoryOf(nil); got != CategoryUnknown {
+		t.Errorf("Expected unknown for nil, got %s", got)
+	}
+}
+
+func TestNewGraphQLErrorKeepsPartialData(t *testing.T) {
+	errs := []GraphQLError{{Message: "Entity not found"}}
+
+	apiErr := newGraphQLError(errs, json.RawMessage(`{"viewer":{"id":"user-1"},"issue":null}`))
+	if string(apiErr.Data) != `{"viewer":{"id":"user-1"},"issue":null}` {
+		t.Errorf("Expected the partial data to be kept, got %s", apiErr.Data)
+	}
+
+	for _, data := range []json.RawMessage{nil, json.RawMessage("null")} {
+		if apiErr := newGraphQLError(errs, data); apiErr.Data != nil {
+			t.Errorf("Expected no data for %q, got %s", data, apiErr.Data)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+)
+
+// maxRateLimitWait is the longest any rate limiter wait may take; 0 is unbounded
+var maxRateLimitWait time.Duration
+
+// SetMaxRateLimitWait bounds every rate limiter wait made by the API layer (the
+// --max-wait flag). A wait that would take longer fails immediately with a
+// *ratelimit.ErrRateLimitWait. 0 waits as long as needed.
+func SetMaxRateLimitWait(d time.Duration) {
+	maxRateLimitWait = d
+}
+
+// WaitForRateLimit waits on limiter for at most the duration set with SetMaxRateLimitWait
+func WaitForRateLimit(ctx context.Context, limiter *ratelimit.RateLimiter) error {
+	return limiter.WaitWithTimeout(ctx, maxRateLimitWait)
+}
+
+// boundedWaiter is a PageWaiter that honors SetMaxRateLimitWait
+type boundedWaiter struct {
+	limiter *ratelimit.RateLimiter
+}
+
+// Wait implements PageWaiter
+func (w boundedWaiter) Wait(ctx context.Context) error {
+	return WaitForRateLimit(ctx, w.limiter)
+}
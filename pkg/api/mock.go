@@ -0,0 +1,119 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// MockDirEnvVar names the directory of canned responses used in mock mode
+const MockDirEnvVar = "LINCTL_MOCK_DIR"
+
+// MockAuthHeader is the credential reported in mock mode, where no login is needed
+const MockAuthHeader = "mock"
+
+// mockRecordFile is the file in the mock directory that mutation inputs are appended to
+const mockRecordFile = "mutations.jsonl"
+
+// mockEnabled is set by EnableMockMode; LINCTL_MOCK_DIR alone never turns mock mode on
+var mockEnabled bool
+
+// EnableMockMode acknowledges mock mode (the --mock flag). Clients created
+// afterwards serve every request from LINCTL_MOCK_DIR instead of Linear.
+func EnableMockMode() {
+	mockEnabled = true
+}
+
+// MockModeEnabled reports whether mock mode is acknowledged and LINCTL_MOCK_DIR is set
+func MockModeEnabled() bool {
+	return mockEnabled && os.Getenv(MockDirEnvVar) != ""
+}
+
+// MockRecord is one mutation received in mock mode, appended to mutations.jsonl
+type MockRecord struct {
+	Timestamp time.Time              `json:"timestamp"`
+	Operation string                 `json:"operation"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// mockTransport answers requests from <dir>/<OperationName>.json. Each file holds
+// a GraphQL response body: {"data": {...}} or {"errors": [...]}.
+type mockTransport struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// mockFromEnv returns the mock transport when mock mode is enabled
+func mockFromEnv() *mockTransport {
+	if !MockModeEnabled() {
+		return nil
+	}
+	return &mockTransport{dir: os.Getenv(MockDirEnvVar)}
+}
+
+// execute serves a request from the mock directory, recording mutation variables first
+func (m *mockTransport) execute(query string, variables map[string]interface{}, result interface{}) error {
+	operation := parseOperation(query)
+	if operation.Name == "" {
+		return fmt.Errorf("mock mode requires named operations")
+	}
+
+	if operation.Type == "mutation" {
+		if err := m.record(operation.Name, variables); err != nil {
+			return err
+		}
+	}
+
+	path := filepath.Join(m.dir, operation.Name+".json")
+	body, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("no mock response for %s %s: create %s", operation.Type, operation.Name, path)
+		}
+		return fmt.Errorf("failed to read mock response: %w", err)
+	}
+
+	var gqlResp GraphQLResponse
+	if err := json.Unmarshal(body, &gqlResp); err != nil {
+		return fmt.Errorf("invalid mock response %s: %w", path, err)
+	}
+	if len(gqlResp.Errors) > 0 {
+		return newGraphQLError(gqlResp.Errors, gqlResp.Data)
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(gqlResp.Data, result); err != nil {
+			return fmt.Errorf("failed to unmarshal mock data from %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// record appends a mutation's variables to mutations.jsonl for later assertions
+func (m *mockTransport) record(operation string, variables map[string]interface{}) error {
+	line, err := json.Marshal(MockRecord{
+		Timestamp: time.Now().UTC(),
+		Operation: operation,
+		Variables: variables,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode mock record: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := os.OpenFile(filepath.Join(m.dir, mockRecordFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to record mock mutation: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to record mock mutation: %w", err)
+	}
+	return nil
+}
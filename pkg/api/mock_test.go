@@ -0,0 +1,146 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// enableMockMode turns on mock mode for dir until the test ends
+func enableMockMode(t *testing.T, dir string) {
+	t.Helper()
+	t.Setenv(MockDirEnvVar, dir)
+	EnableMockMode()
+	t.Cleanup(func() { mockEnabled = false })
+}
+
+func writeMockResponse(t *testing.T, dir, operation, body string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, operation+".json"), []byte(body), 0600); err != nil {
+		t.Fatalf("Failed to write mock response: %v", err)
+	}
+}
+
+func TestMockModeRequiresAcknowledgement(t *testing.T) {
+	t.Setenv(MockDirEnvVar, t.TempDir())
+
+	if MockModeEnabled() {
+		t.Fatal("Expected LINCTL_MOCK_DIR alone not to enable mock mode")
+	}
+	if NewClient("test-auth-header").mock != nil {
+		t.Fatal("Expected clients to use the network without acknowledgement")
+	}
+
+	EnableMockMode()
+	t.Cleanup(func() { mockEnabled = false })
+
+	if !MockModeEnabled() {
+		t.Fatal("Expected mock mode once acknowledged")
+	}
+
+	t.Setenv(MockDirEnvVar, "")
+	if MockModeEnabled() {
+		t.Error("Expected mock mode to require LINCTL_MOCK_DIR")
+	}
+}
+
+func TestMockClient_ServesCannedResponse(t *testing.T) {
+	dir := t.TempDir()
+	enableMockMode(t, dir)
+	writeMockResponse(t, dir, "Issue", `{"data": {"issue": {"id": "issue-1", "identifier": "ENG-1", "title": "Canned"}}}`)
+
+	issue, err := NewClient("test-auth-header").GetIssue(context.Background(), "ENG-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if issue.Identifier != "ENG-1" || issue.Title != "Canned" {
+		t.Errorf("Expected canned issue, got %+v", issue)
+	}
+
+	// The enhanced client answers from the same directory
+	var data map[string]interface{}
+	enhanced := NewEnhancedClient("test-auth-header", DefaultEnhancedClientConfig())
+	if err := enhanced.Execute(context.Background(), `query Issue { issue(id: "ENG-1") { id } }`, nil, &data); err != nil {
+		t.Fatalf("Unexpected enhanced client error: %v", err)
+	}
+	if _, ok := data["issue"]; !ok {
+		t.Errorf("Expected canned data from the enhanced client, got %v", data)
+	}
+}
+
+func TestMockClient_RecordsMutations(t *testing.T) {
+	dir := t.TempDir()
+	enableMockMode(t, dir)
+	writeMockResponse(t, dir, "CreateComment", `{"data": {"commentCreate": {"comment": {"id": "comment-1", "body": "Hi"}}}}`)
+
+	client := NewClient("test-auth-header")
+	for _, body := range []string{"Hi", "Again"} {
+		if _, err := client.CreateComment(context.Background(), CommentCreateInput{IssueID: "ENG-1", Body: body}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	file, err := os.Open(filepath.Join(dir, mockRecordFile))
+	if err != nil {
+		t.Fatalf("Expected mutations to be recorded: %v", err)
+	}
+	defer file.Close()
+
+	var records []MockRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record MockRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Invalid record: %v", err)
+		}
+		records = append(records, record)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+	input := records[1].Variables["input"].(map[string]interface{})
+	if records[1].Operation != "CreateComment" || input["body"] != "Again" {
+		t.Errorf("Unexpected record %+v", records[1])
+	}
+}
+
+func TestMockClient_QueriesAreNotRecorded(t *testing.T) {
+	dir := t.TempDir()
+	enableMockMode(t, dir)
+	writeMockResponse(t, dir, "Issue", `{"data": {"issue": {"id": "issue-1"}}}`)
+
+	if _, err := NewClient("test-auth-header").GetIssue(context.Background(), "ENG-1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, mockRecordFile)); !os.IsNotExist(err) {
+		t.Error("Expected queries not to be recorded")
+	}
+}
+
+func TestMockClient_Errors(t *testing.T) {
+	dir := t.TempDir()
+	enableMockMode(t, dir)
+	writeMockResponse(t, dir, "Issue", `{"errors": [{"message": "Entity not found"}]}`)
+	client := NewClient("test-auth-header")
+
+	_, err := client.GetIssue(context.Background(), "ENG-404")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Category != CategoryNotFound {
+		t.Errorf("Expected canned not_found APIError, got %v", err)
+	}
+
+	_, err = client.GetTeam(context.Background(), "ENG")
+	if err == nil || !strings.Contains(err.Error(), "Team.json") {
+		t.Errorf("Expected missing response error naming the file, got %v", err)
+	}
+
+	if err := client.Execute(context.Background(), `{ viewer { id } }`, nil, nil); err == nil {
+		t.Error("Expected anonymous operations to be rejected")
+	}
+}
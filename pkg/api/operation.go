@@ -0,0 +1,119 @@
+package api
+
+import "strings"
+
+// Operation is a top-level operation definition in a GraphQL document
+type Operation struct {
+	// Type is "query", "mutation" or "subscription"
+	Type string
+	// Name is the operation name, or "" for anonymous operations
+	Name string
+}
+
+// parseOperations returns the top-level operation definitions in a GraphQL document.
+// Fragment definitions are skipped, and a bare selection set ({ ... }) is an anonymous query.
+// It only tokenizes as far as needed to find definitions; it does not validate the document.
+func parseOperations(query string) []Operation {
+	var operations []Operation
+	var pending *Operation
+	inFragment := false
+	expectName := false
+	depth := 0
+
+	for i := 0; i < len(query); {
+		ch := query[i]
+
+		switch {
+		case ch == '#':
+			// Comment until end of line
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+			continue
+		case ch == '"':
+			i = skipString(query, i)
+			continue
+		case ch == '{' || ch == '(' || ch == '[':
+			if depth == 0 && ch == '{' {
+				if pending != nil {
+					operations = append(operations, *pending)
+				} else if !inFragment {
+					operations = append(operations, Operation{Type: "query"})
+				}
+				pending = nil
+				inFragment = false
+			}
+			expectName = false
+			depth++
+		case ch == '}' || ch == ')' || ch == ']':
+			if depth > 0 {
+				depth--
+			}
+		case isNameStart(ch):
+			start := i
+			for i < len(query) && isNameChar(query[i]) {
+				i++
+			}
+			if depth == 0 {
+				word := query[start:i]
+				if expectName {
+					pending.Name = word
+					expectName = false
+				} else if pending == nil && !inFragment {
+					switch lower := strings.ToLower(word); lower {
+					case "query", "mutation", "subscription":
+						pending = &Operation{Type: lower}
+						expectName = true
+					case "fragment":
+						inFragment = true
+					}
+				}
+			}
+			continue
+		case ch != ' ' && ch != '\t' && ch != '\n' && ch != '\r' && ch != ',':
+			// Punctuation such as @ or : ends the position where a name may appear
+			expectName = false
+		}
+		i++
+	}
+
+	return operations
+}
+
+// parseOperation returns the first top-level operation in a GraphQL document,
+// defaulting to an anonymous query when none is found
+func parseOperation(query string) Operation {
+	if operations := parseOperations(query); len(operations) > 0 {
+		return operations[0]
+	}
+	return Operation{Type: "query"}
+}
+
+// skipString returns the index just past the string literal starting at i,
+// handling both "..." and """block""" strings
+func skipString(query string, i int) int {
+	if strings.HasPrefix(query[i:], `"""`) {
+		if end := strings.Index(query[i+3:], `"""`); end >= 0 {
+			return i + 3 + end + 3
+		}
+		return len(query)
+	}
+
+	for i++; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			i++
+		case '"', '\n':
+			return i + 1
+		}
+	}
+	return len(query)
+}
+
+func isNameStart(ch byte) bool {
+	return ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
+}
+
+func isNameChar(ch byte) bool {
+	return isNameStart(ch) || (ch >= '0' && ch <= '9')
+}
@@ -0,0 +1,53 @@
+package api
+
+import "testing"
+
+func TestParseOperations(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []Operation
+	}{
+		{name: "anonymous selection set", query: "{ viewer { id } }", expected: []Operation{{Type: "query"}}},
+		{name: "named query", query: "query Me { viewer { id } }", expected: []Operation{{Type: "query", Name: "Me"}}},
+		{
+			name:     "variables and directives",
+			query:    `query Issue($id: String! = "a { b") @cached { issue(id: $id) { id } }`,
+			expected: []Operation{{Type: "query", Name: "Issue"}},
+		},
+		{name: "unnamed with variables", query: "query($n: Int) { issues(first: $n) { nodes { id } } }", expected: []Operation{{Type: "query"}}},
+		{name: "mutation", query: "mutation CreateIssue { issueCreate { success } }", expected: []Operation{{Type: "mutation", Name: "CreateIssue"}}},
+		{
+			name: "fragments and comments are skipped",
+			query: `# query Hidden { viewer { id } }
+fragment UserFields on User { id name }
+query Me { viewer { ...UserFields } }`,
+			expected: []Operation{{Type: "query", Name: "Me"}},
+		},
+		{
+			name:     "multiple operations",
+			query:    "query Me { viewer { id } } mutation Archive { issueArchive(id: \"x\") { success } }",
+			expected: []Operation{{Type: "query", Name: "Me"}, {Type: "mutation", Name: "Archive"}},
+		},
+		{
+			name:     "block string",
+			query:    `mutation Comment { commentCreate(input: {body: """query Evil { x }"""}) { success } }`,
+			expected: []Operation{{Type: "mutation", Name: "Comment"}},
+		},
+		{name: "empty", query: "", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operations := parseOperations(tt.query)
+			if len(operations) != len(tt.expected) {
+				t.Fatalf("Expected %d operations, got %+v", len(tt.expected), operations)
+			}
+			for i, operation := range operations {
+				if operation != tt.expected[i] {
+					t.Errorf("Operation %d: expected %+v, got %+v", i, tt.expected[i], operation)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/httpclient"
+)
+
+// OperationTimeoutEnvVar bounds a whole GraphQL operation, as a Go duration (e.g. 2m)
+const OperationTimeoutEnvVar = "LINCTL_OPERATION_TIMEOUT"
+
+// OperationTimeoutError is returned when a GraphQL operation runs past its
+// LINCTL_OPERATION_TIMEOUT deadline. The deadline covers every attempt, rate
+// limiter wait and retry delay, unlike LINCTL_HTTP_TIMEOUT which bounds each
+// HTTP request on its own.
+type OperationTimeoutError struct {
+	Timeout   time.Duration
+	Operation string
+}
+
+// Error implements error
+func (e *OperationTimeoutError) Error() string {
+	operation := "GraphQL operation"
+	if e.Operation != "" {
+		operation += " " + e.Operation
+	}
+	return fmt.Sprintf("operation timeout: %s did not complete within %s including retries (%s); this is not the per-request HTTP timeout (%s)",
+		operation, e.Timeout, OperationTimeoutEnvVar, httpclient.TimeoutEnvVar)
+}
+
+// Unwrap lets errors.Is match context.DeadlineExceeded
+func (e *OperationTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// OperationTimeoutFromEnv parses LINCTL_OPERATION_TIMEOUT, returning 0 (no
+// deadline) when it is unset
+func OperationTimeoutFromEnv() (time.Duration, error) {
+	return ParseOperationTimeout(os.Getenv(OperationTimeoutEnvVar))
+}
+
+// ParseOperationTimeout parses an operation timeout such as "90s". An empty
+// value disables the deadline.
+func ParseOperationTimeout(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("invalid %s=%q: expected a positive duration such as 2m", OperationTimeoutEnvVar, value)
+	}
+	return timeout, nil
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+)
+
+// DefaultMaxPages is the most pages Paginate fetches unless configured otherwise
+const DefaultMaxPages = 100
+
+// MaxPagesEnvVar overrides DefaultMaxPages
+const MaxPagesEnvVar = "LINCTL_MAX_PAGES"
+
+// ErrMaxPagesReached is returned, along with the items fetched so far, when
+// pagination stops at the page cap rather than the last page
+var ErrMaxPagesReached = errors.New("pagination stopped at the page limit")
+
+// PageWaiter is waited on before every page after the first
+type PageWaiter interface {
+	Wait(ctx context.Context) error
+}
+
+// PaginateOption configures Paginate
+type PaginateOption func(*paginateConfig)
+
+type paginateConfig struct {
+	maxPages int
+	limiter  PageWaiter
+}
+
+// WithMaxPages caps the number of pages fetched
+func WithMaxPages(maxPages int) PaginateOption {
+	return func(c *paginateConfig) {
+		c.maxPages = maxPages
+	}
+}
+
+// WithPageLimiter sets the rate limiter waited on between pages, in place of
+// the process-wide ratelimit.Shared limiter
+func WithPageLimiter(limiter PageWaiter) PaginateOption {
+	return func(c *paginateConfig) {
+		c.limiter = limiter
+	}
+}
+
+// Paginate calls fetchPage with each page's cursor, starting from "", until a
+// page reports no next page, and returns the items from every page in order.
+// It waits on the process-wide rate limiter between pages, so paging honours
+// the same limits as other calls, and stops when ctx is cancelled.
+// After maxPages pages (LINCTL_MAX_PAGES, default 100) it returns the items so
+// far with ErrMaxPagesReached.
+func Paginate[T any](ctx context.Context, fetchPage func(cursor string) (items []T, endCursor string, hasNext bool, err error), opts ...PaginateOption) ([]T, error) {
+	config := paginateConfig{maxPages: maxPagesFromEnv()}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.limiter == nil {
+		config.limiter = boundedWaiter{ratelimit.Shared()}
+	}
+
+	var items []T
+	cursor := ""
+
+	for page := 0; ; page++ {
+		if page >= config.maxPages {
+			return items, fmt.Errorf("%w of %d; narrow the query or raise %s", ErrMaxPagesReached, config.maxPages, MaxPagesEnvVar)
+		}
+		if ctx.Err() != nil {
+			return items, context.Cause(ctx)
+		}
+		if page > 0 {
+			if err := config.limiter.Wait(ctx); err != nil {
+				return items, err
+			}
+		}
+
+		pageItems, endCursor, hasNext, err := fetchPage(cursor)
+		if err != nil {
+			return items, err
+		}
+		items = append(items, pageItems...)
+
+		if !hasNext || endCursor == "" {
+			return items, nil
+		}
+		cursor = endCursor
+	}
+}
+
+// maxPagesFromEnv returns LINCTL_MAX_PAGES, or DefaultMaxPages when it is unset or invalid
+func maxPagesFromEnv() int {
+	if value := os.Getenv(MaxPagesEnvVar); value != "" {
+		if maxPages, err := strconv.Atoi(value); err == nil && maxPages > 0 {
+			return maxPages
+		}
+	}
+	return DefaultMaxPages
+}
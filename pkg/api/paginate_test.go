@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+)
+
+type countingWaiter struct {
+	waits int
+}
+
+func (w *countingWaiter) Wait(ctx context.Context) error {
+	w.waits++
+	return ctx.Err()
+}
+
+// pagedFetcher serves total integers in pages of size, recording the cursors requested
+func pagedFetcher(total, size int, cursors *[]string) func(cursor string) ([]int, string, bool, error) {
+	return func(cursor string) ([]int, string, bool, error) {
+		*cursors = append(*cursors, cursor)
+		start := 0
+		if cursor != "" {
+			fmt.Sscanf(cursor, "cursor-%d", &start)
+		}
+		end := start + size
+		if end > total {
+			end = total
+		}
+
+		items := make([]int, 0, end-start)
+		for i := start; i < end; i++ {
+			items = append(items, i)
+		}
+		return items, fmt.Sprintf("cursor-%d", end), end < total, nil
+	}
+}
+
+func TestPaginate_AllPages(t *testing.T) {
+	var cursors []string
+	waiter := &countingWaiter{}
+
+	items, err := Paginate(context.Background(), pagedFetcher(25, 10, &cursors), WithPageLimiter(waiter))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 25 || items[24] != 24 {
+		t.Errorf("Expected 25 items in order, got %v", items)
+	}
+	if fmt.Sprint(cursors) != "[ cursor-10 cursor-20]" {
+		t.Errorf("Unexpected cursors: %q", cursors)
+	}
+	if waiter.waits != 2 {
+		t.Errorf("Expected the limiter to be waited on between pages (2 times), got %d", waiter.waits)
+	}
+}
+
+func TestPaginate_MaxPages(t *testing.T) {
+	var cursors []string
+
+	items, err := Paginate(context.Background(), pagedFetcher(100, 10, &cursors), WithMaxPages(3), WithPageLimiter(&countingWaiter{}))
+	if !errors.Is(err, ErrMaxPagesReached) {
+		t.Fatalf("Expected ErrMaxPagesReached, got %v", err)
+	}
+	if len(items) != 30 || len(cursors) != 3 {
+		t.Errorf("Expected 3 pages (30 items) before stopping, got %d items from %d pages", len(items), len(cursors))
+	}
+}
+
+func TestPaginate_MaxPagesFromEnv(t *testing.T) {
+	t.Setenv(MaxPagesEnvVar, "2")
+	var cursors []string
+
+	_, err := Paginate(context.Background(), pagedFetcher(100, 10, &cursors), WithPageLimiter(&countingWaiter{}))
+	if !errors.Is(err, ErrMaxPagesReached) || len(cursors) != 2 {
+		t.Errorf("Expected %s=2 to stop after 2 pages, got %d pages (err %v)", MaxPagesEnvVar, len(cursors), err)
+	}
+}
+
+func TestPaginate_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var cursors []string
+	fetch := pagedFetcher(100, 10, &cursors)
+
+	items, err := Paginate(ctx, func(cursor string) ([]int, string, bool, error) {
+		items, next, hasNext, err := fetch(cursor)
+		cancel() // Ctrl-C arrives while the first page is in flight
+		return items, next, hasNext, err
+	}, WithPageLimiter(&countingWaiter{}))
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if len(cursors) != 1 || len(items) != 10 {
+		t.Errorf("Expected pagination to stop after the first page, got %d pages", len(cursors))
+	}
+}
+
+func TestPaginate_ContextCause(t *testing.T) {
+	cause := errors.New("out of time")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(cause)
+
+	_, err := Paginate(ctx, pagedFetcher(100, 10, new([]string)), WithPageLimiter(&countingWaiter{}))
+	if !errors.Is(err, cause) {
+		t.Errorf("Expected the cancellation cause, got %v", err)
+	}
+}
+
+func TestPaginate_FetchError(t *testing.T) {
+	_, err := Paginate(context.Background(), func(cursor string) ([]int, string, bool, error) {
+		return nil, "", false, errors.New("boom")
+	})
+	if err == nil || err.Error() != "boom" {
+		t.Errorf("Expected fetch error to be returned, got %v", err)
+	}
+}
+
+func TestPaginate_SharedLimiter(t *testing.T) {
+	ratelimit.ResetShared()
+	t.Cleanup(ratelimit.ResetShared)
+	defer SetMaxRateLimitWait(0)
+
+	// A limiter with no burst left makes the wait for the second page too long
+	config := ratelimit.DefaultRateLimitConfig()
+	config.RequestsPerSecond = 0.01
+	config.Burst = 1
+	ratelimit.SharedWithConfig(config, nil).Allow()
+	SetMaxRateLimitWait(time.Millisecond)
+
+	var cursors []string
+	_, err := Paginate(context.Background(), pagedFetcher(20, 10, &cursors))
+	var waitErr *ratelimit.ErrRateLimitWait
+	if !errors.As(err, &waitErr) {
+		t.Fatalf("Expected paging to wait on the shared limiter, got %v", err)
+	}
+	if len(cursors) != 1 {
+		t.Errorf("Expected to stop before the second page, fetched %d", len(cursors))
+	}
+}
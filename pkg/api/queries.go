@@ -3,7 +3,15 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
 	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/batch"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
 )
 
 // User represents a Linear user
@@ -17,6 +25,7 @@ type User struct {
 	Active      bool       `json:"active"`
 	Admin       bool       `json:"admin"`
 	CreatedAt   *time.Time `json:"createdAt"`
+	Teams       *Teams     `json:"teams,omitempty"`
 }
 
 // Team represents a Linear team
@@ -74,6 +83,7 @@ type Issue struct {
 	Creator               *User            `json:"creator"`
 	Subscribers           *Users           `json:"subscribers"`
 	Relations             *IssueRelations  `json:"relations"`
+	InverseRelations      *IssueRelations  `json:"inverseRelations,omitempty"`
 	History               *IssueHistory    `json:"history"`
 	Reactions             []Reaction       `json:"reactions"`
 	SlackIssueComments    []SlackComment   `json:"slackIssueComments"`
@@ -143,6 +153,21 @@ type Projects struct {
 	PageInfo PageInfo  `json:"pageInfo"`
 }
 
+// ProjectMilestone represents a milestone within a project
+type ProjectMilestone struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	TargetDate  *string `json:"targetDate"`
+	SortOrder   float64 `json:"sortOrder"`
+}
+
+// ProjectMilestones represents a paginated list of project milestones
+type ProjectMilestones struct {
+	Nodes    []ProjectMilestone `json:"nodes"`
+	PageInfo PageInfo           `json:"pageInfo"`
+}
+
 type Users struct {
 	Nodes    []User   `json:"nodes"`
 	PageInfo PageInfo `json:"pageInfo"`
@@ -173,6 +198,12 @@ type Cycle struct {
 	ScopeHistory []float64  `json:"scopeHistory"`
 }
 
+// Cycles represents a paginated list of cycles
+type Cycles struct {
+	Nodes    []Cycle  `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
 // Attachment represents a file attachment or link
 type Attachment struct {
 	ID        string                 `json:"id"`
@@ -221,6 +252,8 @@ func (a *Attachment) UnmarshalJSON(data []byte) error {
 // Attachments represents a paginated list of attachments
 type Attachments struct {
 	Nodes []Attachment `json:"nodes"`
+	// PageInfo is only requested when listing an issue's attachments
+	PageInfo *PageInfo `json:"pageInfo,omitempty"`
 }
 
 // Initiative represents a Linear initiative
@@ -248,7 +281,8 @@ type IssueRelation struct {
 }
 
 type IssueHistory struct {
-	Nodes []IssueHistoryEntry `json:"nodes"`
+	Nodes    []IssueHistoryEntry `json:"nodes"`
+	PageInfo *PageInfo           `json:"pageInfo,omitempty"`
 }
 
 type IssueHistoryEntry struct {
@@ -271,6 +305,18 @@ type IssueHistoryEntry struct {
 	ToProject       *Project  `json:"toProject"`
 	AddedLabelIds   []string  `json:"addedLabelIds"`
 	RemovedLabelIds []string  `json:"removedLabelIds"`
+	// Populated by GetIssueHistory only
+	FromEstimate       *float64 `json:"fromEstimate,omitempty"`
+	ToEstimate         *float64 `json:"toEstimate,omitempty"`
+	FromDueDate        *string  `json:"fromDueDate,omitempty"`
+	ToDueDate          *string  `json:"toDueDate,omitempty"`
+	FromParent         *Issue   `json:"fromParent,omitempty"`
+	ToParent           *Issue   `json:"toParent,omitempty"`
+	AddedLabels        []Label  `json:"addedLabels,omitempty"`
+	RemovedLabels      []Label  `json:"removedLabels,omitempty"`
+	UpdatedDescription bool     `json:"updatedDescription,omitempty"`
+	Archived           bool     `json:"archived,omitempty"`
+	Trashed            bool     `json:"trashed,omitempty"`
 }
 
 type Reaction struct {
@@ -372,25 +418,57 @@ type ProjectLink struct {
 
 // IssueCreateInput represents input for creating an issue with actor support
 type IssueCreateInput struct {
-	Title          string   `json:"title"`
-	Description    *string  `json:"description,omitempty"`
-	TeamID         string   `json:"teamId"`
-	AssigneeID     *string  `json:"assigneeId,omitempty"`
-	Priority       *int     `json:"priority,omitempty"`
-	StateID        *string  `json:"stateId,omitempty"`
-	LabelIDs       []string `json:"labelIds,omitempty"`
-	ProjectID      *string  `json:"projectId,omitempty"`
-	CycleID        *string  `json:"cycleId,omitempty"`
-	Estimate       *float64 `json:"estimate,omitempty"`
-	DueDate        *string  `json:"dueDate,omitempty"`
-	CreateAsUser   *string  `json:"createAsUser,omitempty"`
-	DisplayIconURL *string  `json:"displayIconUrl,omitempty"`
+	// ID lets the client choose the new issue's UUID, see pkg/idempotency
+	ID          *string  `json:"id,omitempty"`
+	Title       string   `json:"title"`
+	Description *string  `json:"description,omitempty"`
+	TeamID      string   `json:"teamId"`
+	AssigneeID  *string  `json:"assigneeId,omitempty"`
+	Priority    *int     `json:"priority,omitempty"`
+	StateID     *string  `json:"stateId,omitempty"`
+	LabelIDs    []string `json:"labelIds,omitempty"`
+	ProjectID   *string  `json:"projectId,omitempty"`
+	CycleID     *string  `json:"cycleId,omitempty"`
+	// ProjectMilestoneID associates the issue with a milestone, see ProjectMilestone
+	ProjectMilestoneID *string  `json:"projectMilestoneId,omitempty"`
+	Estimate           *float64 `json:"estimate,omitempty"`
+	DueDate            *string  `json:"dueDate,omitempty"`
+	CreateAsUser       *string  `json:"createAsUser,omitempty"`
+	DisplayIconURL     *string  `json:"displayIconUrl,omitempty"`
 }
 
 // CommentCreateInput represents input for creating a comment with actor support
 type CommentCreateInput struct {
+	// ID lets the client choose the new comment's UUID, see pkg/idempotency
+	ID             *string `json:"id,omitempty"`
 	IssueID        string  `json:"issueId"`
 	Body           string  `json:"body"`
+	ParentID       *string `json:"parentId,omitempty"`
+	CreateAsUser   *string `json:"createAsUser,omitempty"`
+	DisplayIconURL *string `json:"displayIconUrl,omitempty"`
+}
+
+// ProjectMilestoneCreateInput represents input for creating a project milestone
+type ProjectMilestoneCreateInput struct {
+	ProjectID   string  `json:"projectId"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	TargetDate  *string `json:"targetDate,omitempty"`
+}
+
+// LabelCreateInput represents input for creating an issue label
+type LabelCreateInput struct {
+	Name        string  `json:"name"`
+	Color       string  `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+	TeamID      string  `json:"teamId,omitempty"`
+}
+
+// CommentUpdateInput represents input for updating a comment with actor support.
+// ID identifies the comment and is sent separately from the input object.
+type CommentUpdateInput struct {
+	ID             string  `json:"-"`
+	Body           string  `json:"body"`
 	CreateAsUser   *string `json:"createAsUser,omitempty"`
 	DisplayIconURL *string `json:"displayIconUrl,omitempty"`
 }
@@ -423,11 +501,146 @@ func (c *Client) GetViewer(ctx context.Context) (*User, error) {
 	return &response.Viewer, nil
 }
 
+// GetViewerWithTeams returns the current user along with their team memberships
+func (c *Client) GetViewerWithTeams(ctx context.Context) (*User, error) {
+	query := `
+		query MeWithTeams {
+			viewer {
+				id
+				name
+				email
+				avatarUrl
+				isMe
+				active
+				admin
+				teams(first: 100) {
+					nodes {
+						id
+						key
+						name
+					}
+				}
+			}
+		}
+	`
+
+	var response struct {
+		Viewer User `json:"viewer"`
+	}
+
+	err := c.Execute(ctx, query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Viewer, nil
+}
+
+// Organization is the Linear workspace the credentials belong to
+type Organization struct {
+	ID           string                    `json:"id"`
+	Name         string                    `json:"name"`
+	URLKey       string                    `json:"urlKey"`
+	LogoURL      *string                   `json:"logoUrl"`
+	UserCount    int                       `json:"userCount"`
+	CreatedAt    time.Time                 `json:"createdAt"`
+	Subscription *OrganizationSubscription `json:"subscription"`
+}
+
+// OrganizationSubscription is the paid plan of an organization
+type OrganizationSubscription struct {
+	Type  string `json:"type"`
+	Seats int    `json:"seats"`
+}
+
+// Plan returns the organization's subscription type, or "free" without a paid plan
+func (o *Organization) Plan() string {
+	if o.Subscription == nil || o.Subscription.Type == "" {
+		return "free"
+	}
+	return o.Subscription.Type
+}
+
+// GetOrganization returns the organization of the authenticated user
+func (c *Client) GetOrganization(ctx context.Context) (*Organization, error) {
+	query := `
+		query Organization {
+			organization {
+				id
+				name
+				urlKey
+				logoUrl
+				userCount
+				createdAt
+				subscription {
+					type
+					seats
+				}
+			}
+		}
+	`
+
+	var response struct {
+		Organization Organization `json:"organization"`
+	}
+
+	err := c.Execute(ctx, query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Organization, nil
+}
+
 // GetIssues returns a list of issues with optional filtering
-func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*Issues, error) {
+// Archived issues are only returned when includeArchived is true
+func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool) (*Issues, error) {
+	return c.getIssues(ctx, filter, first, after, orderBy, nil, includeArchived)
+}
+
+// GetIssuesSorted is GetIssues ordered server-side by sort, a list of
+// IssueSortInput objects such as {"priority": {"order": "Ascending"}}. The
+// order holds across pages, so cursors from one page continue the same sort.
+func (c *Client) GetIssuesSorted(ctx context.Context, filter map[string]interface{}, first int, after string, sort []map[string]interface{}, includeArchived bool) (*Issues, error) {
+	return c.getIssues(ctx, filter, first, after, "", sort, includeArchived)
+}
+
+// GetViewerAssignedIssues is GetIssuesSorted limited to issues assigned to the
+// authenticated user
+func (c *Client) GetViewerAssignedIssues(ctx context.Context, filter map[string]interface{}, first int, after string, sort []map[string]interface{}, includeArchived bool) (*Issues, error) {
+	return c.getViewerIssues(ctx, "assignee", filter, first, after, sort, includeArchived)
+}
+
+// GetViewerCreatedIssues is GetIssuesSorted limited to issues created by the
+// authenticated user
+func (c *Client) GetViewerCreatedIssues(ctx context.Context, filter map[string]interface{}, first int, after string, sort []map[string]interface{}, includeArchived bool) (*Issues, error) {
+	return c.getViewerIssues(ctx, "creator", filter, first, after, sort, includeArchived)
+}
+
+// getViewerIssues adds a filter on the viewer's ID for field to filter. The ID
+// is looked up once per client, so paging does not repeat the lookup.
+func (c *Client) getViewerIssues(ctx context.Context, field string, filter map[string]interface{}, first int, after string, sort []map[string]interface{}, includeArchived bool) (*Issues, error) {
+	if c.viewerID == "" {
+		viewer, err := c.GetViewer(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.viewerID = viewer.ID
+	}
+
+	scoped := make(map[string]interface{}, len(filter)+1)
+	for key, value := range filter {
+		scoped[key] = value
+	}
+	scoped[field] = map[string]interface{}{"id": map[string]interface{}{"eq": c.viewerID}}
+
+	return c.getIssues(ctx, scoped, first, after, "", sort, includeArchived)
+}
+
+func (c *Client) getIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, sort []map[string]interface{}, includeArchived bool) (*Issues, error) {
 	query := `
-		query Issues($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
-			issues(filter: $filter, first: $first, after: $after, orderBy: $orderBy) {
+		query Issues($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy, $sort: [IssueSortInput!], $includeArchived: Boolean) {
+			issues(filter: $filter, first: $first, after: $after, orderBy: $orderBy, sort: $sort, includeArchived: $includeArchived) {
 				nodes {
 					id
 					identifier
@@ -439,6 +652,7 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 					updatedAt
 					dueDate
 					url
+					archivedAt
 					state {
 						id
 						name
@@ -472,7 +686,8 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 	`
 
 	variables := map[string]interface{}{
-		"first": first,
+		"first":           first,
+		"includeArchived": includeArchived,
 	}
 	if filter != nil {
 		variables["filter"] = filter
@@ -483,6 +698,9 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 	if orderBy != "" {
 		variables["orderBy"] = orderBy
 	}
+	if len(sort) > 0 {
+		variables["sort"] = sort
+	}
 
 	var response struct {
 		Issues Issues `json:"issues"`
@@ -496,6 +714,71 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 	return &response.Issues, nil
 }
 
+// SearchIssues runs a full-text search over issue titles, descriptions and
+// identifiers. Results are ranked by relevance and can be narrowed with filter.
+// Archived issues are only returned when includeArchived is true
+func (c *Client) SearchIssues(ctx context.Context, term string, filter map[string]interface{}, first int, after string, includeArchived bool) (*Issues, error) {
+	query := `
+		query SearchIssues($term: String!, $filter: IssueFilter, $first: Int, $after: String, $includeArchived: Boolean) {
+			searchIssues(term: $term, filter: $filter, first: $first, after: $after, includeArchived: $includeArchived) {
+				nodes {
+					id
+					identifier
+					title
+					description
+					priority
+					createdAt
+					updatedAt
+					url
+					state {
+						id
+						name
+						type
+						color
+					}
+					assignee {
+						id
+						name
+						email
+					}
+					team {
+						id
+						key
+						name
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"term":            term,
+		"first":           first,
+		"includeArchived": includeArchived,
+	}
+	if len(filter) > 0 {
+		variables["filter"] = filter
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		SearchIssues Issues `json:"searchIssues"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.SearchIssues, nil
+}
+
 // GetIssue returns a single issue by ID
 func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 	query := `
@@ -715,9 +998,11 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 						fromTitle
 						toTitle
 						fromCycle {
+							number
 							name
 						}
 						toCycle {
+							number
 							name
 						}
 						fromProject {
@@ -765,6 +1050,51 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 	return &response.Issue, nil
 }
 
+// RecentIssueIdentifiers returns identifiers of recently updated issues that
+// start with prefix (case-insensitive). A prefix containing a team key, such as
+// "ENG-", limits the query to that team.
+func (c *Client) RecentIssueIdentifiers(ctx context.Context, prefix string) ([]string, error) {
+	query := `
+		query RecentIssueIdentifiers($filter: IssueFilter) {
+			issues(filter: $filter, first: 50, orderBy: updatedAt) {
+				nodes {
+					identifier
+				}
+			}
+		}
+	`
+
+	prefix = strings.ToUpper(prefix)
+	variables := map[string]interface{}{}
+	if teamKey, _, found := strings.Cut(prefix, "-"); found && teamKey != "" {
+		variables["filter"] = map[string]interface{}{
+			"team": map[string]interface{}{"key": map[string]interface{}{"eq": teamKey}},
+		}
+	}
+
+	var response struct {
+		Issues struct {
+			Nodes []struct {
+				Identifier string `json:"identifier"`
+			} `json:"nodes"`
+		} `json:"issues"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers := []string{}
+	for _, issue := range response.Issues.Nodes {
+		if strings.HasPrefix(issue.Identifier, prefix) {
+			identifiers = append(identifiers, issue.Identifier)
+		}
+	}
+
+	return identifiers, nil
+}
+
 // GetTeams returns a list of teams
 func (c *Client) GetTeams(ctx context.Context, first int, after string, orderBy string) (*Teams, error) {
 	query := `
@@ -1027,6 +1357,84 @@ func (c *Client) GetProject(ctx context.Context, id string) (*Project, error) {
 	return &response.Project, nil
 }
 
+// GetProjectMilestones returns a page of a project's milestones
+func (c *Client) GetProjectMilestones(ctx context.Context, projectID string, first int, after string) (*ProjectMilestones, error) {
+	query := `
+		query ProjectMilestones($id: String!, $first: Int, $after: String) {
+			project(id: $id) {
+				projectMilestones(first: $first, after: $after) {
+					nodes {
+						id
+						name
+						description
+						targetDate
+						sortOrder
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    projectID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Project struct {
+			ProjectMilestones ProjectMilestones `json:"projectMilestones"`
+		} `json:"project"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Project.ProjectMilestones, nil
+}
+
+// CreateProjectMilestone creates a milestone within a project
+func (c *Client) CreateProjectMilestone(ctx context.Context, input ProjectMilestoneCreateInput) (*ProjectMilestone, error) {
+	query := `
+		mutation CreateProjectMilestone($input: ProjectMilestoneCreateInput!) {
+			projectMilestoneCreate(input: $input) {
+				projectMilestone {
+					id
+					name
+					description
+					targetDate
+					sortOrder
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		ProjectMilestoneCreate struct {
+			ProjectMilestone ProjectMilestone `json:"projectMilestone"`
+		} `json:"projectMilestoneCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ProjectMilestoneCreate.ProjectMilestone, nil
+}
+
 // UpdateIssue updates an issue's fields
 func (c *Client) UpdateIssue(ctx context.Context, id string, input map[string]interface{}) (*Issue, error) {
 	query := `
@@ -1058,6 +1466,13 @@ func (c *Client) UpdateIssue(ctx context.Context, id string, input map[string]in
 						key
 						name
 					}
+					cycle {
+						id
+						number
+						name
+						startsAt
+						endsAt
+					}
 					labels {
 						nodes {
 							id
@@ -1089,57 +1504,34 @@ func (c *Client) UpdateIssue(ctx context.Context, id string, input map[string]in
 	return &response.IssueUpdate.Issue, nil
 }
 
-// CreateIssue creates a new issue
-func (c *Client) CreateIssue(ctx context.Context, input IssueCreateInput) (*Issue, error) {
+// IssueArchivePayload represents the result of archiving or unarchiving an issue
+type IssueArchivePayload struct {
+	Success bool   `json:"success"`
+	Entity  *Issue `json:"entity"`
+}
+
+// ArchiveIssue archives an issue
+func (c *Client) ArchiveIssue(ctx context.Context, id string) (*IssueArchivePayload, error) {
 	query := `
-		mutation CreateIssue($input: IssueCreateInput!) {
-			issueCreate(input: $input) {
-				issue {
+		mutation ArchiveIssue($id: String!) {
+			issueArchive(id: $id) {
+				success
+				entity {
 					id
 					identifier
 					title
-					description
-					priority
-					estimate
-					createdAt
-					updatedAt
-					dueDate
-					state {
-						id
-						name
-						type
-						color
-					}
-					assignee {
-						id
-						name
-						email
-					}
-					team {
-						id
-						key
-						name
-					}
-					labels {
-						nodes {
-							id
-							name
-							color
-						}
-					}
+					archivedAt
 				}
 			}
 		}
 	`
 
 	variables := map[string]interface{}{
-		"input": input,
+		"id": id,
 	}
 
 	var response struct {
-		IssueCreate struct {
-			Issue Issue `json:"issue"`
-		} `json:"issueCreate"`
+		IssueArchive IssueArchivePayload `json:"issueArchive"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
@@ -1147,30 +1539,31 @@ func (c *Client) CreateIssue(ctx context.Context, input IssueCreateInput) (*Issu
 		return nil, err
 	}
 
-	return &response.IssueCreate.Issue, nil
+	return &response.IssueArchive, nil
 }
 
-// GetTeam returns a single team by key
-func (c *Client) GetTeam(ctx context.Context, key string) (*Team, error) {
+// UnarchiveIssue restores an archived issue
+func (c *Client) UnarchiveIssue(ctx context.Context, id string) (*IssueArchivePayload, error) {
 	query := `
-		query Team($key: String!) {
-			team(id: $key) {
-				id
-				key
-				name
-				description
-				private
-				issueCount
+		mutation UnarchiveIssue($id: String!) {
+			issueUnarchive(id: $id) {
+				success
+				entity {
+					id
+					identifier
+					title
+					archivedAt
+				}
 			}
 		}
 	`
 
 	variables := map[string]interface{}{
-		"key": key,
+		"id": id,
 	}
 
 	var response struct {
-		Team Team `json:"team"`
+		IssueUnarchive IssueArchivePayload `json:"issueUnarchive"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
@@ -1178,50 +1571,40 @@ func (c *Client) GetTeam(ctx context.Context, key string) (*Team, error) {
 		return nil, err
 	}
 
-	return &response.Team, nil
-}
-
-// Comment represents a Linear comment
-type Comment struct {
-	ID        string     `json:"id"`
-	Body      string     `json:"body"`
-	CreatedAt time.Time  `json:"createdAt"`
-	UpdatedAt time.Time  `json:"updatedAt"`
-	EditedAt  *time.Time `json:"editedAt"`
-	User      *User      `json:"user"`
-	Parent    *Comment   `json:"parent"`
-	Children  *Comments  `json:"children"`
+	return &response.IssueUnarchive, nil
 }
 
-// Comments represents a paginated list of comments
-type Comments struct {
-	Nodes    []Comment `json:"nodes"`
-	PageInfo PageInfo  `json:"pageInfo"`
-}
+// Issue relation types accepted by Linear
+const (
+	IssueRelationBlocks    = "blocks"
+	IssueRelationRelated   = "related"
+	IssueRelationDuplicate = "duplicate"
+)
 
-// WorkflowState represents a Linear workflow state
-type WorkflowState struct {
-	ID          string  `json:"id"`
-	Name        string  `json:"name"`
-	Type        string  `json:"type"`
-	Color       string  `json:"color"`
-	Description string  `json:"description"`
-	Position    float64 `json:"position"`
+// IssueRelationInput represents input for creating a relation between two issues
+type IssueRelationInput struct {
+	IssueID        string `json:"issueId"`
+	RelatedIssueID string `json:"relatedIssueId"`
+	Type           string `json:"type"`
 }
 
-// GetTeamStates returns workflow states for a team
-func (c *Client) GetTeamStates(ctx context.Context, teamKey string) ([]WorkflowState, error) {
+// CreateIssueRelation creates a relation between two issues
+func (c *Client) CreateIssueRelation(ctx context.Context, input IssueRelationInput) (*IssueRelation, error) {
 	query := `
-		query TeamStates($key: String!) {
-			team(id: $key) {
-				states {
-					nodes {
+		mutation CreateIssueRelation($input: IssueRelationCreateInput!) {
+			issueRelationCreate(input: $input) {
+				issueRelation {
+					id
+					type
+					issue {
 						id
-						name
-						type
-						color
-						description
-						position
+						identifier
+						title
+					}
+					relatedIssue {
+						id
+						identifier
+						title
 					}
 				}
 			}
@@ -1229,15 +1612,13 @@ func (c *Client) GetTeamStates(ctx context.Context, teamKey string) ([]WorkflowS
 	`
 
 	variables := map[string]interface{}{
-		"key": teamKey,
+		"input": input,
 	}
 
 	var response struct {
-		Team struct {
-			States struct {
-				Nodes []WorkflowState `json:"nodes"`
-			} `json:"states"`
-		} `json:"team"`
+		IssueRelationCreate struct {
+			IssueRelation IssueRelation `json:"issueRelation"`
+		} `json:"issueRelationCreate"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
@@ -1245,27 +1626,60 @@ func (c *Client) GetTeamStates(ctx context.Context, teamKey string) ([]WorkflowS
 		return nil, err
 	}
 
-	return response.Team.States.Nodes, nil
+	return &response.IssueRelationCreate.IssueRelation, nil
 }
 
-// GetTeamMembers returns members of a specific team
-func (c *Client) GetTeamMembers(ctx context.Context, teamKey string) (*Users, error) {
+// DeleteIssueRelation deletes an issue relation, reporting whether Linear confirmed the deletion
+func (c *Client) DeleteIssueRelation(ctx context.Context, id string) (bool, error) {
 	query := `
-		query TeamMembers($key: String!) {
-			team(id: $key) {
-				members {
-					nodes {
-						id
+		mutation DeleteIssueRelation($id: String!) {
+			issueRelationDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		IssueRelationDelete struct {
+			Success bool `json:"success"`
+		} `json:"issueRelationDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return false, err
+	}
+
+	return response.IssueRelationDelete.Success, nil
+}
+
+// AttachmentInput represents input for attaching a link to an issue
+type AttachmentInput struct {
+	IssueID  string  `json:"issueId"`
+	URL      string  `json:"url"`
+	Title    string  `json:"title"`
+	Subtitle *string `json:"subtitle,omitempty"`
+}
+
+// CreateAttachment attaches an external link, such as a pull request, to an issue
+func (c *Client) CreateAttachment(ctx context.Context, input AttachmentInput) (*Attachment, error) {
+	query := `
+		mutation CreateAttachment($input: AttachmentCreateInput!) {
+			attachmentCreate(input: $input) {
+				success
+				attachment {
+					id
+					title
+					subtitle
+					url
+					createdAt
+					creator {
 						name
 						email
-						avatarUrl
-						isMe
-						active
-						admin
-					}
-					pageInfo {
-						hasNextPage
-						endCursor
 					}
 				}
 			}
@@ -1273,57 +1687,66 @@ func (c *Client) GetTeamMembers(ctx context.Context, teamKey string) (*Users, er
 	`
 
 	variables := map[string]interface{}{
-		"key": teamKey,
+		"input": input,
 	}
 
 	var response struct {
-		Team struct {
-			Members Users `json:"members"`
-		} `json:"team"`
+		AttachmentCreate struct {
+			Success    bool       `json:"success"`
+			Attachment Attachment `json:"attachment"`
+		} `json:"attachmentCreate"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
 	if err != nil {
 		return nil, err
 	}
+	if !response.AttachmentCreate.Success {
+		return nil, fmt.Errorf("attachment was not created")
+	}
 
-	return &response.Team.Members, nil
+	return &response.AttachmentCreate.Attachment, nil
 }
 
-// GetUsers returns a list of all users
-func (c *Client) GetUsers(ctx context.Context, first int, after string, orderBy string) (*Users, error) {
+// GetIssueAttachments returns a page of the attachments on an issue
+func (c *Client) GetIssueAttachments(ctx context.Context, issueID string, first int, after string) (*Attachments, error) {
 	query := `
-		query Users($first: Int, $after: String, $orderBy: PaginationOrderBy) {
-			users(first: $first, after: $after, orderBy: $orderBy) {
-				nodes {
-					id
-					name
-					email
-					avatarUrl
-					isMe
-					active
-					admin
-				}
-				pageInfo {
-					hasNextPage
-					endCursor
+		query IssueAttachments($id: String!, $first: Int, $after: String) {
+			issue(id: $id) {
+				attachments(first: $first, after: $after) {
+					nodes {
+						id
+						title
+						subtitle
+						url
+						metadata
+						createdAt
+						creator {
+							name
+							email
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
 				}
 			}
 		}
 	`
 
 	variables := map[string]interface{}{
+		"id":    issueID,
 		"first": first,
 	}
 	if after != "" {
 		variables["after"] = after
 	}
-	if orderBy != "" {
-		variables["orderBy"] = orderBy
-	}
 
 	var response struct {
-		Users Users `json:"users"`
+		Issue struct {
+			Attachments Attachments `json:"attachments"`
+		} `json:"issue"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
@@ -1331,31 +1754,36 @@ func (c *Client) GetUsers(ctx context.Context, first int, after string, orderBy
 		return nil, err
 	}
 
-	return &response.Users, nil
+	return &response.Issue.Attachments, nil
 }
 
-// GetUser returns a specific user by email
-func (c *Client) GetUser(ctx context.Context, email string) (*User, error) {
+// IssuePayload represents the result of a mutation that returns an issue
+type IssuePayload struct {
+	Success bool   `json:"success"`
+	Issue   *Issue `json:"issue"`
+}
+
+// SubscribeToIssue subscribes the authenticated user to an issue's notifications
+func (c *Client) SubscribeToIssue(ctx context.Context, id string) (*IssuePayload, error) {
 	query := `
-		query User($email: String!) {
-			user(email: $email) {
-				id
-				name
-				email
-				avatarUrl
-				isMe
-				active
-				admin
+		mutation SubscribeToIssue($id: String!) {
+			issueSubscribe(id: $id) {
+				success
+				issue {
+					id
+					identifier
+					title
+				}
 			}
 		}
 	`
 
 	variables := map[string]interface{}{
-		"email": email,
+		"id": id,
 	}
 
 	var response struct {
-		User User `json:"user"`
+		IssueSubscribe IssuePayload `json:"issueSubscribe"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
@@ -1363,29 +1791,18 @@ func (c *Client) GetUser(ctx context.Context, email string) (*User, error) {
 		return nil, err
 	}
 
-	return &response.User, nil
+	return &response.IssueSubscribe, nil
 }
 
-// GetIssueComments returns comments for a specific issue
-func (c *Client) GetIssueComments(ctx context.Context, issueID string, first int, after string, orderBy string) (*Comments, error) {
+// IsSubscribedToIssue reports whether the user with userID is subscribed to an issue
+func (c *Client) IsSubscribedToIssue(ctx context.Context, issueID string, userID string) (bool, error) {
 	query := `
-		query IssueComments($id: String!, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
+		query IssueSubscription($id: String!, $userId: ID!) {
 			issue(id: $id) {
-				comments(first: $first, after: $after, orderBy: $orderBy) {
+				id
+				subscribers(filter: { id: { eq: $userId } }) {
 					nodes {
 						id
-						body
-						createdAt
-						updatedAt
-						user {
-							id
-							name
-							email
-						}
-					}
-					pageInfo {
-						hasNextPage
-						endCursor
 					}
 				}
 			}
@@ -1393,58 +1810,45 @@ func (c *Client) GetIssueComments(ctx context.Context, issueID string, first int
 	`
 
 	variables := map[string]interface{}{
-		"id":    issueID,
-		"first": first,
-	}
-	if after != "" {
-		variables["after"] = after
-	}
-	if orderBy != "" {
-		variables["orderBy"] = orderBy
+		"id":     issueID,
+		"userId": userID,
 	}
 
 	var response struct {
 		Issue struct {
-			Comments Comments `json:"comments"`
+			Subscribers Users `json:"subscribers"`
 		} `json:"issue"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	return &response.Issue.Comments, nil
+	return len(response.Issue.Subscribers.Nodes) > 0, nil
 }
 
-// CreateComment creates a new comment on an issue
-func (c *Client) CreateComment(ctx context.Context, input CommentCreateInput) (*Comment, error) {
+// UnsubscribeFromIssue unsubscribes the authenticated user from an issue's notifications
+func (c *Client) UnsubscribeFromIssue(ctx context.Context, id string) (*IssuePayload, error) {
 	query := `
-		mutation CreateComment($input: CommentCreateInput!) {
-			commentCreate(input: $input) {
-				comment {
+		mutation UnsubscribeFromIssue($id: String!) {
+			issueUnsubscribe(id: $id) {
+				success
+				issue {
 					id
-					body
-					createdAt
-					updatedAt
-					user {
-						id
-						name
-						email
-					}
+					identifier
+					title
 				}
 			}
 		}
 	`
 
 	variables := map[string]interface{}{
-		"input": input,
+		"id": id,
 	}
 
 	var response struct {
-		CommentCreate struct {
-			Comment Comment `json:"comment"`
-		} `json:"commentCreate"`
+		IssueUnsubscribe IssuePayload `json:"issueUnsubscribe"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
@@ -1452,14 +1856,1172 @@ func (c *Client) CreateComment(ctx context.Context, input CommentCreateInput) (*
 		return nil, err
 	}
 
-	return &response.CommentCreate.Comment, nil
+	return &response.IssueUnsubscribe, nil
 }
 
-// CreateCommentSimple creates a new comment on an issue (backward compatibility)
-func (c *Client) CreateCommentSimple(ctx context.Context, issueID string, body string) (*Comment, error) {
-	input := CommentCreateInput{
-		IssueID: issueID,
-		Body:    body,
-	}
-	return c.CreateComment(ctx, input)
+// CreateIssue creates a new issue
+func (c *Client) CreateIssue(ctx context.Context, input IssueCreateInput) (*Issue, error) {
+	return c.createIssue(ctx, input, nil)
+}
+
+// createIssue creates a new issue, passing the HTTP response to observe when set
+func (c *Client) createIssue(ctx context.Context, input IssueCreateInput, observe func(*http.Response)) (*Issue, error) {
+	query := `
+		mutation CreateIssue($input: IssueCreateInput!) {
+			issueCreate(input: $input) {
+				issue {
+					id
+					identifier
+					title
+					description
+					priority
+					estimate
+					createdAt
+					updatedAt
+					dueDate
+					state {
+						id
+						name
+						type
+						color
+					}
+					assignee {
+						id
+						name
+						email
+					}
+					team {
+						id
+						key
+						name
+					}
+					labels {
+						nodes {
+							id
+							name
+							color
+						}
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		IssueCreate struct {
+			Issue Issue `json:"issue"`
+		} `json:"issueCreate"`
+	}
+
+	err := c.execute(ctx, query, variables, &response, observe)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IssueCreate.Issue, nil
+}
+
+// BulkCreateResult is the outcome of creating one issue in a bulk request
+type BulkCreateResult struct {
+	Issue *Issue
+	Error error
+}
+
+// BulkCreateIssues creates issues on a batch executor, with up to
+// LINCTL_MAX_CONCURRENCY requests in flight, waiting on the shared rate
+// limiter between requests. Concurrency is reduced when Linear reports the
+// rate limit is exhausted and recovers gradually. Results are returned in
+// input order; a failed row does not stop the remaining rows unless the
+// context is cancelled.
+func (c *Client) BulkCreateIssues(ctx context.Context, inputs []IssueCreateInput) []BulkCreateResult {
+	limiter := ratelimit.Shared()
+	controller := ratelimit.NewConcurrencyController(ratelimit.DefaultConcurrencyConfig(), limiter, nil)
+	results := make([]BulkCreateResult, len(inputs))
+
+	tasks := make([]batch.Task, len(inputs))
+	for i, input := range inputs {
+		tasks[i] = func(ctx context.Context) error {
+			if err := WaitForRateLimit(ctx, limiter); err != nil {
+				return err
+			}
+			issue, err := c.createIssue(ctx, input, controller.UpdateFromResponse)
+			results[i].Issue = issue
+			return err
+		}
+	}
+
+	for i, result := range batch.NewExecutor(controller, nil).Run(ctx, tasks) {
+		results[i].Error = result.Error
+	}
+	return results
+}
+
+// GetTeam returns a single team by key
+func (c *Client) GetTeam(ctx context.Context, key string) (*Team, error) {
+	query := `
+		query Team($key: String!) {
+			team(id: $key) {
+				id
+				key
+				name
+				description
+				private
+				issueCount
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"key": key,
+	}
+
+	var response struct {
+		Team Team `json:"team"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Team, nil
+}
+
+// GetLabels returns the issue labels available to a team.
+// teamID may be either a team ID or a team key.
+func (c *Client) GetLabels(ctx context.Context, teamID string) (*Labels, error) {
+	query := `
+		query TeamLabels($id: String!) {
+			team(id: $id) {
+				labels(first: 250) {
+					nodes {
+						id
+						name
+						color
+						description
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": teamID,
+	}
+
+	var response struct {
+		Team struct {
+			Labels Labels `json:"labels"`
+		} `json:"team"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Team.Labels, nil
+}
+
+// GetCycles returns a page of a team's cycles; the team is identified by ID or key
+func (c *Client) GetCycles(ctx context.Context, teamID string, first int, after string) (*Cycles, error) {
+	query := `
+		query TeamCycles($id: String!, $first: Int, $after: String) {
+			team(id: $id) {
+				cycles(first: $first, after: $after) {
+					nodes {
+						id
+						number
+						name
+						description
+						startsAt
+						endsAt
+						progress
+						completedAt
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    teamID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Team struct {
+			Cycles Cycles `json:"cycles"`
+		} `json:"team"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Team.Cycles, nil
+}
+
+// CreateLabel creates a new issue label
+func (c *Client) CreateLabel(ctx context.Context, input LabelCreateInput) (*Label, error) {
+	query := `
+		mutation CreateLabel($input: IssueLabelCreateInput!) {
+			issueLabelCreate(input: $input) {
+				issueLabel {
+					id
+					name
+					color
+					description
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		IssueLabelCreate struct {
+			IssueLabel Label `json:"issueLabel"`
+		} `json:"issueLabelCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.IssueLabelCreate.IssueLabel, nil
+}
+
+// Comment represents a Linear comment
+type Comment struct {
+	ID        string     `json:"id"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	EditedAt  *time.Time `json:"editedAt"`
+	User      *User      `json:"user"`
+	Parent    *Comment   `json:"parent"`
+	Children  *Comments  `json:"children"`
+	URL       string     `json:"url,omitempty"`
+}
+
+// Comments represents a paginated list of comments
+type Comments struct {
+	Nodes    []Comment `json:"nodes"`
+	PageInfo PageInfo  `json:"pageInfo"`
+}
+
+// WorkflowState represents a Linear workflow state
+type WorkflowState struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Color       string  `json:"color"`
+	Description string  `json:"description"`
+	Position    float64 `json:"position"`
+}
+
+// GetTeamStates returns workflow states for a team
+func (c *Client) GetTeamStates(ctx context.Context, teamKey string) ([]WorkflowState, error) {
+	query := `
+		query TeamStates($key: String!) {
+			team(id: $key) {
+				states {
+					nodes {
+						id
+						name
+						type
+						color
+						description
+						position
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"key": teamKey,
+	}
+
+	var response struct {
+		Team struct {
+			States struct {
+				Nodes []WorkflowState `json:"nodes"`
+			} `json:"states"`
+		} `json:"team"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Team.States.Nodes, nil
+}
+
+// GetWorkflowStates returns the workflow states of a team, by team ID, ordered
+// by type and then position as Linear shows them on the board
+func (c *Client) GetWorkflowStates(ctx context.Context, teamID string) ([]WorkflowState, error) {
+	query := `
+		query WorkflowStates($teamId: ID!) {
+			workflowStates(first: 250, filter: { team: { id: { eq: $teamId } } }) {
+				nodes {
+					id
+					name
+					type
+					color
+					description
+					position
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"teamId": teamID,
+	}
+
+	var response struct {
+		WorkflowStates struct {
+			Nodes []WorkflowState `json:"nodes"`
+		} `json:"workflowStates"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	states := response.WorkflowStates.Nodes
+	sort.SliceStable(states, func(i, j int) bool {
+		ri, rj := workflowStateTypeRank(states[i].Type), workflowStateTypeRank(states[j].Type)
+		if ri != rj {
+			return ri < rj
+		}
+		return states[i].Position < states[j].Position
+	})
+	return states, nil
+}
+
+// workflowStateTypes lists Linear's workflow state types in board order
+var workflowStateTypes = []string{"triage", "backlog", "unstarted", "started", "completed", "canceled"}
+
+// workflowStateTypeRank returns the board position of a state type; unknown
+// types sort last
+func workflowStateTypeRank(stateType string) int {
+	for i, t := range workflowStateTypes {
+		if t == stateType {
+			return i
+		}
+	}
+	return len(workflowStateTypes)
+}
+
+// GetTeamMembers returns members of a specific team
+func (c *Client) GetTeamMembers(ctx context.Context, teamKey string) (*Users, error) {
+	query := `
+		query TeamMembers($key: String!) {
+			team(id: $key) {
+				members {
+					nodes {
+						id
+						name
+						email
+						avatarUrl
+						isMe
+						active
+						admin
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"key": teamKey,
+	}
+
+	var response struct {
+		Team struct {
+			Members Users `json:"members"`
+		} `json:"team"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Team.Members, nil
+}
+
+// GetUsers returns a list of all users
+func (c *Client) GetUsers(ctx context.Context, first int, after string, orderBy string) (*Users, error) {
+	query := `
+		query Users($first: Int, $after: String, $orderBy: PaginationOrderBy) {
+			users(first: $first, after: $after, orderBy: $orderBy) {
+				nodes {
+					id
+					name
+					email
+					avatarUrl
+					isMe
+					active
+					admin
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+	if orderBy != "" {
+		variables["orderBy"] = orderBy
+	}
+
+	var response struct {
+		Users Users `json:"users"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Users, nil
+}
+
+// GetUser returns a specific user by email
+func (c *Client) GetUser(ctx context.Context, email string) (*User, error) {
+	query := `
+		query User($email: String!) {
+			user(email: $email) {
+				id
+				name
+				email
+				avatarUrl
+				isMe
+				active
+				admin
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"email": email,
+	}
+
+	var response struct {
+		User User `json:"user"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.User, nil
+}
+
+// ErrUserNotFound is returned by FindUser when no user matches
+var ErrUserNotFound = errors.New("user not found")
+
+// AmbiguousUserError is returned by FindUser when more than one user matches
+type AmbiguousUserError struct {
+	Query      string
+	Candidates []User
+}
+
+func (e *AmbiguousUserError) Error() string {
+	candidates := make([]string, len(e.Candidates))
+	for i, user := range e.Candidates {
+		candidates[i] = fmt.Sprintf("%s <%s>", user.Name, user.Email)
+	}
+	return fmt.Sprintf("multiple users match %q: %s", e.Query, strings.Join(candidates, ", "))
+}
+
+// FindUser resolves a user by email, falling back to name or display name.
+// Matching is case-insensitive.
+func (c *Client) FindUser(ctx context.Context, query string) (*User, error) {
+	gql := `
+		query FindUser($filter: UserFilter) {
+			users(filter: $filter, first: 10) {
+				nodes {
+					id
+					name
+					displayName
+					email
+					avatarUrl
+					isMe
+					active
+					admin
+				}
+			}
+		}
+	`
+
+	filters := []map[string]interface{}{
+		{"email": map[string]interface{}{"eqIgnoreCase": query}},
+		{"or": []map[string]interface{}{
+			{"name": map[string]interface{}{"eqIgnoreCase": query}},
+			{"displayName": map[string]interface{}{"eqIgnoreCase": query}},
+		}},
+	}
+
+	for _, filter := range filters {
+		var response struct {
+			Users Users `json:"users"`
+		}
+
+		err := c.Execute(ctx, gql, map[string]interface{}{"filter": filter}, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		switch len(response.Users.Nodes) {
+		case 0:
+			continue
+		case 1:
+			return &response.Users.Nodes[0], nil
+		default:
+			return nil, &AmbiguousUserError{Query: query, Candidates: response.Users.Nodes}
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no user matches %q by email or name", ErrUserNotFound, query)
+}
+
+// GetIssueComments returns comments for a specific issue, optionally filtered
+// with a CommentFilter such as {"user": {"id": {"eq": userID}}}
+func (c *Client) GetIssueComments(ctx context.Context, issueID string, filter map[string]interface{}, first int, after string, orderBy string) (*Comments, error) {
+	query := `
+		query IssueComments($id: String!, $filter: CommentFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
+			issue(id: $id) {
+				comments(filter: $filter, first: $first, after: $after, orderBy: $orderBy) {
+					nodes {
+						id
+						body
+						createdAt
+						updatedAt
+						user {
+							id
+							name
+							email
+						}
+						parent {
+							id
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"first": first,
+	}
+	if filter != nil {
+		variables["filter"] = filter
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+	if orderBy != "" {
+		variables["orderBy"] = orderBy
+	}
+
+	var response struct {
+		Issue struct {
+			Comments Comments `json:"comments"`
+		} `json:"issue"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Issue.Comments, nil
+}
+
+// GetIssueHistory returns one page of an issue's change history: state,
+// assignee, priority, label and other field changes with the actor behind each
+func (c *Client) GetIssueHistory(ctx context.Context, issueID string, first int, after string) (*IssueHistory, error) {
+	query := `
+		query IssueHistory($id: String!, $first: Int, $after: String) {
+			issue(id: $id) {
+				history(first: $first, after: $after) {
+					nodes {
+						id
+						createdAt
+						updatedAt
+						actor {
+							id
+							name
+							email
+						}
+						fromAssignee {
+							name
+						}
+						toAssignee {
+							name
+						}
+						fromState {
+							name
+							type
+						}
+						toState {
+							name
+							type
+						}
+						fromPriority
+						toPriority
+						fromTitle
+						toTitle
+						fromCycle {
+							number
+							name
+						}
+						toCycle {
+							number
+							name
+						}
+						fromProject {
+							name
+						}
+						toProject {
+							name
+						}
+						fromEstimate
+						toEstimate
+						fromDueDate
+						toDueDate
+						fromParent {
+							identifier
+						}
+						toParent {
+							identifier
+						}
+						addedLabelIds
+						removedLabelIds
+						addedLabels {
+							id
+							name
+						}
+						removedLabels {
+							id
+							name
+						}
+						updatedDescription
+						archived
+						trashed
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Issue struct {
+			History IssueHistory `json:"history"`
+		} `json:"issue"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Issue.History, nil
+}
+
+// CreateComment creates a new comment on an issue
+func (c *Client) CreateComment(ctx context.Context, input CommentCreateInput) (*Comment, error) {
+	return c.createComment(ctx, input, nil)
+}
+
+// createComment is CreateComment, passing the HTTP response to observe, see execute
+func (c *Client) createComment(ctx context.Context, input CommentCreateInput, observe func(*http.Response)) (*Comment, error) {
+	query := `
+		mutation CreateComment($input: CommentCreateInput!) {
+			commentCreate(input: $input) {
+				comment {
+					id
+					body
+					createdAt
+					updatedAt
+					user {
+						id
+						name
+						email
+					}
+					parent {
+						id
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		CommentCreate struct {
+			Comment Comment `json:"comment"`
+		} `json:"commentCreate"`
+	}
+
+	err := c.execute(ctx, query, variables, &response, observe)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.CommentCreate.Comment, nil
+}
+
+// GetComment gets a single comment by ID
+func (c *Client) GetComment(ctx context.Context, id string) (*Comment, error) {
+	query := `
+		query Comment($id: String!) {
+			comment(id: $id) {
+				id
+				body
+				createdAt
+				updatedAt
+				user {
+					id
+					name
+					email
+				}
+				parent {
+					id
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		Comment Comment `json:"comment"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Comment, nil
+}
+
+// CreateCommentSimple creates a new comment on an issue (backward compatibility)
+func (c *Client) CreateCommentSimple(ctx context.Context, issueID string, body string) (*Comment, error) {
+	input := CommentCreateInput{
+		IssueID: issueID,
+		Body:    body,
+	}
+	return c.CreateComment(ctx, input)
+}
+
+// CommentActor attributes comments to an actor, see CommentCreateInput
+type CommentActor struct {
+	CreateAsUser   *string
+	DisplayIconURL *string
+}
+
+// BroadcastResult is the outcome of posting a broadcast comment to one issue
+type BroadcastResult struct {
+	IssueID string
+	Comment *Comment
+	Error   error
+}
+
+// BroadcastComment posts body as a comment on each issue in turn, waiting on
+// the process-wide rate limiter between requests. Results are returned in
+// input order; a failed issue does not stop the rest unless the context is cancelled.
+func (c *Client) BroadcastComment(ctx context.Context, issueIDs []string, body string, actor *CommentActor) []BroadcastResult {
+	limiter := ratelimit.Shared()
+	results := make([]BroadcastResult, len(issueIDs))
+
+	for i, issueID := range issueIDs {
+		results[i].IssueID = issueID
+		if err := WaitForRateLimit(ctx, limiter); err != nil {
+			for j := i; j < len(issueIDs); j++ {
+				results[j] = BroadcastResult{IssueID: issueIDs[j], Error: err}
+			}
+			break
+		}
+
+		input := CommentCreateInput{IssueID: issueID, Body: body}
+		if actor != nil {
+			input.CreateAsUser = actor.CreateAsUser
+			input.DisplayIconURL = actor.DisplayIconURL
+		}
+		results[i].Comment, results[i].Error = c.createComment(ctx, input, limiter.UpdateFromResponse)
+	}
+
+	return results
+}
+
+// UpdateComment updates the body of an existing comment
+func (c *Client) UpdateComment(ctx context.Context, input CommentUpdateInput) (*Comment, error) {
+	query := `
+		mutation UpdateComment($id: String!, $input: CommentUpdateInput!) {
+			commentUpdate(id: $id, input: $input) {
+				comment {
+					id
+					body
+					createdAt
+					updatedAt
+					user {
+						id
+						name
+						email
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    input.ID,
+		"input": input,
+	}
+
+	var response struct {
+		CommentUpdate struct {
+			Comment Comment `json:"comment"`
+		} `json:"commentUpdate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.CommentUpdate.Comment, nil
+}
+
+// DeleteComment deletes a comment, reporting whether Linear confirmed the deletion
+func (c *Client) DeleteComment(ctx context.Context, id string) (bool, error) {
+	query := `
+		mutation DeleteComment($id: String!) {
+			commentDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		CommentDelete struct {
+			Success bool `json:"success"`
+		} `json:"commentDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return false, err
+	}
+
+	return response.CommentDelete.Success, nil
+}
+
+// ReactionInput represents input for reacting to a comment
+type ReactionInput struct {
+	CommentID string `json:"commentId"`
+	Emoji     string `json:"emoji"`
+}
+
+// CreateReaction adds an emoji reaction to a comment
+func (c *Client) CreateReaction(ctx context.Context, input ReactionInput) (*Reaction, error) {
+	query := `
+		mutation CreateReaction($input: ReactionCreateInput!) {
+			reactionCreate(input: $input) {
+				success
+				reaction {
+					id
+					emoji
+					createdAt
+					user {
+						id
+						name
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		ReactionCreate struct {
+			Success  bool     `json:"success"`
+			Reaction Reaction `json:"reaction"`
+		} `json:"reactionCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ReactionCreate.Reaction, nil
+}
+
+// GetCommentReactions returns the reactions on a comment
+func (c *Client) GetCommentReactions(ctx context.Context, commentID string) ([]Reaction, error) {
+	query := `
+		query CommentReactions($id: String!) {
+			comment(id: $id) {
+				reactions {
+					id
+					emoji
+					createdAt
+					user {
+						id
+						name
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": commentID,
+	}
+
+	var response struct {
+		Comment struct {
+			Reactions []Reaction `json:"reactions"`
+		} `json:"comment"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Comment.Reactions, nil
+}
+
+// DeleteReaction deletes a reaction, reporting whether Linear confirmed the deletion
+func (c *Client) DeleteReaction(ctx context.Context, id string) (bool, error) {
+	query := `
+		mutation DeleteReaction($id: String!) {
+			reactionDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		ReactionDelete struct {
+			Success bool `json:"success"`
+		} `json:"reactionDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return false, err
+	}
+
+	return response.ReactionDelete.Success, nil
+}
+
+// Favorite is an entity in the user's favorites, such as an issue or project.
+// GetFavorites only fills in the ID of the favorited issue or project.
+type Favorite struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	SortOrder float64   `json:"sortOrder"`
+	CreatedAt time.Time `json:"createdAt"`
+	Issue     *Issue    `json:"issue,omitempty"`
+	Project   *Project  `json:"project,omitempty"`
+}
+
+// Favorites is a page of favorites
+type Favorites struct {
+	Nodes    []Favorite `json:"nodes"`
+	PageInfo PageInfo   `json:"pageInfo"`
+}
+
+// FavoriteInput represents input for favoriting an issue or a project
+type FavoriteInput struct {
+	IssueID   string `json:"issueId,omitempty"`
+	ProjectID string `json:"projectId,omitempty"`
+}
+
+// GetFavorites returns a page of the current user's favorites
+func (c *Client) GetFavorites(ctx context.Context, first int, after string) (*Favorites, error) {
+	query := `
+		query Favorites($first: Int, $after: String) {
+			favorites(first: $first, after: $after) {
+				nodes {
+					id
+					type
+					sortOrder
+					createdAt
+					issue {
+						id
+					}
+					project {
+						id
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Favorites Favorites `json:"favorites"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Favorites, nil
+}
+
+// CreateFavorite adds an issue or project to the current user's favorites
+func (c *Client) CreateFavorite(ctx context.Context, input FavoriteInput) (*Favorite, error) {
+	query := `
+		mutation CreateFavorite($input: FavoriteCreateInput!) {
+			favoriteCreate(input: $input) {
+				success
+				favorite {
+					id
+					type
+					sortOrder
+					createdAt
+					issue {
+						id
+						identifier
+						title
+					}
+					project {
+						id
+						name
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		FavoriteCreate struct {
+			Success  bool     `json:"success"`
+			Favorite Favorite `json:"favorite"`
+		} `json:"favoriteCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.FavoriteCreate.Favorite, nil
+}
+
+// DeleteFavorite removes a favorite
+func (c *Client) DeleteFavorite(ctx context.Context, id string) (bool, error) {
+	query := `
+		mutation DeleteFavorite($id: String!) {
+			favoriteDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		FavoriteDelete struct {
+			Success bool `json:"success"`
+		} `json:"favoriteDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return false, err
+	}
+
+	return response.FavoriteDelete.Success, nil
 }
@@ -3,10 +3,16 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
 )
 
 func TestIssueCreateInput(t *testing.T) {
@@ -196,6 +202,19 @@ func TestCommentCreateInput(t *testing.T) {
 				"displayIconUrl": "https://example.com/agent.png",
 			},
 		},
+		{
+			name: "reply to a comment",
+			input: CommentCreateInput{
+				IssueID:  "issue-123",
+				Body:     "Agreed",
+				ParentID: stringPtr("comment-456"),
+			},
+			expected: map[string]interface{}{
+				"issueId":  "issue-123",
+				"body":     "Agreed",
+				"parentId": "comment-456",
+			},
+		},
 		{
 			name: "comment with only actor name",
 			input: CommentCreateInput{
@@ -604,6 +623,130 @@ func TestCreateComment(t *testing.T) {
 	}
 }
 
+func TestUpdateComment(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"commentUpdate": map[string]interface{}{
+					"comment": map[string]interface{}{
+						"id":   "comment-456",
+						"body": "Edited",
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	comment, err := client.UpdateComment(context.Background(), CommentUpdateInput{
+		ID:           "comment-456",
+		Body:         "Edited",
+		CreateAsUser: stringPtr("AI Agent"),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if comment.ID != "comment-456" || comment.Body != "Edited" {
+		t.Errorf("Expected updated comment, got %+v", comment)
+	}
+
+	if req.Variables["id"] != "comment-456" {
+		t.Errorf("Expected id variable comment-456, got %v", req.Variables["id"])
+	}
+	input := req.Variables["input"].(map[string]interface{})
+	if input["body"] != "Edited" || input["createAsUser"] != "AI Agent" {
+		t.Errorf("Expected body and actor in input, got %v", input)
+	}
+	if _, ok := input["id"]; ok {
+		t.Error("Expected id to be sent outside the input object")
+	}
+}
+
+func TestDeleteComment(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    map[string]interface{}
+		wantSuccess bool
+		wantErr     bool
+	}{
+		{
+			name: "deleted",
+			response: map[string]interface{}{
+				"data": map[string]interface{}{"commentDelete": map[string]interface{}{"success": true}},
+			},
+			wantSuccess: true,
+		},
+		{
+			name: "not found",
+			response: map[string]interface{}{
+				"errors": []map[string]interface{}{{"message": "Entity not found"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(tt.response)
+			}))
+			defer server.Close()
+
+			client := NewClientWithURL(server.URL, "test-auth-header")
+
+			success, err := client.DeleteComment(context.Background(), "comment-456")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if success != tt.wantSuccess {
+				t.Errorf("Expected success %v, got %v", tt.wantSuccess, success)
+			}
+		})
+	}
+}
+
+func TestGetComment(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"comment": map[string]interface{}{
+					"id":   "comment-456",
+					"body": "Looks good",
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	comment, err := client.GetComment(context.Background(), "comment-456")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if comment.ID != "comment-456" || comment.Body != "Looks good" {
+		t.Errorf("Expected comment, got %+v", comment)
+	}
+	if req.Variables["id"] != "comment-456" {
+		t.Errorf("Expected id variable comment-456, got %v", req.Variables["id"])
+	}
+}
+
 func TestCreateCommentSimple(t *testing.T) {
 	// Test backward compatibility method
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -646,15 +789,1410 @@ func TestCreateCommentSimple(t *testing.T) {
 	}
 }
 
-// Helper functions for creating pointers
-func stringPtr(s string) *string {
-	return &s
+func TestArchiveIssue(t *testing.T) {
+	tests := []struct {
+		name           string
+		unarchive      bool
+		mutation       string
+		serverResponse map[string]interface{}
+		expectError    bool
+	}{
+		{
+			name:     "archive",
+			mutation: "issueArchive",
+			serverResponse: map[string]interface{}{
+				"data": map[string]interface{}{
+					"issueArchive": map[string]interface{}{
+						"success": true,
+						"entity": map[string]interface{}{
+							"id":         "issue-456",
+							"identifier": "TEST-123",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:      "unarchive",
+			unarchive: true,
+			mutation:  "issueUnarchive",
+			serverResponse: map[string]interface{}{
+				"data": map[string]interface{}{
+					"issueUnarchive": map[string]interface{}{
+						"success": true,
+						"entity": map[string]interface{}{
+							"id":         "issue-456",
+							"identifier": "TEST-123",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:     "not found",
+			mutation: "issueArchive",
+			serverResponse: map[string]interface{}{
+				"errors": []map[string]interface{}{
+					{"message": "Entity not found"},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req GraphQLRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Errorf("Failed to decode request: %v", err)
+				}
+
+				if !strings.Contains(req.Query, tt.mutation+"(id: $id)") {
+					t.Errorf("Expected query to call %s, got %s", tt.mutation, req.Query)
+				}
+
+				if req.Variables["id"] != "TEST-123" {
+					t.Errorf("Expected id TEST-123, got %v", req.Variables["id"])
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			client := NewClientWithURL(server.URL, "test-auth-header")
+
+			var result *IssueArchivePayload
+			var err error
+			if tt.unarchive {
+				result, err = client.UnarchiveIssue(context.Background(), "TEST-123")
+			} else {
+				result, err = client.ArchiveIssue(context.Background(), "TEST-123")
+			}
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), "not found") {
+					t.Errorf("Expected not found error, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !result.Success {
+				t.Error("Expected success to be true")
+			}
+
+			if result.Entity == nil || result.Entity.Identifier != "TEST-123" {
+				t.Errorf("Expected entity TEST-123, got %+v", result.Entity)
+			}
+		})
+	}
 }
 
-func intPtr(i int) *int {
-	return &i
+func TestSubscribeToIssue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		if !strings.Contains(req.Query, "issueSubscribe(id: $id)") {
+			t.Errorf("Expected query to call issueSubscribe, got %s", req.Query)
+		}
+
+		if req.Variables["id"] != "issue-456" {
+			t.Errorf("Expected id issue-456, got %v", req.Variables["id"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"issueSubscribe": map[string]interface{}{
+					"success": true,
+					"issue": map[string]interface{}{
+						"id":         "issue-456",
+						"identifier": "TEST-123",
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	result, err := client.SubscribeToIssue(context.Background(), "issue-456")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !result.Success {
+		t.Error("Expected success to be true")
+	}
+
+	if result.Issue == nil || result.Issue.Identifier != "TEST-123" {
+		t.Errorf("Expected issue TEST-123, got %+v", result.Issue)
+	}
 }
 
-func float64Ptr(f float64) *float64 {
-	return &f
+func TestIsSubscribedToIssue(t *testing.T) {
+	for _, subscribed := range []bool{true, false} {
+		t.Run(fmt.Sprintf("subscribed=%v", subscribed), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req GraphQLRequest
+				json.NewDecoder(r.Body).Decode(&req)
+
+				if req.Variables["id"] != "TEST-123" || req.Variables["userId"] != "user-1" {
+					t.Errorf("Expected issue and user variables, got %v", req.Variables)
+				}
+
+				nodes := []map[string]interface{}{}
+				if subscribed {
+					nodes = append(nodes, map[string]interface{}{"id": "user-1"})
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": map[string]interface{}{
+						"issue": map[string]interface{}{
+							"id":          "issue-456",
+							"subscribers": map[string]interface{}{"nodes": nodes},
+						},
+					},
+				})
+			}))
+			defer server.Close()
+
+			client := NewClientWithURL(server.URL, "test-auth-header")
+
+			got, err := client.IsSubscribedToIssue(context.Background(), "TEST-123", "user-1")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != subscribed {
+				t.Errorf("Expected subscribed %v, got %v", subscribed, got)
+			}
+		})
+	}
+}
+
+func TestUnsubscribeFromIssue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		if !strings.Contains(req.Query, "issueUnsubscribe(id: $id)") {
+			t.Errorf("Expected query to call issueUnsubscribe, got %s", req.Query)
+		}
+
+		if req.Variables["id"] != "issue-456" {
+			t.Errorf("Expected id issue-456, got %v", req.Variables["id"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"issueUnsubscribe": map[string]interface{}{
+					"success": true,
+					"issue": map[string]interface{}{
+						"id":         "issue-456",
+						"identifier": "TEST-123",
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	result, err := client.UnsubscribeFromIssue(context.Background(), "issue-456")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !result.Success {
+		t.Error("Expected success to be true")
+	}
+}
+
+// useSharedConcurrency resets the shared rate limiter to allow
+// maxConcurrency requests in flight for the rest of the test
+func useSharedConcurrency(t *testing.T, maxConcurrency int) {
+	t.Helper()
+	ratelimit.ResetShared()
+	t.Cleanup(ratelimit.ResetShared)
+
+	config := ratelimit.DefaultRateLimitConfig()
+	config.MaxConcurrency = maxConcurrency
+	ratelimit.SharedWithConfig(config, nil)
+}
+
+func TestBulkCreateIssues(t *testing.T) {
+	useSharedConcurrency(t, 1)
+	var titles []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		input := req.Variables["input"].(map[string]interface{})
+		title := input["title"].(string)
+		titles = append(titles, title)
+
+		w.Header().Set("Content-Type", "application/json")
+		if title == "Broken" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"errors": []map[string]interface{}{{"message": "Team not found"}},
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"issueCreate": map[string]interface{}{
+					"issue": map[string]interface{}{
+						"id":         "id-" + title,
+						"identifier": "TEST-" + title,
+						"title":      title,
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	results := client.BulkCreateIssues(context.Background(), []IssueCreateInput{
+		{Title: "One", TeamID: "team-123"},
+		{Title: "Broken", TeamID: "team-123"},
+		{Title: "Three", TeamID: "team-123"},
+	})
+
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+	if strings.Join(titles, ",") != "One,Broken,Three" {
+		t.Errorf("Expected rows to be created in order, got %v", titles)
+	}
+	if results[0].Error != nil || results[0].Issue.Identifier != "TEST-One" {
+		t.Errorf("Expected first row to succeed, got %+v", results[0])
+	}
+	if results[1].Error == nil || results[1].Issue != nil {
+		t.Errorf("Expected second row to fail, got %+v", results[1])
+	}
+	if results[2].Error != nil || results[2].Issue.Identifier != "TEST-Three" {
+		t.Errorf("Expected third row to succeed after a failure, got %+v", results[2])
+	}
+}
+
+func TestBulkCreateIssues_Cancelled(t *testing.T) {
+	client := NewClientWithURL("http://127.0.0.1:0", "test-auth-header")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := client.BulkCreateIssues(ctx, []IssueCreateInput{{Title: "One"}, {Title: "Two"}})
+	for i, result := range results {
+		if result.Error == nil {
+			t.Errorf("Expected row %d to fail after cancellation", i)
+		}
+	}
+}
+
+func TestExecute_ReportsContextCause(t *testing.T) {
+	client := NewClientWithURL("http://127.0.0.1:0", "test-auth-header")
+
+	cause := errors.New("linctl did not finish within --timeout 1s")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(cause)
+
+	err := client.Execute(ctx, "query { viewer { id } }", nil, nil)
+	if !errors.Is(err, cause) {
+		t.Errorf("Expected the cancellation cause, got %v", err)
+	}
+}
+
+func TestBulkCreateIssues_Concurrent(t *testing.T) {
+	useSharedConcurrency(t, 3)
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		title := req.Variables["input"].(map[string]interface{})["title"].(string)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"issueCreate": map[string]interface{}{
+					"issue": map[string]interface{}{"id": "id-" + title, "identifier": "TEST-" + title, "title": title},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	inputs := make([]IssueCreateInput, 9)
+	for i := range inputs {
+		inputs[i] = IssueCreateInput{Title: fmt.Sprintf("%d", i), TeamID: "team-123"}
+	}
+
+	results := client.BulkCreateIssues(context.Background(), inputs)
+
+	for i, result := range results {
+		if result.Error != nil || result.Issue.Identifier != fmt.Sprintf("TEST-%d", i) {
+			t.Errorf("Expected row %d to succeed in input order, got %+v", i, result)
+		}
+	}
+	if peak < 2 || peak > 3 {
+		t.Errorf("Expected between 2 and 3 requests in flight, got %d", peak)
+	}
+}
+
+func TestBroadcastComment(t *testing.T) {
+	var posted []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		input := req.Variables["input"].(map[string]interface{})
+		posted = append(posted, input)
+
+		w.Header().Set("Content-Type", "application/json")
+		if input["issueId"] == "issue-bad" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"errors": []map[string]interface{}{{"message": "Entity not found"}},
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"commentCreate": map[string]interface{}{
+					"comment": map[string]interface{}{"id": "comment-" + input["issueId"].(string), "body": input["body"]},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+	bot := "Release Bot"
+	results := client.BroadcastComment(context.Background(), []string{"issue-1", "issue-bad", "issue-2"}, "Heads up", &CommentActor{CreateAsUser: &bot})
+
+	if len(results) != 3 || len(posted) != 3 {
+		t.Fatalf("Expected every issue to be tried, got %d results and %d requests", len(results), len(posted))
+	}
+	if results[0].Error != nil || results[0].Comment.ID != "comment-issue-1" || results[2].Comment == nil {
+		t.Errorf("Expected the first and last issues to get the comment in order, got %+v", results)
+	}
+	if results[1].Error == nil || results[1].IssueID != "issue-bad" {
+		t.Errorf("Expected the failed issue to report its error, got %+v", results[1])
+	}
+	for _, input := range posted {
+		if input["body"] != "Heads up" || input["createAsUser"] != bot {
+			t.Errorf("Expected the same body and actor on every comment, got %v", input)
+		}
+	}
+}
+
+func TestBroadcastComment_Cancelled(t *testing.T) {
+	client := NewClientWithURL("http://127.0.0.1:0", "test-auth-header")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for i, result := range client.BroadcastComment(ctx, []string{"issue-1", "issue-2"}, "Heads up", nil) {
+		if result.Error == nil {
+			t.Errorf("Expected issue %d to fail after cancellation", i)
+		}
+	}
+}
+
+func TestFindUser(t *testing.T) {
+	jane := map[string]interface{}{"id": "user-jane", "name": "Jane Smith", "email": "jane@example.com"}
+	janeDoe := map[string]interface{}{"id": "user-jane-doe", "name": "Jane Smith", "email": "jdoe@example.com"}
+
+	tests := []struct {
+		name        string
+		query       string
+		byEmail     []interface{}
+		byName      []interface{}
+		expectedID  string
+		expectedErr string
+		requests    int
+	}{
+		{
+			name:       "match by email",
+			query:      "jane@example.com",
+			byEmail:    []interface{}{jane},
+			expectedID: "user-jane",
+			requests:   1,
+		},
+		{
+			name:       "fall back to name",
+			query:      "jane smith",
+			byName:     []interface{}{jane},
+			expectedID: "user-jane",
+			requests:   2,
+		},
+		{
+			name:        "ambiguous name",
+			query:       "Jane Smith",
+			byName:      []interface{}{jane, janeDoe},
+			expectedErr: "multiple users match \"Jane Smith\": Jane Smith <jane@example.com>, Jane Smith <jdoe@example.com>",
+			requests:    2,
+		},
+		{
+			name:        "no match",
+			query:       "nobody",
+			expectedErr: "user not found",
+			requests:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				var req GraphQLRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Errorf("Failed to decode request: %v", err)
+				}
+
+				filter := req.Variables["filter"].(map[string]interface{})
+				nodes := tt.byName
+				if email, ok := filter["email"].(map[string]interface{}); ok {
+					if email["eqIgnoreCase"] != tt.query {
+						t.Errorf("Expected email filter for %q, got %v", tt.query, email)
+					}
+					nodes = tt.byEmail
+				}
+				if nodes == nil {
+					nodes = []interface{}{}
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": map[string]interface{}{
+						"users": map[string]interface{}{"nodes": nodes},
+					},
+				})
+			}))
+			defer server.Close()
+
+			client := NewClientWithURL(server.URL, "test-auth-header")
+
+			user, err := client.FindUser(context.Background(), tt.query)
+			if requests != tt.requests {
+				t.Errorf("Expected %d requests, got %d", tt.requests, requests)
+			}
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if user.ID != tt.expectedID {
+				t.Errorf("Expected user %s, got %s", tt.expectedID, user.ID)
+			}
+		})
+	}
+}
+
+func TestRecentIssueIdentifiers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		filter, _ := req.Variables["filter"].(map[string]interface{})
+		team, _ := filter["team"].(map[string]interface{})
+		key, _ := team["key"].(map[string]interface{})
+		if key["eq"] != "ENG" {
+			t.Errorf("Expected team filter ENG, got %v", req.Variables["filter"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"issues": map[string]interface{}{
+					"nodes": []map[string]interface{}{
+						{"identifier": "ENG-12"},
+						{"identifier": "ENG-3"},
+						{"identifier": "ENG-120"},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	identifiers, err := client.RecentIssueIdentifiers(context.Background(), "eng-12")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Join(identifiers, ",") != "ENG-12,ENG-120" {
+		t.Errorf("Expected ENG-12 and ENG-120, got %v", identifiers)
+	}
+}
+
+func TestGetIssues_IncludeArchived(t *testing.T) {
+	archivedAt := "2024-01-01T00:00:00Z"
+
+	for _, includeArchived := range []bool{false, true} {
+		t.Run(fmt.Sprintf("includeArchived=%v", includeArchived), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req GraphQLRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Errorf("Failed to decode request: %v", err)
+				}
+
+				if !strings.Contains(req.Query, "includeArchived: $includeArchived") {
+					t.Error("Expected query to pass includeArchived to issues")
+				}
+
+				// Simulate Linear: archived issues are only returned when requested
+				nodes := []map[string]interface{}{
+					{"id": "issue-1", "identifier": "TEST-1"},
+				}
+				if req.Variables["includeArchived"] == true {
+					nodes = append(nodes, map[string]interface{}{"id": "issue-2", "identifier": "TEST-2", "archivedAt": archivedAt})
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": map[string]interface{}{
+						"issues": map[string]interface{}{"nodes": nodes},
+					},
+				})
+			}))
+			defer server.Close()
+
+			client := NewClientWithURL(server.URL, "test-auth-header")
+
+			issues, err := client.GetIssues(context.Background(), nil, 10, "", "", includeArchived)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			archivedCount := 0
+			for _, issue := range issues.Nodes {
+				if issue.ArchivedAt != nil {
+					archivedCount++
+				}
+			}
+
+			if includeArchived && archivedCount != 1 {
+				t.Errorf("Expected archived issue with includeArchived, got %d", archivedCount)
+			}
+			if !includeArchived && archivedCount != 0 {
+				t.Errorf("Expected archived issues to be excluded by default, got %d", archivedCount)
+			}
+		})
+	}
+}
+
+// Helper functions for creating pointers
+func stringPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func TestGetLabels(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"team": map[string]interface{}{
+					"labels": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"id": "label-1", "name": "bug", "color": "#ff0000"},
+							{"id": "label-2", "name": "feature", "color": "#00ff00"},
+						},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	labels, err := client.GetLabels(context.Background(), "ENG")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(labels.Nodes) != 2 || labels.Nodes[0].Name != "bug" || labels.Nodes[0].Color != "#ff0000" {
+		t.Errorf("Expected two labels, got %+v", labels.Nodes)
+	}
+	if req.Variables["id"] != "ENG" {
+		t.Errorf("Expected id variable ENG, got %v", req.Variables["id"])
+	}
+}
+
+func TestGetWorkflowStates(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"workflowStates": map[string]interface{}{
+					"nodes": []map[string]interface{}{
+						{"id": "s-done", "name": "Done", "type": "completed", "position": 0},
+						{"id": "s-review", "name": "In Review", "type": "started", "position": 2},
+						{"id": "s-progress", "name": "In Progress", "type": "started", "position": 1},
+						{"id": "s-todo", "name": "Todo", "type": "unstarted", "position": 0},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	states, err := client.GetWorkflowStates(context.Background(), "team-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var names []string
+	for _, state := range states {
+		names = append(names, state.Name)
+	}
+	if strings.Join(names, ",") != "Todo,In Progress,In Review,Done" {
+		t.Errorf("Expected states in board order, got %v", names)
+	}
+	if req.Variables["teamId"] != "team-1" {
+		t.Errorf("Expected teamId variable team-1, got %v", req.Variables["teamId"])
+	}
+}
+
+func TestGetIssueHistory(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"issue":{"history":{
+			"nodes":[{"id":"h1","createdAt":"2024-03-01T12:00:00Z","actor":{"name":"Jane"},
+				"fromState":{"name":"Todo","type":"unstarted"},"toState":{"name":"Done","type":"completed"},
+				"addedLabels":[{"id":"l1","name":"bug"}],"updatedDescription":true}],
+			"pageInfo":{"hasNextPage":true,"endCursor":"cursor-2"}}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	history, err := client.GetIssueHistory(context.Background(), "LIN-1", 25, "cursor-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(history.Nodes) != 1 || history.Nodes[0].ToState.Name != "Done" || history.Nodes[0].AddedLabels[0].Name != "bug" || !history.Nodes[0].UpdatedDescription {
+		t.Errorf("Unexpected history %+v", history.Nodes)
+	}
+	if history.PageInfo == nil || !history.PageInfo.HasNextPage || history.PageInfo.EndCursor != "cursor-2" {
+		t.Errorf("Unexpected page info %+v", history.PageInfo)
+	}
+	if req.Variables["id"] != "LIN-1" || req.Variables["after"] != "cursor-1" || req.Variables["first"] != float64(25) {
+		t.Errorf("Unexpected variables %v", req.Variables)
+	}
+}
+
+func TestCreateLabel(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"issueLabelCreate": map[string]interface{}{
+					"issueLabel": map[string]interface{}{
+						"id":    "label-1",
+						"name":  "bug",
+						"color": "#ff0000",
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	label, err := client.CreateLabel(context.Background(), LabelCreateInput{
+		Name:   "bug",
+		Color:  "#ff0000",
+		TeamID: "team-123",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if label.ID != "label-1" || label.Name != "bug" {
+		t.Errorf("Expected created label, got %+v", label)
+	}
+
+	input := req.Variables["input"].(map[string]interface{})
+	if input["name"] != "bug" || input["color"] != "#ff0000" || input["teamId"] != "team-123" {
+		t.Errorf("Expected name, color and team in input, got %v", input)
+	}
+	if _, ok := input["description"]; ok {
+		t.Error("Expected description to be omitted when unset")
+	}
+}
+
+func TestGetProjectMilestones(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+		if req.Variables["id"] != "project-1" || req.Variables["first"] != float64(25) || req.Variables["after"] != "cursor-0" {
+			t.Errorf("Unexpected variables %v", req.Variables)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"project": map[string]interface{}{
+					"projectMilestones": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"id": "milestone-1", "name": "Beta", "targetDate": "2024-05-01"},
+						},
+						"pageInfo": map[string]interface{}{"hasNextPage": true, "endCursor": "cursor-1"},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+	milestones, err := client.GetProjectMilestones(context.Background(), "project-1", 25, "cursor-0")
+	if err != nil {
+		t.Fatalf("GetProjectMilestones failed: %v", err)
+	}
+	if len(milestones.Nodes) != 1 || milestones.Nodes[0].Name != "Beta" || *milestones.Nodes[0].TargetDate != "2024-05-01" {
+		t.Errorf("Unexpected milestones %+v", milestones.Nodes)
+	}
+	if !milestones.PageInfo.HasNextPage || milestones.PageInfo.EndCursor != "cursor-1" {
+		t.Errorf("Expected page info, got %+v", milestones.PageInfo)
+	}
+}
+
+func TestCreateProjectMilestone(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"projectMilestoneCreate": map[string]interface{}{
+					"projectMilestone": map[string]interface{}{
+						"id":         "milestone-1",
+						"name":       "Beta",
+						"targetDate": "2024-05-01",
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	targetDate := "2024-05-01"
+	milestone, err := client.CreateProjectMilestone(context.Background(), ProjectMilestoneCreateInput{
+		ProjectID:  "project-1",
+		Name:       "Beta",
+		TargetDate: &targetDate,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if milestone.ID != "milestone-1" || milestone.Name != "Beta" {
+		t.Errorf("Expected created milestone, got %+v", milestone)
+	}
+
+	input := req.Variables["input"].(map[string]interface{})
+	if input["projectId"] != "project-1" || input["name"] != "Beta" || input["targetDate"] != "2024-05-01" {
+		t.Errorf("Expected project, name and target date in input, got %v", input)
+	}
+	if _, ok := input["description"]; ok {
+		t.Error("Expected description to be omitted when unset")
+	}
+}
+
+func TestCreateIssueRelation(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"issueRelationCreate": map[string]interface{}{
+					"issueRelation": map[string]interface{}{
+						"id":           "relation-1",
+						"type":         "blocks",
+						"issue":        map[string]interface{}{"id": "issue-1", "identifier": "LIN-1"},
+						"relatedIssue": map[string]interface{}{"id": "issue-2", "identifier": "LIN-2"},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	relation, err := client.CreateIssueRelation(context.Background(), IssueRelationInput{
+		IssueID:        "issue-1",
+		RelatedIssueID: "issue-2",
+		Type:           IssueRelationBlocks,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if relation.ID != "relation-1" || relation.Issue.Identifier != "LIN-1" || relation.RelatedIssue.Identifier != "LIN-2" {
+		t.Errorf("Expected created relation, got %+v", relation)
+	}
+
+	input := req.Variables["input"].(map[string]interface{})
+	if input["issueId"] != "issue-1" || input["relatedIssueId"] != "issue-2" || input["type"] != "blocks" {
+		t.Errorf("Expected relation input, got %v", input)
+	}
+}
+
+func TestDeleteIssueRelation(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"issueRelationDelete": map[string]interface{}{"success": true}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	success, err := client.DeleteIssueRelation(context.Background(), "relation-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !success {
+		t.Error("Expected deletion to succeed")
+	}
+	if req.Variables["id"] != "relation-1" {
+		t.Errorf("Expected id variable relation-1, got %v", req.Variables["id"])
+	}
+}
+
+func TestGetViewerWithTeams(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"id":    "user-1",
+					"name":  "Test User",
+					"email": "test@example.com",
+					"teams": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"id": "team-1", "key": "ENG", "name": "Engineering"},
+							{"id": "team-2", "key": "OPS", "name": "Operations"},
+						},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	viewer, err := client.GetViewerWithTeams(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(req.Query, "teams") {
+		t.Error("Expected query to request viewer teams")
+	}
+	if viewer.Teams == nil || len(viewer.Teams.Nodes) != 2 {
+		t.Fatalf("Expected 2 teams, got %+v", viewer.Teams)
+	}
+	if viewer.Teams.Nodes[0].Key != "ENG" {
+		t.Errorf("Expected first team ENG, got %s", viewer.Teams.Nodes[0].Key)
+	}
+}
+
+func TestGetCycles(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"team": map[string]interface{}{
+					"cycles": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"id": "cycle-1", "number": 1, "name": "Sprint 1", "startsAt": "2024-01-01T00:00:00.000Z", "endsAt": "2024-01-15T00:00:00.000Z", "progress": 1},
+							{"id": "cycle-2", "number": 2, "startsAt": "2024-01-15T00:00:00.000Z", "endsAt": "2024-01-29T00:00:00.000Z", "progress": 0.25},
+						},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	cycles, err := client.GetCycles(context.Background(), "team-1", 50, "cursor-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if req.Variables["id"] != "team-1" {
+		t.Errorf("Expected id variable team-1, got %v", req.Variables["id"])
+	}
+	if req.Variables["first"] != float64(50) || req.Variables["after"] != "cursor-1" {
+		t.Errorf("Expected first=50 and after=cursor-1, got %v", req.Variables)
+	}
+	if len(cycles.Nodes) != 2 || cycles.Nodes[1].Progress != 0.25 {
+		t.Errorf("Unexpected cycles: %+v", cycles.Nodes)
+	}
+}
+
+func TestSearchIssues(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"searchIssues": map[string]interface{}{
+					"nodes": []map[string]interface{}{
+						{"id": "issue-1", "identifier": "ENG-1", "title": "Auth bug"},
+					},
+					"pageInfo": map[string]interface{}{"hasNextPage": true, "endCursor": "cursor-1"},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	filter := map[string]interface{}{"team": map[string]interface{}{"key": map[string]interface{}{"eq": "ENG"}}}
+	issues, err := client.SearchIssues(context.Background(), "auth bug", filter, 25, "cursor-0", true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(issues.Nodes) != 1 || issues.Nodes[0].Identifier != "ENG-1" {
+		t.Errorf("Expected ENG-1, got %+v", issues.Nodes)
+	}
+	if !issues.PageInfo.HasNextPage || issues.PageInfo.EndCursor != "cursor-1" {
+		t.Errorf("Expected page info to be decoded, got %+v", issues.PageInfo)
+	}
+	if !strings.Contains(req.Query, "searchIssues") {
+		t.Error("Expected the searchIssues query")
+	}
+	if req.Variables["term"] != "auth bug" || req.Variables["after"] != "cursor-0" || req.Variables["first"] != float64(25) {
+		t.Errorf("Unexpected variables %v", req.Variables)
+	}
+	if _, ok := req.Variables["filter"]; !ok {
+		t.Error("Expected the filter to be sent")
+	}
+	if req.Variables["includeArchived"] != true || !strings.Contains(req.Query, "includeArchived: $includeArchived") {
+		t.Errorf("Expected includeArchived to be passed to searchIssues, got %v", req.Variables["includeArchived"])
+	}
+}
+
+func TestGetIssueComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		filter, ok := req.Variables["filter"].(map[string]interface{})
+		if !ok || fmt.Sprint(filter["user"]) != "map[id:map[eq:user-1]]" {
+			t.Errorf("Expected author filter in variables, got %v", req.Variables["filter"])
+		}
+		if req.Variables["after"] != "cursor-1" {
+			t.Errorf("Expected after cursor, got %v", req.Variables["after"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"issue": map[string]interface{}{
+					"comments": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"id": "comment-1", "body": "Root"},
+							{"id": "comment-2", "body": "Reply", "parent": map[string]interface{}{"id": "comment-1"}},
+						},
+						"pageInfo": map[string]interface{}{"hasNextPage": false},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+	filter := map[string]interface{}{"user": map[string]interface{}{"id": map[string]interface{}{"eq": "user-1"}}}
+
+	comments, err := client.GetIssueComments(context.Background(), "LIN-123", filter, 50, "cursor-1", "")
+	if err != nil {
+		t.Fatalf("GetIssueComments failed: %v", err)
+	}
+	if len(comments.Nodes) != 2 {
+		t.Fatalf("Expected 2 comments, got %d", len(comments.Nodes))
+	}
+	if comments.Nodes[0].Parent != nil {
+		t.Error("Expected the first comment to have no parent")
+	}
+	if comments.Nodes[1].Parent == nil || comments.Nodes[1].Parent.ID != "comment-1" {
+		t.Errorf("Expected the reply's parent to be comment-1, got %+v", comments.Nodes[1].Parent)
+	}
+}
+
+func TestCreateAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+		if !strings.Contains(req.Query, "attachmentCreate") {
+			t.Errorf("Expected attachmentCreate mutation, got %s", req.Query)
+		}
+
+		input := req.Variables["input"].(map[string]interface{})
+		if input["issueId"] != "LIN-123" || input["url"] != "https://github.com/org/repo/pull/42" || input["title"] != "PR #42" {
+			t.Errorf("Unexpected input %v", input)
+		}
+		if _, ok := input["subtitle"]; ok {
+			t.Error("Expected subtitle to be omitted when unset")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"attachmentCreate": map[string]interface{}{
+					"success": true,
+					"attachment": map[string]interface{}{
+						"id":    "attachment-1",
+						"title": "PR #42",
+						"url":   "https://github.com/org/repo/pull/42",
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+	attachment, err := client.CreateAttachment(context.Background(), AttachmentInput{
+		IssueID: "LIN-123",
+		URL:     "https://github.com/org/repo/pull/42",
+		Title:   "PR #42",
+	})
+	if err != nil {
+		t.Fatalf("CreateAttachment failed: %v", err)
+	}
+	if attachment.ID != "attachment-1" {
+		t.Errorf("Expected attachment-1, got %s", attachment.ID)
+	}
+}
+
+func TestGetIssueAttachments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+		if req.Variables["id"] != "LIN-123" || req.Variables["first"] != float64(50) {
+			t.Errorf("Unexpected variables %v", req.Variables)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"issue": map[string]interface{}{
+					"attachments": map[string]interface{}{
+						"nodes": []map[string]interface{}{
+							{"id": "attachment-1", "title": "PR #42", "url": "https://github.com/org/repo/pull/42"},
+						},
+						"pageInfo": map[string]interface{}{"hasNextPage": true, "endCursor": "cursor-1"},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+	attachments, err := client.GetIssueAttachments(context.Background(), "LIN-123", 50, "")
+	if err != nil {
+		t.Fatalf("GetIssueAttachments failed: %v", err)
+	}
+	if len(attachments.Nodes) != 1 || attachments.Nodes[0].ID != "attachment-1" {
+		t.Errorf("Unexpected attachments %+v", attachments.Nodes)
+	}
+	if attachments.PageInfo == nil || !attachments.PageInfo.HasNextPage || attachments.PageInfo.EndCursor != "cursor-1" {
+		t.Errorf("Expected page info, got %+v", attachments.PageInfo)
+	}
+}
+
+func TestGetIssuesSorted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		if !strings.Contains(req.Query, "sort: $sort") {
+			t.Error("Expected the sort argument in the query")
+		}
+		sort, ok := req.Variables["sort"].([]interface{})
+		if !ok || len(sort) != 1 {
+			t.Fatalf("Expected one sort entry, got %v", req.Variables["sort"])
+		}
+		priority, _ := sort[0].(map[string]interface{})["priority"].(map[string]interface{})
+		if priority["order"] != "Descending" {
+			t.Errorf("Expected descending priority sort, got %v", sort[0])
+		}
+		if req.Variables["after"] != "cursor-1" {
+			t.Errorf("Expected the cursor to be passed with the sort, got %v", req.Variables["after"])
+		}
+		if _, ok := req.Variables["orderBy"]; ok {
+			t.Error("Expected no orderBy alongside sort")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"issues": map[string]interface{}{
+					"nodes": []map[string]interface{}{{"id": "issue-1", "identifier": "TEST-1"}},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+
+	sort := []map[string]interface{}{{"priority": map[string]interface{}{"order": "Descending"}}}
+	issues, err := client.GetIssuesSorted(context.Background(), nil, 10, "cursor-1", sort, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(issues.Nodes) != 1 {
+		t.Errorf("Expected 1 issue, got %d", len(issues.Nodes))
+	}
+}
+
+func TestGetViewerIssues(t *testing.T) {
+	viewerQueries := 0
+	var filters []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(req.Query, "viewer {") {
+			viewerQueries++
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{"viewer": map[string]interface{}{"id": "user-me"}},
+			})
+			return
+		}
+
+		filter, _ := req.Variables["filter"].(map[string]interface{})
+		filters = append(filters, filter)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"issues": map[string]interface{}{
+					"nodes": []map[string]interface{}{{"id": "issue-1", "identifier": "TEST-1"}},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+	state := map[string]interface{}{"name": map[string]interface{}{"eq": "Todo"}}
+	filter := map[string]interface{}{"state": state}
+
+	if _, err := client.GetViewerAssignedIssues(context.Background(), filter, 10, "", nil, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.GetViewerCreatedIssues(context.Background(), filter, 10, "", nil, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if viewerQueries != 1 {
+		t.Errorf("Expected the viewer to be looked up once, got %d lookups", viewerQueries)
+	}
+	if len(filters) != 2 {
+		t.Fatalf("Expected 2 issue queries, got %d", len(filters))
+	}
+	for i, field := range []string{"assignee", "creator"} {
+		scope, _ := filters[i][field].(map[string]interface{})
+		id, _ := scope["id"].(map[string]interface{})
+		if id["eq"] != "user-me" {
+			t.Errorf("Expected %s filtered to the viewer, got %v", field, filters[i])
+		}
+		if filters[i]["state"] == nil {
+			t.Errorf("Expected the caller's filter to be kept, got %v", filters[i])
+		}
+	}
+	if _, ok := filter["assignee"]; ok {
+		t.Error("Expected the caller's filter not to be modified")
+	}
+}
+
+func TestGetOrganization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+		if !strings.Contains(req.Query, "organization {") {
+			t.Errorf("Expected an organization query, got %s", req.Query)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id":           "org-1",
+					"name":         "Acme",
+					"urlKey":       "acme",
+					"userCount":    42,
+					"createdAt":    "2023-01-02T03:04:05Z",
+					"subscription": map[string]interface{}{"type": "business", "seats": 50},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+	org, err := client.GetOrganization(context.Background())
+	if err != nil {
+		t.Fatalf("GetOrganization failed: %v", err)
+	}
+	if org.Name != "Acme" || org.URLKey != "acme" || org.UserCount != 42 || org.Plan() != "business" {
+		t.Errorf("Unexpected organization %+v", org)
+	}
+
+	if plan := (&Organization{}).Plan(); plan != "free" {
+		t.Errorf("Expected an organization without a subscription to be on the free plan, got %q", plan)
+	}
+}
+
+func TestCreateReaction(t *testing.T) {
+	var req GraphQLRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"reactionCreate": map[string]interface{}{
+					"success":  true,
+					"reaction": map[string]interface{}{"id": "reaction-1", "emoji": "👍", "user": map[string]interface{}{"id": "user-1"}},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "test-auth-header")
+	reaction, err := client.CreateReaction(context.Background(), ReactionInput{CommentID: "comment-1", Emoji: "👍"})
+	if err != nil {
+		t.Fatalf("CreateReaction failed: %v", err)
+	}
+	if reaction.ID != "reaction-1" || reaction.Emoji != "👍" {
+		t.Errorf("Unexpected reaction %+v", reaction)
+	}
+
+	input, _ := req.Variables["input"].(map[string]interface{})
+	if !strings.Contains(req.Query, "reactionCreate") || input["commentId"] != "comment-1" || input["emoji"] != "👍" {
+		t.Errorf("Unexpected request %s %v", req.Query, req.Variables)
+	}
 }
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// TraceFileEnvVar names the file that request traces are appended to
+const TraceFileEnvVar = "LINCTL_TRACE_FILE"
+
+// redactedValue replaces sensitive header values in traces
+const redactedValue = "[REDACTED]"
+
+// tracedHeaders are the request headers whose values are written to traces.
+// Every other header, including Authorization and any LINCTL_EXTRA_HEADERS
+// entry that may carry a gateway token, is redacted.
+var tracedHeaders = map[string]bool{
+	"Content-Type":     true,
+	"User-Agent":       true,
+	"X-Request-Id":     true,
+	"X-Correlation-Id": true,
+}
+
+// TraceRecord is a single request written to the trace file as one JSON line
+type TraceRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	RequestID string    `json:"request_id"`
+	// CorrelationID is the caller's ID from --correlation-id or LINCTL_CORRELATION_ID
+	CorrelationID string            `json:"correlation_id,omitempty"`
+	QueryType     string            `json:"query_type"`
+	Operation     string            `json:"operation,omitempty"`
+	DurationMS    int64             `json:"duration_ms"`
+	StatusCode    int               `json:"status_code,omitempty"`
+	Retries       int64             `json:"retries"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	Error         string            `json:"error,omitempty"`
+}
+
+// tracer appends trace records to a file shared by all clients in the process
+type tracer struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+var (
+	envTracer     *tracer
+	envTracerOnce sync.Once
+)
+
+// tracerFromEnv returns the tracer for LINCTL_TRACE_FILE, or nil when tracing is disabled.
+// The file is opened once and reused for the life of the process.
+func tracerFromEnv() *tracer {
+	envTracerOnce.Do(func() {
+		path := os.Getenv(TraceFileEnvVar)
+		if path == "" {
+			return
+		}
+
+		t, err := newTracer(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: tracing disabled: %v\n", err)
+			return
+		}
+		envTracer = t
+	})
+	return envTracer
+}
+
+// newTracer opens path for appending trace records
+func newTracer(path string) (*tracer, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trace file %s: %w", path, err)
+	}
+	return &tracer{encoder: json.NewEncoder(file)}, nil
+}
+
+// record writes a trace record; write errors are ignored so tracing never fails a request
+func (t *tracer) record(record TraceRecord) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_ = t.encoder.Encode(record)
+}
+
+// traceHeaders copies request headers for a trace, redacting every header
+// not in tracedHeaders
+func traceHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name := range header {
+		if !tracedHeaders[http.CanonicalHeaderKey(name)] {
+			headers[name] = redactedValue
+			continue
+		}
+		headers[name] = header.Get(name)
+	}
+	return headers
+}
@@ -0,0 +1,206 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/resilience"
+)
+
+func readTraceRecords(t *testing.T, path string) []TraceRecord {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open trace file: %v", err)
+	}
+	defer file.Close()
+
+	var records []TraceRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record TraceRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Invalid trace line %q: %v", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestEnhancedClient_Trace(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"viewer":{"id":"123"}}}`))
+	}))
+	defer server.Close()
+
+	config := DefaultEnhancedClientConfig()
+	config.BaseURL = server.URL
+	config.Logger = logging.NewNoOpLogger()
+	config.RetryConfig = resilience.RetryConfig{
+		MaxAttempts:  3,
+		InitialDelay: time.Millisecond,
+		MaxDelay:     10 * time.Millisecond,
+		Multiplier:   2.0,
+	}
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tr, err := newTracer(path)
+	if err != nil {
+		t.Fatalf("Failed to create tracer: %v", err)
+	}
+
+	client := NewEnhancedClient("Bearer secret-token", config)
+	client.tracer = tr
+
+	if err := client.Execute(context.Background(), `query Viewer { viewer { id } }`, nil, nil); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+
+	records := readTraceRecords(t, path)
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 trace record, got %d", len(records))
+	}
+
+	record := records[0]
+	if !strings.HasPrefix(record.RequestID, "req_") {
+		t.Errorf("Expected request ID, got %q", record.RequestID)
+	}
+	if record.QueryType != "query" || record.Operation != "Viewer" {
+		t.Errorf("Expected query Viewer, got %s %s", record.QueryType, record.Operation)
+	}
+	if record.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", record.StatusCode)
+	}
+	if record.Retries != 1 {
+		t.Errorf("Expected 1 retry, got %d", record.Retries)
+	}
+	if record.Headers["Authorization"] != redactedValue {
+		t.Errorf("Expected Authorization to be redacted, got %q", record.Headers["Authorization"])
+	}
+	if record.Headers["X-Request-Id"] != record.RequestID {
+		t.Errorf("Expected X-Request-ID header to match, got %q", record.Headers["X-Request-Id"])
+	}
+
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "secret-token") {
+		t.Error("Expected the auth token to never be written to the trace")
+	}
+}
+
+func TestEnhancedClient_TraceError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"errors":[{"message":"Entity not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := DefaultEnhancedClientConfig()
+	config.BaseURL = server.URL
+	config.Logger = logging.NewNoOpLogger()
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tr, err := newTracer(path)
+	if err != nil {
+		t.Fatalf("Failed to create tracer: %v", err)
+	}
+
+	client := NewEnhancedClient("test-auth", config)
+	client.tracer = tr
+
+	if err := client.Execute(context.Background(), `mutation UpdateIssue { issueUpdate { success } }`, nil, nil); err == nil {
+		t.Fatal("Expected GraphQL error")
+	}
+
+	records := readTraceRecords(t, path)
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 trace record, got %d", len(records))
+	}
+	if records[0].QueryType != "mutation" || !strings.Contains(records[0].Error, "Entity not found") {
+		t.Errorf("Expected mutation with error, got %+v", records[0])
+	}
+}
+
+func TestEnhancedClient_TraceRedactsExtraHeaders(t *testing.T) {
+	t.Setenv("LINCTL_EXTRA_HEADERS", "X-Gateway-Token: gateway-secret")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{"viewer":{"id":"123"}}}`))
+	}))
+	defer server.Close()
+
+	config := DefaultEnhancedClientConfig()
+	config.BaseURL = server.URL
+	config.Logger = logging.NewNoOpLogger()
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tr, err := newTracer(path)
+	if err != nil {
+		t.Fatalf("Failed to create tracer: %v", err)
+	}
+
+	client := NewEnhancedClient("test-auth", config)
+	client.tracer = tr
+
+	ctx := logging.WithCorrelationID(context.Background(), "build-42")
+	if err := client.Execute(ctx, `query Viewer { viewer { id } }`, nil, nil); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+
+	records := readTraceRecords(t, path)
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 trace record, got %d", len(records))
+	}
+	headers := records[0].Headers
+	if headers["X-Gateway-Token"] != redactedValue {
+		t.Errorf("Expected the extra header to be redacted, got %q", headers["X-Gateway-Token"])
+	}
+	if headers["Content-Type"] != "application/json" || headers["User-Agent"] == "" || headers["X-Correlation-Id"] != "build-42" {
+		t.Errorf("Expected allow-listed headers to keep their values, got %v", headers)
+	}
+
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "gateway-secret") {
+		t.Error("Expected the extra header value to never be written to the trace")
+	}
+}
+
+func TestTracer_ConcurrentWrites(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tr, err := newTracer(path)
+	if err != nil {
+		t.Fatalf("Failed to create tracer: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tr.record(TraceRecord{RequestID: "req", QueryType: "query"})
+		}()
+	}
+	wg.Wait()
+
+	if records := readTraceRecords(t, path); len(records) != 50 {
+		t.Errorf("Expected 50 intact records, got %d", len(records))
+	}
+}
@@ -4,14 +4,18 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/nicholls-inc/linctl/pkg/api"
+	"github.com/nicholls-inc/linctl/pkg/config"
 	"github.com/nicholls-inc/linctl/pkg/oauth"
+	"github.com/nicholls-inc/linctl/pkg/output"
 )
 
 type User struct {
@@ -19,6 +23,14 @@ type User struct {
 	Name      string `json:"name"`
 	Email     string `json:"email"`
 	AvatarURL string `json:"avatarUrl,omitempty"`
+	Teams     []Team `json:"teams,omitempty"`
+}
+
+// Team is a team the authenticated user is a member of
+type Team struct {
+	ID   string `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
 }
 
 type AuthConfig struct {
@@ -26,9 +38,23 @@ type AuthConfig struct {
 	// OAuthToken removed - OAuth tokens are now managed exclusively by OAuth TokenStore
 }
 
-// getConfigPath returns the path to the auth config file
+// getConfigPath returns the path to the auth config file for the active profile
 // This variable allows for mocking in tests
 var getConfigPath = func() (string, error) {
+	return configPathForProfile(config.ActiveProfile())
+}
+
+// configPathForProfile returns the auth config path for a profile.
+// Named profiles live under ~/.linctl/profiles/<name>/, the default profile uses the legacy path.
+func configPathForProfile(profile string) (string, error) {
+	profileDir, err := config.ProfileDir(profile)
+	if err != nil {
+		return "", err
+	}
+	if profileDir != "" {
+		return filepath.Join(profileDir, "auth.json"), nil
+	}
+
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		return "", err
@@ -48,6 +74,10 @@ func saveAuth(config AuthConfig) error {
 		return err
 	}
 
+	if err := os.MkdirAll(filepath.Dir(configPath), 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
 	return os.WriteFile(configPath, data, 0600)
 }
 
@@ -77,6 +107,11 @@ func loadAuth() (*AuthConfig, error) {
 
 // GetAuthHeader returns the authorization header value with unified token management
 func GetAuthHeader() (string, error) {
+	// Mock mode never talks to Linear, so no credentials are needed
+	if api.MockModeEnabled() {
+		return api.MockAuthHeader, nil
+	}
+
 	// First try OAuth with automatic token refresh
 	token, oauthErr := getValidOAuthTokenWithRefresh()
 	if oauthErr == nil && token != "" {
@@ -119,6 +154,10 @@ func getValidOAuthTokenWithRefresh() (string, error) {
 
 	// Check if OAuth is configured
 	if !oauthConfig.IsComplete() {
+		// Device flow logins need no client secret, so use their stored token directly
+		if token, err := getStoredDeviceToken(); err == nil {
+			return token, nil
+		}
 		return "", fmt.Errorf("OAuth not configured via environment variables (missing CLIENT_ID or CLIENT_SECRET)")
 	}
 
@@ -131,6 +170,10 @@ func getValidOAuthTokenWithRefresh() (string, error) {
 	// Get valid token with automatic refresh (this handles token expiry internally)
 	tokenResp, err := oauthClient.GetValidTokenWithRefresh(context.Background(), oauthConfig.Scopes)
 	if err != nil {
+		// A rejected refresh token already carries the re-auth prompt
+		if errors.Is(err, oauth.ErrReauthRequired) {
+			return "", err
+		}
 		// Enhanced error context for debugging
 		if oauth.IsTokenError(err) {
 			return "", fmt.Errorf("OAuth token authentication failed (token may be expired or invalid): %w\n💡 Try: linctl auth login --oauth", err)
@@ -141,6 +184,59 @@ func getValidOAuthTokenWithRefresh() (string, error) {
 	return tokenResp.AccessToken, nil
 }
 
+// CheckScope verifies that the stored OAuth token was granted the scope op
+// needs, so a missing permission fails before the mutation is sent instead of
+// as an opaque 403. API keys carry the user's own permissions and are not checked.
+func CheckScope(authHeader, op string) error {
+	if api.MockModeEnabled() || !strings.HasPrefix(authHeader, "Bearer ") {
+		return nil
+	}
+
+	oauthConfig, err := oauth.LoadFromEnvironment()
+	if err != nil {
+		return nil
+	}
+
+	required := oauthConfig.RequiresScope(op)
+	if required == "" {
+		return nil
+	}
+
+	oauthClient := oauth.NewOAuthClient(oauthConfig.ClientID, oauthConfig.ClientSecret, oauthConfig.BaseURL)
+	scopes, err := oauthClient.StoredTokenScopes()
+	if err != nil || len(scopes) == 0 {
+		// Nothing recorded to check against, so leave the decision to the API
+		return nil
+	}
+
+	granted := &oauth.Config{Scopes: scopes}
+	if granted.HasScope(required) {
+		return nil
+	}
+
+	return fmt.Errorf("OAuth token is missing the %q scope required for %s (granted: %s)\n💡 Add %s to LINEAR_SCOPES and re-authenticate: linctl auth login --oauth",
+		required, op, strings.Join(scopes, ", "), required)
+}
+
+// getStoredDeviceToken returns a valid stored token obtained through the device flow
+func getStoredDeviceToken() (string, error) {
+	tokenStore, err := oauth.NewTokenStore()
+	if err != nil {
+		return "", err
+	}
+
+	storedToken, err := tokenStore.GetValidTokenWithBuffer(2 * time.Minute)
+	if err != nil {
+		return "", err
+	}
+
+	if storedToken.GrantType != oauth.DeviceCodeGrantType {
+		return "", fmt.Errorf("stored OAuth token was not issued by the device flow")
+	}
+
+	return storedToken.AccessToken, nil
+}
+
 // Login handles the authentication flow
 func Login(plaintext, jsonOut bool) error {
 	return loginWithAPIKey(plaintext, jsonOut)
@@ -149,13 +245,13 @@ func Login(plaintext, jsonOut bool) error {
 // loginWithAPIKey handles Personal API Key authentication
 func loginWithAPIKey(plaintext, jsonOut bool) error {
 	if !plaintext && !jsonOut {
-		fmt.Println("\n" + color.New(color.FgYellow).Sprint("📝 Personal API Key Authentication"))
-		fmt.Println("Get your API key from: https://linear.app/settings/api")
+		fmt.Fprintln(output.PromptWriter(), "\n"+color.New(color.FgYellow).Sprint("📝 Personal API Key Authentication"))
+		fmt.Fprintln(output.PromptWriter(), "Get your API key from: https://linear.app/settings/api")
 
 		// Get the config path to show to the user
 		configPath, _ := getConfigPath()
-		fmt.Printf("Your credentials will be stored in: %s\n", color.New(color.FgCyan).Sprint(configPath))
-		fmt.Print("\nEnter your Personal API Key: ")
+		fmt.Fprintf(output.PromptWriter(), "Your credentials will be stored in: %s\n", color.New(color.FgCyan).Sprint(configPath))
+		fmt.Fprint(output.PromptWriter(), "\nEnter your Personal API Key: ")
 	}
 
 	reader := bufio.NewReader(os.Stdin)
@@ -163,36 +259,69 @@ func loginWithAPIKey(plaintext, jsonOut bool) error {
 	if err != nil {
 		return err
 	}
-	apiKey = strings.TrimSpace(apiKey)
 
+	user, err := saveAPIKey(apiKey)
+	if err != nil {
+		return err
+	}
+
+	if !plaintext && !jsonOut {
+		fmt.Printf("\n%s Authenticated as %s (%s)\n",
+			color.New(color.FgGreen).Sprint("✅"),
+			color.New(color.FgCyan).Sprint(user.Name),
+			color.New(color.FgCyan).Sprint(user.Email))
+	}
+
+	return nil
+}
+
+// LoginWithAPIKey authenticates non-interactively with the given Personal API
+// Key, for CI where the key cannot be piped to the prompt. The key is never
+// printed; the returned user does not include it.
+func LoginWithAPIKey(apiKey string, plaintext, jsonOut bool) (*User, error) {
+	user, err := saveAPIKey(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !plaintext && !jsonOut {
+		fmt.Printf("%s Authenticated as %s (%s)\n",
+			color.New(color.FgGreen).Sprint("✅"),
+			color.New(color.FgCyan).Sprint(user.Name),
+			color.New(color.FgCyan).Sprint(user.Email))
+	}
+
+	return user, nil
+}
+
+// saveAPIKey checks the key against the viewer query and stores it
+func saveAPIKey(apiKey string) (*User, error) {
+	apiKey = strings.TrimSpace(apiKey)
 	if apiKey == "" {
-		return fmt.Errorf("API key cannot be empty")
+		return nil, fmt.Errorf("API key cannot be empty")
 	}
 
 	// Test the API key
 	client := api.NewClient(apiKey)
-	user, err := client.GetViewer(context.Background())
+	viewer, err := client.GetViewer(context.Background())
 	if err != nil {
-		return fmt.Errorf("invalid API key: %v", err)
+		return nil, fmt.Errorf("invalid API key: %v", err)
 	}
 
 	// Save the API key
 	config := AuthConfig{
 		APIKey: apiKey,
 	}
-	err = saveAuth(config)
-	if err != nil {
-		return err
-	}
-
-	if !plaintext && !jsonOut {
-		fmt.Printf("\n%s Authenticated as %s (%s)\n",
-			color.New(color.FgGreen).Sprint("✅"),
-			color.New(color.FgCyan).Sprint(user.Name),
-			color.New(color.FgCyan).Sprint(user.Email))
+	if err := saveAuth(config); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &User{
+		ID:        viewer.ID,
+		Name:      viewer.Name,
+		Email:     viewer.Email,
+		AvatarURL: viewer.AvatarURL,
+	}, nil
 }
 
 // LoginWithOAuth handles OAuth authentication flow with existing auth detection
@@ -219,20 +348,20 @@ func LoginWithOAuth(plaintext, jsonOut bool) error {
 	// If environment variables are not set, prompt for them
 	if !oauthConfig.IsComplete() {
 		if !plaintext && !jsonOut {
-			fmt.Println("\n" + color.New(color.FgYellow).Sprint("🔐 OAuth Authentication Setup"))
-			fmt.Println("You need Linear OAuth application credentials.")
-			fmt.Println("Create an OAuth app at: https://linear.app/settings/api/applications/new")
-			fmt.Println()
-			fmt.Println(color.New(color.FgCyan).Sprint("💡 Tip: Set LINEAR_CLIENT_ID and LINEAR_CLIENT_SECRET environment variables for automated workflows"))
+			fmt.Fprintln(output.PromptWriter(), "\n"+color.New(color.FgYellow).Sprint("🔐 OAuth Authentication Setup"))
+			fmt.Fprintln(output.PromptWriter(), "You need Linear OAuth application credentials.")
+			fmt.Fprintln(output.PromptWriter(), "Create an OAuth app at: https://linear.app/settings/api/applications/new")
+			fmt.Fprintln(output.PromptWriter())
+			fmt.Fprintln(output.PromptWriter(), color.New(color.FgCyan).Sprint("💡 Tip: Set LINEAR_CLIENT_ID and LINEAR_CLIENT_SECRET environment variables for automated workflows"))
 
 			// Get the config path to show to the user
 			configPath, _ := getConfigPath()
-			fmt.Printf("Your credentials will be stored in: %s\n", color.New(color.FgCyan).Sprint(configPath))
+			fmt.Fprintf(output.PromptWriter(), "Your credentials will be stored in: %s\n", color.New(color.FgCyan).Sprint(configPath))
 		}
 
 		if oauthConfig.ClientID == "" {
 			if !plaintext && !jsonOut {
-				fmt.Print("\nEnter your OAuth Client ID: ")
+				fmt.Fprint(output.PromptWriter(), "\nEnter your OAuth Client ID: ")
 			}
 			reader := bufio.NewReader(os.Stdin)
 			input, err := reader.ReadString('\n')
@@ -244,7 +373,7 @@ func LoginWithOAuth(plaintext, jsonOut bool) error {
 
 		if oauthConfig.ClientSecret == "" {
 			if !plaintext && !jsonOut {
-				fmt.Print("Enter your OAuth Client Secret: ")
+				fmt.Fprint(output.PromptWriter(), "Enter your OAuth Client Secret: ")
 			}
 			reader := bufio.NewReader(os.Stdin)
 			input, err := reader.ReadString('\n')
@@ -311,15 +440,96 @@ func LoginWithOAuth(plaintext, jsonOut bool) error {
 	return nil
 }
 
+// LoginWithDevice handles the OAuth device authorization flow for headless
+// machines and returns the authenticated user
+func LoginWithDevice(plaintext, jsonOut bool) (*User, error) {
+	oauthConfig, err := oauth.LoadFromEnvironment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OAuth config: %w", err)
+	}
+
+	// The device flow only needs a client ID
+	if oauthConfig.ClientID == "" {
+		if !plaintext && !jsonOut {
+			fmt.Fprintln(output.PromptWriter(), "\n"+color.New(color.FgYellow).Sprint("🔐 OAuth Device Authentication Setup"))
+			fmt.Fprintln(output.PromptWriter(), color.New(color.FgCyan).Sprint("💡 Tip: Set LINEAR_CLIENT_ID to skip this prompt"))
+			fmt.Fprint(output.PromptWriter(), "\nEnter your OAuth Client ID: ")
+		}
+		reader := bufio.NewReader(os.Stdin)
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		oauthConfig.ClientID = strings.TrimSpace(input)
+	}
+
+	if oauthConfig.ClientID == "" {
+		return nil, fmt.Errorf("OAuth client ID is required")
+	}
+
+	oauthClient := oauth.NewOAuthClient(oauthConfig.ClientID, "", oauthConfig.BaseURL)
+
+	ctx := context.Background()
+	device, err := oauthClient.StartDeviceAuthorization(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start device authorization: %v", err)
+	}
+
+	verificationURL := device.VerificationURI
+	if device.VerificationURIComplete != "" {
+		verificationURL = device.VerificationURIComplete
+	}
+
+	// Instructions go to stderr in machine-readable modes so stdout stays parseable
+	if !plaintext && !jsonOut {
+		fmt.Fprintf(output.PromptWriter(), "\nOpen %s and enter the code: %s\n",
+			color.New(color.FgCyan).Sprint(verificationURL),
+			color.New(color.FgYellow, color.Bold).Sprint(device.UserCode))
+		fmt.Fprintln(output.PromptWriter(), color.New(color.FgBlue).Sprint("⏳ Waiting for approval..."))
+	} else {
+		fmt.Fprintf(os.Stderr, "Open %s and enter the code: %s\n", verificationURL, device.UserCode)
+	}
+
+	tokenResp, err := oauthClient.PollDeviceToken(ctx, device)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OAuth token: %v", err)
+	}
+
+	// Test the token by getting current user
+	client := api.NewClient("Bearer " + tokenResp.AccessToken)
+	user, err := client.GetViewer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate OAuth token: %v", err)
+	}
+
+	if !plaintext && !jsonOut {
+		fmt.Printf("\n%s Device login complete!\n", color.New(color.FgGreen).Sprint("✅"))
+		fmt.Printf("Authenticated as: %s (%s)\n",
+			color.New(color.FgCyan).Sprint(user.Name),
+			color.New(color.FgCyan).Sprint(user.Email))
+	}
+
+	return &User{
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		AvatarURL: user.AvatarURL,
+	}, nil
+}
+
 // AuthStatus represents comprehensive authentication status
 type AuthStatus struct {
-	Authenticated bool                   `json:"authenticated"`
-	Method        string                 `json:"method"` // "oauth", "api_key", or "none"
-	User          *User                  `json:"user,omitempty"`
-	TokenExpiry   *string                `json:"token_expires_at,omitempty"`
-	Scopes        []string               `json:"scopes,omitempty"`
-	Suggestions   []string               `json:"suggestions,omitempty"`
-	Environment   map[string]interface{} `json:"environment,omitempty"`
+	Authenticated  bool                   `json:"authenticated"`
+	Method         string                 `json:"method"` // "oauth", "api_key", or "none"
+	User           *User                  `json:"user,omitempty"`
+	TokenExpiry    *string                `json:"token_expires_at,omitempty"`
+	Scopes         []string               `json:"scopes,omitempty"`
+	DefaultTeam    *Team                  `json:"default_team,omitempty"`
+	TeamConfigured bool                   `json:"team_configured"` // DefaultTeam comes from LINEAR_DEFAULT_TEAM
+	Suggestions    []string               `json:"suggestions,omitempty"`
+	Environment    map[string]interface{} `json:"environment,omitempty"`
+	// FetchedAt is when the user was fetched from the API (RFC3339, UTC)
+	FetchedAt string `json:"fetched_at,omitempty"`
 }
 
 // determineAuthMethod determines the current authentication method using the same priority as GetAuthHeader
@@ -365,6 +575,18 @@ func GetAuthStatus() (*AuthStatus, error) {
 	if userErr == nil {
 		status.Authenticated = true
 		status.User = user
+		status.FetchedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	// Commands only fall back to a team named by LINEAR_DEFAULT_TEAM, so no
+	// default is reported without it
+	if teamConfig := oauth.LoadTeamFromEnvironment(); teamConfig.IsConfigured() {
+		status.TeamConfigured = true
+		var teams []Team
+		if user != nil {
+			teams = user.Teams
+		}
+		status.DefaultTeam = configuredTeam(teams, teamConfig.DefaultTeam)
 	}
 
 	// Determine authentication method by checking the same priority as GetAuthHeader
@@ -415,6 +637,44 @@ func GetAuthStatus() (*AuthStatus, error) {
 	return status, nil
 }
 
+// RefreshAuthStatus is GetAuthStatus after re-validating the OAuth token
+// against the API, refreshing it when it is rejected. The user is always
+// fetched live. A token that cannot be re-validated is reported as a suggestion.
+func RefreshAuthStatus() (*AuthStatus, error) {
+	validateErr := revalidateOAuthToken()
+
+	status, err := GetAuthStatus()
+	if err != nil {
+		return nil, err
+	}
+	if validateErr != nil {
+		status.Suggestions = append(status.Suggestions, fmt.Sprintf("OAuth token failed re-validation: %v", validateErr))
+	}
+	return status, nil
+}
+
+// revalidateOAuthToken checks the OAuth token with a live API call, refreshing
+// it when it is rejected. Without OAuth client credentials there is nothing to
+// refresh, and fetching the user validates the credentials instead.
+func revalidateOAuthToken() error {
+	if api.MockModeEnabled() {
+		return nil
+	}
+
+	oauthConfig, err := oauth.LoadFromEnvironment()
+	if err != nil || !oauthConfig.IsComplete() {
+		return nil
+	}
+
+	oauthClient, err := oauth.NewOAuthClientFromConfig(oauthConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create OAuth client: %w", err)
+	}
+
+	_, err = oauthClient.ValidateAndRefreshToken(context.Background(), oauthConfig.Scopes)
+	return err
+}
+
 // GetCurrentUser returns the current authenticated user
 func GetCurrentUser() (*User, error) {
 	authHeader, err := GetAuthHeader()
@@ -423,18 +683,36 @@ func GetCurrentUser() (*User, error) {
 	}
 
 	client := api.NewClient(authHeader)
-	apiUser, err := client.GetViewer(context.Background())
+	apiUser, err := client.GetViewerWithTeams(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert api.User to auth.User
-	return &User{
+	user := &User{
 		ID:        apiUser.ID,
 		Name:      apiUser.Name,
 		Email:     apiUser.Email,
 		AvatarURL: apiUser.AvatarURL,
-	}, nil
+	}
+	if apiUser.Teams != nil {
+		for _, team := range apiUser.Teams.Nodes {
+			user.Teams = append(user.Teams, Team{ID: team.ID, Key: team.Key, Name: team.Name})
+		}
+	}
+
+	return user, nil
+}
+
+// configuredTeam returns the membership whose key matches LINEAR_DEFAULT_TEAM,
+// or a team with just the key when the user is not a member of it
+func configuredTeam(teams []Team, key string) *Team {
+	for _, team := range teams {
+		if strings.EqualFold(team.Key, key) {
+			return &team
+		}
+	}
+	return &Team{Key: key}
 }
 
 // RefreshOAuthTokenWithFeedback forces a refresh of the OAuth token with user-friendly errors
@@ -500,6 +778,38 @@ func GetOAuthTokenInfo() (map[string]interface{}, error) {
 	return tokenInfo, nil
 }
 
+// ClearedToken reports what ClearOAuthToken removed
+type ClearedToken struct {
+	Cleared         bool   `json:"cleared"`
+	Backend         string `json:"backend"`
+	Location        string `json:"location"`
+	APIKeyPreserved bool   `json:"api_key_preserved"`
+}
+
+// ClearOAuthToken removes only the stored OAuth token of the active profile.
+// Unlike Logout it keeps the API key, so the next call falls back to it or
+// obtains a fresh OAuth token.
+func ClearOAuthToken() (*ClearedToken, error) {
+	tokenStore, err := oauth.NewTokenStore()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open OAuth token store: %w", err)
+	}
+
+	result := &ClearedToken{
+		Cleared:  tokenStore.HasToken(),
+		Backend:  tokenStore.Backend(),
+		Location: tokenStore.Location(),
+	}
+	if err := tokenStore.ClearToken(); err != nil {
+		return nil, err
+	}
+
+	if config, err := loadAuth(); err == nil && config.APIKey != "" {
+		result.APIKeyPreserved = true
+	}
+	return result, nil
+}
+
 // Logout clears stored credentials
 func Logout() error {
 	// Clear legacy config
@@ -2,7 +2,14 @@ package auth
 
 import (
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/nicholls-inc/linctl/pkg/oauth"
 )
 
 func TestAuthConfig_JSONSerialization_Minimal(t *testing.T) {
@@ -49,3 +56,176 @@ func TestAuthConfig_JSONSerialization_Minimal(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigPathForProfile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	path, err := configPathForProfile("")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := filepath.Join(home, ".linctl-auth.json"); path != expected {
+		t.Errorf("Expected legacy path %s, got %s", expected, path)
+	}
+
+	path, err = configPathForProfile("work")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := filepath.Join(home, ".linctl", "profiles", "work", "auth.json"); path != expected {
+		t.Errorf("Expected profile path %s, got %s", expected, path)
+	}
+}
+
+func TestConfiguredTeam(t *testing.T) {
+	teams := []Team{
+		{ID: "team-1", Key: "ENG", Name: "Engineering"},
+		{ID: "team-2", Key: "OPS", Name: "Operations"},
+	}
+
+	if team := configuredTeam(teams, "ops"); team.ID != "team-2" {
+		t.Errorf("Expected LINEAR_DEFAULT_TEAM to match the OPS membership, got %+v", team)
+	}
+	if team := configuredTeam(teams, "DESIGN"); team.Key != "DESIGN" || team.ID != "" {
+		t.Errorf("Expected a key-only team outside the user's memberships, got %+v", team)
+	}
+}
+
+func TestCheckScope(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("LINCTL_PROFILE", "")
+	t.Setenv("LINCTL_TOKEN_BACKEND", "")
+
+	store, err := oauth.NewTokenStore()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := store.SaveToken(&oauth.TokenResponse{AccessToken: "token", ExpiresIn: 3600, Scope: "read,issues:create"}); err != nil {
+		t.Fatalf("Failed to save token: %v", err)
+	}
+
+	if err := CheckScope("Bearer token", "issue.create"); err != nil {
+		t.Errorf("Expected issues:create to be allowed, got %v", err)
+	}
+
+	err = CheckScope("Bearer token", "comment.create")
+	if err == nil {
+		t.Fatal("Expected missing comments:create scope to be rejected")
+	}
+	if !strings.Contains(err.Error(), "LINEAR_SCOPES") {
+		t.Errorf("Expected error to point at LINEAR_SCOPES, got %v", err)
+	}
+
+	for _, op := range []string{"issue.archive", "issue.link", "issue.unlink", "issue.attach", "issue.move",
+		"issue.subscribe", "issue.unsubscribe", "comment.delete", "comment.unreact", "label.create"} {
+		if err := CheckScope("Bearer token", op); err == nil {
+			t.Errorf("Expected %s to require write", op)
+		}
+	}
+
+	if err := CheckScope("lin_api_key", "comment.create"); err != nil {
+		t.Errorf("Expected API keys to skip the scope check, got %v", err)
+	}
+	if err := CheckScope("Bearer token", "auth.logout"); err != nil {
+		t.Errorf("Expected auth operations to need no scope, got %v", err)
+	}
+}
+
+func TestLoginWithAPIKey_Empty(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("LINCTL_PROFILE", "")
+
+	if _, err := LoginWithAPIKey("  ", true, false); err == nil || !strings.Contains(err.Error(), "cannot be empty") {
+		t.Fatalf("Expected empty API key to be rejected, got %v", err)
+	}
+	if _, err := loadAuth(); err == nil {
+		t.Error("Expected no credentials to be saved for an empty API key")
+	}
+}
+
+func TestRevalidateOAuthToken(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("LINCTL_PROFILE", "")
+	t.Setenv("LINCTL_TOKEN_BACKEND", "")
+
+	t.Run("without OAuth credentials", func(t *testing.T) {
+		t.Setenv("LINEAR_CLIENT_ID", "")
+		t.Setenv("LINEAR_CLIENT_SECRET", "")
+		if err := revalidateOAuthToken(); err != nil {
+			t.Errorf("Expected nothing to re-validate, got %v", err)
+		}
+	})
+
+	t.Run("validates against the API", func(t *testing.T) {
+		var validations int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/oauth/token":
+				_, _ = w.Write([]byte(`{"access_token":"fresh-token","token_type":"Bearer","expires_in":3600,"scope":"read write"}`))
+			case "/graphql":
+				validations++
+				if r.Header.Get("Authorization") != "Bearer fresh-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte(`{"data":{"viewer":{"id":"user-1","name":"Jane"}}}`))
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+		defer server.Close()
+
+		t.Setenv("LINEAR_CLIENT_ID", "client-id")
+		t.Setenv("LINEAR_CLIENT_SECRET", "client-secret")
+		t.Setenv("LINEAR_BASE_URL", server.URL)
+
+		if err := revalidateOAuthToken(); err != nil {
+			t.Fatalf("Expected the token to validate, got %v", err)
+		}
+		if validations != 1 {
+			t.Errorf("Expected one live validation request, got %d", validations)
+		}
+	})
+}
+
+func TestClearOAuthToken(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("LINCTL_PROFILE", "")
+	t.Setenv("LINCTL_TOKEN_BACKEND", "")
+	t.Setenv("LINCTL_ENCRYPT_TOKENS", "")
+
+	configPath := filepath.Join(home, ".linctl-auth.json")
+	if err := os.WriteFile(configPath, []byte(`{"api_key":"lin_api_test"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tokenStore, err := oauth.NewTokenStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tokenStore.SaveToken(&oauth.TokenResponse{AccessToken: "suspect-token", TokenType: "Bearer", ExpiresIn: 3600}); err != nil {
+		t.Fatal(err)
+	}
+
+	cleared, err := ClearOAuthToken()
+	if err != nil {
+		t.Fatalf("ClearOAuthToken failed: %v", err)
+	}
+	if !cleared.Cleared || !cleared.APIKeyPreserved || cleared.Location != tokenStore.Location() {
+		t.Errorf("Unexpected result %+v", cleared)
+	}
+	if tokenStore.HasToken() {
+		t.Error("Expected the OAuth token to be removed")
+	}
+	if data, err := os.ReadFile(configPath); err != nil || !strings.Contains(string(data), "lin_api_test") {
+		t.Errorf("Expected the API key to be kept, got %q (%v)", data, err)
+	}
+
+	cleared, err = ClearOAuthToken()
+	if err != nil || cleared.Cleared {
+		t.Errorf("Expected clearing again to be a no-op, got %+v (%v)", cleared, err)
+	}
+}
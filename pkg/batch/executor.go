@@ -0,0 +1,122 @@
+package batch
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+)
+
+// Task is a single unit of work executed by the batch executor
+type Task func(ctx context.Context) error
+
+// Result holds the outcome of a single task
+type Result struct {
+	Index int   `json:"index"`
+	Error error `json:"error,omitempty"`
+}
+
+// Executor runs tasks concurrently, bounded by a semaphore whose size is
+// supplied by a ConcurrencyController
+type Executor struct {
+	controller *ratelimit.ConcurrencyController
+	logger     logging.Logger
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	active int
+	peak   int
+}
+
+// NewExecutor creates a new batch executor. A nil controller follows the
+// shared rate limiter, so LINCTL_MAX_CONCURRENCY bounds the workers.
+func NewExecutor(controller *ratelimit.ConcurrencyController, logger logging.Logger) *Executor {
+	if logger == nil {
+		logger = logging.NewNoOpLogger()
+	}
+
+	if controller == nil {
+		controller = ratelimit.NewConcurrencyController(ratelimit.DefaultConcurrencyConfig(), ratelimit.Shared(), logger)
+	}
+
+	e := &Executor{
+		controller: controller,
+		logger:     logger,
+	}
+	e.cond = sync.NewCond(&e.mu)
+
+	return e
+}
+
+// Run executes all tasks and returns one result per task, in task order.
+// Tasks not yet started when ctx is cancelled report the context error.
+func (e *Executor) Run(ctx context.Context, tasks []Task) []Result {
+	results := make([]Result, len(tasks))
+	var wg sync.WaitGroup
+
+	for i, task := range tasks {
+		results[i].Index = i
+
+		if err := e.acquire(ctx); err != nil {
+			results[i].Error = err
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, task Task) {
+			defer wg.Done()
+			defer e.release()
+
+			results[i].Error = task(ctx)
+		}(i, task)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// PeakConcurrency returns the highest number of tasks observed running at once
+func (e *Executor) PeakConcurrency() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.peak
+}
+
+// acquire blocks until a worker slot is available under the current limit
+func (e *Executor) acquire(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		limit := e.controller.Limit()
+		if e.active < limit {
+			e.active++
+			if e.active > e.peak {
+				e.peak = e.active
+			}
+			return nil
+		}
+
+		e.logger.Debug("Batch executor waiting for worker slot",
+			logging.Int("active", e.active),
+			logging.Int("limit", limit),
+		)
+
+		// A waiter only blocks while at least one task is active, so a
+		// release is guaranteed to wake it
+		e.cond.Wait()
+	}
+}
+
+// release frees a worker slot and wakes any waiting dispatcher
+func (e *Executor) release() {
+	e.mu.Lock()
+	e.active--
+	e.mu.Unlock()
+	e.cond.Broadcast()
+}
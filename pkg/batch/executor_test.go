@@ -0,0 +1,125 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/ratelimit"
+)
+
+func TestExecutor_RunReturnsResultsInOrder(t *testing.T) {
+	executor := NewExecutor(nil, logging.NewNoOpLogger())
+
+	expectedErr := errors.New("task failed")
+	tasks := []Task{
+		func(ctx context.Context) error { return nil },
+		func(ctx context.Context) error { return expectedErr },
+		func(ctx context.Context) error { return nil },
+	}
+
+	results := executor.Run(context.Background(), tasks)
+
+	if len(results) != len(tasks) {
+		t.Fatalf("Expected %d results, got %d", len(tasks), len(results))
+	}
+
+	for i, result := range results {
+		if result.Index != i {
+			t.Errorf("Expected result index %d, got %d", i, result.Index)
+		}
+	}
+
+	if results[1].Error != expectedErr {
+		t.Errorf("Expected error for task 1, got %v", results[1].Error)
+	}
+
+	if results[0].Error != nil || results[2].Error != nil {
+		t.Errorf("Expected no errors for tasks 0 and 2")
+	}
+}
+
+func TestExecutor_ConcurrencyShrinksOnLowRemaining(t *testing.T) {
+	limiterConfig := ratelimit.DefaultRateLimitConfig()
+	limiterConfig.MaxConcurrency = 4
+	limiter := ratelimit.NewRateLimiter(limiterConfig, logging.NewNoOpLogger())
+	controller := ratelimit.NewConcurrencyController(ratelimit.DefaultConcurrencyConfig(), limiter, logging.NewNoOpLogger())
+
+	tasks := make([]Task, 8)
+	for i := range tasks {
+		tasks[i] = func(ctx context.Context) error {
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		}
+	}
+
+	executor := NewExecutor(controller, logging.NewNoOpLogger())
+	executor.Run(context.Background(), tasks)
+
+	if executor.PeakConcurrency() != controller.MaxWorkers() {
+		t.Errorf("Expected peak %d with plentiful quota, got %d", controller.MaxWorkers(), executor.PeakConcurrency())
+	}
+
+	// Observe a nearly exhausted quota, as a Linear response would report it
+	resp := &http.Response{Header: make(http.Header)}
+	resp.Header.Set("X-RateLimit-Limit", "1000")
+	resp.Header.Set("X-RateLimit-Remaining", "10")
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+	limiter.UpdateFromResponse(resp)
+
+	executor = NewExecutor(controller, logging.NewNoOpLogger())
+	executor.Run(context.Background(), tasks)
+
+	if executor.PeakConcurrency() != 1 {
+		t.Errorf("Expected concurrency to shrink to 1 with low quota, got %d", executor.PeakConcurrency())
+	}
+}
+
+func TestNewExecutor_SharedLimiterConcurrency(t *testing.T) {
+	ratelimit.ResetShared()
+	t.Cleanup(ratelimit.ResetShared)
+
+	config := ratelimit.DefaultRateLimitConfig()
+	config.MaxConcurrency = 2
+	ratelimit.SharedWithConfig(config, nil)
+
+	tasks := make([]Task, 6)
+	for i := range tasks {
+		tasks[i] = func(ctx context.Context) error {
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		}
+	}
+
+	executor := NewExecutor(nil, logging.NewNoOpLogger())
+	executor.Run(context.Background(), tasks)
+
+	if executor.PeakConcurrency() != 2 {
+		t.Errorf("Expected the shared limiter's max concurrency of 2, got %d", executor.PeakConcurrency())
+	}
+}
+
+func TestExecutor_ContextCancellation(t *testing.T) {
+	executor := NewExecutor(nil, logging.NewNoOpLogger())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	results := executor.Run(ctx, []Task{func(ctx context.Context) error {
+		called = true
+		return nil
+	}})
+
+	if called {
+		t.Error("Expected task not to run after cancellation")
+	}
+
+	if results[0].Error != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", results[0].Error)
+	}
+}
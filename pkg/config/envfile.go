@@ -0,0 +1,193 @@
+package config
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// DefaultEnvFile is loaded from the working directory when present, unless
+// --env-file names another file or --no-env-file is given
+const DefaultEnvFile = ".linctl.env"
+
+// envKeyPattern matches the variable names accepted in an env file
+var envKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// envKeyPrefixes are the only variables an env file may set
+var envKeyPrefixes = []string{"LINCTL_", "LINEAR_"}
+
+// explicitOnlyEnvKeys change where requests and credentials go, so they are
+// only read from a file named with --env-file, never from a .linctl.env that
+// happens to be in the working directory (such as one committed to a clone)
+var explicitOnlyEnvKeys = map[string]bool{
+	"LINCTL_HTTP_PROXY":   true,
+	"LINCTL_TLS_INSECURE": true,
+	"LINEAR_BASE_URL":     true,
+	"LINCTL_TOKEN_FILE":   true,
+	"LINCTL_MOCK_DIR":     true,
+}
+
+// LoadEnvFile sets the variables in a dotenv file that are not already set in
+// the process environment, returning the names it set. explicit is true for a
+// file named with --env-file: a missing file is then an error, and it may set
+// the proxy, TLS, endpoint, token file and mock directory variables. The
+// default file is loaded opportunistically and may not.
+func LoadEnvFile(path string, explicit bool) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !explicit {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open env file: %w", err)
+	}
+	defer file.Close()
+
+	// Anyone could point LINEAR_BASE_URL elsewhere through a world-writable file
+	if info, err := file.Stat(); err == nil && runtime.GOOS != "windows" && info.Mode().Perm()&0o002 != 0 {
+		return nil, fmt.Errorf("env file %s is world-writable; run: chmod o-w %s", path, path)
+	}
+
+	vars, err := ParseEnvFile(file, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check every key before setting any, so a rejected file changes nothing
+	for _, v := range vars {
+		if !hasEnvKeyPrefix(v.Key) {
+			return nil, fmt.Errorf("env file %s: %s is not a linctl setting (only LINCTL_* and LINEAR_* variables are allowed)", path, v.Key)
+		}
+		if explicitOnlyEnvKeys[v.Key] && !explicit {
+			return nil, fmt.Errorf("env file %s: %s is only read from a file passed with --env-file", path, v.Key)
+		}
+	}
+
+	var set []string
+	for _, v := range vars {
+		if _, exists := os.LookupEnv(v.Key); exists {
+			continue
+		}
+		if err := os.Setenv(v.Key, v.Value); err != nil {
+			return set, fmt.Errorf("failed to set %s from %s: %w", v.Key, path, err)
+		}
+		set = append(set, v.Key)
+	}
+	return set, nil
+}
+
+// hasEnvKeyPrefix reports whether key is a LINCTL_* or LINEAR_* variable
+func hasEnvKeyPrefix(key string) bool {
+	for _, prefix := range envKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// EnvVar is one KEY=VALUE assignment from an env file
+type EnvVar struct {
+	Key   string
+	Value string
+}
+
+// ParseEnvFile parses KEY=VALUE lines. Blank lines and lines starting with #
+// are skipped, an "export " prefix is allowed, and values may be double quoted
+// (with Go escapes such as \n), single quoted (literal) or bare, where a
+// " #" starts a trailing comment. name is used in error messages.
+func ParseEnvFile(r io.Reader, name string) ([]EnvVar, error) {
+	var vars []EnvVar
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		v, err := parseEnvLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", name, lineNo, err)
+		}
+		vars = append(vars, v)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read env file %s: %w", name, err)
+	}
+	return vars, nil
+}
+
+// parseEnvLine parses one non-blank, non-comment env file line
+func parseEnvLine(line string) (EnvVar, error) {
+	line = strings.TrimPrefix(line, "export ")
+
+	key, value, found := strings.Cut(line, "=")
+	if !found {
+		return EnvVar{}, fmt.Errorf("expected KEY=VALUE, got %q", line)
+	}
+	key = strings.TrimSpace(key)
+	if !envKeyPattern.MatchString(key) {
+		return EnvVar{}, fmt.Errorf("invalid variable name %q", key)
+	}
+
+	value, err := parseEnvValue(strings.TrimSpace(value))
+	if err != nil {
+		return EnvVar{}, fmt.Errorf("%s: %w", key, err)
+	}
+	return EnvVar{Key: key, Value: value}, nil
+}
+
+// parseEnvValue unquotes a value and strips any trailing comment
+func parseEnvValue(raw string) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+
+	quote := raw[0]
+	if quote != '"' && quote != '\'' {
+		if i := strings.Index(raw, " #"); i >= 0 {
+			raw = raw[:i]
+		}
+		return strings.TrimSpace(raw), nil
+	}
+
+	end := closingQuote(raw, quote)
+	if end < 0 {
+		return "", fmt.Errorf("unterminated %c-quoted value", quote)
+	}
+	if rest := strings.TrimSpace(raw[end+1:]); rest != "" && !strings.HasPrefix(rest, "#") {
+		return "", fmt.Errorf("unexpected text after quoted value: %q", rest)
+	}
+
+	if quote == '\'' {
+		return raw[1:end], nil
+	}
+	value, err := strconv.Unquote(raw[:end+1])
+	if err != nil {
+		return "", fmt.Errorf("invalid escape in quoted value")
+	}
+	return value, nil
+}
+
+// closingQuote returns the index of the quote closing raw[0], skipping
+// backslash escapes in double-quoted values, or -1 if there is none
+func closingQuote(raw string, quote byte) int {
+	for i := 1; i < len(raw); i++ {
+		switch raw[i] {
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+		case quote:
+			return i
+		}
+	}
+	return -1
+}
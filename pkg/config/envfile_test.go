@@ -0,0 +1,145 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestParseEnvFile(t *testing.T) {
+	content := `# linctl settings
+LINEAR_DEFAULT_TEAM=ENG
+export LINCTL_LOG_LEVEL=debug   # trailing comment
+LINEAR_DEFAULT_ACTOR="Release Bot"
+LINCTL_EXTRA_HEADERS='X-Team: a#b'
+ESCAPED="line1\nline2 \"quoted\"" # comment
+EMPTY=
+URL=https://example.com/#anchor
+`
+	vars, err := ParseEnvFile(strings.NewReader(content), ".linctl.env")
+	if err != nil {
+		t.Fatalf("ParseEnvFile failed: %v", err)
+	}
+
+	want := []EnvVar{
+		{"LINEAR_DEFAULT_TEAM", "ENG"},
+		{"LINCTL_LOG_LEVEL", "debug"},
+		{"LINEAR_DEFAULT_ACTOR", "Release Bot"},
+		{"LINCTL_EXTRA_HEADERS", "X-Team: a#b"},
+		{"ESCAPED", "line1\nline2 \"quoted\""},
+		{"EMPTY", ""},
+		{"URL", "https://example.com/#anchor"},
+	}
+	if len(vars) != len(want) {
+		t.Fatalf("Expected %d variables, got %+v", len(want), vars)
+	}
+	for i := range want {
+		if vars[i] != want[i] {
+			t.Errorf("Line %d: expected %+v, got %+v", i, want[i], vars[i])
+		}
+	}
+}
+
+func TestParseEnvFile_Malformed(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"missing equals", "A=1\nLINEAR_DEFAULT_TEAM\n", ".linctl.env:2: expected KEY=VALUE"},
+		{"invalid name", "1TEAM=ENG", "invalid variable name"},
+		{"unterminated quote", `TEAM="ENG`, "unterminated \"-quoted value"},
+		{"text after quote", `TEAM="ENG" extra`, "unexpected text after quoted value"},
+		{"bad escape", `TEAM="\q"`, "invalid escape"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEnvFile(strings.NewReader(tt.content), ".linctl.env")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLoadEnvFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".linctl.env")
+	if err := os.WriteFile(path, []byte("LINCTL_TEST_ENVFILE_NEW=from-file\nLINCTL_TEST_ENVFILE_SET=from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("LINCTL_TEST_ENVFILE_SET", "from-env")
+	t.Setenv("LINCTL_TEST_ENVFILE_NEW", "")
+	os.Unsetenv("LINCTL_TEST_ENVFILE_NEW")
+
+	set, err := LoadEnvFile(path, true)
+	if err != nil {
+		t.Fatalf("LoadEnvFile failed: %v", err)
+	}
+	if len(set) != 1 || set[0] != "LINCTL_TEST_ENVFILE_NEW" {
+		t.Errorf("Expected only the unset variable to be set, got %v", set)
+	}
+	if got := os.Getenv("LINCTL_TEST_ENVFILE_NEW"); got != "from-file" {
+		t.Errorf("Expected from-file, got %q", got)
+	}
+	if got := os.Getenv("LINCTL_TEST_ENVFILE_SET"); got != "from-env" {
+		t.Errorf("Expected the process environment to win, got %q", got)
+	}
+
+	missing := filepath.Join(t.TempDir(), "missing.env")
+	if _, err := LoadEnvFile(missing, false); err != nil {
+		t.Errorf("Expected an optional missing file to be ignored, got %v", err)
+	}
+	if _, err := LoadEnvFile(missing, true); err == nil {
+		t.Error("Expected a required missing file to fail")
+	}
+
+	if runtime.GOOS != "windows" {
+		if err := os.Chmod(path, 0666); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadEnvFile(path, true); err == nil || !strings.Contains(err.Error(), "world-writable") {
+			t.Errorf("Expected a world-writable file to be rejected, got %v", err)
+		}
+	}
+}
+
+func TestLoadEnvFile_RestrictedKeys(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	t.Setenv("LINCTL_TEST_ENVFILE_NEW", "")
+	os.Unsetenv("LINCTL_TEST_ENVFILE_NEW")
+
+	other := write("other.env", "LINCTL_TEST_ENVFILE_NEW=1\nPATH=/tmp/evil\n")
+	for _, explicit := range []bool{true, false} {
+		if _, err := LoadEnvFile(other, explicit); err == nil || !strings.Contains(err.Error(), "PATH is not a linctl setting") {
+			t.Errorf("Expected PATH to be rejected (explicit %v), got %v", explicit, err)
+		}
+	}
+	if _, exists := os.LookupEnv("LINCTL_TEST_ENVFILE_NEW"); exists {
+		t.Error("Expected a rejected file to set nothing")
+	}
+
+	for _, key := range []string{"LINCTL_HTTP_PROXY", "LINCTL_TLS_INSECURE", "LINEAR_BASE_URL", "LINCTL_TOKEN_FILE", "LINCTL_MOCK_DIR"} {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, "")
+			os.Unsetenv(key)
+			path := write(key+".env", key+"=value\n")
+
+			if _, err := LoadEnvFile(path, false); err == nil || !strings.Contains(err.Error(), "--env-file") {
+				t.Errorf("Expected %s to be refused from the implicit file, got %v", key, err)
+			}
+			if set, err := LoadEnvFile(path, true); err != nil || len(set) != 1 {
+				t.Errorf("Expected %s to be allowed from --env-file, got %v (err %v)", key, set, err)
+			}
+		})
+	}
+}
@@ -14,24 +14,30 @@ import (
 
 // ProductionConfig holds all production-ready configuration
 type ProductionConfig struct {
-	Retry     resilience.RetryConfig    `json:"retry"`
-	RateLimit ratelimit.RateLimitConfig `json:"rate_limit"`
-	Logging   LoggingConfig             `json:"logging"`
-	Security  SecurityConfig            `json:"security"`
-	Metrics   MetricsConfig             `json:"metrics"`
+	Retry          resilience.RetryConfig          `json:"retry"`
+	RateLimit      ratelimit.RateLimitConfig       `json:"rate_limit"`
+	CircuitBreaker resilience.CircuitBreakerConfig `json:"circuit_breaker"`
+	Logging        LoggingConfig                   `json:"logging"`
+	Security       SecurityConfig                  `json:"security"`
+	Metrics        MetricsConfig                   `json:"metrics"`
 }
 
 // LoggingConfig configures logging behavior
 type LoggingConfig struct {
-	Level  string `json:"level"`
-	Format string `json:"format"`
+	Level      string `json:"level"`
+	Format     string `json:"format"`
+	File       string `json:"file"`
+	MaxSizeMB  int    `json:"max_size_mb"`
+	MaxBackups int    `json:"max_backups"`
 }
 
 // SecurityConfig configures security features
 type SecurityConfig struct {
-	EncryptTokens bool `json:"encrypt_tokens"`
-	AuditLog      bool `json:"audit_log"`
-	ValidateInput bool `json:"validate_input"`
+	EncryptTokens bool   `json:"encrypt_tokens"`
+	TokenKey      string `json:"-"`
+	AuditLog      bool   `json:"audit_log"`
+	AuditLogPath  string `json:"audit_log_path"`
+	ValidateInput bool   `json:"validate_input"`
 }
 
 // MetricsConfig configures metrics collection
@@ -43,11 +49,12 @@ type MetricsConfig struct {
 // LoadProductionConfig loads configuration from environment variables
 func LoadProductionConfig() (*ProductionConfig, error) {
 	config := &ProductionConfig{
-		Retry:     loadRetryConfig(),
-		RateLimit: loadRateLimitConfig(),
-		Logging:   loadLoggingConfig(),
-		Security:  loadSecurityConfig(),
-		Metrics:   loadMetricsConfig(),
+		Retry:          loadRetryConfig(),
+		RateLimit:      loadRateLimitConfig(),
+		CircuitBreaker: loadCircuitBreakerConfig(),
+		Logging:        loadLoggingConfig(),
+		Security:       loadSecurityConfig(),
+		Metrics:        loadMetricsConfig(),
 	}
 
 	return config, nil
@@ -77,6 +84,10 @@ func loadRetryConfig() resilience.RetryConfig {
 		config.Jitter = jitter
 	}
 
+	if mode := getEnvString("LINCTL_RETRY_JITTER_MODE", ""); mode != "" {
+		config.JitterMode = resilience.JitterMode(strings.ToLower(mode))
+	}
+
 	return config
 }
 
@@ -104,14 +115,42 @@ func loadRateLimitConfig() ratelimit.RateLimitConfig {
 		config.BackoffDelay = backoff
 	}
 
+	if concurrency := getEnvInt("LINCTL_MAX_CONCURRENCY", config.MaxConcurrency); concurrency > 0 {
+		config.MaxConcurrency = concurrency
+	}
+
+	return config
+}
+
+// loadCircuitBreakerConfig loads circuit breaker configuration from environment
+func loadCircuitBreakerConfig() resilience.CircuitBreakerConfig {
+	config := resilience.DefaultCircuitBreakerConfig()
+
+	config.Enabled = getEnvBool("LINCTL_CIRCUIT_BREAKER", config.Enabled)
+
+	if threshold := getEnvInt("LINCTL_CIRCUIT_THRESHOLD", config.Threshold); threshold > 0 {
+		config.Threshold = threshold
+	}
+
+	if window := getEnvDuration("LINCTL_CIRCUIT_WINDOW", config.Window); window > 0 {
+		config.Window = window
+	}
+
+	if cooldown := getEnvDuration("LINCTL_CIRCUIT_COOLDOWN", config.Cooldown); cooldown > 0 {
+		config.Cooldown = cooldown
+	}
+
 	return config
 }
 
 // loadLoggingConfig loads logging configuration from environment
 func loadLoggingConfig() LoggingConfig {
 	return LoggingConfig{
-		Level:  getEnvString("LINCTL_LOG_LEVEL", "info"),
-		Format: getEnvString("LINCTL_LOG_FORMAT", "text"),
+		Level:      getEnvString("LINCTL_LOG_LEVEL", "info"),
+		Format:     getEnvString("LINCTL_LOG_FORMAT", "text"),
+		File:       getEnvString("LINCTL_LOG_FILE", ""),
+		MaxSizeMB:  getEnvInt("LINCTL_LOG_MAX_SIZE_MB", logging.DefaultMaxSizeMB),
+		MaxBackups: getEnvInt("LINCTL_LOG_MAX_BACKUPS", logging.DefaultMaxBackups),
 	}
 }
 
@@ -119,7 +158,9 @@ func loadLoggingConfig() LoggingConfig {
 func loadSecurityConfig() SecurityConfig {
 	return SecurityConfig{
 		EncryptTokens: getEnvBool("LINCTL_ENCRYPT_TOKENS", false),
+		TokenKey:      getEnvString("LINCTL_TOKEN_KEY", ""),
 		AuditLog:      getEnvBool("LINCTL_AUDIT_LOG", true),
+		AuditLogPath:  getEnvString("LINCTL_AUDIT_LOG_PATH", ""),
 		ValidateInput: getEnvBool("LINCTL_VALIDATE_INPUT", true),
 	}
 }
@@ -195,6 +236,9 @@ func (c *ProductionConfig) Validate() error {
 	if c.Retry.Multiplier <= 1.0 {
 		return fmt.Errorf("retry multiplier must be greater than 1.0")
 	}
+	if !resilience.ValidJitterMode(c.Retry.JitterMode) {
+		return fmt.Errorf("retry jitter_mode must be one of: [full equal decorrelated]")
+	}
 
 	// Validate rate limit config
 	if c.RateLimit.RequestsPerSecond <= 0 {
@@ -206,6 +250,9 @@ func (c *ProductionConfig) Validate() error {
 	if c.RateLimit.BackoffDelay <= 0 {
 		return fmt.Errorf("rate_limit backoff_delay must be positive")
 	}
+	if c.RateLimit.MaxConcurrency <= 0 {
+		return fmt.Errorf("rate_limit max_concurrency must be positive")
+	}
 
 	// Validate logging config
 	validLevels := []string{"debug", "info", "warn", "error"}
@@ -217,6 +264,12 @@ func (c *ProductionConfig) Validate() error {
 	if !contains(validFormats, strings.ToLower(c.Logging.Format)) {
 		return fmt.Errorf("logging format must be one of: %v", validFormats)
 	}
+	if c.Logging.MaxSizeMB < 0 {
+		return fmt.Errorf("logging max_size_mb cannot be negative")
+	}
+	if c.Logging.MaxBackups < 0 {
+		return fmt.Errorf("logging max_backups cannot be negative")
+	}
 
 	return nil
 }
@@ -246,6 +299,7 @@ func (c *ProductionConfig) PrintConfig(logger logging.Logger) {
 		logging.Duration("retry_max_delay", c.Retry.MaxDelay),
 		logging.String("retry_multiplier", fmt.Sprintf("%.1f", c.Retry.Multiplier)),
 		logging.Bool("retry_jitter", c.Retry.Jitter),
+		logging.String("retry_jitter_mode", string(c.Retry.JitterMode)),
 
 		// Rate limit config
 		logging.String("rate_limit_rps", fmt.Sprintf("%.1f", c.RateLimit.RequestsPerSecond)),
@@ -253,14 +307,25 @@ func (c *ProductionConfig) PrintConfig(logger logging.Logger) {
 		logging.Bool("rate_limit_enabled", c.RateLimit.Enabled),
 		logging.Bool("rate_limit_adaptive", c.RateLimit.AdaptiveMode),
 		logging.Duration("rate_limit_backoff", c.RateLimit.BackoffDelay),
+		logging.Int("max_concurrency", c.RateLimit.MaxConcurrency),
+
+		// Circuit breaker config
+		logging.Bool("circuit_breaker_enabled", c.CircuitBreaker.Enabled),
+		logging.Int("circuit_threshold", c.CircuitBreaker.Threshold),
+		logging.Duration("circuit_window", c.CircuitBreaker.Window),
+		logging.Duration("circuit_cooldown", c.CircuitBreaker.Cooldown),
 
 		// Logging config
 		logging.String("log_level", c.Logging.Level),
 		logging.String("log_format", c.Logging.Format),
+		logging.String("log_file", c.Logging.File),
+		logging.Int("log_max_size_mb", c.Logging.MaxSizeMB),
+		logging.Int("log_max_backups", c.Logging.MaxBackups),
 
 		// Security config
 		logging.Bool("encrypt_tokens", c.Security.EncryptTokens),
 		logging.Bool("audit_log", c.Security.AuditLog),
+		logging.String("audit_log_path", c.Security.AuditLogPath),
 		logging.Bool("validate_input", c.Security.ValidateInput),
 
 		// Metrics config
@@ -290,6 +355,7 @@ Retry Configuration:
   LINCTL_RETRY_MAX_DELAY=30s         # Maximum delay between retries
   LINCTL_RETRY_MULTIPLIER=2.0        # Delay multiplier for exponential backoff
   LINCTL_RETRY_JITTER=true           # Add random jitter to delays
+  LINCTL_RETRY_JITTER_MODE=full      # Jitter strategy: full, equal, or decorrelated (default ±25%)
 
 Rate Limiting Configuration:
   LINCTL_RATE_LIMIT_RPS=10.0         # Requests per second limit
@@ -297,15 +363,45 @@ Rate Limiting Configuration:
   LINCTL_RATE_LIMIT_ENABLED=true     # Enable rate limiting
   LINCTL_RATE_LIMIT_ADAPTIVE=true    # Enable adaptive rate limiting
   LINCTL_RATE_LIMIT_BACKOFF=5s       # Backoff delay for rate limit hits
+  LINCTL_MAX_CONCURRENCY=5           # Most requests in flight for batch operations
+  LINCTL_GLOBAL_RATE_LIMIT=false     # Share one rate limiter between all clients in the process
+  LINCTL_MAX_PAGES=100               # Most pages a list command fetches before stopping
+
+Circuit Breaker Configuration:
+  LINCTL_CIRCUIT_BREAKER=false       # Stop sending requests while the API is failing
+  LINCTL_CIRCUIT_THRESHOLD=5         # Consecutive failures before the breaker opens
+  LINCTL_CIRCUIT_WINDOW=1m           # Window in which failures must occur
+  LINCTL_CIRCUIT_COOLDOWN=30s        # Time before a trial request is allowed
 
 Logging Configuration:
   LINCTL_LOG_LEVEL=info              # Log level (debug, info, warn, error)
   LINCTL_LOG_FORMAT=text             # Log format (text, json)
+  LINCTL_LOG_FILE=/var/log/linctl.log  # Write logs to this file (0600) instead of stderr
+  LINCTL_LOG_MAX_SIZE_MB=10          # Rotate the log file at this size
+  LINCTL_LOG_MAX_BACKUPS=3           # Rotated log files to keep (linctl.log.1, ...)
+  LINCTL_TRACE_FILE=/tmp/trace.jsonl # Append one JSON line per API request (auth redacted)
+
+HTTP Configuration:
+  LINCTL_HTTP_TIMEOUT=30s            # Timeout for each request to Linear
+  LINCTL_HTTP_PROXY=http://proxy:8080  # Proxy for requests to Linear (default: HTTPS_PROXY)
+  LINCTL_TLS_INSECURE=false          # Skip TLS certificate verification (unsafe, self-hosted only)
+  LINCTL_EXTRA_HEADERS="X-Team: platform, X-Env: prod"  # Extra headers for every request (e.g. for a gateway)
+
+Output Configuration:
+  LINCTL_OUTPUT_FORMAT=text          # Default output format (json, csv, yaml, text)
+
+Testing Configuration:
+  LINCTL_MOCK_DIR=./mocks            # Canned responses served with --mock (ignored without it)
 
 Security Configuration:
   LINCTL_ENCRYPT_TOKENS=false        # Encrypt tokens at rest
+  LINCTL_TOKEN_KEY=passphrase        # Token encryption passphrase (default: machine-specific secret)
+  LINCTL_TOKEN_BACKEND=file          # OAuth token storage (file, keyring)
+  LINCTL_PROFILE=work                # Credential profile stored in ~/.linctl/profiles/<name>
   LINCTL_AUDIT_LOG=true              # Enable audit logging
+  LINCTL_AUDIT_LOG_PATH=~/.linctl-audit.log  # Audit log file (JSON lines)
   LINCTL_VALIDATE_INPUT=true         # Enable input validation
+  LINCTL_QUERY_ALLOWLIST=path        # File of operation names 'linctl api query' may run
 
 Metrics Configuration:
   LINCTL_METRICS_ENABLED=false       # Enable metrics collection
@@ -318,5 +414,6 @@ OAuth Configuration (from previous phases):
   LINEAR_SCOPES=read,write           # OAuth scopes
   LINEAR_DEFAULT_ACTOR=Agent Name    # Default actor for attribution
   LINEAR_DEFAULT_AVATAR_URL=https://example.com/avatar.png  # Default avatar URL
+  LINEAR_DEFAULT_TEAM=ENG            # Team used when --team is omitted
 `
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/nicholls-inc/linctl/pkg/logging"
+	"github.com/nicholls-inc/linctl/pkg/resilience"
 )
 
 func TestLoadProductionConfig(t *testing.T) {
@@ -54,6 +55,7 @@ func TestLoadProductionConfigWithEnvironment(t *testing.T) {
 	os.Setenv("LINCTL_RATE_LIMIT_ENABLED", "false")
 	os.Setenv("LINCTL_RATE_LIMIT_ADAPTIVE", "false")
 	os.Setenv("LINCTL_RATE_LIMIT_BACKOFF", "10s")
+	os.Setenv("LINCTL_MAX_CONCURRENCY", "8")
 
 	os.Setenv("LINCTL_LOG_LEVEL", "debug")
 	os.Setenv("LINCTL_LOG_FORMAT", "json")
@@ -112,6 +114,10 @@ func TestLoadProductionConfigWithEnvironment(t *testing.T) {
 		t.Errorf("Expected rate limit backoff 10s, got %v", config.RateLimit.BackoffDelay)
 	}
 
+	if config.RateLimit.MaxConcurrency != 8 {
+		t.Errorf("Expected max concurrency 8, got %d", config.RateLimit.MaxConcurrency)
+	}
+
 	// Check logging config
 	if config.Logging.Level != "debug" {
 		t.Errorf("Expected logging level debug, got %s", config.Logging.Level)
@@ -198,6 +204,18 @@ func TestProductionConfigValidate(t *testing.T) {
 			expectError: true,
 			errorMsg:    "logging format must be one of",
 		},
+		{
+			name: "invalid retry jitter mode",
+			config: &ProductionConfig{
+				Retry:     resilience.RetryConfig{MaxAttempts: 3, InitialDelay: time.Second, MaxDelay: 30 * time.Second, Multiplier: 2.0, Jitter: true, JitterMode: "sometimes"},
+				RateLimit: loadRateLimitConfig(),
+				Logging:   LoggingConfig{Level: "info", Format: "text"},
+				Security:  SecurityConfig{},
+				Metrics:   MetricsConfig{},
+			},
+			expectError: true,
+			errorMsg:    "retry jitter_mode must be one of",
+		},
 	}
 
 	for _, test := range tests {
@@ -461,6 +479,7 @@ func TestLoadRetryConfig(t *testing.T) {
 	os.Setenv("LINCTL_RETRY_MAX_DELAY", "90s")
 	os.Setenv("LINCTL_RETRY_MULTIPLIER", "2.5")
 	os.Setenv("LINCTL_RETRY_JITTER", "false")
+	os.Setenv("LINCTL_RETRY_JITTER_MODE", "Decorrelated")
 
 	config = loadRetryConfig()
 	if config.MaxAttempts != 7 {
@@ -478,6 +497,9 @@ func TestLoadRetryConfig(t *testing.T) {
 	if config.Jitter != false {
 		t.Errorf("Expected jitter false, got %v", config.Jitter)
 	}
+	if config.JitterMode != resilience.JitterModeDecorrelated {
+		t.Errorf("Expected jitter mode decorrelated, got %q", config.JitterMode)
+	}
 }
 
 func TestLoadRateLimitConfig(t *testing.T) {
@@ -521,6 +543,47 @@ func TestLoadRateLimitConfig(t *testing.T) {
 	}
 }
 
+func TestLoadCircuitBreakerConfig(t *testing.T) {
+	clearTestEnvVars()
+	defer clearTestEnvVars()
+
+	// Test with defaults
+	config := loadCircuitBreakerConfig()
+	if config.Enabled {
+		t.Error("Circuit breaker should be disabled by default")
+	}
+	if config.Threshold != 5 {
+		t.Errorf("Expected default threshold 5, got %d", config.Threshold)
+	}
+
+	// Test with environment variables
+	os.Setenv("LINCTL_CIRCUIT_BREAKER", "true")
+	os.Setenv("LINCTL_CIRCUIT_THRESHOLD", "3")
+	os.Setenv("LINCTL_CIRCUIT_WINDOW", "2m")
+	os.Setenv("LINCTL_CIRCUIT_COOLDOWN", "10s")
+
+	config = loadCircuitBreakerConfig()
+	if !config.Enabled {
+		t.Error("Expected circuit breaker to be enabled")
+	}
+	if config.Threshold != 3 {
+		t.Errorf("Expected threshold 3, got %d", config.Threshold)
+	}
+	if config.Window != 2*time.Minute {
+		t.Errorf("Expected window 2m, got %v", config.Window)
+	}
+	if config.Cooldown != 10*time.Second {
+		t.Errorf("Expected cooldown 10s, got %v", config.Cooldown)
+	}
+
+	// Invalid thresholds keep the default
+	os.Setenv("LINCTL_CIRCUIT_THRESHOLD", "0")
+	config = loadCircuitBreakerConfig()
+	if config.Threshold != 5 {
+		t.Errorf("Expected default threshold for invalid value, got %d", config.Threshold)
+	}
+}
+
 func TestLoadLoggingConfig(t *testing.T) {
 	clearTestEnvVars()
 	defer clearTestEnvVars()
@@ -619,15 +682,22 @@ func clearTestEnvVars() {
 		"LINCTL_RETRY_MAX_DELAY",
 		"LINCTL_RETRY_MULTIPLIER",
 		"LINCTL_RETRY_JITTER",
+		"LINCTL_RETRY_JITTER_MODE",
 		"LINCTL_RATE_LIMIT_RPS",
 		"LINCTL_RATE_LIMIT_BURST",
 		"LINCTL_RATE_LIMIT_ENABLED",
 		"LINCTL_RATE_LIMIT_ADAPTIVE",
 		"LINCTL_RATE_LIMIT_BACKOFF",
+		"LINCTL_MAX_CONCURRENCY",
+		"LINCTL_CIRCUIT_BREAKER",
+		"LINCTL_CIRCUIT_THRESHOLD",
+		"LINCTL_CIRCUIT_WINDOW",
+		"LINCTL_CIRCUIT_COOLDOWN",
 		"LINCTL_LOG_LEVEL",
 		"LINCTL_LOG_FORMAT",
 		"LINCTL_ENCRYPT_TOKENS",
 		"LINCTL_AUDIT_LOG",
+		"LINCTL_AUDIT_LOG_PATH",
 		"LINCTL_VALIDATE_INPUT",
 		"LINCTL_METRICS_ENABLED",
 		"LINCTL_METRICS_EXPORT_PATH",
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ProfileEnvVar selects the active profile when --profile is not given
+const ProfileEnvVar = "LINCTL_PROFILE"
+
+// DefaultProfile is the name reported for the legacy, unnamed profile
+const DefaultProfile = "default"
+
+// profileNamePattern keeps profile names safe to use as directory names
+var profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,63}$`)
+
+// activeProfile is set from the --profile flag and overrides LINCTL_PROFILE
+var activeProfile string
+
+// SetActiveProfile selects the profile used for credential storage
+func SetActiveProfile(name string) {
+	activeProfile = strings.TrimSpace(name)
+}
+
+// ActiveProfile returns the selected profile, or "" for the legacy default profile
+func ActiveProfile() string {
+	name := activeProfile
+	if name == "" {
+		name = strings.TrimSpace(os.Getenv(ProfileEnvVar))
+	}
+	if name == DefaultProfile {
+		return ""
+	}
+	return name
+}
+
+// ValidateProfileName checks that a profile name can be used as a directory name
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use letters, numbers, '-' and '_' (maximum 64 characters)", name)
+	}
+	return nil
+}
+
+// ProfilesDir returns the directory holding named profiles (~/.linctl/profiles)
+func ProfilesDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".linctl", "profiles"), nil
+}
+
+// ProfileDir returns the directory for a named profile.
+// It returns "" for the default profile, whose files live at their legacy paths.
+func ProfileDir(name string) (string, error) {
+	if name == "" || name == DefaultProfile {
+		return "", nil
+	}
+	if err := ValidateProfileName(name); err != nil {
+		return "", err
+	}
+
+	profilesDir, err := ProfilesDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(profilesDir, name), nil
+}
+
+// ListProfiles returns the names of the named profiles, sorted alphabetically
+func ListProfiles() ([]string, error) {
+	profilesDir, err := ProfilesDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(profilesDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read profiles directory: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() && ValidateProfileName(entry.Name()) == nil {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestActiveProfile(t *testing.T) {
+	defer SetActiveProfile("")
+
+	tests := []struct {
+		name     string
+		flag     string
+		env      string
+		expected string
+	}{
+		{"none", "", "", ""},
+		{"env", "", "work", "work"},
+		{"flag overrides env", "personal", "work", "personal"},
+		{"default name", "default", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(ProfileEnvVar, tt.env)
+			SetActiveProfile(tt.flag)
+
+			if got := ActiveProfile(); got != tt.expected {
+				t.Errorf("Expected profile %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateProfileName(t *testing.T) {
+	for _, name := range []string{"work", "client_a", "Team-2"} {
+		if err := ValidateProfileName(name); err != nil {
+			t.Errorf("Expected %q to be valid, got %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "../etc", "a/b", "-work", ".hidden"} {
+		if err := ValidateProfileName(name); err == nil {
+			t.Errorf("Expected %q to be rejected", name)
+		}
+	}
+}
+
+func TestProfileDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	dir, err := ProfileDir("")
+	if err != nil || dir != "" {
+		t.Errorf("Expected no directory for the default profile, got %q, %v", dir, err)
+	}
+
+	dir, err = ProfileDir("work")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := filepath.Join(home, ".linctl", "profiles", "work"); dir != expected {
+		t.Errorf("Expected %s, got %s", expected, dir)
+	}
+
+	if _, err := ProfileDir("../work"); err == nil {
+		t.Error("Expected invalid profile name to be rejected")
+	}
+}
+
+func TestListProfiles(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	profiles, err := ListProfiles()
+	if err != nil || len(profiles) != 0 {
+		t.Fatalf("Expected no profiles, got %v, %v", profiles, err)
+	}
+
+	profilesDir := filepath.Join(home, ".linctl", "profiles")
+	for _, name := range []string{"work", "personal"} {
+		if err := os.MkdirAll(filepath.Join(profilesDir, name), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(profilesDir, "notes.txt"), nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	profiles, err = ListProfiles()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(profiles, []string{"personal", "work"}) {
+		t.Errorf("Expected sorted profile directories, got %v", profiles)
+	}
+}
@@ -0,0 +1,179 @@
+package httpclient
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultCompressThreshold is the request body size, in bytes, above which
+// bodies are gzipped when LINCTL_HTTP_COMPRESS_THRESHOLD is unset
+const DefaultCompressThreshold = 1024
+
+// gzipHosts records, per host, whether the server accepts gzipped request
+// bodies. It is shared by every client since several are built per command.
+var gzipHosts sync.Map
+
+// compressTransport gzips request bodies larger than threshold once the server
+// has said it accepts them, and asks for and decompresses gzipped responses
+type compressTransport struct {
+	next      http.RoundTripper
+	threshold int
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *compressTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	out := req.Clone(req.Context())
+
+	// Setting Accept-Encoding ourselves turns off the transport's own
+	// decompression, so the response is decompressed below instead
+	decompress := req.Header.Get("Accept-Encoding") == ""
+	if decompress {
+		out.Header.Set("Accept-Encoding", "gzip")
+	}
+
+	var body []byte
+	compressed := false
+	if t.shouldCompress(req) {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(body) > t.threshold {
+			zipped, err := gzipBytes(body)
+			if err != nil {
+				return nil, err
+			}
+			setBody(out, zipped)
+			out.Header.Set("Content-Encoding", "gzip")
+			compressed = true
+		} else {
+			setBody(out, body)
+		}
+	}
+
+	resp, err := t.next.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+
+	// The server advertised gzip but rejected it anyway: stop compressing
+	// for this host and send the original body
+	if compressed && resp.StatusCode == http.StatusUnsupportedMediaType {
+		gzipHosts.Store(req.URL.Host, false)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		out = out.Clone(req.Context())
+		out.Header.Del("Content-Encoding")
+		setBody(out, body)
+		if resp, err = t.next.RoundTrip(out); err != nil {
+			return nil, err
+		}
+	}
+
+	if acceptsGzip(resp.Header) {
+		if _, known := gzipHosts.Load(req.URL.Host); !known {
+			gzipHosts.Store(req.URL.Host, true)
+		}
+	}
+
+	if decompress && strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		resp.Body = &gzipBody{body: resp.Body}
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		resp.Uncompressed = true
+	}
+
+	return resp, nil
+}
+
+// shouldCompress reports whether req's body may need compressing: compression
+// is on, the host accepts gzip and the body is not known to be small
+func (t *compressTransport) shouldCompress(req *http.Request) bool {
+	if t.threshold <= 0 || req.Body == nil || req.Body == http.NoBody {
+		return false
+	}
+	if req.Header.Get("Content-Encoding") != "" {
+		return false
+	}
+	if req.ContentLength > 0 && req.ContentLength <= int64(t.threshold) {
+		return false
+	}
+	supported, _ := gzipHosts.Load(req.URL.Host)
+	return supported == true
+}
+
+// setBody replaces req's body with data, keeping it replayable for redirects
+func setBody(req *http.Request, data []byte) {
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	req.ContentLength = int64(len(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+}
+
+// gzipBytes compresses data with gzip
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// acceptsGzip reports whether a response's Accept-Encoding header lists gzip,
+// which is how a server says it takes gzipped request bodies (RFC 7694)
+func acceptsGzip(header http.Header) bool {
+	for _, value := range header.Values("Accept-Encoding") {
+		for _, coding := range strings.Split(value, ",") {
+			name, params, _ := strings.Cut(coding, ";")
+			if !strings.EqualFold(strings.TrimSpace(name), "gzip") {
+				continue
+			}
+			if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+				if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// gzipBody decompresses a response body, opening the gzip stream on the first
+// Read so a bad header surfaces as a read error like any other body error
+type gzipBody struct {
+	body   io.ReadCloser
+	reader *gzip.Reader
+	err    error
+}
+
+// Read implements io.Reader
+func (b *gzipBody) Read(p []byte) (int, error) {
+	if b.reader == nil && b.err == nil {
+		b.reader, b.err = gzip.NewReader(b.body)
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+	return b.reader.Read(p)
+}
+
+// Close implements io.Closer
+func (b *gzipBody) Close() error {
+	return b.body.Close()
+}
@@ -0,0 +1,227 @@
+package httpclient
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// echoServer decompresses gzipped request bodies and echoes them back,
+// gzipped when the client accepts it. It advertises gzip support unless
+// rejectGzip is set, in which case gzipped requests get a 415.
+type echoServer struct {
+	rejectGzip bool
+	encodings  []string
+}
+
+func (s *echoServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.encodings = append(s.encodings, r.Header.Get("Content-Encoding"))
+	w.Header().Set("Accept-Encoding", "gzip")
+
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		if s.rejectGzip {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body = reader
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		_, _ = writer.Write(data)
+		_ = writer.Close()
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// post sends body to url and returns the decoded response body
+func post(t *testing.T, client *http.Client, url, body string) (string, *http.Response) {
+	t.Helper()
+	resp, err := client.Post(url, "application/json", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read response: %v", err)
+	}
+	return string(data), resp
+}
+
+// resetGzipHosts forgets which hosts accept gzip, so tests start from scratch
+func resetGzipHosts(t *testing.T) {
+	forget := func() {
+		gzipHosts.Range(func(host, _ any) bool {
+			gzipHosts.Delete(host)
+			return true
+		})
+	}
+	forget()
+	t.Cleanup(forget)
+}
+
+func TestCompress_RoundTrip(t *testing.T) {
+	resetGzipHosts(t)
+	handler := &echoServer{}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := New(DefaultConfig())
+	large := `{"query":"` + strings.Repeat("x", 2*DefaultCompressThreshold) + `"}`
+	small := `{"query":"{ viewer { id } }"}`
+
+	// The first request learns that the server accepts gzip
+	for i, body := range []string{large, large, small} {
+		got, resp := post(t, client, server.URL, body)
+		if got != body {
+			t.Errorf("Request %d: expected the body to be echoed back, got %d bytes", i, len(got))
+		}
+		if !resp.Uncompressed {
+			t.Errorf("Request %d: expected the gzipped response to be decompressed", i)
+		}
+	}
+
+	want := []string{"", "gzip", ""}
+	for i, encoding := range handler.encodings {
+		if encoding != want[i] {
+			t.Errorf("Request %d: expected Content-Encoding %q, got %q", i, want[i], encoding)
+		}
+	}
+}
+
+func TestCompress_Disabled(t *testing.T) {
+	resetGzipHosts(t)
+	handler := &echoServer{}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := New(Config{CompressThreshold: 0})
+	large := strings.Repeat("x", 4*DefaultCompressThreshold)
+	for i := 0; i < 2; i++ {
+		if got, _ := post(t, client, server.URL, large); got != large {
+			t.Errorf("Expected the body to be echoed back, got %d bytes", len(got))
+		}
+	}
+
+	for _, encoding := range handler.encodings {
+		if encoding != "" {
+			t.Errorf("Expected no request compression with a zero threshold, got %q", encoding)
+		}
+	}
+}
+
+func TestCompress_UnsupportedMediaTypeFallsBack(t *testing.T) {
+	resetGzipHosts(t)
+	handler := &echoServer{rejectGzip: true}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := New(DefaultConfig())
+	large := strings.Repeat("x", 2*DefaultCompressThreshold)
+	for i := 0; i < 3; i++ {
+		got, resp := post(t, client, server.URL, large)
+		if resp.StatusCode != http.StatusOK || got != large {
+			t.Fatalf("Request %d: expected the uncompressed retry to succeed, got %d", i, resp.StatusCode)
+		}
+	}
+
+	// Uncompressed, then gzip rejected and resent, then never gzipped again
+	want := []string{"", "gzip", "", ""}
+	if len(handler.encodings) != len(want) {
+		t.Fatalf("Expected %d requests, got %v", len(want), handler.encodings)
+	}
+	for i, encoding := range handler.encodings {
+		if encoding != want[i] {
+			t.Errorf("Request %d: expected Content-Encoding %q, got %q", i, want[i], encoding)
+		}
+	}
+}
+
+func TestCompress_CallerAcceptEncoding(t *testing.T) {
+	resetGzipHosts(t)
+	server := httptest.NewServer(&echoServer{})
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("hello"))
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, err := New(DefaultConfig()).Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.Header.Get("Content-Encoding") != "gzip" {
+		t.Error("Expected the response to be left compressed when the caller set Accept-Encoding")
+	}
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"", false},
+		{"gzip", true},
+		{"br, GZIP", true},
+		{"gzip;q=0.5", true},
+		{"gzip;q=0", false},
+		{"deflate", false},
+	}
+
+	for _, tt := range tests {
+		header := http.Header{}
+		if tt.value != "" {
+			header.Set("Accept-Encoding", tt.value)
+		}
+		if got := acceptsGzip(header); got != tt.want {
+			t.Errorf("acceptsGzip(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestLoadConfig_CompressThreshold(t *testing.T) {
+	tests := []struct {
+		value        string
+		want         int
+		wantProblems int
+	}{
+		{"", DefaultCompressThreshold, 0},
+		{"4096", 4096, 0},
+		{"0", 0, 0},
+		{"-1", DefaultCompressThreshold, 1},
+		{"1KB", DefaultCompressThreshold, 1},
+	}
+
+	for _, tt := range tests {
+		config, problems := loadConfig(func(key string) string {
+			if key == CompressThresholdEnvVar {
+				return tt.value
+			}
+			return ""
+		})
+		if config.CompressThreshold != tt.want {
+			t.Errorf("%s=%q: expected threshold %d, got %d", CompressThresholdEnvVar, tt.value, tt.want, config.CompressThreshold)
+		}
+		if len(problems) != tt.wantProblems {
+			t.Errorf("%s=%q: expected %d problems, got %v", CompressThresholdEnvVar, tt.value, tt.wantProblems, problems)
+		}
+	}
+}
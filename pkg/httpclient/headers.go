@@ -0,0 +1,82 @@
+package httpclient
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// ExtraHeadersEnvVar lists headers to send with every request, for gateways
+// in front of Linear, as comma-separated "Name: value" pairs
+const ExtraHeadersEnvVar = "LINCTL_EXTRA_HEADERS"
+
+// reservedHeaders are set by the clients themselves and cannot be replaced
+var reservedHeaders = map[string]bool{
+	"Authorization": true,
+	"Content-Type":  true,
+}
+
+// ExtraHeadersFromEnv parses LINCTL_EXTRA_HEADERS, returning nil when it is unset
+func ExtraHeadersFromEnv() (http.Header, error) {
+	return ParseExtraHeaders(os.Getenv(ExtraHeadersEnvVar))
+}
+
+// ParseExtraHeaders parses comma-separated "Name: value" pairs such as
+// "X-Team: platform, X-Env: prod". Entries without a colon, with an invalid
+// name or naming Authorization or Content-Type are rejected.
+func ParseExtraHeaders(value string) (http.Header, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	headers := http.Header{}
+	for _, entry := range strings.Split(value, ",") {
+		name, headerValue, ok := strings.Cut(entry, ":")
+		name = strings.TrimSpace(name)
+		headerValue = strings.TrimSpace(headerValue)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid %s entry %q: expected Name: value", ExtraHeadersEnvVar, strings.TrimSpace(entry))
+		}
+		if !validHeaderName(name) {
+			return nil, fmt.Errorf("invalid %s entry %q: %q is not a valid header name", ExtraHeadersEnvVar, strings.TrimSpace(entry), name)
+		}
+		if strings.ContainsAny(headerValue, "\r\n") {
+			return nil, fmt.Errorf("invalid %s entry %q: values cannot contain line breaks", ExtraHeadersEnvVar, name)
+		}
+
+		canonical := http.CanonicalHeaderKey(name)
+		if reservedHeaders[canonical] {
+			return nil, fmt.Errorf("%s cannot set %s; linctl sets it itself", ExtraHeadersEnvVar, canonical)
+		}
+		headers.Add(canonical, headerValue)
+	}
+
+	return headers, nil
+}
+
+// SetExtraHeaders adds headers to req, skipping any the client has already
+// set so they never replace its own Authorization or Content-Type
+func SetExtraHeaders(req *http.Request, headers http.Header) {
+	for name, values := range headers {
+		if reservedHeaders[name] || req.Header.Get(name) != "" {
+			continue
+		}
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+}
+
+// validHeaderName reports whether name is an RFC 7230 token
+func validHeaderName(name string) bool {
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", r):
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package httpclient
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseExtraHeaders(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    http.Header
+		expectError string
+	}{
+		{
+			name:     "unset",
+			value:    "",
+			expected: nil,
+		},
+		{
+			name:  "several headers",
+			value: "X-Team: platform, x-env:prod",
+			expected: http.Header{
+				"X-Team": {"platform"},
+				"X-Env":  {"prod"},
+			},
+		},
+		{
+			name:     "value containing a colon",
+			value:    "X-Upstream: http://gateway:8080",
+			expected: http.Header{"X-Upstream": {"http://gateway:8080"}},
+		},
+		{
+			name:     "repeated header",
+			value:    "X-Tag: a, X-Tag: b",
+			expected: http.Header{"X-Tag": {"a", "b"}},
+		},
+		{
+			name:        "missing colon",
+			value:       "X-Team platform",
+			expectError: `invalid LINCTL_EXTRA_HEADERS entry "X-Team platform": expected Name: value`,
+		},
+		{
+			name:        "empty entry",
+			value:       "X-Team: platform,",
+			expectError: "expected Name: value",
+		},
+		{
+			name:        "invalid name",
+			value:       "X Team: platform",
+			expectError: `"X Team" is not a valid header name`,
+		},
+		{
+			name:        "authorization",
+			value:       "authorization: Bearer other",
+			expectError: "cannot set Authorization",
+		},
+		{
+			name:        "content type",
+			value:       "Content-Type: text/plain",
+			expectError: "cannot set Content-Type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseExtraHeaders(tt.value)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetExtraHeaders(t *testing.T) {
+	req, _ := http.NewRequest("POST", "https://example.com", nil)
+	req.Header.Set("Authorization", "lin_api_key")
+	req.Header.Set("User-Agent", "linctl/1.0.0")
+
+	SetExtraHeaders(req, http.Header{
+		"Authorization": {"Bearer other"},
+		"User-Agent":    {"gateway"},
+		"X-Team":        {"platform"},
+	})
+
+	if got := req.Header.Get("Authorization"); got != "lin_api_key" {
+		t.Errorf("Expected Authorization to be kept, got %q", got)
+	}
+	if got := req.Header.Values("User-Agent"); len(got) != 1 || got[0] != "linctl/1.0.0" {
+		t.Errorf("Expected User-Agent to be kept, got %q", got)
+	}
+	if got := req.Header.Get("X-Team"); got != "platform" {
+		t.Errorf("Expected X-Team platform, got %q", got)
+	}
+}
@@ -0,0 +1,147 @@
+// Package httpclient builds the HTTP clients used to reach Linear so the API,
+// OAuth and retry layers share one set of timeout, proxy and TLS settings.
+package httpclient
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// TimeoutEnvVar overrides the request timeout, as a Go duration (e.g. 45s)
+	TimeoutEnvVar = "LINCTL_HTTP_TIMEOUT"
+	// ProxyEnvVar routes requests through a proxy instead of HTTPS_PROXY/HTTP_PROXY
+	ProxyEnvVar = "LINCTL_HTTP_PROXY"
+	// TLSInsecureEnvVar disables TLS certificate verification
+	TLSInsecureEnvVar = "LINCTL_TLS_INSECURE"
+	// CompressThresholdEnvVar sets the request body size in bytes above which
+	// bodies are gzipped; 0 turns request compression off
+	CompressThresholdEnvVar = "LINCTL_HTTP_COMPRESS_THRESHOLD"
+)
+
+// DefaultTimeout is the request timeout when LINCTL_HTTP_TIMEOUT is unset
+const DefaultTimeout = 30 * time.Second
+
+// Config configures the transport of a client built by New
+type Config struct {
+	// Timeout bounds each request, including reading the response body
+	Timeout time.Duration
+	// Proxy receives every request; nil falls back to the standard proxy environment variables
+	Proxy *url.URL
+	// TLSInsecure skips certificate verification, for endpoints behind an intercepting proxy
+	TLSInsecure bool
+	// CompressThreshold is the body size in bytes above which requests are
+	// gzipped, once the server says it accepts gzip; zero or less never compresses
+	CompressThreshold int
+}
+
+// DefaultConfig returns the settings used when no environment overrides are set
+func DefaultConfig() Config {
+	return Config{Timeout: DefaultTimeout, CompressThreshold: DefaultCompressThreshold}
+}
+
+var (
+	warnedMu sync.Mutex
+	warned   = map[string]bool{}
+)
+
+// ConfigFromEnv returns DefaultConfig with LINCTL_HTTP_TIMEOUT, LINCTL_HTTP_PROXY,
+// LINCTL_TLS_INSECURE and LINCTL_HTTP_COMPRESS_THRESHOLD applied. Invalid values are ignored with a warning.
+func ConfigFromEnv() Config {
+	config, problems := loadConfig(os.Getenv)
+	for _, problem := range problems {
+		warnOnce(problem)
+	}
+	return config
+}
+
+// loadConfig applies the environment read through getenv, returning a message
+// for each value it could not use
+func loadConfig(getenv func(string) string) (Config, []string) {
+	config := DefaultConfig()
+	var problems []string
+
+	if value := getenv(TimeoutEnvVar); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			problems = append(problems, fmt.Sprintf("ignoring %s=%q: expected a positive duration such as 45s", TimeoutEnvVar, value))
+		} else {
+			config.Timeout = timeout
+		}
+	}
+
+	if value := getenv(ProxyEnvVar); value != "" {
+		proxy, err := url.Parse(value)
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			problems = append(problems, fmt.Sprintf("ignoring %s=%q: expected a URL such as http://proxy:8080", ProxyEnvVar, value))
+		} else {
+			config.Proxy = proxy
+		}
+	}
+
+	if value := getenv(TLSInsecureEnvVar); value != "" {
+		insecure, err := strconv.ParseBool(value)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("ignoring %s=%q: expected true or false", TLSInsecureEnvVar, value))
+		} else {
+			config.TLSInsecure = insecure
+		}
+	}
+
+	if value := getenv(CompressThresholdEnvVar); value != "" {
+		threshold, err := strconv.Atoi(value)
+		if err != nil || threshold < 0 {
+			problems = append(problems, fmt.Sprintf("ignoring %s=%q: expected a size in bytes, or 0 to disable", CompressThresholdEnvVar, value))
+		} else {
+			config.CompressThreshold = threshold
+		}
+	}
+
+	return config, problems
+}
+
+// New returns an HTTP client using config. A zero Timeout means DefaultTimeout.
+// Responses are always requested and decompressed as gzip.
+func New(config Config) *http.Client {
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.Proxy != nil {
+		transport.Proxy = http.ProxyURL(config.Proxy)
+	}
+	if config.TLSInsecure {
+		warnOnce(fmt.Sprintf("%s is set: TLS certificate verification is DISABLED. "+
+			"Anyone on the network path can read and modify requests, including your credentials. "+
+			"Only use this for a trusted self-hosted endpoint.", TLSInsecureEnvVar))
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: true,
+		}
+	}
+
+	return &http.Client{
+		Timeout:   config.Timeout,
+		Transport: &compressTransport{next: transport, threshold: config.CompressThreshold},
+	}
+}
+
+// warnOnce prints message to stderr the first time it is seen, since several
+// clients are usually built per command
+func warnOnce(message string) {
+	warnedMu.Lock()
+	defer warnedMu.Unlock()
+
+	if warned[message] {
+		return
+	}
+	warned[message] = true
+	fmt.Fprintf(os.Stderr, "Warning: %s\n", message)
+}
@@ -0,0 +1,145 @@
+package httpclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		env          map[string]string
+		wantTimeout  time.Duration
+		wantProxy    string
+		wantInsecure bool
+		wantProblems int
+	}{
+		{
+			name:        "defaults",
+			env:         map[string]string{},
+			wantTimeout: DefaultTimeout,
+		},
+		{
+			name: "all set",
+			env: map[string]string{
+				TimeoutEnvVar:     "45s",
+				ProxyEnvVar:       "http://proxy.internal:8080",
+				TLSInsecureEnvVar: "true",
+			},
+			wantTimeout:  45 * time.Second,
+			wantProxy:    "http://proxy.internal:8080",
+			wantInsecure: true,
+		},
+		{
+			name: "invalid values fall back",
+			env: map[string]string{
+				TimeoutEnvVar:     "soon",
+				ProxyEnvVar:       "proxy.internal",
+				TLSInsecureEnvVar: "maybe",
+			},
+			wantTimeout:  DefaultTimeout,
+			wantProblems: 3,
+		},
+		{
+			name:         "non-positive timeout",
+			env:          map[string]string{TimeoutEnvVar: "0s"},
+			wantTimeout:  DefaultTimeout,
+			wantProblems: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, problems := loadConfig(func(key string) string { return tt.env[key] })
+
+			if config.Timeout != tt.wantTimeout {
+				t.Errorf("Expected timeout %v, got %v", tt.wantTimeout, config.Timeout)
+			}
+			gotProxy := ""
+			if config.Proxy != nil {
+				gotProxy = config.Proxy.String()
+			}
+			if gotProxy != tt.wantProxy {
+				t.Errorf("Expected proxy %q, got %q", tt.wantProxy, gotProxy)
+			}
+			if config.TLSInsecure != tt.wantInsecure {
+				t.Errorf("Expected TLSInsecure %v, got %v", tt.wantInsecure, config.TLSInsecure)
+			}
+			if len(problems) != tt.wantProblems {
+				t.Errorf("Expected %d problems, got %v", tt.wantProblems, problems)
+			}
+		})
+	}
+}
+
+func TestConfigFromEnv_Timeout(t *testing.T) {
+	t.Setenv(TimeoutEnvVar, "2m")
+
+	if timeout := ConfigFromEnv().Timeout; timeout != 2*time.Minute {
+		t.Errorf("Expected 2m timeout from %s, got %v", TimeoutEnvVar, timeout)
+	}
+}
+
+func TestNew_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	if _, err := New(Config{Timeout: 50 * time.Millisecond}).Get(server.URL); err == nil {
+		t.Error("Expected a request slower than the timeout to fail")
+	}
+
+	resp, err := New(Config{Timeout: 5 * time.Second}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected a request within the timeout to succeed: %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestNew_DefaultTimeout(t *testing.T) {
+	if timeout := New(Config{}).Timeout; timeout != DefaultTimeout {
+		t.Errorf("Expected zero timeout to mean %v, got %v", DefaultTimeout, timeout)
+	}
+}
+
+func TestNew_Proxy(t *testing.T) {
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.Host
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	resp, err := New(Config{Proxy: proxyURL}).Get("http://linear.example/graphql")
+	if err != nil {
+		t.Fatalf("Expected request through the proxy to succeed: %v", err)
+	}
+	resp.Body.Close()
+
+	if proxiedHost != "linear.example" {
+		t.Errorf("Expected the proxy to receive the request for linear.example, got %q", proxiedHost)
+	}
+}
+
+func TestNew_TLSInsecure(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	if _, err := New(DefaultConfig()).Get(server.URL); err == nil {
+		t.Error("Expected a self-signed certificate to be rejected by default")
+	}
+
+	resp, err := New(Config{TLSInsecure: true}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected TLSInsecure to accept a self-signed certificate: %v", err)
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,235 @@
+// Package idempotency makes create commands safe to retry under a caller-chosen key.
+//
+// Linear has no idempotency header, but create mutations accept a client-chosen
+// UUID. DeriveID turns an idempotency key into a stable UUID, so a retried create
+// either creates the entity once or fails because it already exists, in which case
+// the caller can fetch it by that ID. A local Store additionally remembers recent
+// results for a short TTL, so a repeat returns the earlier result without calling
+// the API and a key reused with different input is rejected.
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/config"
+)
+
+// DefaultTTL is how long a created result is remembered locally
+const DefaultTTL = time.Hour
+
+// maxKeyLength bounds idempotency keys; a UUID is 36 characters
+const maxKeyLength = 128
+
+// ErrKeyConflict is returned when a key is reused with a different input
+var ErrKeyConflict = errors.New("idempotency key was already used with a different input")
+
+// Entry is a remembered create result
+type Entry struct {
+	Operation string          `json:"operation"`
+	Key       string          `json:"key"`
+	Hash      string          `json:"hash"`
+	Result    json.RawMessage `json:"result"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// Store remembers create results in a JSON file next to the credential files
+type Store struct {
+	path string
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+var mu sync.Mutex
+
+// NewStore returns the store for the active profile
+func NewStore() (*Store, error) {
+	path, err := storePath(config.ActiveProfile())
+	if err != nil {
+		return nil, err
+	}
+	return NewStoreWithPath(path, DefaultTTL), nil
+}
+
+// NewStoreWithPath returns a store kept at path that remembers results for ttl
+func NewStoreWithPath(path string, ttl time.Duration) *Store {
+	return &Store{path: path, ttl: ttl, now: time.Now}
+}
+
+// storePath returns the cache path for a profile. Named profiles keep it under
+// ~/.linctl/profiles/<name>/, the default profile uses ~/.linctl-idempotency.json.
+func storePath(profile string) (string, error) {
+	profileDir, err := config.ProfileDir(profile)
+	if err != nil {
+		return "", err
+	}
+	if profileDir != "" {
+		return filepath.Join(profileDir, "idempotency.json"), nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".linctl-idempotency.json"), nil
+}
+
+// ValidateKey checks that an idempotency key is usable
+func ValidateKey(key string) error {
+	if strings.TrimSpace(key) == "" {
+		return fmt.Errorf("idempotency key cannot be empty")
+	}
+	if len(key) > maxKeyLength {
+		return fmt.Errorf("idempotency key is too long (maximum %d characters)", maxKeyLength)
+	}
+	for _, r := range key {
+		if r < 0x21 || r > 0x7e {
+			return fmt.Errorf("idempotency key must be printable ASCII without spaces")
+		}
+	}
+	return nil
+}
+
+// Hash fingerprints the inputs of a create so a reused key can be detected
+func Hash(values ...interface{}) (string, error) {
+	h := sha256.New()
+	for _, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", fmt.Errorf("failed to hash input: %w", err)
+		}
+		h.Write(data)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// DeriveID returns the UUID used for the entity created by operation under key.
+// The same operation and key always give the same ID, formatted as a version 4 UUID
+// because that is the format Linear accepts for client-chosen IDs.
+func DeriveID(operation, key string) string {
+	sum := sha256.Sum256([]byte("linctl-idempotency:" + operation + ":" + key))
+	b := sum[:16]
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// Lookup returns the remembered result of operation under key, if it has not expired.
+// It returns ErrKeyConflict when the key was used for a create with a different hash.
+func (s *Store) Lookup(operation, key, hash string) (*Entry, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	entries, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	entry, ok := entries[entryKey(operation, key)]
+	if !ok {
+		return nil, nil
+	}
+	if entry.Hash != hash {
+		return nil, fmt.Errorf("%w: %s", ErrKeyConflict, key)
+	}
+	return &entry, nil
+}
+
+// Save remembers result for operation under key and drops expired entries
+func (s *Store) Save(operation, key, hash string, result interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode result: %w", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	entries, err := s.load()
+	if err != nil {
+		return err
+	}
+	entries[entryKey(operation, key)] = Entry{
+		Operation: operation,
+		Key:       key,
+		Hash:      hash,
+		Result:    data,
+		CreatedAt: s.now().UTC(),
+	}
+
+	return s.write(entries)
+}
+
+// load reads the unexpired entries; a missing file is an empty store
+func (s *Store) load() (map[string]Entry, error) {
+	entries := make(map[string]Entry)
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entries, nil
+		}
+		return nil, fmt.Errorf("failed to read idempotency cache: %w", err)
+	}
+
+	var stored []Entry
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to parse idempotency cache %s: %w", s.path, err)
+	}
+
+	cutoff := s.now().Add(-s.ttl)
+	for _, entry := range stored {
+		if entry.CreatedAt.After(cutoff) {
+			entries[entryKey(entry.Operation, entry.Key)] = entry
+		}
+	}
+	return entries, nil
+}
+
+// write replaces the cache file atomically with 0600 permissions
+func (s *Store) write(entries map[string]Entry) error {
+	stored := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		stored = append(stored, entry)
+	}
+
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode idempotency cache: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return fmt.Errorf("failed to create idempotency cache directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".idempotency-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write idempotency cache: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write idempotency cache: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write idempotency cache: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to write idempotency cache: %w", err)
+	}
+	return nil
+}
+
+func entryKey(operation, key string) string {
+	return operation + "\x00" + key
+}
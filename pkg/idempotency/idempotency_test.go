@@ -0,0 +1,134 @@
+package idempotency
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var uuidV4Pattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestDeriveID(t *testing.T) {
+	id := DeriveID("issue.create", "key-1")
+
+	if !uuidV4Pattern.MatchString(id) {
+		t.Errorf("Expected a version 4 UUID, got %s", id)
+	}
+	if DeriveID("issue.create", "key-1") != id {
+		t.Error("Expected the same ID for the same operation and key")
+	}
+	if DeriveID("issue.create", "key-2") == id {
+		t.Error("Expected a different ID for a different key")
+	}
+	if DeriveID("comment.create", "key-1") == id {
+		t.Error("Expected a different ID for a different operation")
+	}
+}
+
+func TestValidateKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{"uuid", "3f2b8c1e-7a54-4d3b-9d0e-2c6f1a8b9e47", false},
+		{"empty", "", true},
+		{"spaces", "my key", true},
+		{"too long", string(make([]byte, maxKeyLength+1)), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateKey(tt.key); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateKey(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	a, _ := Hash(map[string]string{"title": "Bug"}, "comment")
+	b, _ := Hash(map[string]string{"title": "Bug"}, "comment")
+	c, _ := Hash(map[string]string{"title": "Bug"}, "other")
+
+	if a != b {
+		t.Error("Expected equal inputs to hash equally")
+	}
+	if a == c {
+		t.Error("Expected different inputs to hash differently")
+	}
+}
+
+func TestStoreSaveAndLookup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "idempotency.json")
+	store := NewStoreWithPath(path, time.Hour)
+
+	entry, err := store.Lookup("issue.create", "key-1", "hash-a")
+	if err != nil || entry != nil {
+		t.Fatalf("Expected empty store, got %v / %v", entry, err)
+	}
+
+	if err := store.Save("issue.create", "key-1", "hash-a", map[string]string{"identifier": "ENG-42"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	entry, err = store.Lookup("issue.create", "key-1", "hash-a")
+	if err != nil || entry == nil {
+		t.Fatalf("Expected remembered entry, got %v / %v", entry, err)
+	}
+	var result map[string]string
+	if err := json.Unmarshal(entry.Result, &result); err != nil || result["identifier"] != "ENG-42" {
+		t.Errorf("Unexpected result %s", entry.Result)
+	}
+
+	if _, err := store.Lookup("issue.create", "key-1", "hash-b"); !errors.Is(err, ErrKeyConflict) {
+		t.Errorf("Expected ErrKeyConflict, got %v", err)
+	}
+	if entry, _ := store.Lookup("comment.create", "key-1", "hash-a"); entry != nil {
+		t.Error("Expected keys to be scoped to their operation")
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Expected cache file: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected 0600 permissions, got %v", info.Mode().Perm())
+	}
+}
+
+func TestStoreExpiry(t *testing.T) {
+	store := NewStoreWithPath(filepath.Join(t.TempDir(), "idempotency.json"), time.Hour)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	if err := store.Save("issue.create", "key-1", "hash-a", "ENG-42"); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	now = now.Add(59 * time.Minute)
+	if entry, _ := store.Lookup("issue.create", "key-1", "hash-a"); entry == nil {
+		t.Error("Expected entry within the TTL")
+	}
+
+	now = now.Add(2 * time.Minute)
+	entry, err := store.Lookup("issue.create", "key-1", "hash-b")
+	if err != nil || entry != nil {
+		t.Errorf("Expected expired entry to be forgotten, got %v / %v", entry, err)
+	}
+}
+
+func TestStoreCorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "idempotency.json")
+	if err := os.WriteFile(path, []byte("not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewStoreWithPath(path, time.Hour).Lookup("issue.create", "key-1", "hash-a"); err == nil {
+		t.Error("Expected an error for a corrupt cache file")
+	}
+}
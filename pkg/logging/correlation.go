@@ -0,0 +1,75 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"unicode"
+)
+
+// CorrelationIDEnvVar sets an ID, such as an orchestrator's trace ID, that is
+// attached to every log line and sent with every API request
+const CorrelationIDEnvVar = "LINCTL_CORRELATION_ID"
+
+// CorrelationIDField is the log field holding the correlation ID
+const CorrelationIDField = "correlation_id"
+
+// maxCorrelationIDLength keeps the ID a reasonable size for a header
+const maxCorrelationIDLength = 256
+
+// correlationIDOverride wins over LINCTL_CORRELATION_ID, see SetCorrelationID
+var correlationIDOverride string
+
+// correlationIDKey is the context key for a per-request correlation ID
+type correlationIDKey struct{}
+
+// SetCorrelationID overrides LINCTL_CORRELATION_ID for this process (the
+// --correlation-id flag). An empty ID removes the override.
+func SetCorrelationID(id string) {
+	correlationIDOverride = id
+}
+
+// CorrelationID returns the ID set with --correlation-id or
+// LINCTL_CORRELATION_ID, or "" when there is none
+func CorrelationID() string {
+	if correlationIDOverride != "" {
+		return correlationIDOverride
+	}
+	return os.Getenv(CorrelationIDEnvVar)
+}
+
+// ValidateCorrelationID checks that id can be sent as a header value
+func ValidateCorrelationID(id string) error {
+	if len(id) > maxCorrelationIDLength {
+		return fmt.Errorf("correlation ID is too long (maximum %d characters)", maxCorrelationIDLength)
+	}
+	for _, r := range id {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return fmt.Errorf("correlation ID must be printable ASCII")
+		}
+	}
+	return nil
+}
+
+// WithCorrelationID returns a context whose requests use id instead of the
+// process-wide correlation ID
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFromContext returns the correlation ID for a request made with
+// ctx: the one set with WithCorrelationID, otherwise CorrelationID
+func CorrelationIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(correlationIDKey{}).(string); ok && id != "" {
+		return id
+	}
+	return CorrelationID()
+}
+
+// withCorrelationID adds the process-wide correlation ID, if any, to logger
+func withCorrelationID(logger Logger) Logger {
+	if id := CorrelationID(); id != "" {
+		return logger.With(String(CorrelationIDField, id))
+	}
+	return logger
+}
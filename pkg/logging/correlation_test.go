@@ -0,0 +1,72 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestCorrelationID_Precedence(t *testing.T) {
+	defer SetCorrelationID("")
+	t.Setenv(CorrelationIDEnvVar, "")
+
+	if id := CorrelationIDFromContext(context.Background()); id != "" {
+		t.Errorf("Expected no correlation ID by default, got %q", id)
+	}
+
+	t.Setenv(CorrelationIDEnvVar, "from-env")
+	if id := CorrelationID(); id != "from-env" {
+		t.Errorf("Expected the %s ID, got %q", CorrelationIDEnvVar, id)
+	}
+
+	SetCorrelationID("from-flag")
+	if id := CorrelationID(); id != "from-flag" {
+		t.Errorf("Expected the override to win over the environment, got %q", id)
+	}
+
+	ctx := WithCorrelationID(context.Background(), "from-context")
+	if id := CorrelationIDFromContext(ctx); id != "from-context" {
+		t.Errorf("Expected the context ID to win, got %q", id)
+	}
+	if id := CorrelationIDFromContext(WithCorrelationID(context.Background(), "")); id != "from-flag" {
+		t.Errorf("Expected an empty context ID to fall back to the process ID, got %q", id)
+	}
+}
+
+func TestValidateCorrelationID(t *testing.T) {
+	tests := []struct {
+		id      string
+		wantErr bool
+	}{
+		{"", false},
+		{"4bf92f3577b34da6a3ce929d0e0e4736", false},
+		{"job-42/step 3", false},
+		{"line\nbreak", true},
+		{"café", true},
+		{strings.Repeat("a", maxCorrelationIDLength+1), true},
+	}
+
+	for _, tt := range tests {
+		if err := ValidateCorrelationID(tt.id); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateCorrelationID(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+		}
+	}
+}
+
+func TestWithCorrelationID_Logger(t *testing.T) {
+	defer SetCorrelationID("")
+	SetCorrelationID("trace-123")
+
+	var buf bytes.Buffer
+	withCorrelationID(NewLoggerWithConfig(InfoLevel, "json", &buf)).Info("test message")
+
+	var entry LogEntry
+	if err := json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &entry); err != nil {
+		t.Fatalf("Failed to parse JSON log entry: %v", err)
+	}
+	if entry.Fields[CorrelationIDField] != "trace-123" {
+		t.Errorf("Expected %s=trace-123, got %v", CorrelationIDField, entry.Fields[CorrelationIDField])
+	}
+}
@@ -0,0 +1,155 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+)
+
+const (
+	// DefaultMaxSizeMB is the size a log file reaches before it is rotated
+	DefaultMaxSizeMB = 10
+	// DefaultMaxBackups is the number of rotated files kept alongside the log
+	DefaultMaxBackups = 3
+)
+
+// rotatingFile is an io.Writer that appends to a log file, renaming it to
+// path.1 (and shifting older backups up to path.N) once it exceeds maxSize.
+// Writes are serialized, so loggers in the client and rate limiter can share it.
+type rotatingFile struct {
+	mu      sync.Mutex
+	path    string
+	maxSize int64
+	backups int
+	file    *os.File
+	size    int64
+}
+
+var (
+	openFilesMu sync.Mutex
+	openFiles   = map[string]*rotatingFile{}
+)
+
+// NewFileLogger creates a logger that writes to the file at path, rotating it
+// by LINCTL_LOG_MAX_SIZE_MB (default 10) and keeping LINCTL_LOG_MAX_BACKUPS
+// (default 3) old files. The file is created with 0600 permissions since
+// entries may contain request metadata. Loggers for the same path share one
+// file handle.
+func NewFileLogger(path string, level LogLevel, format string) (Logger, error) {
+	writer, err := openRotatingFile(path, getEnvInt("LINCTL_LOG_MAX_SIZE_MB", DefaultMaxSizeMB), getEnvInt("LINCTL_LOG_MAX_BACKUPS", DefaultMaxBackups))
+	if err != nil {
+		return nil, err
+	}
+	return NewLoggerWithConfig(level, format, writer), nil
+}
+
+// openRotatingFile returns the shared writer for path, opening it on first use
+func openRotatingFile(path string, maxSizeMB, backups int) (*rotatingFile, error) {
+	path = filepath.Clean(path)
+
+	openFilesMu.Lock()
+	defer openFilesMu.Unlock()
+
+	if f, ok := openFiles[path]; ok {
+		return f, nil
+	}
+
+	if maxSizeMB <= 0 {
+		maxSizeMB = DefaultMaxSizeMB
+	}
+	if backups < 0 {
+		backups = 0
+	}
+
+	f := &rotatingFile{
+		path:    path,
+		maxSize: int64(maxSizeMB) * 1024 * 1024,
+		backups: backups,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	openFiles[path] = f
+	return f, nil
+}
+
+// Write appends p to the log, rotating first if p would take it past maxSize
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// open opens the log for appending, creating it owner-only
+func (f *rotatingFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0700); err != nil {
+		return fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// rotate shifts path.N-1 to path.N down to path to path.1, dropping the
+// oldest backup, then starts a new file. With no backups the log is truncated.
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file: %w", err)
+	}
+
+	if f.backups == 0 {
+		if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to truncate log file: %w", err)
+		}
+		return f.open()
+	}
+
+	for i := f.backups - 1; i >= 1; i-- {
+		if err := os.Rename(f.backupPath(i), f.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to rotate log file: %w", err)
+		}
+	}
+	if err := os.Rename(f.path, f.backupPath(1)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to rotate log file: %w", err)
+	}
+
+	return f.open()
+}
+
+// backupPath names the i-th most recent rotated file
+func (f *rotatingFile) backupPath(i int) string {
+	return f.path + "." + strconv.Itoa(i)
+}
+
+// getEnvInt reads a non-negative integer from the environment, or returns fallback
+func getEnvInt(key string, fallback int) int {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.Atoi(value); err == nil && n >= 0 {
+			return n
+		}
+	}
+	return fallback
+}
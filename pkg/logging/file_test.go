@@ -0,0 +1,179 @@
+package logging
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestNewFileLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "linctl.log")
+
+	logger, err := NewFileLogger(path, InfoLevel, "json")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	logger.Debug("hidden")
+	logger.Info("request sent", String("operation", "GetIssue"))
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Expected log file to be created: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("Expected 0600 permissions, got %o", perm)
+	}
+
+	data, _ := os.ReadFile(path)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("Expected only the info entry, got %q", data)
+	}
+
+	var entry LogEntry
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("Expected a JSON entry: %v", err)
+	}
+	if entry.Message != "request sent" || entry.Fields["operation"] != "GetIssue" {
+		t.Errorf("Unexpected entry: %+v", entry)
+	}
+}
+
+func TestNewFileLogger_SharesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "linctl.log")
+
+	first, err := openRotatingFile(path, 1, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, err := openRotatingFile(path, 1, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first != second {
+		t.Error("Expected loggers for the same path to share one writer")
+	}
+}
+
+func TestRotatingFile_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "linctl.log")
+
+	f, err := openRotatingFile(path, 1, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	f.maxSize = 100
+
+	line := strings.Repeat("x", 59) + "\n"
+	for i := 0; i < 5; i++ {
+		if _, err := f.Write([]byte(line)); err != nil {
+			t.Fatalf("Unexpected write error: %v", err)
+		}
+	}
+
+	// Each 60-byte line overflows a 100-byte file, so every write but the
+	// first rotates; only two backups are kept
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatalf("Expected %s to exist: %v", name, err)
+		}
+		if string(data) != line {
+			t.Errorf("Expected one line in %s, got %q", name, data)
+		}
+		info, _ := os.Stat(name)
+		if perm := info.Mode().Perm(); perm != 0600 {
+			t.Errorf("Expected 0600 permissions on %s, got %o", name, perm)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Error("Expected backups beyond LINCTL_LOG_MAX_BACKUPS to be dropped")
+	}
+}
+
+func TestRotatingFile_NoBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "linctl.log")
+
+	f, err := openRotatingFile(path, 1, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	f.maxSize = 10
+
+	f.Write([]byte("first line\n"))
+	f.Write([]byte("second\n"))
+
+	data, _ := os.ReadFile(path)
+	if string(data) != "second\n" {
+		t.Errorf("Expected the log to start over, got %q", data)
+	}
+	if _, err := os.Stat(path + ".1"); !os.IsNotExist(err) {
+		t.Error("Expected no backup files")
+	}
+}
+
+func TestRotatingFile_ConcurrentWrites(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "linctl.log")
+
+	client, err := NewFileLogger(path, InfoLevel, "text")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	limiter, err := NewFileLogger(path, InfoLevel, "text")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			client.Info("client request")
+		}()
+		go func() {
+			defer wg.Done()
+			limiter.Info("rate limit wait")
+		}()
+	}
+	wg.Wait()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer file.Close()
+
+	count := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasSuffix(line, "INFO client request") && !strings.HasSuffix(line, "INFO rate limit wait") {
+			t.Errorf("Expected whole entries, got interleaved line %q", line)
+		}
+		count++
+	}
+	if count != 100 {
+		t.Errorf("Expected 100 entries, got %d", count)
+	}
+}
+
+func TestNewLogger_LogFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "linctl.log")
+	t.Setenv("LINCTL_LOG_FILE", path)
+
+	NewLogger().Warn("written to file")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected LINCTL_LOG_FILE to be written: %v", err)
+	}
+	if !strings.Contains(string(data), "written to file") {
+		t.Errorf("Expected the entry in the log file, got %q", data)
+	}
+}
@@ -65,7 +65,9 @@ type StructuredLogger struct {
 	baseFields map[string]interface{}
 }
 
-// NewLogger creates a new structured logger
+// NewLogger creates a new structured logger configured by LINCTL_LOG_LEVEL,
+// LINCTL_LOG_FORMAT and LINCTL_LOG_FILE. Every line carries the correlation ID,
+// if one is set.
 func NewLogger() Logger {
 	level := InfoLevel
 	format := "text"
@@ -90,12 +92,21 @@ func NewLogger() Logger {
 		}
 	}
 
-	return &StructuredLogger{
+	// Send logs to a rotating file instead of stderr when configured
+	if path := os.Getenv("LINCTL_LOG_FILE"); path != "" {
+		logger, err := NewFileLogger(path, level, format)
+		if err == nil {
+			return withCorrelationID(logger)
+		}
+		fmt.Fprintf(os.Stderr, "Warning: LINCTL_LOG_FILE: %v; logging to stderr\n", err)
+	}
+
+	return withCorrelationID(&StructuredLogger{
 		level:      level,
 		format:     format,
 		writer:     os.Stderr,
 		baseFields: make(map[string]interface{}),
-	}
+	})
 }
 
 // NewLoggerWithConfig creates a logger with specific configuration
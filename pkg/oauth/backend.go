@@ -0,0 +1,137 @@
+package oauth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+// Token backend names selectable via LINCTL_TOKEN_BACKEND
+const (
+	BackendFile    = "file"
+	BackendKeyring = "keyring"
+)
+
+// Keyring entry used for the OAuth token
+const (
+	keyringService = "linctl"
+	keyringUser    = "oauth-token"
+)
+
+// tokenBackend persists raw token bytes
+// Read returns an error wrapping os.ErrNotExist when no token is stored
+type tokenBackend interface {
+	Name() string
+	Location() string
+	Read() ([]byte, error)
+	Write(data []byte) error
+	Delete() error
+}
+
+// fileBackend stores the token in a file readable only by the owner
+type fileBackend struct {
+	path string
+}
+
+func (b *fileBackend) Name() string {
+	return BackendFile
+}
+
+func (b *fileBackend) Location() string {
+	return b.path
+}
+
+func (b *fileBackend) Read() ([]byte, error) {
+	return os.ReadFile(b.path)
+}
+
+func (b *fileBackend) Write(data []byte) error {
+	// Ensure directory exists
+	dir := filepath.Dir(b.path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	// Write with secure permissions (readable only by owner)
+	return os.WriteFile(b.path, data, 0600)
+}
+
+func (b *fileBackend) Delete() error {
+	return os.Remove(b.path)
+}
+
+// keyringBackend stores the token in the OS keychain
+type keyringBackend struct {
+	service string
+	user    string
+}
+
+func (b *keyringBackend) Name() string {
+	return BackendKeyring
+}
+
+func (b *keyringBackend) Location() string {
+	return fmt.Sprintf("OS keyring (%s/%s)", b.service, b.user)
+}
+
+func (b *keyringBackend) Read() ([]byte, error) {
+	secret, err := keyring.Get(b.service, b.user)
+	if err != nil {
+		if errors.Is(err, keyring.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %v", os.ErrNotExist, err)
+		}
+		return nil, err
+	}
+	return []byte(secret), nil
+}
+
+func (b *keyringBackend) Write(data []byte) error {
+	return keyring.Set(b.service, b.user, string(data))
+}
+
+func (b *keyringBackend) Delete() error {
+	err := keyring.Delete(b.service, b.user)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("%w: %v", os.ErrNotExist, err)
+	}
+	return err
+}
+
+// keyringAvailable reports whether the OS keyring can be used
+func keyringAvailable(b *keyringBackend) bool {
+	_, err := keyring.Get(b.service, b.user)
+	return err == nil || errors.Is(err, keyring.ErrNotFound)
+}
+
+// profileKeyringUser returns the keyring entry for a profile's OAuth token
+func profileKeyringUser(profile string) string {
+	if profile == "" {
+		return keyringUser
+	}
+	return keyringUser + ":" + profile
+}
+
+// selectTokenBackend picks the backend requested via LINCTL_TOKEN_BACKEND,
+// falling back to the file backend when the keyring is unavailable
+func selectTokenBackend(filePath, user string) tokenBackend {
+	requested := strings.ToLower(strings.TrimSpace(os.Getenv("LINCTL_TOKEN_BACKEND")))
+
+	if requested == BackendKeyring {
+		backend := &keyringBackend{service: keyringService, user: user}
+		if keyringAvailable(backend) {
+			logDebug("Using keyring token backend")
+			return backend
+		}
+		logDebug("OS keyring unavailable, falling back to file token backend at %s", filePath)
+	} else if requested != "" && requested != BackendFile {
+		logDebug("Unknown LINCTL_TOKEN_BACKEND %q, using file token backend", requested)
+	} else {
+		logDebug("Using file token backend at %s", filePath)
+	}
+
+	return &fileBackend{path: filePath}
+}
@@ -0,0 +1,102 @@
+package oauth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/zalando/go-keyring"
+)
+
+func TestSelectTokenBackend(t *testing.T) {
+	keyring.MockInit()
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	tests := []struct {
+		name     string
+		env      string
+		expected string
+	}{
+		{"default", "", BackendFile},
+		{"explicit file", "file", BackendFile},
+		{"keyring", "keyring", BackendKeyring},
+		{"keyring uppercase", "KEYRING", BackendKeyring},
+		{"unknown", "vault", BackendFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LINCTL_TOKEN_BACKEND", tt.env)
+
+			backend := selectTokenBackend(path, keyringUser)
+			if backend.Name() != tt.expected {
+				t.Errorf("Expected backend %s, got %s", tt.expected, backend.Name())
+			}
+		})
+	}
+}
+
+func TestSelectTokenBackend_KeyringUnavailable(t *testing.T) {
+	keyring.MockInitWithError(keyring.ErrUnsupportedPlatform)
+	defer keyring.MockInit()
+
+	t.Setenv("LINCTL_TOKEN_BACKEND", "keyring")
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	backend := selectTokenBackend(path, keyringUser)
+	if backend.Name() != BackendFile {
+		t.Errorf("Expected fallback to file backend, got %s", backend.Name())
+	}
+	if backend.Location() != path {
+		t.Errorf("Expected file backend at %s, got %s", path, backend.Location())
+	}
+}
+
+func TestTokenStore_KeyringBackend(t *testing.T) {
+	keyring.MockInit()
+
+	store := &TokenStore{backend: &keyringBackend{service: keyringService, user: keyringUser}}
+
+	if _, err := store.LoadToken(); err == nil {
+		t.Error("Expected error loading from an empty keyring")
+	}
+
+	token := &TokenResponse{AccessToken: "keyring-token", TokenType: "Bearer", ExpiresIn: 3600}
+	if err := store.SaveToken(token); err != nil {
+		t.Fatalf("Failed to save token: %v", err)
+	}
+
+	loaded, err := store.LoadToken()
+	if err != nil {
+		t.Fatalf("Failed to load token: %v", err)
+	}
+	if loaded.AccessToken != "keyring-token" {
+		t.Errorf("Expected keyring-token, got %s", loaded.AccessToken)
+	}
+
+	if err := store.ClearToken(); err != nil {
+		t.Fatalf("Failed to clear token: %v", err)
+	}
+	if _, err := store.LoadToken(); err == nil {
+		t.Error("Expected error loading after clearing the keyring")
+	}
+
+	// Clearing an already empty keyring is not an error
+	if err := store.ClearToken(); err != nil {
+		t.Errorf("Expected no error clearing empty keyring, got %v", err)
+	}
+}
+
+func TestNewTokenStore_DefaultsToFileBackend(t *testing.T) {
+	// t.Setenv restores any value from the environment after the test
+	t.Setenv("LINCTL_TOKEN_BACKEND", "")
+	os.Unsetenv("LINCTL_TOKEN_BACKEND")
+
+	store, err := NewTokenStore()
+	if err != nil {
+		t.Fatalf("Failed to create token store: %v", err)
+	}
+	if store.Backend() != BackendFile {
+		t.Errorf("Expected file backend by default, got %s", store.Backend())
+	}
+}
@@ -3,14 +3,24 @@ package oauth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
 	"strings"
 	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/httpclient"
 )
 
+// RefreshTokenGrantType is the OAuth grant type for exchanging a refresh token
+const RefreshTokenGrantType = "refresh_token"
+
+// ErrReauthRequired is returned when the stored refresh token has been rejected
+// and the user must log in again
+var ErrReauthRequired = errors.New("stored OAuth refresh token is no longer valid\n💡 Re-authenticate with: linctl auth login --oauth")
+
 // logDebug logs debug messages if LINCTL_DEBUG environment variable is set
 func logDebug(format string, args ...interface{}) {
 	if os.Getenv("LINCTL_DEBUG") != "" {
@@ -18,6 +28,13 @@ func logDebug(format string, args ...interface{}) {
 	}
 }
 
+// extraHeadersFromEnv returns the LINCTL_EXTRA_HEADERS headers. A malformed
+// value is reported when the CLI starts, so it is ignored here.
+func extraHeadersFromEnv() http.Header {
+	headers, _ := httpclient.ExtraHeadersFromEnv()
+	return headers
+}
+
 // OAuthClient handles OAuth client credentials flow for Linear
 type OAuthClient struct {
 	clientID     string
@@ -26,12 +43,14 @@ type OAuthClient struct {
 	httpClient   *http.Client
 	tokenStore   *TokenStore
 	config       *Config
+	// extraHeaders come from LINCTL_EXTRA_HEADERS and are sent with every request
+	extraHeaders http.Header
 }
 
 // NewOAuthClient creates a new OAuth client for Linear
 func NewOAuthClient(clientID, clientSecret, baseURL string) *OAuthClient {
 	if baseURL == "" {
-		baseURL = "https://api.linear.app"
+		baseURL = BaseURL()
 	}
 
 	tokenStore, _ := NewTokenStore() // Ignore error, will handle gracefully
@@ -40,10 +59,9 @@ func NewOAuthClient(clientID, clientSecret, baseURL string) *OAuthClient {
 		clientID:     clientID,
 		clientSecret: clientSecret,
 		baseURL:      baseURL,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-		tokenStore: tokenStore,
+		httpClient:   httpclient.New(httpclient.ConfigFromEnv()),
+		tokenStore:   tokenStore,
+		extraHeaders: extraHeadersFromEnv(),
 	}
 }
 
@@ -62,11 +80,10 @@ func NewOAuthClientFromConfig(config *Config) (*OAuthClient, error) {
 		clientID:     config.ClientID,
 		clientSecret: config.ClientSecret,
 		baseURL:      config.BaseURL,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-		tokenStore: tokenStore,
-		config:     config,
+		httpClient:   httpclient.New(httpclient.ConfigFromEnv()),
+		tokenStore:   tokenStore,
+		config:       config,
+		extraHeaders: extraHeadersFromEnv(),
 	}, nil
 }
 
@@ -91,6 +108,7 @@ func (c *OAuthClient) GetAccessToken(ctx context.Context, scopes []string) (*Tok
 	// Set headers
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.SetBasicAuth(c.clientID, c.clientSecret)
+	httpclient.SetExtraHeaders(req, c.extraHeaders)
 
 	// Make request
 	resp, err := c.httpClient.Do(req)
@@ -98,6 +116,7 @@ func (c *OAuthClient) GetAccessToken(ctx context.Context, scopes []string) (*Tok
 		return nil, fmt.Errorf("failed to request access token: %w", err)
 	}
 	defer resp.Body.Close()
+	warnClockSkew(resp)
 
 	// Check response status
 	if resp.StatusCode != http.StatusOK {
@@ -154,6 +173,7 @@ func (c *OAuthClient) ValidateToken(ctx context.Context, accessToken string) err
 	// Set headers
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+accessToken)
+	httpclient.SetExtraHeaders(req, c.extraHeaders)
 
 	// Make request
 	resp, err := c.httpClient.Do(req)
@@ -205,6 +225,7 @@ func (c *OAuthClient) GetValidToken(ctx context.Context, scopes []string) (*Toke
 }
 
 // GetValidTokenWithRefresh returns a valid access token with enhanced refresh logic and retry
+// A stored refresh token is preferred over a new client credentials grant
 func (c *OAuthClient) GetValidTokenWithRefresh(ctx context.Context, scopes []string) (*TokenResponse, error) {
 	if c.tokenStore == nil {
 		// Fallback to direct token request if no token store
@@ -218,6 +239,18 @@ func (c *OAuthClient) GetValidTokenWithRefresh(ctx context.Context, scopes []str
 		return storedToken.ToTokenResponse(), nil
 	}
 
+	// Rotate the refresh token if one was issued with the stored token
+	if storedToken, err := c.tokenStore.LoadToken(); err == nil && storedToken.RefreshToken != "" {
+		newToken, err := c.RefreshWithToken(ctx)
+		if err == nil {
+			return newToken, nil
+		}
+		if errors.Is(err, ErrReauthRequired) {
+			return nil, err
+		}
+		logDebug("Warning: refresh token grant failed, falling back to client credentials: %v", err)
+	}
+
 	// Token is missing, expired, or will expire soon - get a new one with retry logic
 	const maxRetries = 3
 	var lastErr error
@@ -244,6 +277,122 @@ func (c *OAuthClient) GetValidTokenWithRefresh(ctx context.Context, scopes []str
 	return nil, fmt.Errorf("failed to get new access token after %d attempts: %w", maxRetries, lastErr)
 }
 
+// RefreshWithToken exchanges the stored refresh token for a new access token
+// Rotated refresh tokens are saved back to the token store. When no refresh
+// token is stored it falls back to the client credentials grant. If the
+// refresh token is rejected the stored token is cleared and ErrReauthRequired is returned.
+func (c *OAuthClient) RefreshWithToken(ctx context.Context) (*TokenResponse, error) {
+	if c.tokenStore == nil {
+		return c.GetAccessToken(ctx, c.refreshScopes(nil))
+	}
+
+	storedToken, err := c.tokenStore.LoadToken()
+	if err != nil || storedToken.RefreshToken == "" {
+		newToken, err := c.GetAccessToken(ctx, c.refreshScopes(storedToken))
+		if err != nil {
+			return nil, err
+		}
+		if saveErr := c.tokenStore.SaveToken(newToken); saveErr != nil {
+			logDebug("Warning: failed to save OAuth token to store: %v", saveErr)
+		}
+		return newToken, nil
+	}
+
+	newToken, errorCode, err := c.requestRefreshToken(ctx, storedToken.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	switch errorCode {
+	case "":
+	case "invalid_grant":
+		if clearErr := c.tokenStore.ClearToken(); clearErr != nil {
+			logDebug("Warning: failed to clear rejected OAuth token: %v", clearErr)
+		}
+		return nil, ErrReauthRequired
+	default:
+		return nil, fmt.Errorf("refresh token grant failed: %s", errorCode)
+	}
+
+	// Servers that do not rotate refresh tokens omit them from the response
+	if newToken.RefreshToken == "" {
+		newToken.RefreshToken = storedToken.RefreshToken
+	}
+
+	// Keep the original grant type so device flow logins are still recognised
+	if saveErr := c.tokenStore.SaveTokenWithGrantType(newToken, storedToken.GrantType); saveErr != nil {
+		logDebug("Warning: failed to save rotated OAuth token: %v", saveErr)
+	}
+
+	return newToken, nil
+}
+
+// requestRefreshToken makes a single refresh token request
+// It returns the OAuth error code when the server rejects the request
+func (c *OAuthClient) requestRefreshToken(ctx context.Context, refreshToken string) (*TokenResponse, string, error) {
+	tokenURL := c.baseURL + "/oauth/token"
+
+	data := url.Values{
+		"grant_type":    {RefreshTokenGrantType},
+		"refresh_token": {refreshToken},
+		"client_id":     {c.clientID},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create token request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// Public clients such as device flow logins have no secret
+	if c.clientSecret != "" {
+		req.SetBasicAuth(c.clientID, c.clientSecret)
+	}
+
+	httpclient.SetExtraHeaders(req, c.extraHeaders)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to refresh access token: %w", err)
+	}
+	defer resp.Body.Close()
+	warnClockSkew(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		var errorResp oauthErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errorResp); err == nil && errorResp.Error != "" {
+			return nil, errorResp.Error, nil
+		}
+		return nil, "", fmt.Errorf("OAuth request failed with status: %d", resp.StatusCode)
+	}
+
+	var tokenResp TokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return nil, "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	if tokenResp.AccessToken == "" {
+		return nil, "", fmt.Errorf("received empty access token")
+	}
+
+	if tokenResp.TokenType == "" {
+		tokenResp.TokenType = "Bearer"
+	}
+
+	return &tokenResp, "", nil
+}
+
+// refreshScopes returns the scopes to request when falling back to client credentials
+func (c *OAuthClient) refreshScopes(storedToken *StoredToken) []string {
+	if c.config != nil && len(c.config.Scopes) > 0 {
+		return c.config.Scopes
+	}
+	if storedToken != nil && storedToken.Scope != "" {
+		return strings.Fields(storedToken.Scope)
+	}
+	return DefaultScopes()
+}
+
 // RefreshToken forces a token refresh and saves the new token with retry logic
 func (c *OAuthClient) RefreshToken(ctx context.Context, scopes []string) (*TokenResponse, error) {
 	const maxRetries = 3
@@ -298,6 +447,20 @@ func (c *OAuthClient) GetStoredTokenInfo() map[string]interface{} {
 	return storedToken.GetTokenInfo()
 }
 
+// StoredTokenScopes returns the scopes granted to the stored token
+func (c *OAuthClient) StoredTokenScopes() ([]string, error) {
+	if c.tokenStore == nil {
+		return nil, fmt.Errorf("no token store available")
+	}
+
+	storedToken, err := c.tokenStore.LoadToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return storedToken.Scopes(), nil
+}
+
 // ClearStoredToken removes any stored token
 func (c *OAuthClient) ClearStoredToken() error {
 	if c.tokenStore == nil {
@@ -3,6 +3,7 @@ package oauth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
@@ -269,3 +270,152 @@ func TestAuthenticationPersistenceAcrossTokenExpiry(t *testing.T) {
 
 	t.Log("✅ Authentication persistence across token expiry test passed")
 }
+
+// TestRefreshWithToken verifies the refresh token grant, rotation and invalid_grant handling
+func TestRefreshWithToken(t *testing.T) {
+	newServer := func(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *OAuthClient {
+		t.Helper()
+		server := httptest.NewServer(http.HandlerFunc(handler))
+		t.Cleanup(server.Close)
+
+		client := NewOAuthClient("test-client-id", "test-client-secret", server.URL)
+		client.tokenStore = NewTokenStoreWithPath(filepath.Join(t.TempDir(), "test-token.json"))
+		return client
+	}
+
+	expiredToken := func(refreshToken string) *TokenResponse {
+		return &TokenResponse{
+			AccessToken:  "old-token",
+			TokenType:    "Bearer",
+			ExpiresIn:    -1,
+			Scope:        "read write",
+			RefreshToken: refreshToken,
+		}
+	}
+
+	t.Run("rotates and saves refresh token", func(t *testing.T) {
+		client := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			if r.Form.Get("grant_type") != RefreshTokenGrantType {
+				t.Errorf("Expected refresh_token grant, got %q", r.Form.Get("grant_type"))
+			}
+			if r.Form.Get("refresh_token") != "refresh-1" {
+				t.Errorf("Expected stored refresh token, got %q", r.Form.Get("refresh_token"))
+			}
+			json.NewEncoder(w).Encode(TokenResponse{
+				AccessToken:  "new-token",
+				ExpiresIn:    3600,
+				Scope:        "read write",
+				RefreshToken: "refresh-2",
+			})
+		})
+		if err := client.tokenStore.SaveTokenWithGrantType(expiredToken("refresh-1"), DeviceCodeGrantType); err != nil {
+			t.Fatalf("Failed to save token: %v", err)
+		}
+
+		token, err := client.RefreshWithToken(context.Background())
+		if err != nil {
+			t.Fatalf("RefreshWithToken failed: %v", err)
+		}
+		if token.AccessToken != "new-token" {
+			t.Errorf("Expected new-token, got %s", token.AccessToken)
+		}
+
+		stored, err := client.tokenStore.LoadToken()
+		if err != nil {
+			t.Fatalf("Failed to load token: %v", err)
+		}
+		if stored.RefreshToken != "refresh-2" {
+			t.Errorf("Expected rotated refresh token to be saved, got %q", stored.RefreshToken)
+		}
+		if stored.GrantType != DeviceCodeGrantType {
+			t.Errorf("Expected grant type to be preserved, got %q", stored.GrantType)
+		}
+	})
+
+	t.Run("keeps refresh token when not rotated", func(t *testing.T) {
+		client := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(TokenResponse{AccessToken: "new-token", ExpiresIn: 3600})
+		})
+		client.tokenStore.SaveToken(expiredToken("refresh-1"))
+
+		if _, err := client.RefreshWithToken(context.Background()); err != nil {
+			t.Fatalf("RefreshWithToken failed: %v", err)
+		}
+
+		stored, _ := client.tokenStore.LoadToken()
+		if stored.RefreshToken != "refresh-1" {
+			t.Errorf("Expected existing refresh token to be kept, got %q", stored.RefreshToken)
+		}
+	})
+
+	t.Run("invalid_grant clears stored token", func(t *testing.T) {
+		client := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+		})
+		client.tokenStore.SaveToken(expiredToken("revoked"))
+
+		_, err := client.RefreshWithToken(context.Background())
+		if !errors.Is(err, ErrReauthRequired) {
+			t.Fatalf("Expected ErrReauthRequired, got %v", err)
+		}
+		if _, err := client.tokenStore.LoadToken(); err == nil {
+			t.Error("Expected stored token to be cleared")
+		}
+	})
+
+	t.Run("falls back to client credentials", func(t *testing.T) {
+		client := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			if r.Form.Get("grant_type") != "client_credentials" {
+				t.Errorf("Expected client_credentials grant, got %q", r.Form.Get("grant_type"))
+			}
+			if r.Form.Get("scope") != "read write" {
+				t.Errorf("Expected stored scopes, got %q", r.Form.Get("scope"))
+			}
+			json.NewEncoder(w).Encode(TokenResponse{AccessToken: "cc-token", ExpiresIn: 3600})
+		})
+		client.tokenStore.SaveToken(expiredToken(""))
+
+		token, err := client.RefreshWithToken(context.Background())
+		if err != nil {
+			t.Fatalf("RefreshWithToken failed: %v", err)
+		}
+		if token.AccessToken != "cc-token" {
+			t.Errorf("Expected cc-token, got %s", token.AccessToken)
+		}
+	})
+
+	t.Run("GetValidTokenWithRefresh prefers refresh token", func(t *testing.T) {
+		client := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			if r.Form.Get("grant_type") != RefreshTokenGrantType {
+				t.Errorf("Expected refresh_token grant, got %q", r.Form.Get("grant_type"))
+			}
+			json.NewEncoder(w).Encode(TokenResponse{AccessToken: "new-token", ExpiresIn: 3600, RefreshToken: "refresh-2"})
+		})
+		client.tokenStore.SaveToken(expiredToken("refresh-1"))
+
+		token, err := client.GetValidTokenWithRefresh(context.Background(), []string{"read"})
+		if err != nil {
+			t.Fatalf("GetValidTokenWithRefresh failed: %v", err)
+		}
+		if token.AccessToken != "new-token" {
+			t.Errorf("Expected new-token, got %s", token.AccessToken)
+		}
+	})
+
+	t.Run("GetValidTokenWithRefresh surfaces re-auth", func(t *testing.T) {
+		client := newServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+		})
+		client.tokenStore.SaveToken(expiredToken("revoked"))
+
+		_, err := client.GetValidTokenWithRefresh(context.Background(), []string{"read"})
+		if !errors.Is(err, ErrReauthRequired) {
+			t.Fatalf("Expected ErrReauthRequired, got %v", err)
+		}
+	})
+}
@@ -262,6 +262,29 @@ func TestOAuthClient_ValidateToken_Success(t *testing.T) {
 	}
 }
 
+func TestOAuthClient_ExtraHeaders(t *testing.T) {
+	t.Setenv("LINCTL_EXTRA_HEADERS", "X-Team: platform")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Team"); got != "platform" {
+			t.Errorf("Expected X-Team platform, got %q", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Expected Bearer test-token authorization, got %s", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": {"viewer": {"id": "user-123", "name": "Test User"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewOAuthClient("test-client-id", "test-client-secret", server.URL)
+
+	if err := client.ValidateToken(context.Background(), "test-token"); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
 func TestOAuthClient_ValidateToken_Unauthorized(t *testing.T) {
 	// Mock server that returns 401 Unauthorized
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
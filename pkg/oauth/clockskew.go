@@ -0,0 +1,98 @@
+package oauth
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// ClockSkewEnvVar is the clock skew tolerated in token expiry checks, as a Go duration
+	ClockSkewEnvVar = "LINCTL_CLOCK_SKEW"
+	// CheckClockSkewEnvVar compares the local clock with the Date header of OAuth responses
+	CheckClockSkewEnvVar = "LINCTL_CHECK_CLOCK_SKEW"
+
+	// DefaultClockSkew is the tolerance used when LINCTL_CLOCK_SKEW is unset
+	DefaultClockSkew = 30 * time.Second
+)
+
+// ClockSkewError reports a local clock that differs from the server's by more
+// than the tolerated skew. Skew is positive when the local clock is ahead.
+type ClockSkewError struct {
+	Skew      time.Duration
+	Tolerance time.Duration
+}
+
+// Error implements error
+func (e *ClockSkewError) Error() string {
+	direction := "ahead of"
+	skew := e.Skew
+	if skew < 0 {
+		direction = "behind"
+		skew = -skew
+	}
+	return fmt.Sprintf("clock skew: the local clock is %s %s the server (tolerance %s, %s); token expiry checks may be wrong, sync the system clock",
+		skew.Round(time.Second), direction, e.Tolerance, ClockSkewEnvVar)
+}
+
+// ClockSkewFromEnv parses LINCTL_CLOCK_SKEW, returning DefaultClockSkew when it is unset
+func ClockSkewFromEnv() (time.Duration, error) {
+	value := os.Getenv(ClockSkewEnvVar)
+	if value == "" {
+		return DefaultClockSkew, nil
+	}
+	skew, err := time.ParseDuration(value)
+	if err != nil || skew < 0 {
+		return 0, fmt.Errorf("invalid %s=%q: expected a duration such as 30s", ClockSkewEnvVar, value)
+	}
+	return skew, nil
+}
+
+// clockSkewTolerance returns LINCTL_CLOCK_SKEW. A malformed value is reported
+// when the CLI starts, so the default is used here.
+func clockSkewTolerance() time.Duration {
+	skew, err := ClockSkewFromEnv()
+	if err != nil {
+		return DefaultClockSkew
+	}
+	return skew
+}
+
+// clockSkewCheckEnabled reports whether LINCTL_CHECK_CLOCK_SKEW is set
+func clockSkewCheckEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(CheckClockSkewEnvVar))
+	return enabled
+}
+
+// CheckClockSkew compares now with a server Date header, returning a
+// *ClockSkewError when they differ by more than tolerance. A missing or
+// malformed header is not an error.
+func CheckClockSkew(serverDate string, now time.Time, tolerance time.Duration) error {
+	if serverDate == "" {
+		return nil
+	}
+	server, err := http.ParseTime(serverDate)
+	if err != nil {
+		return nil
+	}
+
+	// The Date header has one second resolution
+	skew := now.Truncate(time.Second).Sub(server)
+	if skew > tolerance || -skew > tolerance {
+		return &ClockSkewError{Skew: skew, Tolerance: tolerance}
+	}
+	return nil
+}
+
+// warnClockSkew prints a warning when LINCTL_CHECK_CLOCK_SKEW is set and the
+// response's Date header shows more skew than LINCTL_CLOCK_SKEW tolerates
+func warnClockSkew(resp *http.Response) {
+	if !clockSkewCheckEnabled() {
+		return
+	}
+	if err := CheckClockSkew(resp.Header.Get("Date"), time.Now(), clockSkewTolerance()); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
@@ -0,0 +1,113 @@
+package oauth
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestClockSkewFromEnv(t *testing.T) {
+	tests := []struct {
+		value     string
+		expected  time.Duration
+		expectErr bool
+	}{
+		{value: "", expected: DefaultClockSkew},
+		{value: "90s", expected: 90 * time.Second},
+		{value: "0", expected: 0},
+		{value: "-5s", expectErr: true},
+		{value: "soon", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv(ClockSkewEnvVar, tt.value)
+			skew, err := ClockSkewFromEnv()
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Expected an error for %q", tt.value)
+				}
+				return
+			}
+			if err != nil || skew != tt.expected {
+				t.Errorf("ClockSkewFromEnv() = %v, %v, want %v", skew, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetValidTokenWithBuffer_ClockSkew(t *testing.T) {
+	store := NewTokenStoreWithPath(filepath.Join(t.TempDir(), "token.json"))
+
+	// Expires in 100s: inside a 2-minute buffer, but within it once 30s of skew is tolerated
+	writeToken(t, store, 100*time.Second)
+
+	t.Setenv(ClockSkewEnvVar, "")
+	if _, err := store.GetValidTokenWithBuffer(2 * time.Minute); err != nil {
+		t.Errorf("Expected the default skew tolerance to keep the token: %v", err)
+	}
+
+	t.Setenv(ClockSkewEnvVar, "0")
+	if _, err := store.GetValidTokenWithBuffer(2 * time.Minute); err == nil {
+		t.Error("Expected the token to expire within the buffer without skew tolerance")
+	}
+
+	// The tolerance never extends a token past its expiry
+	writeToken(t, store, -time.Second)
+	t.Setenv(ClockSkewEnvVar, "10m")
+	if _, err := store.GetValidTokenWithBuffer(2 * time.Minute); err == nil {
+		t.Error("Expected an expired token to stay expired")
+	}
+}
+
+func TestCheckClockSkew(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		date     string
+		expected time.Duration
+	}{
+		{name: "in sync", date: now.Format(http.TimeFormat)},
+		{name: "within tolerance", date: now.Add(20 * time.Second).Format(http.TimeFormat)},
+		{name: "local clock ahead", date: now.Add(-2 * time.Minute).Format(http.TimeFormat), expected: 2 * time.Minute},
+		{name: "local clock behind", date: now.Add(5 * time.Minute).Format(http.TimeFormat), expected: -5 * time.Minute},
+		{name: "missing header"},
+		{name: "malformed header", date: "yesterday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckClockSkew(tt.date, now, 30*time.Second)
+			if tt.expected == 0 {
+				if err != nil {
+					t.Errorf("Expected no skew, got %v", err)
+				}
+				return
+			}
+
+			var skewErr *ClockSkewError
+			if !errors.As(err, &skewErr) {
+				t.Fatalf("Expected a ClockSkewError, got %v", err)
+			}
+			if skewErr.Skew != tt.expected {
+				t.Errorf("Expected skew %v, got %v", tt.expected, skewErr.Skew)
+			}
+		})
+	}
+}
+
+func writeToken(t *testing.T, store *TokenStore, expiresIn time.Duration) {
+	t.Helper()
+	now := time.Now()
+	if err := store.writeStoredToken(&StoredToken{
+		AccessToken: "skew-test-token",
+		TokenType:   "Bearer",
+		ExpiresAt:   now.Add(expiresIn),
+		CreatedAt:   now,
+	}); err != nil {
+		t.Fatalf("Failed to save token: %v", err)
+	}
+}
@@ -20,23 +20,47 @@ type ActorConfig struct {
 	DefaultAvatarURL string `json:"default_avatar_url"`
 }
 
+// TeamConfig holds the team used when a command's --team flag is omitted
+type TeamConfig struct {
+	DefaultTeam string `json:"default_team"`
+}
+
 // DefaultScopes returns the default OAuth scopes for Linear
 func DefaultScopes() []string {
 	return []string{"read", "write", "issues:create", "comments:create"}
 }
 
+// DefaultBaseURL is the OAuth base URL when LINEAR_BASE_URL is unset
+const DefaultBaseURL = "https://api.linear.app"
+
+// baseURLOverride wins over LINEAR_BASE_URL, see SetBaseURL
+var baseURLOverride string
+
+// SetBaseURL overrides LINEAR_BASE_URL for this process (the --api-url flag).
+// An empty URL removes the override.
+func SetBaseURL(url string) {
+	baseURLOverride = strings.TrimRight(url, "/")
+}
+
+// BaseURL returns the OAuth base URL: the --api-url override, then
+// LINEAR_BASE_URL, then DefaultBaseURL
+func BaseURL() string {
+	if baseURLOverride != "" {
+		return baseURLOverride
+	}
+	if baseURL := os.Getenv("LINEAR_BASE_URL"); baseURL != "" {
+		return baseURL
+	}
+	return DefaultBaseURL
+}
+
 // LoadFromEnvironment loads OAuth configuration from environment variables
 func LoadFromEnvironment() (*Config, error) {
 	clientID := os.Getenv("LINEAR_CLIENT_ID")
 	clientSecret := os.Getenv("LINEAR_CLIENT_SECRET")
-	baseURL := os.Getenv("LINEAR_BASE_URL")
+	baseURL := BaseURL()
 	scopesEnv := os.Getenv("LINEAR_SCOPES")
 
-	// Set defaults
-	if baseURL == "" {
-		baseURL = "https://api.linear.app"
-	}
-
 	var scopes []string
 	if scopesEnv != "" {
 		// Split scopes by comma and trim whitespace
@@ -97,6 +121,31 @@ func (ac *ActorConfig) GetAvatarURL(provided string) string {
 	return ""
 }
 
+// LoadTeamFromEnvironment loads the default team from LINEAR_DEFAULT_TEAM
+func LoadTeamFromEnvironment() *TeamConfig {
+	return &TeamConfig{
+		DefaultTeam: strings.TrimSpace(os.Getenv("LINEAR_DEFAULT_TEAM")),
+	}
+}
+
+// IsConfigured returns true if a default team is available
+func (tc *TeamConfig) IsConfigured() bool {
+	return tc != nil && tc.DefaultTeam != ""
+}
+
+// GetTeam returns the team key, using the provided value or falling back to
+// the default. It returns an error when neither is set.
+func (tc *TeamConfig) GetTeam(provided string) (string, error) {
+	provided = strings.TrimSpace(provided)
+	if provided != "" {
+		return provided, nil
+	}
+	if tc.IsConfigured() {
+		return tc.DefaultTeam, nil
+	}
+	return "", fmt.Errorf("team is required (--team or LINEAR_DEFAULT_TEAM)")
+}
+
 // Validate checks if the configuration is valid
 func (c *Config) Validate() error {
 	if c == nil {
@@ -140,20 +189,56 @@ func (c *Config) GetScopesString() string {
 	return strings.Join(c.Scopes, " ")
 }
 
-// HasScope checks if a specific scope is included
+// HasScope checks if a specific scope is included. The broad write scope also
+// covers the narrower issues:create and comments:create scopes.
 func (c *Config) HasScope(scope string) bool {
 	if c == nil {
 		return false
 	}
 
 	for _, s := range c.Scopes {
-		if s == scope {
+		if s == scope || (s == "write" && writeImplies[scope]) {
 			return true
 		}
 	}
 	return false
 }
 
+// writeImplies lists the scopes granted by the write scope
+var writeImplies = map[string]bool{
+	"issues:create":   true,
+	"comments:create": true,
+}
+
+// operationScopes maps audit operation names to the narrowest OAuth scope that
+// allows them; mutations not listed here need write
+var operationScopes = map[string]string{
+	"issue.create":   "issues:create",
+	"comment.create": "comments:create",
+}
+
+// RequiresScope returns the OAuth scope needed for op, an operation name such
+// as "issue.create" as recorded in the audit log. Read-only operations (and
+// the auth commands, which manage the token itself) need no extra scope and
+// return "".
+func (c *Config) RequiresScope(op string) string {
+	if scope, ok := operationScopes[op]; ok {
+		return scope
+	}
+	if op == "" || strings.HasPrefix(op, "auth.") {
+		return ""
+	}
+	return "write"
+}
+
+// ParseScopes splits a token's scope string, which Linear returns space- or
+// comma-separated, into individual scopes
+func ParseScopes(scope string) []string {
+	return strings.FieldsFunc(scope, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+}
+
 // GetEnvironmentStatus returns information about environment variable configuration
 func GetEnvironmentStatus() map[string]interface{} {
 	status := map[string]interface{}{
@@ -163,6 +248,7 @@ func GetEnvironmentStatus() map[string]interface{} {
 		"LINEAR_SCOPES":             os.Getenv("LINEAR_SCOPES"),
 		"LINEAR_DEFAULT_ACTOR":      os.Getenv("LINEAR_DEFAULT_ACTOR"),
 		"LINEAR_DEFAULT_AVATAR_URL": os.Getenv("LINEAR_DEFAULT_AVATAR_URL"),
+		"LINEAR_DEFAULT_TEAM":       os.Getenv("LINEAR_DEFAULT_TEAM"),
 	}
 
 	// Don't expose actual values for security
@@ -194,6 +280,10 @@ func GetEnvironmentStatus() map[string]interface{} {
 		status["LINEAR_DEFAULT_AVATAR_URL"] = "not set"
 	}
 
+	if status["LINEAR_DEFAULT_TEAM"].(string) == "" {
+		status["LINEAR_DEFAULT_TEAM"] = "not set"
+	}
+
 	return status
 }
 
@@ -219,10 +309,12 @@ func ValidateEnvironmentForAgent() error {
 func GetAgentConfiguration() map[string]interface{} {
 	config, _ := LoadFromEnvironment()
 	actorConfig := LoadActorFromEnvironment()
+	teamConfig := LoadTeamFromEnvironment()
 
 	result := map[string]interface{}{
 		"oauth_configured":   config != nil && config.IsComplete(),
 		"actor_configured":   actorConfig.IsConfigured(),
+		"team_configured":    teamConfig.IsConfigured(),
 		"environment_status": GetEnvironmentStatus(),
 	}
 
@@ -236,5 +328,9 @@ func GetAgentConfiguration() map[string]interface{} {
 		result["default_avatar_url"] = actorConfig.DefaultAvatarURL
 	}
 
+	if teamConfig.IsConfigured() {
+		result["default_team"] = teamConfig.DefaultTeam
+	}
+
 	return result
 }
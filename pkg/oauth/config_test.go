@@ -2,6 +2,7 @@ package oauth
 
 import (
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -203,6 +204,39 @@ func TestEnvironmentLoading(t *testing.T) {
 	}
 }
 
+func TestBaseURLOverride(t *testing.T) {
+	t.Cleanup(func() { SetBaseURL("") })
+
+	t.Setenv("LINEAR_BASE_URL", "")
+	if got := BaseURL(); got != DefaultBaseURL {
+		t.Errorf("Expected %s by default, got %s", DefaultBaseURL, got)
+	}
+
+	t.Setenv("LINEAR_BASE_URL", "https://custom.linear.app")
+	if got := BaseURL(); got != "https://custom.linear.app" {
+		t.Errorf("Expected LINEAR_BASE_URL, got %s", got)
+	}
+
+	SetBaseURL("http://localhost:8080/")
+	if got := BaseURL(); got != "http://localhost:8080" {
+		t.Errorf("Expected the override to win over LINEAR_BASE_URL, got %s", got)
+	}
+	t.Setenv("LINEAR_CLIENT_ID", "test-client-id")
+	t.Setenv("LINEAR_CLIENT_SECRET", "test-client-secret")
+	config, err := LoadFromEnvironment()
+	if err != nil {
+		t.Fatalf("LoadFromEnvironment failed: %v", err)
+	}
+	if config.BaseURL != "http://localhost:8080" {
+		t.Errorf("Expected the config to use the override, got %s", config.BaseURL)
+	}
+
+	SetBaseURL("")
+	if got := BaseURL(); got != "https://custom.linear.app" {
+		t.Errorf("Expected LINEAR_BASE_URL after clearing the override, got %s", got)
+	}
+}
+
 func TestDefaultScopes(t *testing.T) {
 	scopes := DefaultScopes()
 	expectedScopes := []string{"read", "write", "issues:create", "comments:create"}
@@ -319,6 +353,48 @@ func TestActorConfig(t *testing.T) {
 	}
 }
 
+func TestTeamConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		envTeam      string
+		provided     string
+		expectedTeam string
+		isConfigured bool
+		expectErr    bool
+	}{
+		{name: "flag wins over env", envTeam: "ENG", provided: "DESIGN", expectedTeam: "DESIGN", isConfigured: true},
+		{name: "env fallback", envTeam: "ENG", expectedTeam: "ENG", isConfigured: true},
+		{name: "env is trimmed", envTeam: "  ENG ", expectedTeam: "ENG", isConfigured: true},
+		{name: "flag without env", provided: "DESIGN", expectedTeam: "DESIGN"},
+		{name: "neither set", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("LINEAR_DEFAULT_TEAM", tt.envTeam)
+
+			config := LoadTeamFromEnvironment()
+			if config.IsConfigured() != tt.isConfigured {
+				t.Errorf("Expected IsConfigured() to return %v, got %v", tt.isConfigured, config.IsConfigured())
+			}
+
+			team, err := config.GetTeam(tt.provided)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("Expected an error, got team %q", team)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if team != tt.expectedTeam {
+				t.Errorf("Expected team '%s', got '%s'", tt.expectedTeam, team)
+			}
+		})
+	}
+}
+
 func TestGetEnvironmentStatusWithActor(t *testing.T) {
 	// Save original environment
 	originalClientID := os.Getenv("LINEAR_CLIENT_ID")
@@ -360,3 +436,55 @@ func TestGetEnvironmentStatusWithActor(t *testing.T) {
 		t.Errorf("Expected LINEAR_DEFAULT_AVATAR_URL to be 'https://test.com/avatar.png', got '%s'", status["LINEAR_DEFAULT_AVATAR_URL"])
 	}
 }
+
+func TestConfig_RequiresScope(t *testing.T) {
+	config := &Config{}
+	tests := map[string]string{
+		"issue.create":    "issues:create",
+		"comment.create":  "comments:create",
+		"issue.update":    "write",
+		"label.create":    "write",
+		"issue.archive":   "write",
+		"issue.delete":    "write",
+		"issue.link":      "write",
+		"issue.attach":    "write",
+		"issue.move":      "write",
+		"comment.delete":  "write",
+		"comment.unreact": "write",
+		"auth.login":      "",
+		"":                "",
+	}
+
+	for op, expected := range tests {
+		if got := config.RequiresScope(op); got != expected {
+			t.Errorf("RequiresScope(%q) = %q, expected %q", op, got, expected)
+		}
+	}
+}
+
+func TestConfig_HasScopeWriteImplies(t *testing.T) {
+	config := &Config{Scopes: []string{"read", "write"}}
+	if !config.HasScope("issues:create") || !config.HasScope("comments:create") {
+		t.Error("write scope should cover issues:create and comments:create")
+	}
+	if config.HasScope("admin") {
+		t.Error("write scope should not cover admin")
+	}
+
+	narrow := &Config{Scopes: []string{"read", "issues:create"}}
+	if narrow.HasScope("write") {
+		t.Error("issues:create should not cover write")
+	}
+}
+
+func TestParseScopes(t *testing.T) {
+	for _, scope := range []string{"read write issues:create", "read,write,issues:create", "read, write  issues:create"} {
+		got := ParseScopes(scope)
+		if strings.Join(got, " ") != "read write issues:create" {
+			t.Errorf("ParseScopes(%q) = %v", scope, got)
+		}
+	}
+	if got := ParseScopes(""); len(got) != 0 {
+		t.Errorf("ParseScopes(\"\") = %v, expected none", got)
+	}
+}
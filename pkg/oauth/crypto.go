@@ -0,0 +1,153 @@
+package oauth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// encryptedTokenVersion identifies the on-disk format of encrypted tokens
+const encryptedTokenVersion = 1
+
+// scrypt parameters for deriving the token encryption key
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltSize     = 16
+)
+
+// encryptedTokenFile is the on-disk envelope for an encrypted token
+type encryptedTokenFile struct {
+	Version    int    `json:"version"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// machineSecret returns a machine-specific passphrase used when LINCTL_TOKEN_KEY is not set
+func machineSecret() string {
+	parts := []string{"linctl"}
+
+	for _, path := range []string{"/etc/machine-id", "/var/lib/dbus/machine-id"} {
+		if data, err := os.ReadFile(path); err == nil {
+			parts = append(parts, strings.TrimSpace(string(data)))
+			break
+		}
+	}
+
+	if hostname, err := os.Hostname(); err == nil {
+		parts = append(parts, hostname)
+	}
+
+	if homeDir, err := os.UserHomeDir(); err == nil {
+		parts = append(parts, homeDir)
+	}
+
+	return strings.Join(parts, ":")
+}
+
+// deriveKey derives an AES-256 key from a passphrase and salt using scrypt
+func deriveKey(passphrase string, salt []byte) ([]byte, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive token encryption key: %w", err)
+	}
+	return key, nil
+}
+
+// encryptTokenData encrypts plaintext token data with AES-GCM
+func encryptTokenData(plaintext []byte, passphrase string) ([]byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key, err := deriveKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	envelope := encryptedTokenFile{
+		Version:    encryptedTokenVersion,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plaintext, nil),
+	}
+
+	return json.MarshalIndent(envelope, "", "  ")
+}
+
+// decryptTokenData decrypts an encrypted token envelope
+func decryptTokenData(envelope *encryptedTokenFile, passphrase string) ([]byte, error) {
+	if envelope.Version != encryptedTokenVersion {
+		return nil, fmt.Errorf("unsupported encrypted token version: %d", envelope.Version)
+	}
+
+	key, err := deriveKey(passphrase, envelope.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(envelope.Nonce) != gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid encrypted token nonce")
+	}
+
+	plaintext, err := gcm.Open(nil, envelope.Nonce, envelope.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt token (wrong LINCTL_TOKEN_KEY?): %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// parseEncryptedTokenFile returns the encrypted envelope if data is in the encrypted format
+func parseEncryptedTokenFile(data []byte) (*encryptedTokenFile, bool) {
+	var envelope encryptedTokenFile
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, false
+	}
+
+	if envelope.Version == 0 || len(envelope.Ciphertext) == 0 {
+		return nil, false
+	}
+
+	return &envelope, true
+}
+
+// newGCM creates an AES-GCM cipher for the given key
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	return gcm, nil
+}
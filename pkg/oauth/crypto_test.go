@@ -0,0 +1,149 @@
+package oauth
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTokenStore_EncryptedRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+	store := NewTokenStoreWithPath(path)
+	store.EnableEncryption("test-passphrase")
+
+	token := &TokenResponse{
+		AccessToken: "secret-access-token",
+		TokenType:   "Bearer",
+		ExpiresIn:   3600,
+		Scope:       "read write",
+	}
+
+	if err := store.SaveToken(token); err != nil {
+		t.Fatalf("Failed to save token: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Failed to stat token file: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected file permissions 0600, got %o", info.Mode().Perm())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read token file: %v", err)
+	}
+	if bytes.Contains(data, []byte("secret-access-token")) || bytes.Contains(data, []byte("read write")) {
+		t.Error("Expected on-disk token to not contain readable plaintext")
+	}
+
+	loaded, err := store.LoadToken()
+	if err != nil {
+		t.Fatalf("Failed to load token: %v", err)
+	}
+	if loaded.AccessToken != token.AccessToken {
+		t.Errorf("Expected access token %s, got %s", token.AccessToken, loaded.AccessToken)
+	}
+	if loaded.Scope != token.Scope {
+		t.Errorf("Expected scope %s, got %s", token.Scope, loaded.Scope)
+	}
+}
+
+func TestTokenStore_EncryptedWrongKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	store := NewTokenStoreWithPath(path)
+	store.EnableEncryption("correct-passphrase")
+	if err := store.SaveToken(&TokenResponse{AccessToken: "token", ExpiresIn: 3600}); err != nil {
+		t.Fatalf("Failed to save token: %v", err)
+	}
+
+	other := NewTokenStoreWithPath(path)
+	other.EnableEncryption("wrong-passphrase")
+	if _, err := other.LoadToken(); err == nil {
+		t.Error("Expected error when loading with the wrong passphrase")
+	}
+
+	plain := NewTokenStoreWithPath(path)
+	if _, err := plain.LoadToken(); err == nil {
+		t.Error("Expected error when loading an encrypted token without encryption enabled")
+	}
+}
+
+func TestTokenStore_MigratesPlaintextToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token.json")
+
+	plain := NewTokenStoreWithPath(path)
+	if err := plain.SaveToken(&TokenResponse{AccessToken: "legacy-token", ExpiresIn: 3600}); err != nil {
+		t.Fatalf("Failed to save plaintext token: %v", err)
+	}
+
+	store := NewTokenStoreWithPath(path)
+	store.EnableEncryption("test-passphrase")
+
+	loaded, err := store.LoadToken()
+	if err != nil {
+		t.Fatalf("Expected plaintext token to load with encryption enabled, got %v", err)
+	}
+	if loaded.AccessToken != "legacy-token" {
+		t.Errorf("Expected legacy-token, got %s", loaded.AccessToken)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read token file: %v", err)
+	}
+	if bytes.Contains(data, []byte("legacy-token")) {
+		t.Error("Expected plaintext token to be re-saved encrypted")
+	}
+
+	// The migrated file must still be readable
+	reloaded, err := store.LoadToken()
+	if err != nil {
+		t.Fatalf("Failed to reload migrated token: %v", err)
+	}
+	if reloaded.AccessToken != "legacy-token" {
+		t.Errorf("Expected legacy-token after migration, got %s", reloaded.AccessToken)
+	}
+}
+
+func TestNewTokenStore_HonorsEncryptTokens(t *testing.T) {
+	originalEncrypt := os.Getenv("LINCTL_ENCRYPT_TOKENS")
+	originalKey := os.Getenv("LINCTL_TOKEN_KEY")
+	defer func() {
+		os.Setenv("LINCTL_ENCRYPT_TOKENS", originalEncrypt)
+		os.Setenv("LINCTL_TOKEN_KEY", originalKey)
+	}()
+
+	os.Setenv("LINCTL_ENCRYPT_TOKENS", "true")
+	os.Setenv("LINCTL_TOKEN_KEY", "env-passphrase")
+
+	store, err := NewTokenStore()
+	if err != nil {
+		t.Fatalf("Failed to create token store: %v", err)
+	}
+	if !store.IsEncrypted() || store.passphrase != "env-passphrase" {
+		t.Error("Expected token store to enable encryption from LINCTL_ENCRYPT_TOKENS")
+	}
+
+	os.Setenv("LINCTL_ENCRYPT_TOKENS", "false")
+
+	store, err = NewTokenStore()
+	if err != nil {
+		t.Fatalf("Failed to create token store: %v", err)
+	}
+	if store.IsEncrypted() {
+		t.Error("Expected token store to be unencrypted by default")
+	}
+}
+
+func TestEnableEncryption_DefaultsToMachineSecret(t *testing.T) {
+	store := NewTokenStoreWithPath(filepath.Join(t.TempDir(), "token.json"))
+	store.EnableEncryption("")
+
+	if store.passphrase == "" {
+		t.Error("Expected machine-specific secret when no passphrase is given")
+	}
+}
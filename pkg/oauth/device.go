@@ -0,0 +1,191 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/httpclient"
+)
+
+// DeviceCodeGrantType is the grant type used when polling for a device flow token
+const DeviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// Device flow polling defaults, as specified in RFC 8628
+const (
+	defaultDevicePollInterval = 5
+	slowDownIncrement         = 5
+)
+
+// deviceIntervalUnit is the unit applied to the second-based durations returned by the server
+// This variable allows for faster polling in tests
+var deviceIntervalUnit = time.Second
+
+// StartDeviceAuthorization begins the OAuth device authorization flow
+// The returned user code must be entered by the user at the verification URL
+func (c *OAuthClient) StartDeviceAuthorization(ctx context.Context) (*DeviceAuthorizationResponse, error) {
+	authorizeURL := c.baseURL + "/oauth/device/authorize"
+
+	scopes := DefaultScopes()
+	if c.config != nil && len(c.config.Scopes) > 0 {
+		scopes = c.config.Scopes
+	}
+
+	data := url.Values{
+		"client_id": {c.clientID},
+		"scope":     {strings.Join(scopes, " ")},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", authorizeURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create device authorization request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpclient.SetExtraHeaders(req, c.extraHeaders)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request device authorization: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errorResp oauthErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errorResp); err == nil && errorResp.Error != "" {
+			if errorResp.ErrorDescription != "" {
+				return nil, fmt.Errorf("device authorization failed (%d): %s", resp.StatusCode, errorResp.ErrorDescription)
+			}
+			return nil, fmt.Errorf("device authorization failed (%d): %s", resp.StatusCode, errorResp.Error)
+		}
+		return nil, fmt.Errorf("device authorization failed with status: %d", resp.StatusCode)
+	}
+
+	var deviceResp DeviceAuthorizationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&deviceResp); err != nil {
+		return nil, fmt.Errorf("failed to decode device authorization response: %w", err)
+	}
+
+	if deviceResp.DeviceCode == "" || deviceResp.UserCode == "" {
+		return nil, fmt.Errorf("received incomplete device authorization response")
+	}
+
+	if deviceResp.Interval <= 0 {
+		deviceResp.Interval = defaultDevicePollInterval
+	}
+
+	return &deviceResp, nil
+}
+
+// PollDeviceToken polls the token endpoint until the user approves the device,
+// denies it, or the device code expires. The resulting token is persisted to the
+// token store, and an error is returned when it cannot be saved.
+func (c *OAuthClient) PollDeviceToken(ctx context.Context, device *DeviceAuthorizationResponse) (*TokenResponse, error) {
+	if device == nil {
+		return nil, fmt.Errorf("device authorization cannot be nil")
+	}
+
+	interval := device.Interval
+	if interval <= 0 {
+		interval = defaultDevicePollInterval
+	}
+
+	if device.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(device.ExpiresIn)*deviceIntervalUnit)
+		defer cancel()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return nil, fmt.Errorf("device authorization timed out before approval")
+			}
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(interval) * deviceIntervalUnit):
+		}
+
+		token, errorCode, err := c.requestDeviceToken(ctx, device.DeviceCode)
+		if err != nil {
+			return nil, err
+		}
+
+		switch errorCode {
+		case "":
+			// Unlike a client credentials token, a device login cannot be
+			// silently repeated later, so a token that was not saved is an error
+			if c.tokenStore != nil {
+				if saveErr := c.tokenStore.SaveTokenWithGrantType(token, DeviceCodeGrantType); saveErr != nil {
+					return nil, fmt.Errorf("failed to save device flow token: %w", saveErr)
+				}
+			}
+			return token, nil
+		case "authorization_pending":
+			logDebug("Device authorization pending, polling again in %ds", interval)
+		case "slow_down":
+			interval += slowDownIncrement
+			logDebug("Device authorization asked to slow down, polling every %ds", interval)
+		case "access_denied":
+			return nil, fmt.Errorf("device authorization was denied")
+		case "expired_token":
+			return nil, fmt.Errorf("device code expired before approval")
+		default:
+			return nil, fmt.Errorf("device authorization failed: %s", errorCode)
+		}
+	}
+}
+
+// requestDeviceToken makes a single device code token request
+// It returns the OAuth error code when the server rejects the request
+func (c *OAuthClient) requestDeviceToken(ctx context.Context, deviceCode string) (*TokenResponse, string, error) {
+	tokenURL := c.baseURL + "/oauth/token"
+
+	data := url.Values{
+		"grant_type":  {DeviceCodeGrantType},
+		"device_code": {deviceCode},
+		"client_id":   {c.clientID},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create token request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpclient.SetExtraHeaders(req, c.extraHeaders)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to request access token: %w", err)
+	}
+	defer resp.Body.Close()
+	warnClockSkew(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		var errorResp oauthErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errorResp); err == nil && errorResp.Error != "" {
+			return nil, errorResp.Error, nil
+		}
+		return nil, "", fmt.Errorf("OAuth request failed with status: %d", resp.StatusCode)
+	}
+
+	var tokenResp TokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return nil, "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	if tokenResp.AccessToken == "" {
+		return nil, "", fmt.Errorf("received empty access token")
+	}
+
+	if tokenResp.TokenType == "" {
+		tokenResp.TokenType = "Bearer"
+	}
+
+	return &tokenResp, "", nil
+}
@@ -0,0 +1,205 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// withFastDevicePolling shortens server-provided intervals for tests
+func withFastDevicePolling(t *testing.T) {
+	t.Helper()
+	original := deviceIntervalUnit
+	deviceIntervalUnit = time.Millisecond
+	t.Cleanup(func() { deviceIntervalUnit = original })
+}
+
+func TestOAuthClient_StartDeviceAuthorization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/oauth/device/authorize" {
+			t.Errorf("Expected /oauth/device/authorize path, got %s", r.URL.Path)
+		}
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse form: %v", err)
+		}
+		if r.Form.Get("client_id") != "test-client-id" {
+			t.Errorf("Expected client_id=test-client-id, got %s", r.Form.Get("client_id"))
+		}
+		if r.Form.Get("scope") == "" {
+			t.Error("Expected scope to be sent")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(DeviceAuthorizationResponse{
+			DeviceCode:      "device-123",
+			UserCode:        "ABCD-EFGH",
+			VerificationURI: "https://linear.app/device",
+			ExpiresIn:       900,
+		})
+	}))
+	defer server.Close()
+
+	client := NewOAuthClient("test-client-id", "", server.URL)
+
+	device, err := client.StartDeviceAuthorization(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if device.UserCode != "ABCD-EFGH" {
+		t.Errorf("Expected user code ABCD-EFGH, got %s", device.UserCode)
+	}
+	if device.Interval != defaultDevicePollInterval {
+		t.Errorf("Expected default interval %d, got %d", defaultDevicePollInterval, device.Interval)
+	}
+}
+
+func TestOAuthClient_StartDeviceAuthorization_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(oauthErrorResponse{Error: "invalid_client"})
+	}))
+	defer server.Close()
+
+	client := NewOAuthClient("bad-client", "", server.URL)
+
+	_, err := client.StartDeviceAuthorization(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "invalid_client") {
+		t.Errorf("Expected invalid_client error, got %v", err)
+	}
+}
+
+func TestOAuthClient_PollDeviceToken_PendingThenSuccess(t *testing.T) {
+	withFastDevicePolling(t)
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse form: %v", err)
+		}
+		if r.Form.Get("grant_type") != DeviceCodeGrantType {
+			t.Errorf("Expected device code grant type, got %s", r.Form.Get("grant_type"))
+		}
+		if r.Form.Get("device_code") != "device-123" {
+			t.Errorf("Expected device_code=device-123, got %s", r.Form.Get("device_code"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(oauthErrorResponse{Error: "authorization_pending"})
+		case 2:
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(oauthErrorResponse{Error: "slow_down"})
+		default:
+			json.NewEncoder(w).Encode(TokenResponse{
+				AccessToken: "device-access-token",
+				ExpiresIn:   3600,
+				Scope:       "read write",
+			})
+		}
+	}))
+	defer server.Close()
+
+	client := NewOAuthClient("test-client-id", "", server.URL)
+	client.tokenStore = NewTokenStoreWithPath(filepath.Join(t.TempDir(), "token.json"))
+
+	device := &DeviceAuthorizationResponse{DeviceCode: "device-123", UserCode: "ABCD", ExpiresIn: 900, Interval: 1}
+
+	token, err := client.PollDeviceToken(context.Background(), device)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if token.AccessToken != "device-access-token" {
+		t.Errorf("Expected device-access-token, got %s", token.AccessToken)
+	}
+	if token.TokenType != "Bearer" {
+		t.Errorf("Expected default token type Bearer, got %s", token.TokenType)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 token requests, got %d", calls)
+	}
+
+	stored, err := client.tokenStore.LoadToken()
+	if err != nil {
+		t.Fatalf("Expected token to be persisted, got %v", err)
+	}
+	if stored.GrantType != DeviceCodeGrantType {
+		t.Errorf("Expected stored grant type %s, got %s", DeviceCodeGrantType, stored.GrantType)
+	}
+}
+
+func TestOAuthClient_PollDeviceToken_SaveFailure(t *testing.T) {
+	withFastDevicePolling(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TokenResponse{AccessToken: "device-access-token", ExpiresIn: 3600})
+	}))
+	defer server.Close()
+
+	// A regular file where the token directory should be makes the save fail
+	blocker := filepath.Join(t.TempDir(), "blocker")
+	if err := os.WriteFile(blocker, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewOAuthClient("test-client-id", "", server.URL)
+	client.tokenStore = NewTokenStoreWithPath(filepath.Join(blocker, "token.json"))
+
+	token, err := client.PollDeviceToken(context.Background(), &DeviceAuthorizationResponse{DeviceCode: "device-123", Interval: 1})
+	if err == nil || !strings.Contains(err.Error(), "failed to save device flow token") {
+		t.Errorf("Expected the save failure to be returned, got %v", err)
+	}
+	if token != nil {
+		t.Errorf("Expected no token when it could not be saved, got %+v", token)
+	}
+}
+
+func TestOAuthClient_PollDeviceToken_Denied(t *testing.T) {
+	withFastDevicePolling(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(oauthErrorResponse{Error: "access_denied"})
+	}))
+	defer server.Close()
+
+	client := NewOAuthClient("test-client-id", "", server.URL)
+	client.tokenStore = nil
+
+	_, err := client.PollDeviceToken(context.Background(), &DeviceAuthorizationResponse{DeviceCode: "device-123", Interval: 1})
+	if err == nil || !strings.Contains(err.Error(), "denied") {
+		t.Errorf("Expected denied error, got %v", err)
+	}
+}
+
+func TestOAuthClient_PollDeviceToken_Timeout(t *testing.T) {
+	withFastDevicePolling(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(oauthErrorResponse{Error: "authorization_pending"})
+	}))
+	defer server.Close()
+
+	client := NewOAuthClient("test-client-id", "", server.URL)
+	client.tokenStore = nil
+
+	device := &DeviceAuthorizationResponse{DeviceCode: "device-123", ExpiresIn: 50, Interval: 5}
+
+	_, err := client.PollDeviceToken(context.Background(), device)
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Expected timeout error, got %v", err)
+	}
+}
@@ -2,72 +2,160 @@ package oauth
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/config"
 )
 
 // TokenStore manages OAuth token persistence
 type TokenStore struct {
-	configPath string
+	backend    tokenBackend
+	encrypt    bool
+	passphrase string
 }
 
 // StoredToken represents a token with metadata for persistence
 type StoredToken struct {
-	AccessToken string    `json:"access_token"`
-	TokenType   string    `json:"token_type"`
-	ExpiresIn   int       `json:"expires_in"`
-	Scope       string    `json:"scope"`
-	ExpiresAt   time.Time `json:"expires_at"`
-	CreatedAt   time.Time `json:"created_at"`
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type"`
+	ExpiresIn    int       `json:"expires_in"`
+	Scope        string    `json:"scope"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+	GrantType    string    `json:"grant_type,omitempty"`
 }
 
-// NewTokenStore creates a new token store with the default config path
+// NewTokenStore creates a new token store for the active profile
 func NewTokenStore() (*TokenStore, error) {
-	homeDir, err := os.UserHomeDir()
+	return NewTokenStoreForProfile(config.ActiveProfile())
+}
+
+// NewTokenStoreForProfile creates a new token store using the backend selected by LINCTL_TOKEN_BACKEND
+// The file backend is used unless the OS keyring is requested and available. Named profiles keep
+// their token under ~/.linctl/profiles/<name>/, the default profile uses the legacy path.
+// A token file set with --token-file or LINCTL_TOKEN_FILE wins over both.
+func NewTokenStoreForProfile(profile string) (*TokenStore, error) {
+	var backend tokenBackend
+	if path := TokenFile(); path != "" {
+		logDebug("Using token file %s", path)
+		backend = &fileBackend{path: path}
+	} else {
+		configPath, err := tokenPath(profile)
+		if err != nil {
+			return nil, err
+		}
+		backend = selectTokenBackend(configPath, profileKeyringUser(profile))
+	}
+
+	store := &TokenStore{backend: backend}
+
+	// Honor LINCTL_ENCRYPT_TOKENS and LINCTL_TOKEN_KEY from the production config
+	if prodConfig, err := config.LoadProductionConfig(); err == nil && prodConfig.Security.EncryptTokens {
+		store.EnableEncryption(prodConfig.Security.TokenKey)
+	}
+
+	return store, nil
+}
+
+// tokenPath returns the token file path for a profile
+func tokenPath(profile string) (string, error) {
+	profileDir, err := config.ProfileDir(profile)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get home directory: %w", err)
+		return "", err
+	}
+	if profileDir != "" {
+		return filepath.Join(profileDir, "oauth-token.json"), nil
 	}
 
-	configPath := filepath.Join(homeDir, ".linctl-oauth-token.json")
-	return &TokenStore{configPath: configPath}, nil
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".linctl-oauth-token.json"), nil
 }
 
 // NewTokenStoreWithPath creates a new token store with a custom config path
 func NewTokenStoreWithPath(configPath string) *TokenStore {
-	return &TokenStore{configPath: configPath}
+	return &TokenStore{backend: &fileBackend{path: configPath}}
+}
+
+// Backend returns the name of the storage backend in use
+func (ts *TokenStore) Backend() string {
+	return ts.backend.Name()
+}
+
+// Location returns where the token is stored (a file path or keyring entry)
+func (ts *TokenStore) Location() string {
+	return ts.backend.Location()
+}
+
+// HasToken reports whether a token is stored, without decrypting or parsing it
+func (ts *TokenStore) HasToken() bool {
+	_, err := ts.backend.Read()
+	return err == nil
+}
+
+// EnableEncryption encrypts the token at rest using a key derived from passphrase
+// A machine-specific secret is used when passphrase is empty
+func (ts *TokenStore) EnableEncryption(passphrase string) {
+	if passphrase == "" {
+		passphrase = machineSecret()
+	}
+	ts.encrypt = true
+	ts.passphrase = passphrase
+}
+
+// IsEncrypted reports whether the token store encrypts tokens at rest
+func (ts *TokenStore) IsEncrypted() bool {
+	return ts.encrypt
 }
 
 // SaveToken saves a token response to persistent storage
 func (ts *TokenStore) SaveToken(token *TokenResponse) error {
+	return ts.SaveTokenWithGrantType(token, "")
+}
+
+// SaveTokenWithGrantType saves a token response along with the grant type that issued it
+func (ts *TokenStore) SaveTokenWithGrantType(token *TokenResponse, grantType string) error {
 	if token == nil {
 		return fmt.Errorf("token cannot be nil")
 	}
 
 	now := time.Now()
 	storedToken := StoredToken{
-		AccessToken: token.AccessToken,
-		TokenType:   token.TokenType,
-		ExpiresIn:   token.ExpiresIn,
-		Scope:       token.Scope,
-		ExpiresAt:   now.Add(time.Duration(token.ExpiresIn) * time.Second),
-		CreatedAt:   now,
+		AccessToken:  token.AccessToken,
+		TokenType:    token.TokenType,
+		ExpiresIn:    token.ExpiresIn,
+		Scope:        token.Scope,
+		RefreshToken: token.RefreshToken,
+		ExpiresAt:    now.Add(time.Duration(token.ExpiresIn) * time.Second),
+		CreatedAt:    now,
+		GrantType:    grantType,
 	}
 
+	return ts.writeStoredToken(&storedToken)
+}
+
+// writeStoredToken writes a stored token to the backend, encrypting it if enabled
+func (ts *TokenStore) writeStoredToken(storedToken *StoredToken) error {
 	data, err := json.MarshalIndent(storedToken, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal token: %w", err)
 	}
 
-	// Ensure directory exists
-	dir := filepath.Dir(ts.configPath)
-	if err := os.MkdirAll(dir, 0700); err != nil {
-		return fmt.Errorf("failed to create config directory: %w", err)
+	if ts.encrypt {
+		data, err = encryptTokenData(data, ts.passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt token: %w", err)
+		}
 	}
 
-	// Write with secure permissions (readable only by owner)
-	if err := os.WriteFile(ts.configPath, data, 0600); err != nil {
+	if err := ts.backend.Write(data); err != nil {
 		return fmt.Errorf("failed to save token: %w", err)
 	}
 
@@ -76,17 +164,37 @@ func (ts *TokenStore) SaveToken(token *TokenResponse) error {
 
 // LoadToken loads a token from persistent storage
 func (ts *TokenStore) LoadToken() (*StoredToken, error) {
-	data, err := os.ReadFile(ts.configPath)
+	location := ts.backend.Location()
+
+	data, err := ts.backend.Read()
 	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("no stored OAuth token found at %s", ts.configPath)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("no stored OAuth token found at %s", location)
+		}
+		return nil, fmt.Errorf("failed to read OAuth token file %s: %w", location, err)
+	}
+
+	envelope, encrypted := parseEncryptedTokenFile(data)
+	if encrypted {
+		if !ts.encrypt {
+			return nil, fmt.Errorf("OAuth token file %s is encrypted (set LINCTL_ENCRYPT_TOKENS=true)", location)
+		}
+		data, err = decryptTokenData(envelope, ts.passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read OAuth token file %s: %w", location, err)
 		}
-		return nil, fmt.Errorf("failed to read OAuth token file %s: %w", ts.configPath, err)
 	}
 
 	var token StoredToken
 	if err := json.Unmarshal(data, &token); err != nil {
-		return nil, fmt.Errorf("failed to parse stored OAuth token from %s: %w (file may be corrupted)", ts.configPath, err)
+		return nil, fmt.Errorf("failed to parse stored OAuth token from %s: %w (file may be corrupted)", location, err)
+	}
+
+	// Migrate plaintext tokens when encryption has been enabled
+	if ts.encrypt && !encrypted {
+		if err := ts.writeStoredToken(&token); err != nil {
+			logDebug("Warning: failed to re-save OAuth token encrypted: %v", err)
+		}
 	}
 
 	return &token, nil
@@ -94,8 +202,8 @@ func (ts *TokenStore) LoadToken() (*StoredToken, error) {
 
 // ClearToken removes the stored token
 func (ts *TokenStore) ClearToken() error {
-	err := os.Remove(ts.configPath)
-	if err != nil && !os.IsNotExist(err) {
+	err := ts.backend.Delete()
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
 		return fmt.Errorf("failed to clear token: %w", err)
 	}
 	return nil
@@ -113,14 +221,15 @@ func (ts *TokenStore) IsTokenExpired(token *StoredToken) bool {
 	return time.Now().Add(buffer).After(token.ExpiresAt)
 }
 
-// IsTokenValid checks if a token exists and is not expired
+// IsTokenValid checks if a token exists and is not expired, tolerating the
+// LINCTL_CLOCK_SKEW clock skew within the 5-minute buffer
 func (ts *TokenStore) IsTokenValid() bool {
 	token, err := ts.LoadToken()
 	if err != nil {
 		return false
 	}
 
-	return !ts.IsTokenExpired(token)
+	return !ts.IsTokenExpiredWithBuffer(token, skewedBuffer(5*time.Minute, clockSkewTolerance()))
 }
 
 // GetValidToken returns a valid token if available, nil if expired or missing
@@ -137,14 +246,16 @@ func (ts *TokenStore) GetValidToken() (*StoredToken, error) {
 	return token, nil
 }
 
-// GetValidTokenWithBuffer returns a valid token with custom expiry buffer
+// GetValidTokenWithBuffer returns a valid token with custom expiry buffer.
+// The buffer is reduced by the LINCTL_CLOCK_SKEW tolerance so a skewed clock
+// does not discard a fresh token, but a token is never used past its expiry.
 func (ts *TokenStore) GetValidTokenWithBuffer(buffer time.Duration) (*StoredToken, error) {
 	token, err := ts.LoadToken()
 	if err != nil {
 		return nil, err
 	}
 
-	if ts.IsTokenExpiredWithBuffer(token, buffer) {
+	if ts.IsTokenExpiredWithBuffer(token, skewedBuffer(buffer, clockSkewTolerance())) {
 		return nil, fmt.Errorf("stored token is expired or will expire within buffer")
 	}
 
@@ -161,6 +272,14 @@ func (ts *TokenStore) IsTokenExpiredWithBuffer(token *StoredToken, buffer time.D
 	return time.Now().Add(buffer).After(token.ExpiresAt)
 }
 
+// skewedBuffer reduces an expiry buffer by the tolerated clock skew, never below zero
+func skewedBuffer(buffer, skew time.Duration) time.Duration {
+	if buffer < skew {
+		return 0
+	}
+	return buffer - skew
+}
+
 // ToTokenResponse converts a StoredToken back to a TokenResponse
 func (st *StoredToken) ToTokenResponse() *TokenResponse {
 	if st == nil {
@@ -174,13 +293,19 @@ func (st *StoredToken) ToTokenResponse() *TokenResponse {
 	}
 
 	return &TokenResponse{
-		AccessToken: st.AccessToken,
-		TokenType:   st.TokenType,
-		ExpiresIn:   remainingSeconds,
-		Scope:       st.Scope,
+		AccessToken:  st.AccessToken,
+		TokenType:    st.TokenType,
+		ExpiresIn:    remainingSeconds,
+		Scope:        st.Scope,
+		RefreshToken: st.RefreshToken,
 	}
 }
 
+// Scopes returns the scopes the token was granted
+func (st *StoredToken) Scopes() []string {
+	return ParseScopes(st.Scope)
+}
+
 // GetTokenInfo returns human-readable token information
 func (st *StoredToken) GetTokenInfo() map[string]interface{} {
 	if st == nil {
@@ -201,6 +326,10 @@ func (st *StoredToken) GetTokenInfo() map[string]interface{} {
 		"token_type": st.TokenType,
 	}
 
+	if st.RefreshToken != "" {
+		info["has_refresh_token"] = true
+	}
+
 	if !isExpired {
 		info["expires_in_seconds"] = int(timeUntilExpiry.Seconds())
 		info["expires_in_human"] = formatDuration(timeUntilExpiry)
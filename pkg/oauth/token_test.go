@@ -244,3 +244,87 @@ func TestTokenStore_GetValidTokenWithBuffer(t *testing.T) {
 		t.Error("Token should be expired with 4-minute buffer")
 	}
 }
+
+func TestNewTokenStoreForProfile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("LINCTL_TOKEN_BACKEND", "")
+
+	store, err := NewTokenStoreForProfile("work")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := filepath.Join(home, ".linctl", "profiles", "work", "oauth-token.json"); store.backend.Location() != expected {
+		t.Errorf("Expected profile token path %s, got %s", expected, store.backend.Location())
+	}
+
+	store, err = NewTokenStoreForProfile("")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := filepath.Join(home, ".linctl-oauth-token.json"); store.backend.Location() != expected {
+		t.Errorf("Expected legacy token path %s, got %s", expected, store.backend.Location())
+	}
+
+	if _, err := NewTokenStoreForProfile("../work"); err == nil {
+		t.Error("Expected invalid profile name to be rejected")
+	}
+}
+
+func TestNewTokenStoreForProfile_TokenFile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("LINCTL_TOKEN_BACKEND", BackendKeyring)
+	path := filepath.Join(t.TempDir(), "job", "token.json")
+	t.Setenv(TokenFileEnvVar, path)
+
+	store, err := NewTokenStoreForProfile("work")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if store.Backend() != BackendFile || store.Location() != path {
+		t.Errorf("Expected %s to win over the profile and keyring, got %s at %s", TokenFileEnvVar, store.Backend(), store.Location())
+	}
+
+	override := filepath.Join(t.TempDir(), "flag-token.json")
+	SetTokenFile(override)
+	defer SetTokenFile("")
+	if store, err = NewTokenStoreForProfile(""); err != nil || store.Location() != override {
+		t.Errorf("Expected --token-file to win over %s, got %s (err %v)", TokenFileEnvVar, store.Location(), err)
+	}
+}
+
+func TestPrepareTokenFile(t *testing.T) {
+	if err := PrepareTokenFile(""); err != nil {
+		t.Errorf("Expected the default location to need no checks, got %v", err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "ci", "job-42")
+	if err := PrepareTokenFile(filepath.Join(dir, "token.json")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		t.Fatalf("Expected the directory to be created: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o700 {
+		t.Errorf("Expected the directory to be created with 0700, got %o", perm)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Expected the writability probe to be removed, found %d files", len(entries))
+	}
+
+	if err := PrepareTokenFile(dir); err == nil {
+		t.Error("Expected a directory to be rejected as the token file")
+	}
+
+	if os.Geteuid() != 0 {
+		readOnly := t.TempDir()
+		if err := os.Chmod(readOnly, 0o500); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Chmod(readOnly, 0o700)
+		if err := PrepareTokenFile(filepath.Join(readOnly, "token.json")); err == nil {
+			t.Error("Expected a read-only directory to be rejected")
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package oauth
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// TokenFileEnvVar stores the OAuth token in this file instead of the profile's
+// default location, e.g. a job-scoped temp dir in CI
+const TokenFileEnvVar = "LINCTL_TOKEN_FILE"
+
+// tokenFileOverride wins over LINCTL_TOKEN_FILE, see SetTokenFile
+var tokenFileOverride string
+
+// SetTokenFile overrides LINCTL_TOKEN_FILE for this process (the --token-file
+// flag). An empty path removes the override.
+func SetTokenFile(path string) {
+	tokenFileOverride = path
+}
+
+// TokenFile returns the token file chosen with --token-file or
+// LINCTL_TOKEN_FILE, or "" to use the profile's default location
+func TokenFile() string {
+	if tokenFileOverride != "" {
+		return tokenFileOverride
+	}
+	return os.Getenv(TokenFileEnvVar)
+}
+
+// PrepareTokenFile makes sure a token can be saved at path: its directory is
+// created with 0700 permissions if needed and must be writable. An empty path
+// is the default location and needs no checks.
+func PrepareTokenFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return fmt.Errorf("token file %s is a directory", path)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create token file directory: %w", err)
+	}
+
+	// Permission bits do not tell the whole story (read-only mounts, ACLs),
+	// so check by creating a file
+	probe, err := os.CreateTemp(dir, ".linctl-token-*")
+	if err != nil {
+		return fmt.Errorf("token file directory %s is not writable: %w", dir, err)
+	}
+	_ = probe.Close()
+	_ = os.Remove(probe.Name())
+	return nil
+}
@@ -0,0 +1,59 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Format represents an output format
+type Format string
+
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+	FormatCSV  Format = "csv"
+)
+
+// currentFormat is the effective output format resolved by the root command
+var currentFormat = FormatText
+
+// ParseFormat parses an output format name
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(name))) {
+	case "", FormatText, "table":
+		return FormatText, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	case FormatYAML, "yml":
+		return FormatYAML, nil
+	case FormatCSV:
+		return FormatCSV, nil
+	default:
+		return "", fmt.Errorf("unsupported output format '%s' (valid: json, csv, yaml, table, text)", name)
+	}
+}
+
+// SetFormat sets the effective output format
+func SetFormat(format Format) {
+	currentFormat = format
+}
+
+// CurrentFormat returns the effective output format
+func CurrentFormat() Format {
+	return currentFormat
+}
+
+// writeCSV writes table data as CSV to stdout
+func writeCSV(data TableData) {
+	writer := csv.NewWriter(os.Stdout)
+	if len(data.Headers) > 0 {
+		_ = writer.Write(data.Headers)
+	}
+	for _, row := range data.Rows {
+		_ = writer.Write(row)
+	}
+	writer.Flush()
+}
@@ -0,0 +1,264 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+	"gopkg.in/yaml.v3"
+)
+
+// markdownEscaper escapes characters that would otherwise be interpreted as inline Markdown
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+	`>`, `\>`,
+	`#`, `\#`,
+	`|`, `\|`,
+)
+
+// EscapeMarkdown escapes inline Markdown syntax in s
+func EscapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// escapeTableCell escapes a value for use inside a Markdown table cell
+func escapeTableCell(s string) string {
+	s = EscapeMarkdown(s)
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// IssueMarkdown renders an issue as a standalone Markdown document
+func IssueMarkdown(issue *api.Issue) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s: %s\n\n", issue.Identifier, EscapeMarkdown(issue.Title))
+
+	b.WriteString("| Field | Value |\n")
+	b.WriteString("| --- | --- |\n")
+	for _, row := range issueMetadataRows(issue) {
+		fmt.Fprintf(&b, "| %s | %s |\n", row[0], escapeTableCell(row[1]))
+	}
+
+	if issue.Description != "" {
+		b.WriteString("\n## Description\n\n")
+		b.WriteString(strings.TrimRight(issue.Description, "\n"))
+		b.WriteString("\n")
+	}
+
+	if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
+		b.WriteString("\n## Comments\n\n")
+		writeCommentThread(&b, issue.Comments.Nodes)
+	}
+
+	return b.String()
+}
+
+// issueFrontMatter is the YAML front matter written by IssueFrontMatterMarkdown
+type issueFrontMatter struct {
+	ID         string   `yaml:"id"`
+	Identifier string   `yaml:"identifier"`
+	Title      string   `yaml:"title"`
+	State      string   `yaml:"state,omitempty"`
+	Priority   int      `yaml:"priority"`
+	Assignee   string   `yaml:"assignee,omitempty"`
+	Team       string   `yaml:"team,omitempty"`
+	Project    string   `yaml:"project,omitempty"`
+	Labels     []string `yaml:"labels,omitempty"`
+	Estimate   *float64 `yaml:"estimate,omitempty"`
+	DueDate    string   `yaml:"due_date,omitempty"`
+	Created    string   `yaml:"created"`
+	Updated    string   `yaml:"updated"`
+	Archived   string   `yaml:"archived,omitempty"`
+	URL        string   `yaml:"url,omitempty"`
+}
+
+// IssueFrontMatterMarkdown renders an issue as Markdown with its metadata in
+// YAML front matter, followed by the title and description. It is the file
+// format written by issue export, so the metadata stays machine-readable.
+func IssueFrontMatterMarkdown(issue *api.Issue) (string, error) {
+	meta := issueFrontMatter{
+		ID:         issue.ID,
+		Identifier: issue.Identifier,
+		Title:      issue.Title,
+		Priority:   issue.Priority,
+		Estimate:   issue.Estimate,
+		Created:    issue.CreatedAt.UTC().Format(time.RFC3339),
+		Updated:    issue.UpdatedAt.UTC().Format(time.RFC3339),
+		URL:        issue.URL,
+	}
+	if issue.State != nil {
+		meta.State = issue.State.Name
+	}
+	if issue.Assignee != nil {
+		meta.Assignee = issue.Assignee.Email
+		if meta.Assignee == "" {
+			meta.Assignee = issue.Assignee.Name
+		}
+	}
+	if issue.Team != nil {
+		meta.Team = issue.Team.Key
+	}
+	if issue.Project != nil {
+		meta.Project = issue.Project.Name
+	}
+	if issue.Labels != nil {
+		for _, label := range issue.Labels.Nodes {
+			meta.Labels = append(meta.Labels, label.Name)
+		}
+	}
+	if issue.DueDate != nil {
+		meta.DueDate = *issue.DueDate
+	}
+	if issue.ArchivedAt != nil {
+		meta.Archived = issue.ArchivedAt.UTC().Format(time.RFC3339)
+	}
+
+	frontMatter, err := yaml.Marshal(meta)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode front matter: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("---\n")
+	b.Write(frontMatter)
+	b.WriteString("---\n\n")
+	fmt.Fprintf(&b, "# %s: %s\n", issue.Identifier, EscapeMarkdown(issue.Title))
+
+	if issue.Description != "" {
+		b.WriteString("\n")
+		b.WriteString(strings.TrimRight(issue.Description, "\n"))
+		b.WriteString("\n")
+	}
+
+	if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
+		b.WriteString("\n## Comments\n\n")
+		writeCommentThread(&b, issue.Comments.Nodes)
+	}
+
+	return b.String(), nil
+}
+
+// issueMetadataRows returns the field/value pairs shown in the metadata table
+func issueMetadataRows(issue *api.Issue) [][2]string {
+	rows := [][2]string{}
+
+	if issue.State != nil {
+		rows = append(rows, [2]string{"State", issue.State.Name})
+	}
+
+	priority := issue.PriorityLabel
+	if priority == "" {
+		priority = fmt.Sprintf("%d", issue.Priority)
+	}
+	rows = append(rows, [2]string{"Priority", priority})
+
+	if issue.Assignee != nil {
+		rows = append(rows, [2]string{"Assignee", fmt.Sprintf("%s (%s)", issue.Assignee.Name, issue.Assignee.Email)})
+	} else {
+		rows = append(rows, [2]string{"Assignee", "Unassigned"})
+	}
+
+	if issue.Team != nil {
+		rows = append(rows, [2]string{"Team", fmt.Sprintf("%s (%s)", issue.Team.Name, issue.Team.Key)})
+	}
+
+	if issue.Project != nil {
+		rows = append(rows, [2]string{"Project", issue.Project.Name})
+	}
+
+	if issue.Cycle != nil {
+		rows = append(rows, [2]string{"Cycle", fmt.Sprintf("#%d %s", issue.Cycle.Number, issue.Cycle.Name)})
+	}
+
+	if issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
+		names := make([]string, len(issue.Labels.Nodes))
+		for i, label := range issue.Labels.Nodes {
+			names[i] = label.Name
+		}
+		rows = append(rows, [2]string{"Labels", strings.Join(names, ", ")})
+	}
+
+	if issue.Estimate != nil {
+		rows = append(rows, [2]string{"Estimate", fmt.Sprintf("%.1f", *issue.Estimate)})
+	}
+
+	if issue.DueDate != nil && *issue.DueDate != "" {
+		rows = append(rows, [2]string{"Due Date", *issue.DueDate})
+	}
+
+	rows = append(rows, [2]string{"Created", issue.CreatedAt.Format("2006-01-02 15:04")})
+	rows = append(rows, [2]string{"Updated", issue.UpdatedAt.Format("2006-01-02 15:04")})
+
+	if issue.URL != "" {
+		rows = append(rows, [2]string{"URL", issue.URL})
+	}
+
+	return rows
+}
+
+// writeCommentThread writes comments as a nested list, with replies under their parent
+func writeCommentThread(b *strings.Builder, comments []api.Comment) {
+	known := make(map[string]bool, len(comments))
+	for _, comment := range comments {
+		known[comment.ID] = true
+	}
+
+	replies := make(map[string][]api.Comment)
+	var roots []api.Comment
+	for _, comment := range comments {
+		if comment.Parent != nil && known[comment.Parent.ID] {
+			replies[comment.Parent.ID] = append(replies[comment.Parent.ID], comment)
+		} else {
+			roots = append(roots, comment)
+		}
+	}
+
+	var write func(comment api.Comment, depth int)
+	write = func(comment api.Comment, depth int) {
+		indent := strings.Repeat("  ", depth)
+
+		author := "Unknown"
+		if comment.User != nil {
+			author = comment.User.Name
+		}
+
+		header := fmt.Sprintf("**%s**", EscapeMarkdown(author))
+		if !comment.CreatedAt.IsZero() {
+			header += fmt.Sprintf(" (%s)", comment.CreatedAt.Format("2006-01-02 15:04"))
+		}
+		fmt.Fprintf(b, "%s- %s\n", indent, header)
+
+		for _, line := range strings.Split(strings.TrimRight(comment.Body, "\n"), "\n") {
+			if line == "" {
+				b.WriteString("\n")
+				continue
+			}
+			fmt.Fprintf(b, "%s  %s\n", indent, line)
+		}
+
+		children := replies[comment.ID]
+		// Fall back to embedded children when replies were not returned in the flat list
+		if len(children) == 0 && comment.Children != nil {
+			for _, child := range comment.Children.Nodes {
+				if !known[child.ID] {
+					children = append(children, child)
+				}
+			}
+		}
+		for _, child := range children {
+			write(child, depth+1)
+		}
+	}
+
+	for _, comment := range roots {
+		write(comment, 0)
+	}
+}
@@ -0,0 +1,149 @@
+package output
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+func TestIssueMarkdown(t *testing.T) {
+	created := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+
+	issue := &api.Issue{
+		Identifier:    "ENG-42",
+		Title:         "Fix *bold* | pipes",
+		Description:   "Steps:\n\n1. Run `linctl`\n2. Observe",
+		PriorityLabel: "High",
+		CreatedAt:     created,
+		UpdatedAt:     created,
+		State:         &api.State{Name: "In Progress"},
+		Assignee:      &api.User{Name: "Jane Doe", Email: "jane@example.com"},
+		Team:          &api.Team{Name: "Engineering", Key: "ENG"},
+		Labels: &api.Labels{Nodes: []api.Label{
+			{Name: "bug"},
+			{Name: "needs|triage"},
+		}},
+		Comments: &api.Comments{Nodes: []api.Comment{
+			{ID: "c1", Body: "First comment", CreatedAt: created, User: &api.User{Name: "Jane Doe"}},
+			{ID: "c2", Body: "A reply\nwith two lines", CreatedAt: created, User: &api.User{Name: "John_Smith"}, Parent: &api.Comment{ID: "c1"}},
+			{ID: "c3", Body: "Second thread", CreatedAt: created, User: &api.User{Name: "Jane Doe"}},
+		}},
+	}
+
+	md := IssueMarkdown(issue)
+
+	expectedParts := []string{
+		"# ENG-42: Fix \\*bold\\* \\| pipes\n",
+		"| Field | Value |\n| --- | --- |\n",
+		"| State | In Progress |",
+		"| Priority | High |",
+		"| Assignee | Jane Doe (jane@example.com) |",
+		"| Labels | bug, needs\\|triage |",
+		"## Description\n\nSteps:\n\n1. Run `linctl`\n2. Observe\n",
+		"## Comments\n\n",
+		"- **Jane Doe** (2024-05-01 10:30)\n  First comment\n",
+		"  - **John\\_Smith** (2024-05-01 10:30)\n    A reply\n    with two lines\n",
+		"- **Jane Doe** (2024-05-01 10:30)\n  Second thread\n",
+	}
+
+	for _, part := range expectedParts {
+		if !strings.Contains(md, part) {
+			t.Errorf("Expected markdown to contain %q, got:\n%s", part, md)
+		}
+	}
+
+	// Replies should be nested under their parent, before the next thread
+	if strings.Index(md, "A reply") > strings.Index(md, "Second thread") {
+		t.Error("Expected reply to be rendered before the next top-level comment")
+	}
+}
+
+func TestIssueMarkdown_Minimal(t *testing.T) {
+	issue := &api.Issue{Identifier: "ENG-1", Title: "Plain", Priority: 3}
+
+	md := IssueMarkdown(issue)
+
+	if !strings.Contains(md, "| Assignee | Unassigned |") {
+		t.Errorf("Expected unassigned row, got:\n%s", md)
+	}
+	if !strings.Contains(md, "| Priority | 3 |") {
+		t.Errorf("Expected numeric priority fallback, got:\n%s", md)
+	}
+	if strings.Contains(md, "## Description") || strings.Contains(md, "## Comments") {
+		t.Errorf("Expected no description or comments sections, got:\n%s", md)
+	}
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"plain text", "plain text"},
+		{"a_b*c", "a\\_b\\*c"},
+		{"[link](url)", "\\[link\\](url)"},
+		{"# heading", "\\# heading"},
+		{"back\\slash", "back\\\\slash"},
+	}
+
+	for _, tt := range tests {
+		if got := EscapeMarkdown(tt.input); got != tt.expected {
+			t.Errorf("EscapeMarkdown(%q) = %q, expected %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestIssueFrontMatterMarkdown(t *testing.T) {
+	created := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+	estimate := 3.0
+	due := "2024-06-01"
+
+	issue := &api.Issue{
+		ID:          "uuid-42",
+		Identifier:  "ENG-42",
+		Title:       "Fix: login *loop*",
+		Description: "Steps to reproduce\n",
+		Priority:    2,
+		Estimate:    &estimate,
+		DueDate:     &due,
+		CreatedAt:   created,
+		UpdatedAt:   created,
+		URL:         "https://linear.app/acme/issue/ENG-42",
+		State:       &api.State{Name: "In Progress"},
+		Assignee:    &api.User{Name: "Jane Doe", Email: "jane@example.com"},
+		Team:        &api.Team{Key: "ENG"},
+		Labels:      &api.Labels{Nodes: []api.Label{{Name: "bug"}}},
+	}
+
+	md, err := IssueFrontMatterMarkdown(issue)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `---
+id: uuid-42
+identifier: ENG-42
+title: 'Fix: login *loop*'
+state: In Progress
+priority: 2
+assignee: jane@example.com
+team: ENG
+labels:
+    - bug
+estimate: 3
+due_date: "2024-06-01"
+created: "2024-05-01T10:30:00Z"
+updated: "2024-05-01T10:30:00Z"
+url: https://linear.app/acme/issue/ENG-42
+---
+
+# ENG-42: Fix: login \*loop\*
+
+Steps to reproduce
+`
+	if md != expected {
+		t.Errorf("Unexpected output:\n%s\nExpected:\n%s", md, expected)
+	}
+}
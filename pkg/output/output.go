@@ -3,11 +3,13 @@ package output
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
+	"gopkg.in/yaml.v3"
 )
 
 // TableData represents data for table output
@@ -16,22 +18,83 @@ type TableData struct {
 	Rows    [][]string
 }
 
-// JSON outputs data as JSON
+// JSON outputs data as JSON, or as YAML when that is the effective output format
 func JSON(data interface{}) {
+	if quiet {
+		return
+	}
+	if currentFormat == FormatYAML {
+		YAML(data)
+		return
+	}
+
+	writeJSON(os.Stdout, data)
+}
+
+// writeJSON writes data to w as indented JSON
+func writeJSON(w io.Writer, data interface{}) {
 	jsonData, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error marshaling JSON: %v\n", err)
 		os.Exit(1)
 	}
+	fmt.Fprintln(w, string(jsonData))
+}
+
+// JSONLine outputs data as a single line of JSON, for newline-delimited streams
+func JSONLine(data interface{}) {
+	if quiet {
+		return
+	}
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error marshaling JSON: %v\n", err)
+		os.Exit(1)
+	}
 	fmt.Println(string(jsonData))
 }
 
-// Error outputs an error message
+// YAML outputs data as YAML
+func YAML(data interface{}) {
+	if quiet {
+		return
+	}
+	// Round-trip through JSON so YAML keys match the JSON field names
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error marshaling YAML: %v\n", err)
+		os.Exit(1)
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(jsonData, &generic); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marshaling YAML: %v\n", err)
+		os.Exit(1)
+	}
+
+	yamlData, err := yaml.Marshal(generic)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error marshaling YAML: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Print(string(yamlData))
+}
+
+// Error outputs an error message. In quiet mode a JSON error is written to
+// stderr, as stdout is discarded.
 func Error(message string, plaintext, jsonOut bool) {
-	if jsonOut {
-		JSON(map[string]interface{}{
-			"error": message,
-		})
+	ErrorDetails(message, map[string]interface{}{
+		"error": message,
+	}, plaintext, jsonOut)
+}
+
+// ErrorDetails outputs details in JSON mode and message otherwise, for errors
+// that scripts need to inspect. Like Error, quiet mode writes JSON to stderr.
+func ErrorDetails(message string, details interface{}, plaintext, jsonOut bool) {
+	if jsonOut && quiet {
+		writeJSON(os.Stderr, details)
+	} else if jsonOut {
+		JSON(details)
 	} else if plaintext {
 		fmt.Fprintf(os.Stderr, "Error: %s\n", message)
 	} else {
@@ -41,6 +104,9 @@ func Error(message string, plaintext, jsonOut bool) {
 
 // Success outputs a success message
 func Success(message string, plaintext, jsonOut bool) {
+	if quiet {
+		return
+	}
 	if jsonOut {
 		JSON(map[string]interface{}{
 			"status":  "success",
@@ -55,6 +121,14 @@ func Success(message string, plaintext, jsonOut bool) {
 
 // Table outputs data in table format
 func Table(data TableData, plaintext, jsonOut bool) {
+	if quiet {
+		return
+	}
+	if currentFormat == FormatCSV && !jsonOut {
+		writeCSV(data)
+		return
+	}
+
 	if jsonOut {
 		// Convert table data to JSON
 		jsonData := make([]map[string]interface{}, len(data.Rows))
@@ -94,7 +168,7 @@ func Table(data TableData, plaintext, jsonOut bool) {
 	table.SetRowSeparator("")
 	table.SetHeaderLine(false)
 	table.SetBorder(false)
-	table.SetTablePadding("   ")
+	table.SetTablePadding(tablePadding)
 	table.SetNoWhiteSpace(true)
 
 	// Add color to headers
@@ -104,7 +178,7 @@ func Table(data TableData, plaintext, jsonOut bool) {
 	}
 	table.SetHeader(coloredHeaders)
 
-	for _, row := range data.Rows {
+	for _, row := range fitRows(data.Headers, data.Rows, tableWidth, wrapCells) {
 		table.Append(row)
 	}
 	table.Render()
@@ -112,6 +186,9 @@ func Table(data TableData, plaintext, jsonOut bool) {
 
 // Info outputs an informational message
 func Info(message string, plaintext, jsonOut bool) {
+	if quiet {
+		return
+	}
 	if jsonOut {
 		JSON(map[string]interface{}{
 			"info": message,
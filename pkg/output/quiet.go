@@ -0,0 +1,52 @@
+package output
+
+import (
+	"io"
+	"os"
+)
+
+var (
+	// quiet suppresses all non-error output
+	quiet bool
+	// terminal is os.Stdout as it was before quiet mode replaced it
+	terminal *os.File
+)
+
+// SetQuiet enables or disables quiet mode. While quiet, the output helpers
+// other than Error print nothing and os.Stdout is pointed at the null device so
+// results printed directly by commands are dropped too; errors still go to stderr.
+func SetQuiet(q bool) error {
+	if q == quiet {
+		return nil
+	}
+
+	if q {
+		devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+		if err != nil {
+			return err
+		}
+		terminal = os.Stdout
+		os.Stdout = devNull
+	} else {
+		_ = os.Stdout.Close()
+		os.Stdout = terminal
+		terminal = nil
+	}
+
+	quiet = q
+	return nil
+}
+
+// Quiet reports whether quiet mode is enabled
+func Quiet() bool {
+	return quiet
+}
+
+// PromptWriter returns where interactive prompts should be written. In quiet
+// mode this is still the terminal, since a prompt waiting for input must be seen.
+func PromptWriter() io.Writer {
+	if quiet {
+		return terminal
+	}
+	return os.Stdout
+}
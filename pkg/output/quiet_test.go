@@ -0,0 +1,73 @@
+package output
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+// captureStdout returns what fn writes to os.Stdout
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Failed to create pipe: %v", err)
+	}
+	original := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = original }()
+
+	fn()
+
+	w.Close()
+	data, _ := io.ReadAll(r)
+	return string(data)
+}
+
+func TestSetQuiet(t *testing.T) {
+	out := captureStdout(t, func() {
+		if err := SetQuiet(true); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer func() { _ = SetQuiet(false) }()
+
+		if !Quiet() {
+			t.Error("Expected quiet mode to be enabled")
+		}
+		JSON(map[string]string{"id": "ENG-1"})
+		Success("done", true, false)
+		Info("note", true, false)
+		Table(TableData{Headers: []string{"ID"}, Rows: [][]string{{"ENG-1"}}}, true, false)
+		os.Stdout.WriteString("direct\n")
+		io.WriteString(PromptWriter(), "Enter your key: ")
+	})
+
+	if out != "Enter your key: " {
+		t.Errorf("Expected only the prompt in quiet mode, got %q", out)
+	}
+	if Quiet() {
+		t.Error("Expected quiet mode to be disabled again")
+	}
+
+	out = captureStdout(t, func() {
+		Success("done", true, false)
+	})
+	if !strings.Contains(out, "done") {
+		t.Errorf("Expected output once quiet mode is off, got %q", out)
+	}
+}
+
+func TestErrorDetails_JSON(t *testing.T) {
+	out := captureStdout(t, func() {
+		ErrorDetails("title is too short", map[string]interface{}{
+			"success":           false,
+			"validation_errors": []map[string]string{{"field": "title"}},
+		}, false, true)
+	})
+
+	if !strings.Contains(out, `"validation_errors"`) || strings.Contains(out, "too short") {
+		t.Errorf("Expected the details, not the message, in JSON mode, got %q", out)
+	}
+}
@@ -0,0 +1,104 @@
+package output
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+var (
+	headingPattern  = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	bulletPattern   = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	orderedPattern  = regexp.MustCompile(`^(\s*)(\d+[.)])\s+(.*)$`)
+	rulePattern     = regexp.MustCompile(`^\s*([-*_])(\s*[-*_]){2,}\s*$`)
+	codeSpanPattern = regexp.MustCompile("`[^`\n]+`")
+	boldPattern     = regexp.MustCompile(`\*\*([^*\n]+)\*\*|__([^_\n]+)__`)
+	italicPattern   = regexp.MustCompile(`\*([^*\s][^*\n]*)\*`)
+	strikePattern   = regexp.MustCompile(`~~([^~\n]+)~~`)
+	linkPattern     = regexp.MustCompile(`\[([^\]\n]+)\]\(([^)\s]+)\)`)
+)
+
+// RenderMarkdown renders Markdown for the terminal: headings are bold, list
+// bullets become •, code is highlighted and link targets follow their text.
+// It covers the syntax common in comments rather than all of CommonMark, and
+// leaves anything it does not recognise as written.
+func RenderMarkdown(md string) string {
+	var b strings.Builder
+	inCode := false
+	first := true
+
+	for _, line := range strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+			continue
+		}
+
+		if !first {
+			b.WriteString("\n")
+		}
+		first = false
+
+		if inCode {
+			b.WriteString(color.New(color.FgYellow).Sprint("    " + line))
+			continue
+		}
+
+		switch {
+		case headingPattern.MatchString(line):
+			m := headingPattern.FindStringSubmatch(line)
+			style := color.New(color.Bold)
+			if len(m[1]) == 1 {
+				style = color.New(color.Bold, color.Underline)
+			}
+			b.WriteString(style.Sprint(renderInline(m[2])))
+		case rulePattern.MatchString(line):
+			b.WriteString(color.New(color.Faint).Sprint(strings.Repeat("─", 40)))
+		case strings.HasPrefix(strings.TrimSpace(line), ">"):
+			quote := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), ">"))
+			b.WriteString(color.New(color.Faint).Sprint("│ ") + color.New(color.Italic).Sprint(renderInline(quote)))
+		case bulletPattern.MatchString(line):
+			m := bulletPattern.FindStringSubmatch(line)
+			b.WriteString(m[1] + "  • " + renderInline(m[2]))
+		case orderedPattern.MatchString(line):
+			m := orderedPattern.FindStringSubmatch(line)
+			b.WriteString(m[1] + "  " + m[2] + " " + renderInline(m[3]))
+		default:
+			b.WriteString(renderInline(line))
+		}
+	}
+
+	return b.String()
+}
+
+// renderInline styles emphasis, code spans and links within a line. Code spans
+// are rendered verbatim so asterisks inside them are not treated as emphasis.
+func renderInline(text string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range codeSpanPattern.FindAllStringIndex(text, -1) {
+		b.WriteString(renderEmphasis(text[last:loc[0]]))
+		b.WriteString(color.New(color.FgCyan).Sprint(text[loc[0]+1 : loc[1]-1]))
+		last = loc[1]
+	}
+	b.WriteString(renderEmphasis(text[last:]))
+	return b.String()
+}
+
+// renderEmphasis styles links, bold, italic and ~~strikethrough~~ text
+func renderEmphasis(text string) string {
+	text = linkPattern.ReplaceAllStringFunc(text, func(s string) string {
+		m := linkPattern.FindStringSubmatch(s)
+		return color.New(color.Underline).Sprint(m[1]) + color.New(color.Faint).Sprint(" ("+m[2]+")")
+	})
+	text = boldPattern.ReplaceAllStringFunc(text, func(s string) string {
+		m := boldPattern.FindStringSubmatch(s)
+		return color.New(color.Bold).Sprint(m[1] + m[2])
+	})
+	text = italicPattern.ReplaceAllStringFunc(text, func(s string) string {
+		return color.New(color.Italic).Sprint(s[1 : len(s)-1])
+	})
+	return strikePattern.ReplaceAllStringFunc(text, func(s string) string {
+		return color.New(color.CrossedOut).Sprint(s[2 : len(s)-2])
+	})
+}
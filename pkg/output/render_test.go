@@ -0,0 +1,95 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "plain text is unchanged",
+			input:    "LGTM, thanks",
+			expected: "LGTM, thanks",
+		},
+		{
+			name:     "heading loses its markers",
+			input:    "## Summary ##",
+			expected: "Summary",
+		},
+		{
+			name:     "bullets",
+			input:    "- one\n* two\n  + nested",
+			expected: "  • one\n  • two\n    • nested",
+		},
+		{
+			name:     "ordered list keeps numbers",
+			input:    "1. first\n2) second",
+			expected: "  1. first\n  2) second",
+		},
+		{
+			name:     "emphasis and strikethrough",
+			input:    "**bold** __also bold__ *italic* ~~gone~~",
+			expected: "bold also bold italic gone",
+		},
+		{
+			name:     "link shows its target",
+			input:    "See [the docs](https://example.com/docs)",
+			expected: "See the docs (https://example.com/docs)",
+		},
+		{
+			name:     "code span is not emphasised",
+			input:    "Run `make *all*` now",
+			expected: "Run make *all* now",
+		},
+		{
+			name:     "fenced code is indented verbatim",
+			input:    "Before\n```go\nx := **y**\n```\nAfter",
+			expected: "Before\n    x := **y**\nAfter",
+		},
+		{
+			name:     "block quote",
+			input:    "> quoted *text*",
+			expected: "│ quoted text",
+		},
+		{
+			name:     "horizontal rule",
+			input:    "---",
+			expected: "────────────────────────────────────────",
+		},
+		{
+			name:     "unmatched markers are left alone",
+			input:    "2 * 3 = 6 and a_b_c",
+			expected: "2 * 3 = 6 and a_b_c",
+		},
+	}
+
+	noColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = noColor }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderMarkdown(tt.input); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRenderMarkdown_Styles(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = noColor }()
+
+	got := RenderMarkdown("**bold**")
+	expected := color.New(color.Bold).Sprint("bold")
+	if got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
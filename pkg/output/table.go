@@ -0,0 +1,199 @@
+package output
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/olekukonko/tablewriter"
+)
+
+// DefaultTableWidth is the table width used when stdout is not a terminal
+const DefaultTableWidth = 120
+
+// tablePadding separates table columns
+const tablePadding = "   "
+
+// minColumnWidth is the narrowest a column is shrunk to fit the table width
+const minColumnWidth = 8
+
+var (
+	// tableWidth is the width tables are fitted to; 0 disables fitting
+	tableWidth int
+	// wrapCells wraps cells that do not fit instead of truncating them
+	wrapCells bool
+)
+
+// ansiPattern matches the color escape sequences used in table cells
+var ansiPattern = regexp.MustCompile("\x1b\\[[0-9;]*[mK]")
+
+// ansiReset ends any color started in a cell
+const ansiReset = "\x1b[0m"
+
+// SetTableWidth sets the width rich tables are fitted to (--width). Wider
+// columns are truncated with an ellipsis, or wrapped after SetWrap(true).
+// 0 disables fitting.
+func SetTableWidth(width int) {
+	tableWidth = width
+}
+
+// SetWrap makes rich tables wrap cells that do not fit instead of truncating
+// them (--no-truncate)
+func SetWrap(wrap bool) {
+	wrapCells = wrap
+}
+
+// fitRows returns rows with each cell truncated or wrapped to its column's
+// share of width. Plaintext and CSV output are never fitted.
+func fitRows(headers []string, rows [][]string, width int, wrap bool) [][]string {
+	if width <= 0 || len(rows) == 0 {
+		return rows
+	}
+
+	columns := len(headers)
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+
+	natural := make([]int, columns)
+	floor := make([]int, columns)
+	for i := range natural {
+		if i < len(headers) {
+			natural[i] = tablewriter.DisplayWidth(headers[i])
+		}
+		floor[i] = natural[i]
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if w := cellWidth(cell); w > natural[i] {
+				natural[i] = w
+			}
+		}
+	}
+	for i := range floor {
+		if floor[i] < minColumnWidth {
+			floor[i] = minColumnWidth
+		}
+		if floor[i] > natural[i] {
+			floor[i] = natural[i]
+		}
+	}
+
+	widths := columnWidths(natural, floor, width-len(tablePadding)*(columns-1))
+
+	fitted := make([][]string, len(rows))
+	for r, row := range rows {
+		fitted[r] = make([]string, len(row))
+		for i, cell := range row {
+			switch {
+			case cellWidth(cell) <= widths[i]:
+				fitted[r][i] = cell
+			case wrap:
+				fitted[r][i] = wrapCell(cell, widths[i])
+			default:
+				fitted[r][i] = truncateCell(cell, widths[i])
+			}
+		}
+	}
+	return fitted
+}
+
+// cellWidth is the display width of the widest line of cell
+func cellWidth(cell string) int {
+	width := 0
+	for _, line := range strings.Split(cell, "\n") {
+		if w := tablewriter.DisplayWidth(line); w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+// columnWidths shrinks the widest columns, one character at a time, until
+// the columns fit budget or every column is at its floor
+func columnWidths(natural, floor []int, budget int) []int {
+	widths := append([]int(nil), natural...)
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+
+	for total > budget {
+		widest := -1
+		for i, w := range widths {
+			if w > floor[i] && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+		total--
+	}
+	return widths
+}
+
+// truncateCell shortens cell to width display columns, ending it with an
+// ellipsis. Color escape sequences are kept and closed.
+func truncateCell(cell string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	used := 0
+	colored := false
+	for len(cell) > 0 {
+		if loc := ansiPattern.FindStringIndex(cell); loc != nil && loc[0] == 0 {
+			b.WriteString(cell[:loc[1]])
+			cell = cell[loc[1]:]
+			colored = true
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(cell)
+		w := runewidth.RuneWidth(r)
+		if r == '\n' || used+w > width-1 {
+			break
+		}
+		b.WriteRune(r)
+		used += w
+		cell = cell[size:]
+	}
+
+	b.WriteString("…")
+	if colored {
+		b.WriteString(ansiReset)
+	}
+	return b.String()
+}
+
+// wrapCell wraps cell at word boundaries to width display columns, keeping a
+// leading color on every line. Words longer than width are left whole.
+func wrapCell(cell string, width int) string {
+	prefix := ""
+	for {
+		loc := ansiPattern.FindStringIndex(cell[len(prefix):])
+		if loc == nil || loc[0] != 0 {
+			break
+		}
+		prefix += cell[len(prefix) : len(prefix)+loc[1]]
+	}
+	plain := ansiPattern.ReplaceAllString(cell, "")
+
+	var lines []string
+	for _, paragraph := range strings.Split(plain, "\n") {
+		wrapped, _ := tablewriter.WrapString(paragraph, width)
+		lines = append(lines, wrapped...)
+	}
+	if prefix != "" {
+		for i, line := range lines {
+			lines[i] = prefix + line + ansiReset
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,84 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+func TestFitRows_Truncates(t *testing.T) {
+	headers := []string{"ID", "Title", "URL"}
+	rows := [][]string{
+		{"ENG-1", "A title that is far too long for a narrow terminal", "https://linear.app/team/issue/ENG-1/a-title"},
+		{"ENG-2", "Short", "https://linear.app/x"},
+	}
+
+	fitted := fitRows(headers, rows, 60, false)
+
+	for _, row := range fitted {
+		total := len(tablePadding) * (len(row) - 1)
+		for _, cell := range row {
+			total += tablewriter.DisplayWidth(cell)
+		}
+		if total > 60 {
+			t.Errorf("Expected row to fit 60 columns, got %d: %q", total, row)
+		}
+	}
+	if fitted[0][0] != "ENG-1" {
+		t.Errorf("Expected narrow columns to be left alone, got %q", fitted[0][0])
+	}
+	if !strings.HasSuffix(fitted[0][1], "…") || !strings.HasPrefix(fitted[0][1], "A title") {
+		t.Errorf("Expected the title to be truncated with an ellipsis, got %q", fitted[0][1])
+	}
+	if fitted[1][1] != "Short" {
+		t.Errorf("Expected short cells to be unchanged, got %q", fitted[1][1])
+	}
+}
+
+func TestFitRows_Unlimited(t *testing.T) {
+	rows := [][]string{{"a very long cell that would not fit anywhere narrow"}}
+	if fitted := fitRows([]string{"Cell"}, rows, 0, false); fitted[0][0] != rows[0][0] {
+		t.Errorf("Expected width 0 to leave cells untouched, got %q", fitted[0][0])
+	}
+}
+
+func TestFitRows_Wraps(t *testing.T) {
+	rows := [][]string{{"ENG-1", "one two three four five six seven eight nine ten"}}
+
+	fitted := fitRows([]string{"ID", "Title"}, rows, 30, true)
+
+	lines := strings.Split(fitted[0][1], "\n")
+	if len(lines) < 2 {
+		t.Fatalf("Expected the title to wrap, got %q", fitted[0][1])
+	}
+	for _, line := range lines {
+		if tablewriter.DisplayWidth(line) > 22 {
+			t.Errorf("Expected wrapped lines within 22 columns, got %q", line)
+		}
+	}
+	if strings.Join(strings.Fields(fitted[0][1]), " ") != rows[0][1] {
+		t.Errorf("Expected wrapping to keep every word, got %q", fitted[0][1])
+	}
+}
+
+func TestTruncateCell_Color(t *testing.T) {
+	cell := "\x1b[33mIn Progress\x1b[0m"
+
+	got := truncateCell(cell, 6)
+
+	if got != "\x1b[33mIn Pr…\x1b[0m" {
+		t.Errorf("Expected the color to be kept and closed, got %q", got)
+	}
+	if width := tablewriter.DisplayWidth(got); width != 6 {
+		t.Errorf("Expected a display width of 6, got %d", width)
+	}
+}
+
+func TestWrapCell_Color(t *testing.T) {
+	got := wrapCell("\x1b[36malpha beta\x1b[0m", 5)
+
+	if got != "\x1b[36malpha\x1b[0m\n\x1b[36mbeta\x1b[0m" {
+		t.Errorf("Expected each line to keep the color, got %q", got)
+	}
+}
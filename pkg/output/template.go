@@ -0,0 +1,241 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+// emptyIfMissingFunc is appended to every printing action so that missing
+// fields render as empty, see printMissingAsEmpty
+const emptyIfMissingFunc = "emptyIfMissing"
+
+// templateFuncs are the helper functions available to output templates
+var templateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"join":  strings.Join,
+	"truncate": func(length int, s string) string {
+		if len(s) <= length {
+			return s
+		}
+		if length <= 3 {
+			return s[:length]
+		}
+		return s[:length-3] + "..."
+	},
+	"default": func(def interface{}, value interface{}) interface{} {
+		if value == nil {
+			return def
+		}
+		v := reflect.ValueOf(value)
+		if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+			return def
+		}
+		// Nil structs are rendered as empty maps, see templateValue
+		if v.Kind() == reflect.Map && v.Len() == 0 {
+			return def
+		}
+		if v.IsZero() {
+			return def
+		}
+		return value
+	},
+	"date": func(layout string, value interface{}) string {
+		switch t := value.(type) {
+		case time.Time:
+			return t.Format(layout)
+		case *time.Time:
+			if t == nil {
+				return ""
+			}
+			return t.Format(layout)
+		default:
+			return ""
+		}
+	},
+	"json": func(value interface{}) (string, error) {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	},
+	emptyIfMissingFunc: func(value interface{}) interface{} {
+		if value == nil {
+			return ""
+		}
+		return value
+	},
+}
+
+// ParseTemplate parses an output template with the standard helper functions
+func ParseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output template: %w", err)
+	}
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			printMissingAsEmpty(t.Tree, t.Tree.Root)
+		}
+	}
+	return tmpl, nil
+}
+
+// printMissingAsEmpty pipes every action that prints a value through
+// emptyIfMissing. Templates render maps of interface values (see
+// templateValue), for which missingkey=zero still prints "<no value>".
+func printMissingAsEmpty(tree *parse.Tree, node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			printMissingAsEmpty(tree, child)
+		}
+	case *parse.ActionNode:
+		if len(n.Pipe.Decl) > 0 {
+			return
+		}
+		ident := parse.NewIdentifier(emptyIfMissingFunc).SetTree(tree).SetPos(n.Pos)
+		n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{NodeType: parse.NodeCommand, Pos: n.Pos, Args: []parse.Node{ident}})
+	case *parse.IfNode:
+		printMissingAsEmpty(tree, n.List)
+		printMissingAsEmpty(tree, n.ElseList)
+	case *parse.RangeNode:
+		printMissingAsEmpty(tree, n.List)
+		printMissingAsEmpty(tree, n.ElseList)
+	case *parse.WithNode:
+		printMissingAsEmpty(tree, n.List)
+		printMissingAsEmpty(tree, n.ElseList)
+	}
+}
+
+// ParseTemplateFile reads and parses an output template from disk
+func ParseTemplateFile(path string) (*template.Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template file: %w", err)
+	}
+	return ParseTemplate(path, string(data))
+}
+
+// Template parses tmpl and renders data with it to stdout.
+// Slices are rendered one element at a time, each followed by a newline
+func Template(data interface{}, tmpl string) error {
+	parsed, err := ParseTemplate("template", tmpl)
+	if err != nil {
+		return err
+	}
+	return ExecuteTemplate(parsed, data)
+}
+
+// ExecuteTemplate renders data with tmpl to stdout
+// Slices are rendered one element at a time, each followed by a newline
+func ExecuteTemplate(tmpl *template.Template, data interface{}) error {
+	return executeTemplate(os.Stdout, tmpl, data)
+}
+
+// executeTemplate renders data with tmpl to w
+func executeTemplate(w io.Writer, tmpl *template.Template, data interface{}) error {
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Slice {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Slice {
+		return renderTemplateItem(w, tmpl, data)
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		if err := renderTemplateItem(w, tmpl, v.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderTemplateItem renders a single item, ensuring the output ends with a newline
+func renderTemplateItem(w io.Writer, tmpl *template.Template, item interface{}) error {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, templateValue(reflect.ValueOf(item))); err != nil {
+		return fmt.Errorf("failed to render output template: %w", err)
+	}
+
+	out := b.String()
+	if !strings.HasSuffix(out, "\n") {
+		out += "\n"
+	}
+
+	_, err := io.WriteString(w, out)
+	return err
+}
+
+// templateValue converts structs into maps keyed by Go field name so that
+// unknown fields and fields of nil structs render as empty instead of failing
+func templateValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			if v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Struct && v.Type().Elem() != reflect.TypeOf(time.Time{}) {
+				return map[string]interface{}{}
+			}
+			return nil
+		}
+		return templateValue(v.Elem())
+
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			return v.Interface()
+		}
+		fields := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fields[field.Name] = templateValue(v.Field(i))
+		}
+		return fields
+
+	case reflect.Slice, reflect.Array:
+		switch v.Type().Elem().Kind() {
+		case reflect.Struct, reflect.Ptr, reflect.Interface, reflect.Map:
+			if v.Kind() == reflect.Slice && v.IsNil() {
+				return []interface{}{}
+			}
+			items := make([]interface{}, v.Len())
+			for i := range items {
+				items[i] = templateValue(v.Index(i))
+			}
+			return items
+		}
+		return v.Interface()
+
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return v.Interface()
+		}
+		values := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			values[iter.Key().String()] = templateValue(iter.Value())
+		}
+		return values
+
+	default:
+		return v.Interface()
+	}
+}
@@ -0,0 +1,167 @@
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+func TestExecuteTemplate_Slice(t *testing.T) {
+	tmpl, err := ParseTemplate("test", "{{.Identifier}} {{.Title | upper}}")
+	if err != nil {
+		t.Fatalf("Failed to parse template: %v", err)
+	}
+
+	issues := []api.Issue{
+		{Identifier: "ENG-1", Title: "first"},
+		{Identifier: "ENG-2", Title: "second"},
+	}
+
+	var b strings.Builder
+	if err := executeTemplate(&b, tmpl, issues); err != nil {
+		t.Fatalf("Failed to execute template: %v", err)
+	}
+
+	expected := "ENG-1 FIRST\nENG-2 SECOND\n"
+	if b.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, b.String())
+	}
+}
+
+func TestExecuteTemplate_SingleWithHelpers(t *testing.T) {
+	tmpl, err := ParseTemplate("test", `{{.Identifier}} {{date "2006-01-02" .CreatedAt}} {{default "none" .Assignee}} {{truncate 6 .Title}}`)
+	if err != nil {
+		t.Fatalf("Failed to parse template: %v", err)
+	}
+
+	issue := &api.Issue{
+		Identifier: "ENG-3",
+		Title:      "A long title",
+		CreatedAt:  time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	var b strings.Builder
+	if err := executeTemplate(&b, tmpl, issue); err != nil {
+		t.Fatalf("Failed to execute template: %v", err)
+	}
+
+	expected := "ENG-3 2024-03-01 none A l...\n"
+	if b.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, b.String())
+	}
+}
+
+func TestParseTemplateFile(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "report.tmpl")
+	if err := os.WriteFile(valid, []byte("{{.Identifier}}: {{.Title}}\n"), 0644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+
+	tmpl, err := ParseTemplateFile(valid)
+	if err != nil {
+		t.Fatalf("Failed to parse template file: %v", err)
+	}
+
+	var b strings.Builder
+	if err := executeTemplate(&b, tmpl, api.Issue{Identifier: "ENG-4", Title: "From file"}); err != nil {
+		t.Fatalf("Failed to execute template: %v", err)
+	}
+	if b.String() != "ENG-4: From file\n" {
+		t.Errorf("Unexpected output %q", b.String())
+	}
+
+	invalid := filepath.Join(dir, "broken.tmpl")
+	if err := os.WriteFile(invalid, []byte("{{.Identifier"), 0644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+
+	if _, err := ParseTemplateFile(invalid); err == nil || !strings.Contains(err.Error(), "invalid output template") {
+		t.Errorf("Expected parse error, got %v", err)
+	}
+
+	if _, err := ParseTemplateFile(filepath.Join(dir, "missing.tmpl")); err == nil {
+		t.Error("Expected error for missing template file")
+	}
+}
+
+func TestExecuteTemplate_MissingFieldsRenderEmpty(t *testing.T) {
+	tmpl, err := ParseTemplate("test", "{{.Identifier}}|{{.Assignee.Name}}|{{.NoSuchField}}|{{.State.Name}}")
+	if err != nil {
+		t.Fatalf("Failed to parse template: %v", err)
+	}
+
+	issue := &api.Issue{Identifier: "ENG-4", State: &api.State{Name: "Todo"}}
+
+	var b strings.Builder
+	if err := executeTemplate(&b, tmpl, issue); err != nil {
+		t.Fatalf("Expected missing fields to render empty, got error: %v", err)
+	}
+
+	expected := "ENG-4|||Todo\n"
+	if b.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, b.String())
+	}
+}
+
+func TestExecuteTemplate_MissingFieldsInBlocks(t *testing.T) {
+	tmpl, err := ParseTemplate("test", `{{define "state"}}{{.Missing}}{{.Name}}{{end}}{{.Title}}|{{with .State}}{{template "state" .}}{{end}}|{{range .Labels.Nodes}}{{.Name}}{{.Missing}};{{end}}|{{if .Identifier}}{{.NoSuchField}}{{else}}{{.Other}}{{end}}`)
+	if err != nil {
+		t.Fatalf("Failed to parse template: %v", err)
+	}
+
+	// A field whose value happens to be "<no value>" must be printed as is
+	issue := api.Issue{
+		Identifier: "ENG-6",
+		Title:      "<no value>",
+		State:      &api.State{Name: "Todo"},
+		Labels:     &api.Labels{Nodes: []api.Label{{Name: "bug"}}},
+	}
+
+	var b strings.Builder
+	if err := executeTemplate(&b, tmpl, issue); err != nil {
+		t.Fatalf("Failed to execute template: %v", err)
+	}
+
+	expected := "<no value>|Todo|bug;|\n"
+	if b.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, b.String())
+	}
+}
+
+func TestExecuteTemplate_NestedSlices(t *testing.T) {
+	tmpl, err := ParseTemplate("test", `{{.Identifier}}{{range .Labels.Nodes}} {{.Name}}{{end}}{{if not .CompletedAt}} open{{end}}`)
+	if err != nil {
+		t.Fatalf("Failed to parse template: %v", err)
+	}
+
+	issue := api.Issue{
+		Identifier: "ENG-5",
+		Labels:     &api.Labels{Nodes: []api.Label{{Name: "bug"}, {Name: "ui"}}},
+	}
+
+	var b strings.Builder
+	if err := executeTemplate(&b, tmpl, []api.Issue{issue}); err != nil {
+		t.Fatalf("Failed to execute template: %v", err)
+	}
+
+	expected := "ENG-5 bug ui open\n"
+	if b.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, b.String())
+	}
+}
+
+func TestTemplate_ParseError(t *testing.T) {
+	err := Template([]api.Issue{}, "{{.Identifier")
+	if err == nil {
+		t.Fatal("Expected parse error")
+	}
+	if !strings.Contains(err.Error(), "invalid output template") {
+		t.Errorf("Expected clear template error, got %v", err)
+	}
+}
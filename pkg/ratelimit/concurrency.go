@@ -0,0 +1,195 @@
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/logging"
+)
+
+// ConcurrencyConfig defines adaptive concurrency configuration. The most
+// workers allowed comes from the rate limiter's MaxConcurrency
+// (LINCTL_MAX_CONCURRENCY), so there is one setting for every batch operation.
+type ConcurrencyConfig struct {
+	MinWorkers int  `json:"min_workers"`
+	Adaptive   bool `json:"adaptive"`
+}
+
+// DefaultConcurrencyConfig returns a sensible default concurrency configuration
+func DefaultConcurrencyConfig() ConcurrencyConfig {
+	return ConcurrencyConfig{
+		MinWorkers: 1,
+		Adaptive:   true,
+	}
+}
+
+// concurrencyRampAfter is the number of consecutive successful responses
+// required before a limit reduced by a rate limit response is raised by one
+const concurrencyRampAfter = 10
+
+// ConcurrencyController sizes the worker pool of batch operations. It scales
+// the pool by the rate limit quota most recently observed by a RateLimiter,
+// halves it when a response passed to UpdateFromResponse is a 429 or reports
+// the quota exhausted, and ramps back up by one after a run of successes.
+type ConcurrencyController struct {
+	config     ConcurrencyConfig
+	maxWorkers int
+	limiter    *RateLimiter
+	logger     logging.Logger
+	now        func() time.Time
+	rampAfter  int
+
+	mu        sync.Mutex
+	lastLimit int
+	// backoffLimit caps the pool after rate limit responses
+	backoffLimit int
+	successes    int
+}
+
+// NewConcurrencyController creates a new concurrency controller allowing up
+// to the limiter's MaxConcurrency workers, or the default when limiter is nil.
+// The controller registers itself with limiter, so the limiter's GetStatus
+// reports its current worker limit.
+func NewConcurrencyController(config ConcurrencyConfig, limiter *RateLimiter, logger logging.Logger) *ConcurrencyController {
+	if logger == nil {
+		logger = logging.NewNoOpLogger()
+	}
+
+	maxWorkers := DefaultRateLimitConfig().MaxConcurrency
+	if limiter != nil {
+		maxWorkers = limiter.config.MaxConcurrency
+	}
+	if maxWorkers < 1 {
+		maxWorkers = 1
+	}
+	if config.MinWorkers < 1 {
+		config.MinWorkers = 1
+	}
+	if config.MinWorkers > maxWorkers {
+		config.MinWorkers = maxWorkers
+	}
+
+	cc := &ConcurrencyController{
+		config:       config,
+		maxWorkers:   maxWorkers,
+		limiter:      limiter,
+		logger:       logger,
+		now:          time.Now,
+		rampAfter:    concurrencyRampAfter,
+		lastLimit:    maxWorkers,
+		backoffLimit: maxWorkers,
+	}
+	if limiter != nil {
+		limiter.setConcurrencyController(cc)
+	}
+	return cc
+}
+
+// Limit returns the number of workers that may currently be active
+func (cc *ConcurrencyController) Limit() int {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	limit := cc.calculateLimit()
+	if limit != cc.lastLimit {
+		cc.logger.Debug("Adaptive concurrency updated",
+			logging.Int("old_workers", cc.lastLimit),
+			logging.Int("new_workers", limit),
+		)
+		cc.lastLimit = limit
+	}
+
+	return limit
+}
+
+// MaxWorkers returns the configured upper bound on concurrent workers
+func (cc *ConcurrencyController) MaxWorkers() int {
+	return cc.maxWorkers
+}
+
+// UpdateFromResponse passes resp to the rate limiter, then halves the worker
+// limit on a 429 or an exhausted quota, or counts a success towards raising
+// it again. Pass it as the response hook of the requests the pool makes.
+func (cc *ConcurrencyController) UpdateFromResponse(resp *http.Response) {
+	var info *LinearRateInfo
+	if cc.limiter != nil {
+		info = cc.limiter.updateFromResponse(resp)
+	}
+	if !cc.config.Adaptive {
+		return
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests || (info != nil && info.Remaining == 0):
+		cc.successes = 0
+		next := cc.backoffLimit / 2
+		if next < cc.config.MinWorkers {
+			next = cc.config.MinWorkers
+		}
+		if next != cc.backoffLimit {
+			cc.logger.Warn("Reducing concurrency after rate limit",
+				logging.Int("old_workers", cc.backoffLimit),
+				logging.Int("new_workers", next),
+			)
+			cc.backoffLimit = next
+		}
+	case resp.StatusCode < http.StatusBadRequest && cc.backoffLimit < cc.maxWorkers:
+		cc.successes++
+		if cc.successes >= cc.rampAfter {
+			cc.successes = 0
+			cc.backoffLimit++
+		}
+	}
+}
+
+// calculateLimit scales the worker count by the fraction of quota remaining,
+// capped by any backoff after rate limit responses; callers must hold cc.mu
+func (cc *ConcurrencyController) calculateLimit() int {
+	if !cc.config.Adaptive {
+		return cc.maxWorkers
+	}
+
+	workers := cc.quotaLimit()
+	if workers > cc.backoffLimit {
+		workers = cc.backoffLimit
+	}
+	if workers < cc.config.MinWorkers {
+		workers = cc.config.MinWorkers
+	}
+	return workers
+}
+
+// quotaLimit scales the worker count by the fraction of quota remaining
+func (cc *ConcurrencyController) quotaLimit() int {
+	if cc.limiter == nil {
+		return cc.maxWorkers
+	}
+
+	info := cc.limiter.LastRateInfo()
+	if info == nil || info.Limit <= 0 {
+		return cc.maxWorkers
+	}
+
+	// Quota is restored once the reset time has passed
+	if !info.Reset.IsZero() && !cc.now().Before(info.Reset) {
+		return cc.maxWorkers
+	}
+
+	ratio := float64(info.Remaining) / float64(info.Limit)
+	workers := int(math.Ceil(float64(cc.maxWorkers) * ratio))
+
+	// Never run more workers than there are requests left
+	if workers > info.Remaining {
+		workers = info.Remaining
+	}
+	if workers > cc.maxWorkers {
+		workers = cc.maxWorkers
+	}
+
+	return workers
+}
@@ -0,0 +1,179 @@
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/logging"
+)
+
+func newRateLimitResponse(limit, remaining int, reset time.Time) *http.Response {
+	resp := &http.Response{
+		Header: make(http.Header),
+	}
+	resp.Header.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	resp.Header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	return resp
+}
+
+// newLimiterWithConcurrency returns a rate limiter allowing maxConcurrency requests in flight
+func newLimiterWithConcurrency(maxConcurrency int) *RateLimiter {
+	config := DefaultRateLimitConfig()
+	config.MaxConcurrency = maxConcurrency
+	return NewRateLimiter(config, logging.NewNoOpLogger())
+}
+
+func TestNewConcurrencyController(t *testing.T) {
+	controller := NewConcurrencyController(ConcurrencyConfig{MinWorkers: 5}, newLimiterWithConcurrency(0), nil)
+
+	if controller.MaxWorkers() != 1 {
+		t.Errorf("Expected max workers to be clamped to 1, got %d", controller.MaxWorkers())
+	}
+
+	if controller.config.MinWorkers != 1 {
+		t.Errorf("Expected min workers to be clamped to 1, got %d", controller.config.MinWorkers)
+	}
+
+	// The limiter's MaxConcurrency (LINCTL_MAX_CONCURRENCY) is the one setting
+	if controller := NewConcurrencyController(DefaultConcurrencyConfig(), newLimiterWithConcurrency(7), nil); controller.MaxWorkers() != 7 {
+		t.Errorf("Expected max workers from the limiter, got %d", controller.MaxWorkers())
+	}
+	if controller := NewConcurrencyController(DefaultConcurrencyConfig(), nil, nil); controller.MaxWorkers() != DefaultRateLimitConfig().MaxConcurrency {
+		t.Errorf("Expected the default max workers without a limiter, got %d", controller.MaxWorkers())
+	}
+}
+
+func TestConcurrencyController_NoRateInfo(t *testing.T) {
+	limiter := NewRateLimiter(DefaultRateLimitConfig(), logging.NewNoOpLogger())
+	controller := NewConcurrencyController(DefaultConcurrencyConfig(), limiter, logging.NewNoOpLogger())
+
+	if limit := controller.Limit(); limit != 5 {
+		t.Errorf("Expected full concurrency without rate info, got %d", limit)
+	}
+}
+
+func TestConcurrencyController_ShrinksOnLowRemaining(t *testing.T) {
+	limiter := newLimiterWithConcurrency(10)
+	config := ConcurrencyConfig{MinWorkers: 1, Adaptive: true}
+	controller := NewConcurrencyController(config, limiter, logging.NewNoOpLogger())
+
+	reset := time.Now().Add(time.Hour)
+
+	limiter.UpdateFromResponse(newRateLimitResponse(1000, 1000, reset))
+	if limit := controller.Limit(); limit != 10 {
+		t.Errorf("Expected 10 workers with full quota, got %d", limit)
+	}
+
+	limiter.UpdateFromResponse(newRateLimitResponse(1000, 450, reset))
+	if limit := controller.Limit(); limit != 5 {
+		t.Errorf("Expected 5 workers with 45%% quota, got %d", limit)
+	}
+
+	limiter.UpdateFromResponse(newRateLimitResponse(1000, 20, reset))
+	if limit := controller.Limit(); limit != 1 {
+		t.Errorf("Expected 1 worker with low quota, got %d", limit)
+	}
+}
+
+func TestConcurrencyController_NeverExceedsRemaining(t *testing.T) {
+	limiter := newLimiterWithConcurrency(10)
+	config := ConcurrencyConfig{MinWorkers: 1, Adaptive: true}
+	controller := NewConcurrencyController(config, limiter, logging.NewNoOpLogger())
+
+	limiter.UpdateFromResponse(newRateLimitResponse(4, 3, time.Now().Add(time.Hour)))
+
+	if limit := controller.Limit(); limit != 3 {
+		t.Errorf("Expected workers capped at remaining quota 3, got %d", limit)
+	}
+}
+
+func TestConcurrencyController_RestoresAfterReset(t *testing.T) {
+	limiter := newLimiterWithConcurrency(8)
+	config := ConcurrencyConfig{MinWorkers: 2, Adaptive: true}
+	controller := NewConcurrencyController(config, limiter, logging.NewNoOpLogger())
+
+	reset := time.Now().Add(time.Minute)
+	limiter.UpdateFromResponse(newRateLimitResponse(1000, 0, reset))
+
+	if limit := controller.Limit(); limit != 2 {
+		t.Errorf("Expected min workers with depleted quota, got %d", limit)
+	}
+
+	controller.now = func() time.Time { return reset.Add(time.Second) }
+
+	if limit := controller.Limit(); limit != 8 {
+		t.Errorf("Expected full concurrency after reset, got %d", limit)
+	}
+}
+
+func TestConcurrencyController_NonAdaptive(t *testing.T) {
+	limiter := newLimiterWithConcurrency(4)
+	config := ConcurrencyConfig{MinWorkers: 1, Adaptive: false}
+	controller := NewConcurrencyController(config, limiter, logging.NewNoOpLogger())
+
+	limiter.UpdateFromResponse(newRateLimitResponse(1000, 1, time.Now().Add(time.Hour)))
+
+	if limit := controller.Limit(); limit != 4 {
+		t.Errorf("Expected fixed concurrency in non-adaptive mode, got %d", limit)
+	}
+}
+
+func TestConcurrencyController_BacksOffOnRateLimit(t *testing.T) {
+	limiter := newLimiterWithConcurrency(8)
+	controller := NewConcurrencyController(DefaultConcurrencyConfig(), limiter, nil)
+
+	controller.UpdateFromResponse(newRateLimitResponse(1000, 0, time.Now().Add(-time.Second)))
+	if limit := controller.Limit(); limit != 4 {
+		t.Errorf("Expected workers halved to 4 on exhausted quota, got %d", limit)
+	}
+	if info := limiter.LastRateInfo(); info == nil || info.Remaining != 0 {
+		t.Errorf("Expected the response to reach the rate limiter, got %+v", info)
+	}
+
+	tooMany := &http.Response{StatusCode: http.StatusTooManyRequests, Header: make(http.Header)}
+	for i := 0; i < 3; i++ {
+		controller.UpdateFromResponse(tooMany)
+	}
+	if limit := controller.Limit(); limit != 1 {
+		t.Errorf("Expected workers to bottom out at 1 after 429s, got %d", limit)
+	}
+}
+
+func TestConcurrencyController_RampsUp(t *testing.T) {
+	controller := NewConcurrencyController(DefaultConcurrencyConfig(), newLimiterWithConcurrency(4), nil)
+	tooMany := &http.Response{StatusCode: http.StatusTooManyRequests, Header: make(http.Header)}
+	controller.UpdateFromResponse(tooMany)
+	controller.UpdateFromResponse(tooMany)
+
+	ok := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header)}
+	for i := 0; i < concurrencyRampAfter-1; i++ {
+		controller.UpdateFromResponse(ok)
+	}
+	if limit := controller.Limit(); limit != 1 {
+		t.Fatalf("Expected no ramp before %d successes, got %d", concurrencyRampAfter, limit)
+	}
+
+	controller.UpdateFromResponse(ok)
+	if limit := controller.Limit(); limit != 2 {
+		t.Errorf("Expected workers to ramp to 2, got %d", limit)
+	}
+
+	for i := 0; i < concurrencyRampAfter*5; i++ {
+		controller.UpdateFromResponse(ok)
+	}
+	if limit := controller.Limit(); limit != 4 {
+		t.Errorf("Expected workers capped at max 4, got %d", limit)
+	}
+}
+
+func TestConcurrencyController_NonAdaptiveIgnoresRateLimit(t *testing.T) {
+	controller := NewConcurrencyController(ConcurrencyConfig{MinWorkers: 1}, newLimiterWithConcurrency(4), nil)
+	controller.UpdateFromResponse(&http.Response{StatusCode: http.StatusTooManyRequests, Header: make(http.Header)})
+
+	if limit := controller.Limit(); limit != 4 {
+		t.Errorf("Expected fixed concurrency in non-adaptive mode, got %d", limit)
+	}
+}
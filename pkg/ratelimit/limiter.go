@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -19,6 +20,7 @@ type RateLimitConfig struct {
 	Enabled           bool          `json:"enabled"`
 	AdaptiveMode      bool          `json:"adaptive_mode"`
 	BackoffDelay      time.Duration `json:"backoff_delay"`
+	MaxConcurrency    int           `json:"max_concurrency"`
 }
 
 // DefaultRateLimitConfig returns a sensible default rate limit configuration
@@ -29,6 +31,7 @@ func DefaultRateLimitConfig() RateLimitConfig {
 		Enabled:           true,
 		AdaptiveMode:      true,
 		BackoffDelay:      5 * time.Second,
+		MaxConcurrency:    5,
 	}
 }
 
@@ -37,7 +40,11 @@ type RateLimiter struct {
 	limiter      *rate.Limiter
 	config       RateLimitConfig
 	logger       logging.Logger
+	mu           sync.RWMutex
 	lastRateInfo *LinearRateInfo
+	// concurrency is the controller most recently created for this limiter,
+	// whose live worker limit GetStatus reports
+	concurrency *ConcurrencyController
 }
 
 // LinearRateInfo represents rate limit information from Linear's API
@@ -90,6 +97,66 @@ func (rl *RateLimiter) Wait(ctx context.Context) error {
 	return nil
 }
 
+// ErrRateLimitWait is returned by WaitWithTimeout when a request would have to
+// wait longer than the caller allows
+type ErrRateLimitWait struct {
+	// Wait is how long the request would have had to wait
+	Wait time.Duration
+	// Max is the longest wait the caller allowed
+	Max time.Duration
+	// Reset is when Linear's rate limit window resets, if it was reported
+	Reset time.Time
+}
+
+// Error reports when to try again, rounded up to the next second
+func (e *ErrRateLimitWait) Error() string {
+	seconds := int64((e.Wait + time.Second - 1) / time.Second)
+	return fmt.Sprintf("rate limited, try again in %ds (longer than the %s maximum wait)", seconds, e.Max)
+}
+
+// WaitWithTimeout is Wait, except that it returns an *ErrRateLimitWait instead
+// of blocking when the required delay exceeds maxWait. When Linear has reported that
+// no requests remain, the delay is the time until its window resets. A maxWait of 0
+// or less waits as long as needed.
+func (rl *RateLimiter) WaitWithTimeout(ctx context.Context, maxWait time.Duration) error {
+	if !rl.config.Enabled {
+		return nil
+	}
+	if maxWait <= 0 {
+		return rl.Wait(ctx)
+	}
+
+	now := time.Now()
+	if info := rl.LastRateInfo(); info != nil && info.Remaining <= 0 && info.Reset.After(now) {
+		if wait := info.Reset.Sub(now); wait > maxWait {
+			return &ErrRateLimitWait{Wait: wait, Max: maxWait, Reset: info.Reset}
+		}
+	}
+
+	reservation := rl.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return &ErrRateLimitWait{Max: maxWait}
+	}
+	delay := reservation.DelayFrom(now)
+	if delay > maxWait {
+		reservation.CancelAt(now)
+		return &ErrRateLimitWait{Wait: delay, Max: maxWait}
+	}
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		reservation.Cancel()
+		return ctx.Err()
+	}
+}
+
 // Allow checks if a request is allowed without waiting
 func (rl *RateLimiter) Allow() bool {
 	if !rl.config.Enabled {
@@ -107,16 +174,20 @@ func (rl *RateLimiter) Allow() bool {
 
 // UpdateFromResponse updates the rate limiter based on Linear's response headers
 func (rl *RateLimiter) UpdateFromResponse(resp *http.Response) {
-	if !rl.config.AdaptiveMode {
-		return
-	}
+	rl.updateFromResponse(resp)
+}
 
+// updateFromResponse is UpdateFromResponse, returning the rate limit
+// information parsed from the headers, or nil if there was none
+func (rl *RateLimiter) updateFromResponse(resp *http.Response) *LinearRateInfo {
 	rateInfo := rl.parseRateHeaders(resp)
-	if rateInfo == nil {
-		return
+	if !rl.config.AdaptiveMode || rateInfo == nil {
+		return rateInfo
 	}
 
+	rl.mu.Lock()
 	rl.lastRateInfo = rateInfo
+	rl.mu.Unlock()
 
 	// Adaptive rate limiting based on remaining quota
 	if rateInfo.Remaining > 0 {
@@ -163,6 +234,7 @@ func (rl *RateLimiter) UpdateFromResponse(resp *http.Response) {
 		logging.Int("used", rateInfo.Used),
 		logging.String("reset", rateInfo.Reset.Format(time.RFC3339)),
 	)
+	return rateInfo
 }
 
 // parseRateHeaders extracts rate limit information from HTTP response headers
@@ -238,20 +310,55 @@ func (rl *RateLimiter) GetStatus() map[string]interface{} {
 		"requests_per_second": float64(rl.limiter.Limit()),
 		"burst":               rl.limiter.Burst(),
 		"adaptive_mode":       rl.config.AdaptiveMode,
+		"concurrency":         rl.config.MaxConcurrency,
+		"max_concurrency":     rl.config.MaxConcurrency,
 	}
 
-	if rl.lastRateInfo != nil {
-		status["linear_limit"] = rl.lastRateInfo.Limit
-		status["linear_remaining"] = rl.lastRateInfo.Remaining
-		status["linear_used"] = rl.lastRateInfo.Used
-		if !rl.lastRateInfo.Reset.IsZero() {
-			status["linear_reset"] = rl.lastRateInfo.Reset.Format(time.RFC3339)
+	if controller := rl.concurrencyController(); controller != nil {
+		status["concurrency"] = controller.Limit()
+	}
+
+	if info := rl.LastRateInfo(); info != nil {
+		status["linear_limit"] = info.Limit
+		status["linear_remaining"] = info.Remaining
+		status["linear_used"] = info.Used
+		if !info.Reset.IsZero() {
+			status["linear_reset"] = info.Reset.Format(time.RFC3339)
 		}
 	}
 
 	return status
 }
 
+// setConcurrencyController registers cc as the controller sizing batch
+// operations that share this limiter
+func (rl *RateLimiter) setConcurrencyController(cc *ConcurrencyController) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.concurrency = cc
+}
+
+// concurrencyController returns the registered controller, or nil
+func (rl *RateLimiter) concurrencyController() *ConcurrencyController {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+	return rl.concurrency
+}
+
+// LastRateInfo returns a copy of the most recently observed rate limit
+// information, or nil if no rate limit headers have been seen yet
+func (rl *RateLimiter) LastRateInfo() *LinearRateInfo {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+
+	if rl.lastRateInfo == nil {
+		return nil
+	}
+
+	info := *rl.lastRateInfo
+	return &info
+}
+
 // HandleRateLimitResponse handles a 429 Too Many Requests response
 func (rl *RateLimiter) HandleRateLimitResponse(resp *http.Response) time.Duration {
 	// Update rate info from headers
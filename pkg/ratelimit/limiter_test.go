@@ -2,6 +2,7 @@ package ratelimit
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
 	"testing"
@@ -136,6 +137,69 @@ func TestRateLimiter_WaitContextCancellation(t *testing.T) {
 	}
 }
 
+func TestRateLimiter_WaitWithTimeout(t *testing.T) {
+	config := RateLimitConfig{
+		RequestsPerSecond: 4, // One token every 250ms
+		Burst:             1,
+		Enabled:           true,
+	}
+	limiter := NewRateLimiter(config, logging.NewNoOpLogger())
+
+	if err := limiter.WaitWithTimeout(context.Background(), 50*time.Millisecond); err != nil {
+		t.Fatalf("Expected the burst token to be available, got %v", err)
+	}
+
+	start := time.Now()
+	err := limiter.WaitWithTimeout(context.Background(), 50*time.Millisecond)
+	if elapsed := time.Since(start); elapsed > 25*time.Millisecond {
+		t.Errorf("Expected to fail fast, took %v", elapsed)
+	}
+
+	var waitErr *ErrRateLimitWait
+	if !errors.As(err, &waitErr) {
+		t.Fatalf("Expected ErrRateLimitWait, got %v", err)
+	}
+	if waitErr.Wait <= 50*time.Millisecond || waitErr.Wait > 250*time.Millisecond {
+		t.Errorf("Expected a wait of up to 250ms, got %v", waitErr.Wait)
+	}
+	if !strings.Contains(err.Error(), "try again in 1s") {
+		t.Errorf("Expected the rounded-up wait in the message, got %q", err.Error())
+	}
+
+	// The rejected reservation is returned, so a later wait is not pushed back further
+	start = time.Now()
+	if err := limiter.WaitWithTimeout(context.Background(), time.Second); err != nil {
+		t.Fatalf("Expected a wait within the maximum to succeed, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 400*time.Millisecond {
+		t.Errorf("Expected to wait about 250ms, took %v", elapsed)
+	}
+}
+
+func TestRateLimiter_WaitWithTimeoutUsesReset(t *testing.T) {
+	limiter := NewRateLimiter(DefaultRateLimitConfig(), logging.NewNoOpLogger())
+	reset := time.Now().Add(30 * time.Second)
+	limiter.lastRateInfo = &LinearRateInfo{Limit: 1500, Remaining: 0, Reset: reset}
+
+	err := limiter.WaitWithTimeout(context.Background(), 5*time.Second)
+
+	var waitErr *ErrRateLimitWait
+	if !errors.As(err, &waitErr) {
+		t.Fatalf("Expected ErrRateLimitWait, got %v", err)
+	}
+	if !waitErr.Reset.Equal(reset) {
+		t.Errorf("Expected reset %v, got %v", reset, waitErr.Reset)
+	}
+	if waitErr.Wait < 29*time.Second || waitErr.Wait > 30*time.Second {
+		t.Errorf("Expected about 30s until reset, got %v", waitErr.Wait)
+	}
+
+	// Without a maximum the limiter keeps today's behavior
+	if err := limiter.WaitWithTimeout(context.Background(), 0); err != nil {
+		t.Errorf("Expected no maximum to ignore the reset, got %v", err)
+	}
+}
+
 func TestRateLimiter_Allow(t *testing.T) {
 	config := RateLimitConfig{
 		RequestsPerSecond: 100.0,
@@ -384,6 +448,26 @@ func TestRateLimiter_GetStatus(t *testing.T) {
 	}
 }
 
+func TestRateLimiter_GetStatusConcurrency(t *testing.T) {
+	limiter := NewRateLimiter(DefaultRateLimitConfig(), logging.NewNoOpLogger())
+
+	status := limiter.GetStatus()
+	if status["concurrency"] != 5 || status["max_concurrency"] != 5 {
+		t.Errorf("Expected concurrency=5 and max_concurrency=5 without a controller, got %v", status)
+	}
+
+	controller := NewConcurrencyController(DefaultConcurrencyConfig(), limiter, logging.NewNoOpLogger())
+	controller.UpdateFromResponse(&http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}})
+
+	status = limiter.GetStatus()
+	if status["concurrency"] != controller.Limit() || status["concurrency"] != 2 {
+		t.Errorf("Expected concurrency to report the controller's limit of 2, got %v", status["concurrency"])
+	}
+	if status["max_concurrency"] != 5 {
+		t.Errorf("Expected max_concurrency=5, got %v", status["max_concurrency"])
+	}
+}
+
 func TestRateLimiter_GetStatusWithRateInfo(t *testing.T) {
 	limiter := NewRateLimiter(DefaultRateLimitConfig(), logging.NewNoOpLogger())
 
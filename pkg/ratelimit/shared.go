@@ -0,0 +1,54 @@
+package ratelimit
+
+import (
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/nicholls-inc/linctl/pkg/logging"
+)
+
+// GlobalRateLimitEnvVar opts every EnhancedClient in the process into one
+// shared limiter, so concurrent commands coordinate instead of each spending
+// the full budget
+const GlobalRateLimitEnvVar = "LINCTL_GLOBAL_RATE_LIMIT"
+
+var (
+	sharedMu sync.Mutex
+	shared   *RateLimiter
+)
+
+// GlobalEnabled reports whether LINCTL_GLOBAL_RATE_LIMIT is set to true
+func GlobalEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(GlobalRateLimitEnvVar))
+	return enabled
+}
+
+// Shared returns the process-wide limiter, creating it with
+// DefaultRateLimitConfig if no client has created it yet
+func Shared() *RateLimiter {
+	return SharedWithConfig(DefaultRateLimitConfig(), nil)
+}
+
+// SharedWithConfig returns the process-wide limiter, creating it with config
+// and logger on first use. Later calls get the same limiter whatever config
+// they pass, so the first client to start decides the limits. Rate limit
+// headers fed to UpdateFromResponse by any client adjust it for all of them.
+func SharedWithConfig(config RateLimitConfig, logger logging.Logger) *RateLimiter {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	if shared == nil {
+		shared = NewRateLimiter(config, logger)
+	}
+	return shared
+}
+
+// ResetShared discards the process-wide limiter so the next Shared call
+// starts fresh. Tests use it to keep limiter state from leaking between cases.
+func ResetShared() {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	shared = nil
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestShared(t *testing.T) {
+	ResetShared()
+	t.Cleanup(ResetShared)
+
+	first := Shared()
+	if first == nil {
+		t.Fatal("Shared() returned nil")
+	}
+	if second := Shared(); second != first {
+		t.Error("Expected Shared() to return the same limiter")
+	}
+
+	config := DefaultRateLimitConfig()
+	config.Burst = 1
+	if limiter := SharedWithConfig(config, nil); limiter != first {
+		t.Error("Expected the first limiter to win over a later config")
+	}
+
+	ResetShared()
+	if limiter := Shared(); limiter == first {
+		t.Error("Expected ResetShared to discard the limiter")
+	}
+}
+
+func TestShared_AdaptiveUpdatesFromAnyClient(t *testing.T) {
+	ResetShared()
+	t.Cleanup(ResetShared)
+
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	resp.Header.Set("X-RateLimit-Limit", "1500")
+	resp.Header.Set("X-RateLimit-Remaining", "0")
+	resp.Header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+
+	// A response seen by one client is visible to every holder of the shared limiter
+	Shared().UpdateFromResponse(resp)
+	info := Shared().LastRateInfo()
+	if info == nil || info.Remaining != 0 {
+		t.Fatalf("Expected the shared limiter to record the response, got %+v", info)
+	}
+	if err := Shared().WaitWithTimeout(context.Background(), time.Second); err == nil {
+		t.Error("Expected every client to see the exhausted quota")
+	}
+}
+
+func TestGlobalEnabled(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{"", false},
+		{"true", true},
+		{"1", true},
+		{"false", false},
+		{"yes", false},
+	}
+
+	for _, tt := range tests {
+		t.Setenv(GlobalRateLimitEnvVar, tt.value)
+		if got := GlobalEnabled(); got != tt.expected {
+			t.Errorf("Expected GlobalEnabled() %v for %q, got %v", tt.expected, tt.value, got)
+		}
+	}
+}
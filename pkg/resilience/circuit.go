@@ -0,0 +1,210 @@
+package resilience
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/logging"
+)
+
+// ErrCircuitOpen is returned when the circuit breaker is rejecting requests
+var ErrCircuitOpen = errors.New("circuit breaker is open: Linear API is failing, try again later")
+
+// CircuitState is the state of a circuit breaker
+type CircuitState int
+
+const (
+	// CircuitClosed allows all requests through
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects all requests until the cooldown elapses
+	CircuitOpen
+	// CircuitHalfOpen allows a single trial request through
+	CircuitHalfOpen
+)
+
+// String returns the name of the circuit state
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// CircuitBreakerConfig defines the configuration for the circuit breaker
+type CircuitBreakerConfig struct {
+	Enabled   bool          `json:"enabled"`
+	Threshold int           `json:"threshold"`
+	Window    time.Duration `json:"window"`
+	Cooldown  time.Duration `json:"cooldown"`
+}
+
+// DefaultCircuitBreakerConfig returns the default circuit breaker configuration.
+// The breaker is disabled by default.
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		Enabled:   false,
+		Threshold: 5,
+		Window:    1 * time.Minute,
+		Cooldown:  30 * time.Second,
+	}
+}
+
+// CircuitBreaker stops requests after repeated consecutive failures
+type CircuitBreaker struct {
+	config CircuitBreakerConfig
+	logger logging.Logger
+	now    func() time.Time
+
+	mu           sync.Mutex
+	state        CircuitState
+	failures     int
+	firstFailure time.Time
+	openedAt     time.Time
+	trialActive  bool
+}
+
+// NewCircuitBreaker creates a new circuit breaker
+func NewCircuitBreaker(config CircuitBreakerConfig, logger logging.Logger) *CircuitBreaker {
+	defaults := DefaultCircuitBreakerConfig()
+	if config.Threshold <= 0 {
+		config.Threshold = defaults.Threshold
+	}
+	if config.Window <= 0 {
+		config.Window = defaults.Window
+	}
+	if config.Cooldown <= 0 {
+		config.Cooldown = defaults.Cooldown
+	}
+	if logger == nil {
+		logger = logging.NewNoOpLogger()
+	}
+
+	return &CircuitBreaker{
+		config: config,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Allow reports whether a request may proceed, returning ErrCircuitOpen if not
+func (cb *CircuitBreaker) Allow() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case CircuitOpen:
+		if cb.now().Sub(cb.openedAt) < cb.config.Cooldown {
+			return ErrCircuitOpen
+		}
+		cb.state = CircuitHalfOpen
+		cb.trialActive = true
+		cb.logger.Info("Circuit breaker half-open, allowing trial request")
+		return nil
+	case CircuitHalfOpen:
+		if cb.trialActive {
+			return ErrCircuitOpen
+		}
+		cb.trialActive = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// RecordSuccess records a successful request, closing the breaker
+func (cb *CircuitBreaker) RecordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state != CircuitClosed {
+		cb.logger.Info("Circuit breaker closed after successful trial request")
+	}
+
+	cb.state = CircuitClosed
+	cb.failures = 0
+	cb.trialActive = false
+}
+
+// RecordFailure records a failed request, opening the breaker once the
+// threshold of consecutive failures is reached within the window
+func (cb *CircuitBreaker) RecordFailure() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	now := cb.now()
+
+	if cb.state == CircuitHalfOpen {
+		cb.open(now)
+		return
+	}
+
+	if cb.failures == 0 || now.Sub(cb.firstFailure) > cb.config.Window {
+		cb.failures = 0
+		cb.firstFailure = now
+	}
+	cb.failures++
+
+	if cb.failures >= cb.config.Threshold {
+		cb.open(now)
+	}
+}
+
+// Abandon releases a half-open trial without counting it as a success or failure,
+// e.g. when the request was cancelled by the caller
+func (cb *CircuitBreaker) Abandon() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.trialActive = false
+}
+
+// State returns the current circuit state
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CircuitOpen && cb.now().Sub(cb.openedAt) >= cb.config.Cooldown {
+		return CircuitHalfOpen
+	}
+	return cb.state
+}
+
+// GetStatus returns the current circuit breaker status
+func (cb *CircuitBreaker) GetStatus() map[string]interface{} {
+	state := cb.State()
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	status := map[string]interface{}{
+		"enabled":              true,
+		"state":                state.String(),
+		"consecutive_failures": cb.failures,
+		"threshold":            cb.config.Threshold,
+		"window":               cb.config.Window.String(),
+		"cooldown":             cb.config.Cooldown.String(),
+	}
+
+	if state == CircuitOpen {
+		status["retry_after"] = cb.openedAt.Add(cb.config.Cooldown).Format(time.RFC3339)
+	}
+
+	return status
+}
+
+// open transitions the breaker to the open state; callers must hold cb.mu
+func (cb *CircuitBreaker) open(now time.Time) {
+	cb.state = CircuitOpen
+	cb.openedAt = now
+	cb.trialActive = false
+
+	cb.logger.Warn("Circuit breaker opened after consecutive failures",
+		logging.Int("failures", cb.failures),
+		logging.Duration("cooldown", cb.config.Cooldown),
+	)
+}
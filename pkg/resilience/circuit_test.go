@@ -0,0 +1,149 @@
+package resilience
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// newTestBreaker returns a breaker whose clock is controlled by the returned pointer
+func newTestBreaker(config CircuitBreakerConfig) (*CircuitBreaker, *time.Time) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	cb := NewCircuitBreaker(config, nil)
+	cb.now = func() time.Time { return now }
+	return cb, &now
+}
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	cb, _ := newTestBreaker(CircuitBreakerConfig{Enabled: true, Threshold: 3, Window: time.Minute, Cooldown: 30 * time.Second})
+
+	for i := 0; i < 2; i++ {
+		if err := cb.Allow(); err != nil {
+			t.Fatalf("Expected request %d to be allowed, got %v", i+1, err)
+		}
+		cb.RecordFailure()
+	}
+	if cb.State() != CircuitClosed {
+		t.Fatalf("Expected breaker to stay closed below threshold, got %s", cb.State())
+	}
+
+	cb.RecordFailure()
+	if cb.State() != CircuitOpen {
+		t.Fatalf("Expected breaker to open at threshold, got %s", cb.State())
+	}
+
+	if err := cb.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Expected ErrCircuitOpen, got %v", err)
+	}
+}
+
+func TestCircuitBreaker_SuccessResetsFailures(t *testing.T) {
+	cb, _ := newTestBreaker(CircuitBreakerConfig{Threshold: 2, Window: time.Minute, Cooldown: time.Second})
+
+	cb.RecordFailure()
+	cb.RecordSuccess()
+	cb.RecordFailure()
+
+	if cb.State() != CircuitClosed {
+		t.Errorf("Expected non-consecutive failures to keep breaker closed, got %s", cb.State())
+	}
+}
+
+func TestCircuitBreaker_FailuresOutsideWindow(t *testing.T) {
+	cb, now := newTestBreaker(CircuitBreakerConfig{Threshold: 2, Window: time.Minute, Cooldown: time.Second})
+
+	cb.RecordFailure()
+	*now = now.Add(2 * time.Minute)
+	cb.RecordFailure()
+
+	if cb.State() != CircuitClosed {
+		t.Errorf("Expected failures outside the window not to open the breaker, got %s", cb.State())
+	}
+
+	cb.RecordFailure()
+	if cb.State() != CircuitOpen {
+		t.Errorf("Expected failures within the window to open the breaker, got %s", cb.State())
+	}
+}
+
+func TestCircuitBreaker_HalfOpenTrial(t *testing.T) {
+	cb, now := newTestBreaker(CircuitBreakerConfig{Threshold: 1, Window: time.Minute, Cooldown: 30 * time.Second})
+
+	cb.RecordFailure()
+	*now = now.Add(31 * time.Second)
+
+	if cb.State() != CircuitHalfOpen {
+		t.Fatalf("Expected half-open after cooldown, got %s", cb.State())
+	}
+	if err := cb.Allow(); err != nil {
+		t.Fatalf("Expected trial request to be allowed, got %v", err)
+	}
+	if err := cb.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Expected only one trial request, got %v", err)
+	}
+
+	// A failed trial reopens the breaker for another cooldown
+	cb.RecordFailure()
+	if cb.State() != CircuitOpen {
+		t.Fatalf("Expected failed trial to reopen breaker, got %s", cb.State())
+	}
+
+	*now = now.Add(31 * time.Second)
+	if err := cb.Allow(); err != nil {
+		t.Fatalf("Expected second trial request to be allowed, got %v", err)
+	}
+	cb.RecordSuccess()
+
+	if cb.State() != CircuitClosed {
+		t.Errorf("Expected successful trial to close breaker, got %s", cb.State())
+	}
+	if err := cb.Allow(); err != nil {
+		t.Errorf("Expected requests to flow after closing, got %v", err)
+	}
+}
+
+func TestCircuitBreaker_AbandonReleasesTrial(t *testing.T) {
+	cb, now := newTestBreaker(CircuitBreakerConfig{Threshold: 1, Window: time.Minute, Cooldown: time.Second})
+
+	cb.RecordFailure()
+	*now = now.Add(2 * time.Second)
+
+	if err := cb.Allow(); err != nil {
+		t.Fatalf("Expected trial request to be allowed, got %v", err)
+	}
+	cb.Abandon()
+
+	if err := cb.Allow(); err != nil {
+		t.Errorf("Expected a new trial after abandoning, got %v", err)
+	}
+}
+
+func TestCircuitBreaker_GetStatus(t *testing.T) {
+	cb, _ := newTestBreaker(CircuitBreakerConfig{Threshold: 1, Window: time.Minute, Cooldown: time.Minute})
+
+	status := cb.GetStatus()
+	if status["state"] != "closed" {
+		t.Errorf("Expected closed state, got %v", status["state"])
+	}
+
+	cb.RecordFailure()
+	status = cb.GetStatus()
+	if status["state"] != "open" {
+		t.Errorf("Expected open state, got %v", status["state"])
+	}
+	if _, ok := status["retry_after"]; !ok {
+		t.Error("Expected retry_after while open")
+	}
+}
+
+func TestNewCircuitBreaker_Defaults(t *testing.T) {
+	cb := NewCircuitBreaker(CircuitBreakerConfig{Enabled: true}, nil)
+	defaults := DefaultCircuitBreakerConfig()
+
+	if cb.config.Threshold != defaults.Threshold {
+		t.Errorf("Expected default threshold %d, got %d", defaults.Threshold, cb.config.Threshold)
+	}
+	if cb.config.Cooldown != defaults.Cooldown {
+		t.Errorf("Expected default cooldown %v, got %v", defaults.Cooldown, cb.config.Cooldown)
+	}
+}
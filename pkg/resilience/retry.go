@@ -8,12 +8,36 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/nicholls-inc/linctl/pkg/httpclient"
 	"github.com/nicholls-inc/linctl/pkg/logging"
 )
 
+// JitterMode selects how random jitter is applied to backoff delays
+type JitterMode string
+
+const (
+	// JitterModeFull picks a delay uniformly in [0, backoff]
+	JitterModeFull JitterMode = "full"
+	// JitterModeEqual keeps half the backoff and randomizes the other half: [backoff/2, backoff]
+	JitterModeEqual JitterMode = "equal"
+	// JitterModeDecorrelated picks a delay in [InitialDelay, 3*previous delay], capped at MaxDelay
+	JitterModeDecorrelated JitterMode = "decorrelated"
+)
+
+// ValidJitterMode reports whether mode is a known jitter strategy; "" selects the default ±25% jitter
+func ValidJitterMode(mode JitterMode) bool {
+	switch mode {
+	case "", JitterModeFull, JitterModeEqual, JitterModeDecorrelated:
+		return true
+	default:
+		return false
+	}
+}
+
 // RetryConfig defines the configuration for retry behavior
 type RetryConfig struct {
 	MaxAttempts  int           `json:"max_attempts"`
@@ -21,6 +45,11 @@ type RetryConfig struct {
 	MaxDelay     time.Duration `json:"max_delay"`
 	Multiplier   float64       `json:"multiplier"`
 	Jitter       bool          `json:"jitter"`
+	JitterMode   JitterMode    `json:"jitter_mode,omitempty"`
+	// RandSource drives jitter; seed it to make backoff delays reproducible in
+	// tests. nil uses a time-seeded source. The client serializes its use, but
+	// one source should not be shared between clients.
+	RandSource *rand.Rand `json:"-"`
 }
 
 // DefaultRetryConfig returns a sensible default retry configuration
@@ -36,36 +65,61 @@ func DefaultRetryConfig() RetryConfig {
 
 // RetryableClient wraps an HTTP client with retry logic
 type RetryableClient struct {
-	client *http.Client
-	config RetryConfig
-	logger logging.Logger
+	client  *http.Client
+	config  RetryConfig
+	logger  logging.Logger
+	onRetry func(attempt int, delay time.Duration)
+
+	// randMu guards rng, which is not safe for concurrent use
+	randMu sync.Mutex
+	rng    *rand.Rand
 }
 
 // NewRetryableClient creates a new retryable HTTP client
 func NewRetryableClient(client *http.Client, config RetryConfig, logger logging.Logger) *RetryableClient {
 	if client == nil {
-		client = &http.Client{Timeout: 30 * time.Second}
+		client = httpclient.New(httpclient.ConfigFromEnv())
 	}
 	if logger == nil {
 		logger = logging.NewNoOpLogger()
 	}
 
+	rng := config.RandSource
+	if rng == nil {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
 	return &RetryableClient{
 		client: client,
 		config: config,
 		logger: logger,
+		rng:    rng,
 	}
 }
 
+// OnRetry registers a callback invoked before each retry with the attempt
+// that failed and the delay before the next one
+func (r *RetryableClient) OnRetry(fn func(attempt int, delay time.Duration)) {
+	r.onRetry = fn
+}
+
 // DoWithRetry executes an HTTP request with retry logic
 func (r *RetryableClient) DoWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
+	// Every attempt logs the caller's correlation ID, if any
+	logger := r.logger
+	if id := logging.CorrelationIDFromContext(ctx); id != "" {
+		logger = logger.With(logging.String(logging.CorrelationIDField, id))
+	}
+
 	var lastErr error
+	// prevDelay carries the last delay between attempts for decorrelated jitter
+	var prevDelay time.Duration
 
 	for attempt := 1; attempt <= r.config.MaxAttempts; attempt++ {
 		// Clone the request for each attempt
 		reqClone := req.Clone(ctx)
 
-		r.logger.Debug("Attempting HTTP request",
+		logger.Debug("Attempting HTTP request",
 			logging.String("method", req.Method),
 			logging.String("url", req.URL.String()),
 			logging.Int("attempt", attempt),
@@ -79,7 +133,7 @@ func (r *RetryableClient) DoWithRetry(ctx context.Context, req *http.Request) (*
 		if err != nil {
 			lastErr = err
 
-			r.logger.Warn("HTTP request failed",
+			logger.Warn("HTTP request failed",
 				logging.String("method", req.Method),
 				logging.String("url", req.URL.String()),
 				logging.Int("attempt", attempt),
@@ -89,7 +143,7 @@ func (r *RetryableClient) DoWithRetry(ctx context.Context, req *http.Request) (*
 
 			// Check if we should retry based on the error type
 			if !r.shouldRetryError(err) {
-				r.logger.Debug("Error is not retryable, giving up",
+				logger.Debug("Error is not retryable, giving up",
 					logging.Error(err),
 				)
 				return nil, err
@@ -97,11 +151,13 @@ func (r *RetryableClient) DoWithRetry(ctx context.Context, req *http.Request) (*
 
 			// Don't sleep after the last attempt
 			if attempt < r.config.MaxAttempts {
-				delay := r.calculateDelay(attempt)
-				r.logger.Debug("Retrying after delay",
+				delay := r.calculateDelay(attempt, prevDelay)
+				prevDelay = delay
+				logger.Debug("Retrying after delay",
 					logging.Duration("delay", delay),
 					logging.Int("next_attempt", attempt+1),
 				)
+				r.notifyRetry(attempt, delay)
 
 				select {
 				case <-ctx.Done():
@@ -113,7 +169,7 @@ func (r *RetryableClient) DoWithRetry(ctx context.Context, req *http.Request) (*
 			continue
 		}
 
-		r.logger.Debug("HTTP request completed",
+		logger.Debug("HTTP request completed",
 			logging.String("method", req.Method),
 			logging.String("url", req.URL.String()),
 			logging.Int("attempt", attempt),
@@ -123,7 +179,7 @@ func (r *RetryableClient) DoWithRetry(ctx context.Context, req *http.Request) (*
 
 		// Check if we should retry based on the status code
 		if r.shouldRetryStatus(resp.StatusCode) {
-			r.logger.Warn("HTTP request returned retryable status",
+			logger.Warn("HTTP request returned retryable status",
 				logging.String("method", req.Method),
 				logging.String("url", req.URL.String()),
 				logging.Int("attempt", attempt),
@@ -137,11 +193,13 @@ func (r *RetryableClient) DoWithRetry(ctx context.Context, req *http.Request) (*
 
 			resp.Body.Close() // Close the body before retrying
 
-			delay := r.calculateDelay(attempt)
-			r.logger.Debug("Retrying after delay",
+			delay := r.calculateDelay(attempt, prevDelay)
+			prevDelay = delay
+			logger.Debug("Retrying after delay",
 				logging.Duration("delay", delay),
 				logging.Int("next_attempt", attempt+1),
 			)
+			r.notifyRetry(attempt, delay)
 
 			select {
 			case <-ctx.Done():
@@ -157,7 +215,7 @@ func (r *RetryableClient) DoWithRetry(ctx context.Context, req *http.Request) (*
 	}
 
 	// All attempts exhausted
-	r.logger.Error("All retry attempts exhausted",
+	logger.Error("All retry attempts exhausted",
 		logging.String("method", req.Method),
 		logging.String("url", req.URL.String()),
 		logging.Int("attempts", r.config.MaxAttempts),
@@ -167,6 +225,13 @@ func (r *RetryableClient) DoWithRetry(ctx context.Context, req *http.Request) (*
 	return nil, fmt.Errorf("request failed after %d attempts: %w", r.config.MaxAttempts, lastErr)
 }
 
+// notifyRetry invokes the OnRetry callback, if set
+func (r *RetryableClient) notifyRetry(attempt int, delay time.Duration) {
+	if r.onRetry != nil {
+		r.onRetry(attempt, delay)
+	}
+}
+
 // shouldRetryError determines if an error is retryable
 func (r *RetryableClient) shouldRetryError(err error) bool {
 	// Context cancellation is not retryable
@@ -227,8 +292,10 @@ func (r *RetryableClient) shouldRetryStatus(statusCode int) bool {
 	}
 }
 
-// calculateDelay calculates the delay for the next retry attempt
-func (r *RetryableClient) calculateDelay(attempt int) time.Duration {
+// calculateDelay calculates the delay for the next retry attempt.
+// prev is the delay used before the previous attempt, or 0 before the first retry;
+// it is only consulted by decorrelated jitter.
+func (r *RetryableClient) calculateDelay(attempt int, prev time.Duration) time.Duration {
 	// Calculate exponential backoff
 	delay := float64(r.config.InitialDelay) * math.Pow(r.config.Multiplier, float64(attempt-1))
 
@@ -237,10 +304,28 @@ func (r *RetryableClient) calculateDelay(attempt int) time.Duration {
 		delay = float64(r.config.MaxDelay)
 	}
 
-	// Apply jitter if enabled
-	if r.config.Jitter {
+	if !r.config.Jitter {
+		return time.Duration(delay)
+	}
+
+	switch r.config.JitterMode {
+	case JitterModeFull:
+		delay = r.random() * delay
+	case JitterModeEqual:
+		delay = delay/2 + r.random()*delay/2
+	case JitterModeDecorrelated:
+		base := float64(r.config.InitialDelay)
+		if prev <= 0 {
+			prev = r.config.InitialDelay
+		}
+		upper := float64(prev) * 3
+		delay = base + r.random()*(upper-base)
+		if delay > float64(r.config.MaxDelay) {
+			delay = float64(r.config.MaxDelay)
+		}
+	default:
 		// Add random jitter of ±25%
-		jitter := delay * 0.25 * (rand.Float64()*2 - 1)
+		jitter := delay * 0.25 * (r.random()*2 - 1)
 		delay += jitter
 
 		// Ensure delay is not negative
@@ -252,6 +337,13 @@ func (r *RetryableClient) calculateDelay(attempt int) time.Duration {
 	return time.Duration(delay)
 }
 
+// random returns a pseudo-random number in [0, 1) from the client's source
+func (r *RetryableClient) random() float64 {
+	r.randMu.Lock()
+	defer r.randMu.Unlock()
+	return r.rng.Float64()
+}
+
 // GetClient returns the underlying HTTP client
 func (r *RetryableClient) GetClient() *http.Client {
 	return r.client
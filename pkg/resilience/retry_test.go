@@ -3,6 +3,7 @@ package resilience
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"net"
 	"net/http"
 	"net/http/httptest"
@@ -88,6 +89,54 @@ func TestRetryableClient_RetryOnRetryableStatus(t *testing.T) {
 	}
 }
 
+func TestRetryableClient_OnRetry(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := RetryConfig{
+		MaxAttempts:  3,
+		InitialDelay: 10 * time.Millisecond,
+		MaxDelay:     100 * time.Millisecond,
+		Multiplier:   2.0,
+		Jitter:       false,
+	}
+
+	client := NewRetryableClient(nil, config, logging.NewNoOpLogger())
+
+	var retried []int
+	var waited time.Duration
+	client.OnRetry(func(attempt int, delay time.Duration) {
+		retried = append(retried, attempt)
+		waited += delay
+	})
+
+	req, err := http.NewRequest("GET", server.URL, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	resp, err := client.DoWithRetry(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Request failed after retries: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if len(retried) != 2 || retried[0] != 1 || retried[1] != 2 {
+		t.Errorf("Expected callbacks after attempts [1 2], got %v", retried)
+	}
+	if waited != 30*time.Millisecond {
+		t.Errorf("Expected 30ms total delay, got %v", waited)
+	}
+}
+
 func TestRetryableClient_NoRetryOnNonRetryableStatus(t *testing.T) {
 	attempts := 0
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -270,9 +319,9 @@ func TestCalculateDelay(t *testing.T) {
 	client := NewRetryableClient(nil, config, logging.NewNoOpLogger())
 
 	// Test exponential backoff
-	delay1 := client.calculateDelay(1)
-	delay2 := client.calculateDelay(2)
-	delay3 := client.calculateDelay(3)
+	delay1 := client.calculateDelay(1, 0)
+	delay2 := client.calculateDelay(2, 0)
+	delay3 := client.calculateDelay(3, 0)
 
 	if delay1 != 1*time.Second {
 		t.Errorf("First delay should be 1s, got %v", delay1)
@@ -287,7 +336,7 @@ func TestCalculateDelay(t *testing.T) {
 	}
 
 	// Test max delay cap
-	delay10 := client.calculateDelay(10)
+	delay10 := client.calculateDelay(10, 0)
 	if delay10 != 10*time.Second {
 		t.Errorf("Delay should be capped at max delay (10s), got %v", delay10)
 	}
@@ -306,7 +355,7 @@ func TestCalculateDelayWithJitter(t *testing.T) {
 	// Test that jitter produces different values
 	delays := make([]time.Duration, 10)
 	for i := 0; i < 10; i++ {
-		delays[i] = client.calculateDelay(1)
+		delays[i] = client.calculateDelay(1, 0)
 	}
 
 	// Check that we got some variation (not all delays are identical)
@@ -336,6 +385,130 @@ func TestCalculateDelayWithJitter(t *testing.T) {
 	}
 }
 
+func TestCalculateDelayRandSource(t *testing.T) {
+	for _, mode := range []JitterMode{"", JitterModeFull, JitterModeEqual, JitterModeDecorrelated} {
+		t.Run(string(mode), func(t *testing.T) {
+			delays := func() []time.Duration {
+				config := RetryConfig{
+					InitialDelay: 100 * time.Millisecond,
+					MaxDelay:     10 * time.Second,
+					Multiplier:   2.0,
+					Jitter:       true,
+					JitterMode:   mode,
+					RandSource:   rand.New(rand.NewSource(42)),
+				}
+				client := NewRetryableClient(nil, config, logging.NewNoOpLogger())
+
+				var prev time.Duration
+				delays := make([]time.Duration, 5)
+				for attempt := 1; attempt <= len(delays); attempt++ {
+					delays[attempt-1] = client.calculateDelay(attempt, prev)
+					prev = delays[attempt-1]
+				}
+				return delays
+			}
+
+			first, second := delays(), delays()
+			for i := range first {
+				if first[i] != second[i] {
+					t.Errorf("Expected identical delays from the same seed, got %v and %v", first, second)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateDelayJitterModes(t *testing.T) {
+	tests := []struct {
+		mode JitterMode
+		// bounds returns the allowed delay range for an attempt given the previous delay
+		bounds func(attempt int, prev time.Duration) (time.Duration, time.Duration)
+	}{
+		{
+			mode: JitterModeFull,
+			bounds: func(attempt int, prev time.Duration) (time.Duration, time.Duration) {
+				return 0, exponentialDelay(attempt)
+			},
+		},
+		{
+			mode: JitterModeEqual,
+			bounds: func(attempt int, prev time.Duration) (time.Duration, time.Duration) {
+				return exponentialDelay(attempt) / 2, exponentialDelay(attempt)
+			},
+		},
+		{
+			mode: JitterModeDecorrelated,
+			bounds: func(attempt int, prev time.Duration) (time.Duration, time.Duration) {
+				if prev == 0 {
+					prev = time.Second
+				}
+				upper := 3 * prev
+				if upper > 10*time.Second {
+					upper = 10 * time.Second
+				}
+				return time.Second, upper
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.mode), func(t *testing.T) {
+			config := RetryConfig{
+				InitialDelay: 1 * time.Second,
+				MaxDelay:     10 * time.Second,
+				Multiplier:   2.0,
+				Jitter:       true,
+				JitterMode:   test.mode,
+			}
+			client := NewRetryableClient(nil, config, logging.NewNoOpLogger())
+
+			for run := 0; run < 100; run++ {
+				var prev time.Duration
+				for attempt := 1; attempt <= 6; attempt++ {
+					delay := client.calculateDelay(attempt, prev)
+					low, high := test.bounds(attempt, prev)
+					if delay < low || delay > high {
+						t.Fatalf("Attempt %d delay %v outside [%v, %v] (previous %v)", attempt, delay, low, high, prev)
+					}
+					prev = delay
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateDelayJitterModeDisabled(t *testing.T) {
+	for _, mode := range []JitterMode{JitterModeFull, JitterModeEqual, JitterModeDecorrelated} {
+		config := RetryConfig{
+			InitialDelay: 1 * time.Second,
+			MaxDelay:     10 * time.Second,
+			Multiplier:   2.0,
+			Jitter:       false,
+			JitterMode:   mode,
+		}
+		client := NewRetryableClient(nil, config, logging.NewNoOpLogger())
+
+		var prev time.Duration
+		for attempt := 1; attempt <= 6; attempt++ {
+			delay := client.calculateDelay(attempt, prev)
+			if delay != exponentialDelay(attempt) {
+				t.Errorf("%s: attempt %d expected exact delay %v, got %v", mode, attempt, exponentialDelay(attempt), delay)
+			}
+			prev = delay
+		}
+	}
+}
+
+// exponentialDelay is the unjittered backoff for a 1s initial delay, 2x multiplier, and 10s cap
+func exponentialDelay(attempt int) time.Duration {
+	delay := time.Second << (attempt - 1)
+	if delay > 10*time.Second {
+		delay = 10 * time.Second
+	}
+	return delay
+}
+
 func TestDefaultRetryConfig(t *testing.T) {
 	config := DefaultRetryConfig()
 
@@ -0,0 +1,100 @@
+// Package audit appends records of mutating operations to a local audit log.
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/config"
+)
+
+// Event is a single audit record. It must never hold tokens or other secrets.
+type Event struct {
+	Timestamp time.Time `json:"timestamp"`
+	Operation string    `json:"operation"`
+	Actor     string    `json:"actor"`
+	Target    string    `json:"target,omitempty"`
+	Success   bool      `json:"success"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// secretPattern matches credentials that could leak into error messages
+var secretPattern = regexp.MustCompile(`(?i)(lin_(api|oauth)_[A-Za-z0-9]+|bearer\s+\S+)`)
+
+var mu sync.Mutex
+
+// Record appends event as a JSON line to the audit log when LINCTL_AUDIT_LOG is
+// enabled. The log is created with 0600 permissions and only ever appended to.
+func Record(event Event) error {
+	prodConfig, err := config.LoadProductionConfig()
+	if err != nil {
+		return err
+	}
+	if !prodConfig.Security.AuditLog {
+		return nil
+	}
+
+	path, err := LogPath(prodConfig.Security.AuditLogPath)
+	if err != nil {
+		return err
+	}
+
+	return appendEvent(path, event)
+}
+
+// LogPath returns the audit log path, defaulting to ~/.linctl-audit.log
+func LogPath(configured string) (string, error) {
+	if configured != "" {
+		return configured, nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".linctl-audit.log"), nil
+}
+
+// appendEvent fills in defaults, redacts secrets and appends event to path
+func appendEvent(path string, event Event) error {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+	if event.Actor == "" {
+		event.Actor = localUser()
+	}
+	event.Error = secretPattern.ReplaceAllString(event.Error, "[REDACTED]")
+
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+// localUser returns the operating system user running linctl
+func localUser() string {
+	if current, err := user.Current(); err == nil {
+		return current.Username
+	}
+	return "unknown"
+}
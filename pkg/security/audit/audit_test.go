@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readEvents(t *testing.T, path string) []Event {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open audit log: %v", err)
+	}
+	defer file.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("Invalid JSON line %q: %v", scanner.Text(), err)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestRecord_AppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	t.Setenv("LINCTL_AUDIT_LOG", "true")
+	t.Setenv("LINCTL_AUDIT_LOG_PATH", path)
+
+	if err := Record(Event{Operation: "issue.create", Actor: "Agent", Target: "ENG-1", Success: true}); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	if err := Record(Event{Operation: "auth.login", Success: false, Error: "invalid key lin_api_abc123 rejected"}); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Expected audit log to exist: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected 0600 permissions, got %o", info.Mode().Perm())
+	}
+
+	events := readEvents(t, path)
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(events))
+	}
+	if events[0].Operation != "issue.create" || events[0].Target != "ENG-1" || !events[0].Success || events[0].Actor != "Agent" {
+		t.Errorf("Unexpected first event: %+v", events[0])
+	}
+	if events[0].Timestamp.IsZero() {
+		t.Error("Expected timestamp to be set")
+	}
+	if events[1].Actor == "" {
+		t.Error("Expected actor to default to the local user")
+	}
+	if strings.Contains(events[1].Error, "lin_api_abc123") || !strings.Contains(events[1].Error, "[REDACTED]") {
+		t.Errorf("Expected token to be redacted, got %q", events[1].Error)
+	}
+}
+
+func TestRecord_Disabled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	t.Setenv("LINCTL_AUDIT_LOG", "false")
+	t.Setenv("LINCTL_AUDIT_LOG_PATH", path)
+
+	if err := Record(Event{Operation: "issue.create", Success: true}); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected no audit log when disabled, got %v", err)
+	}
+}
+
+func TestLogPath_Default(t *testing.T) {
+	path, err := LogPath("")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if filepath.Base(path) != ".linctl-audit.log" {
+		t.Errorf("Expected default ~/.linctl-audit.log, got %s", path)
+	}
+}
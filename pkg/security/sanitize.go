@@ -2,8 +2,11 @@ package security
 
 import (
 	"fmt"
+	neturl "net/url"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -12,9 +15,18 @@ var (
 	// Linear issue ID pattern: TEAM-123
 	issueIDPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,10}-\d{1,6}$`)
 
+	// Comment ID pattern: a UUID
+	commentIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+	// Project ID pattern: a UUID, a slug ID (a1b2c3d4e5f6) or a URL slug (my-project-a1b2c3d4e5f6)
+	projectIDPattern = regexp.MustCompile(`^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$`)
+
 	// Team key pattern: 2-10 uppercase letters/numbers
 	teamKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
 
+	// Hex color pattern: #RRGGBB
+	hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
 	// URL pattern for avatar URLs
 	urlPattern = regexp.MustCompile(`^https?://[^\s<>"{}|\\^` + "`" + `\[\]]+$`)
 )
@@ -58,6 +70,34 @@ func SanitizeInput(input string) string {
 	return sanitized
 }
 
+// SanitizeMultilineInput sanitizes multi-line text such as Markdown descriptions.
+// It removes the same dangerous characters as SanitizeInput but keeps line
+// breaks and indentation intact.
+func SanitizeMultilineInput(input string) string {
+	if input == "" {
+		return input
+	}
+
+	input = strings.ReplaceAll(input, "\r\n", "\n")
+
+	var result strings.Builder
+	for _, r := range input {
+		if r == '\n' || r == '\t' || r == ' ' {
+			result.WriteRune(r)
+		} else if !unicode.IsControl(r) {
+			result.WriteRune(r)
+		}
+	}
+
+	// Trim surrounding blank lines and trailing whitespace, keeping leading indentation
+	lines := strings.Split(strings.TrimRight(result.String(), " \t\n"), "\n")
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 // ValidateIssueID validates a Linear issue ID format
 func ValidateIssueID(id string) error {
 	if id == "" {
@@ -99,6 +139,27 @@ func ValidateIssueID(id string) error {
 	return nil
 }
 
+// ValidateCommentID validates a Linear comment ID, which is a UUID
+func ValidateCommentID(id string) error {
+	if id == "" {
+		return ValidationError{
+			Field:   "comment_id",
+			Value:   id,
+			Message: "comment ID cannot be empty",
+		}
+	}
+
+	if !commentIDPattern.MatchString(id) {
+		return ValidationError{
+			Field:   "comment_id",
+			Value:   id,
+			Message: "comment ID must be a UUID (e.g., 3f2b8c1e-7a54-4d3b-9d0e-2c6f1a8b9e47)",
+		}
+	}
+
+	return nil
+}
+
 // ValidateTeamKey validates a Linear team key format
 func ValidateTeamKey(key string) error {
 	if key == "" {
@@ -194,6 +255,77 @@ func ValidateDescription(description string) error {
 	return nil
 }
 
+// ValidateEmoji validates a reaction emoji: a single emoji such as 👍 or a
+// shortcode such as +1 or thumbsup
+func ValidateEmoji(emoji string) error {
+	if emoji == "" {
+		return ValidationError{
+			Field:   "emoji",
+			Value:   emoji,
+			Message: "emoji cannot be empty",
+		}
+	}
+
+	if len(emoji) > 64 {
+		return ValidationError{
+			Field:   "emoji",
+			Value:   emoji,
+			Message: "emoji is too long (maximum 64 bytes)",
+		}
+	}
+
+	for _, r := range emoji {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return ValidationError{
+				Field:   "emoji",
+				Value:   emoji,
+				Message: "emoji cannot contain whitespace or control characters",
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateLabelName validates issue label names
+func ValidateLabelName(name string) error {
+	sanitized := SanitizeInput(name)
+	if sanitized == "" {
+		return ValidationError{
+			Field:   "label_name",
+			Value:   name,
+			Message: "label name cannot be empty",
+		}
+	}
+
+	if len(sanitized) > 80 {
+		return ValidationError{
+			Field:   "label_name",
+			Value:   name,
+			Message: "label name is too long (maximum 80 characters)",
+		}
+	}
+
+	return nil
+}
+
+// ValidateColor validates hex color strings such as #ff0000
+func ValidateColor(color string) error {
+	if color == "" {
+		return nil // Color is optional
+	}
+
+	if !hexColorPattern.MatchString(color) {
+		return ValidationError{
+			Field:   "color",
+			Value:   color,
+			Message: "color must be a hex string in format #RRGGBB (e.g., #ff0000)",
+		}
+	}
+
+	return nil
+}
+
 // ValidateActorName validates actor names for attribution
 func ValidateActorName(name string) error {
 	if name == "" {
@@ -268,6 +400,154 @@ func ValidateAvatarURL(url string) error {
 	return nil
 }
 
+// ValidateAPIURL validates an API base URL such as https://api.linear.app.
+// HTTPS is required except for localhost, where a mock gateway may run
+// without TLS.
+func ValidateAPIURL(rawURL string) error {
+	parsed, err := neturl.Parse(rawURL)
+	if err != nil || parsed.Host == "" || parsed.User != nil || parsed.RawQuery != "" || parsed.Fragment != "" {
+		return ValidationError{
+			Field:   "api_url",
+			Value:   rawURL,
+			Message: "API URL must be an absolute URL such as https://api.linear.app",
+		}
+	}
+
+	local := parsed.Hostname() == "localhost" || parsed.Hostname() == "127.0.0.1" || parsed.Hostname() == "::1"
+	if parsed.Scheme != "https" && !(parsed.Scheme == "http" && local) {
+		return ValidationError{
+			Field:   "api_url",
+			Value:   rawURL,
+			Message: "API URL must use HTTPS (http is only allowed for localhost)",
+		}
+	}
+
+	return nil
+}
+
+// ValidateAttachmentURL validates a link attached to an issue. HTTPS is
+// required unless allowHTTP is set, for internal links without TLS.
+func ValidateAttachmentURL(url string, allowHTTP bool) error {
+	if url == "" {
+		return ValidationError{
+			Field:   "url",
+			Value:   url,
+			Message: "attachment URL cannot be empty",
+		}
+	}
+
+	if !urlPattern.MatchString(url) {
+		return ValidationError{
+			Field:   "url",
+			Value:   url,
+			Message: "attachment URL must be a valid HTTP/HTTPS URL",
+		}
+	}
+
+	if len(url) > 2048 {
+		return ValidationError{
+			Field:   "url",
+			Value:   url,
+			Message: "attachment URL is too long (maximum 2048 characters)",
+		}
+	}
+
+	if !strings.HasPrefix(url, "https://") && !allowHTTP {
+		return ValidationError{
+			Field:   "url",
+			Value:   url,
+			Message: "attachment URL must use HTTPS for security",
+		}
+	}
+
+	return nil
+}
+
+// ValidateMilestoneID validates a Linear project milestone ID, which is a UUID
+func ValidateMilestoneID(id string) error {
+	if !commentIDPattern.MatchString(id) {
+		return ValidationError{
+			Field:   "milestone_id",
+			Value:   id,
+			Message: "milestone ID must be a UUID (e.g., 3f2b8c1e-7a54-4d3b-9d0e-2c6f1a8b9e47)",
+		}
+	}
+	return nil
+}
+
+// ValidateProjectID validates a Linear project ID: a UUID, a slug ID or the
+// slug at the end of a project URL
+func ValidateProjectID(id string) error {
+	if id == "" {
+		return ValidationError{
+			Field:   "project_id",
+			Value:   id,
+			Message: "project ID cannot be empty",
+		}
+	}
+
+	if len(id) > 100 || !projectIDPattern.MatchString(id) {
+		return ValidationError{
+			Field:   "project_id",
+			Value:   id,
+			Message: "project ID must be a UUID or slug ID (e.g., 8f3c2a1b9d4e)",
+		}
+	}
+
+	return nil
+}
+
+// ValidateFavoriteID validates a Linear favorite ID, which is a UUID
+func ValidateFavoriteID(id string) error {
+	if !commentIDPattern.MatchString(id) {
+		return ValidationError{
+			Field:   "favorite_id",
+			Value:   id,
+			Message: "favorite ID must be a UUID (e.g., 3f2b8c1e-7a54-4d3b-9d0e-2c6f1a8b9e47)",
+		}
+	}
+	return nil
+}
+
+// ValidateMilestoneName validates project milestone names
+func ValidateMilestoneName(name string) error {
+	sanitized := SanitizeInput(name)
+	if sanitized == "" {
+		return ValidationError{
+			Field:   "milestone_name",
+			Value:   name,
+			Message: "milestone name cannot be empty",
+		}
+	}
+
+	if len(sanitized) > 80 {
+		return ValidationError{
+			Field:   "milestone_name",
+			Value:   name,
+			Message: "milestone name is too long (maximum 80 characters)",
+		}
+	}
+
+	return nil
+}
+
+// ValidateDate validates a calendar date in YYYY-MM-DD format
+func ValidateDate(field, date string) error {
+	if date == "" {
+		return nil // Dates are optional
+	}
+
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return ValidationError{
+			Field:   field,
+			Value:   date,
+			Message: "date must be a valid date in format YYYY-MM-DD (e.g., 2024-05-01)",
+		}
+	}
+
+	return nil
+}
+
 // ValidatePriority validates issue priority values
 func ValidatePriority(priority int) error {
 	if priority < 0 || priority > 4 {
@@ -280,7 +560,8 @@ func ValidatePriority(priority int) error {
 	return nil
 }
 
-// SanitizeAndValidateAll performs comprehensive validation on common input fields
+// SanitizeAndValidateAll performs comprehensive validation on common input fields.
+// Failures are ordered by field name.
 func SanitizeAndValidateAll(fields map[string]interface{}) (map[string]interface{}, []ValidationError) {
 	var errors []ValidationError
 	sanitized := make(map[string]interface{})
@@ -357,6 +638,11 @@ func SanitizeAndValidateAll(fields map[string]interface{}) (map[string]interface
 		sanitized["priority"] = priority
 	}
 
+	// Map order is random, so report failures in a stable order
+	sort.SliceStable(errors, func(i, j int) bool {
+		return errors[i].Field < errors[j].Field
+	})
+
 	return sanitized, errors
 }
 
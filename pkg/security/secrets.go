@@ -0,0 +1,179 @@
+package security
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// RedactSecretsEnvVar enables secret scanning of issue and comment bodies
+	RedactSecretsEnvVar = "LINCTL_REDACT_SECRETS"
+	// SecretPolicyEnvVar selects what happens to a detected secret: redact or reject
+	SecretPolicyEnvVar = "LINCTL_SECRET_POLICY"
+
+	// SecretPolicyRedact replaces each secret with RedactedSecret (the default)
+	SecretPolicyRedact = "redact"
+	// SecretPolicyReject refuses to send text containing a secret
+	SecretPolicyReject = "reject"
+
+	// RedactedSecret replaces each detected secret
+	RedactedSecret = "***REDACTED***"
+)
+
+// Finding describes a secret detected by RedactSecrets. The secret itself is
+// never recorded.
+type Finding struct {
+	Kind string `json:"kind"`
+	Line int    `json:"line"`
+}
+
+// secretPattern detects one kind of secret. When the pattern has a capture
+// group only the group is redacted, keeping labels such as "Bearer " readable.
+type secretPattern struct {
+	kind    string
+	pattern *regexp.Regexp
+	// valid rejects matches that are likely ordinary text; nil accepts all
+	valid func(secret string) bool
+}
+
+var secretPatterns = []secretPattern{
+	{
+		kind:    "private key",
+		pattern: regexp.MustCompile(`(?s)-----BEGIN [A-Z ]*PRIVATE KEY-----(?:.*?-----END [A-Z ]*PRIVATE KEY-----)?`),
+	},
+	{
+		kind:    "AWS access key ID",
+		pattern: regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16}\b`),
+	},
+	{
+		kind:    "AWS secret access key",
+		pattern: regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?([A-Za-z0-9/+]{40})\b`),
+	},
+	{
+		kind:    "Linear API key",
+		pattern: regexp.MustCompile(`\blin_(?:api|oauth)_[A-Za-z0-9]{32,}\b`),
+	},
+	{
+		kind:    "GitHub token",
+		pattern: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{50,})\b`),
+	},
+	{
+		kind:    "bearer token",
+		pattern: regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]{20,}=*)`),
+		// Words such as "Bearer authentication" are not tokens; tokens mix in digits
+		valid: func(secret string) bool {
+			return strings.ContainsAny(secret, "0123456789")
+		},
+	},
+}
+
+// secretMatch is the byte range of a secret within the scanned text
+type secretMatch struct {
+	kind       string
+	start, end int
+}
+
+// RedactSecrets replaces secrets in text (private keys, AWS keys, Linear API
+// keys, GitHub tokens and bearer tokens) with RedactedSecret, returning the
+// redacted text and a finding for each secret in order of appearance.
+func RedactSecrets(text string) (string, []Finding) {
+	var matches []secretMatch
+	for _, sp := range secretPatterns {
+		for _, loc := range sp.pattern.FindAllStringSubmatchIndex(text, -1) {
+			start, end := loc[0], loc[1]
+			if len(loc) >= 4 && loc[2] >= 0 {
+				start, end = loc[2], loc[3]
+			}
+			if sp.valid != nil && !sp.valid(text[start:end]) {
+				continue
+			}
+			matches = append(matches, secretMatch{kind: sp.kind, start: start, end: end})
+		}
+	}
+	if len(matches) == 0 {
+		return text, nil
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].start != matches[j].start {
+			return matches[i].start < matches[j].start
+		}
+		return matches[i].end > matches[j].end
+	})
+
+	var b strings.Builder
+	var findings []Finding
+	last := 0
+	for _, m := range matches {
+		// A match inside one already redacted (e.g. a key within a private key block) is skipped
+		if m.start < last {
+			continue
+		}
+		b.WriteString(text[last:m.start])
+		b.WriteString(RedactedSecret)
+		findings = append(findings, Finding{Kind: m.kind, Line: strings.Count(text[:m.start], "\n") + 1})
+		last = m.end
+	}
+	b.WriteString(text[last:])
+
+	return b.String(), findings
+}
+
+// SecretScanningEnabled reports whether LINCTL_REDACT_SECRETS turns on secret scanning
+func SecretScanningEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(RedactSecretsEnvVar))
+	return enabled
+}
+
+// SecretPolicyFromEnv returns LINCTL_SECRET_POLICY, defaulting to redact
+func SecretPolicyFromEnv() (string, error) {
+	switch policy := strings.ToLower(strings.TrimSpace(os.Getenv(SecretPolicyEnvVar))); policy {
+	case "", SecretPolicyRedact:
+		return SecretPolicyRedact, nil
+	case SecretPolicyReject:
+		return SecretPolicyReject, nil
+	default:
+		return "", fmt.Errorf("invalid %s=%q: expected %s or %s", SecretPolicyEnvVar, policy, SecretPolicyRedact, SecretPolicyReject)
+	}
+}
+
+// ScanSecrets applies the secret policy to text about to be sent as field.
+// Without LINCTL_REDACT_SECRETS the text is returned unchanged. Under the
+// redact policy secrets are replaced and reported in the findings; under the
+// reject policy a ValidationError naming the kinds of secret is returned.
+func ScanSecrets(field, text string) (string, []Finding, error) {
+	if !SecretScanningEnabled() {
+		return text, nil, nil
+	}
+
+	policy, err := SecretPolicyFromEnv()
+	if err != nil {
+		return "", nil, err
+	}
+
+	redacted, findings := RedactSecrets(text)
+	if len(findings) == 0 {
+		return text, nil, nil
+	}
+
+	if policy == SecretPolicyReject {
+		kinds := make([]string, 0, len(findings))
+		seen := make(map[string]bool)
+		for _, f := range findings {
+			if !seen[f.Kind] {
+				seen[f.Kind] = true
+				kinds = append(kinds, f.Kind)
+			}
+		}
+		return "", findings, ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("contains a possible secret (%s); remove it or set %s=%s", strings.Join(kinds, ", "), SecretPolicyEnvVar, SecretPolicyRedact),
+		}
+	}
+
+	return redacted, findings, nil
+}
@@ -0,0 +1,205 @@
+// Package template loads the issue templates used by `issue create --template`.
+//
+// Templates are YAML files in ~/.linctl/templates, named after the template:
+//
+//	# ~/.linctl/templates/bug.yaml
+//	title_prefix: "[Bug] "
+//	labels: [bug]
+//	priority: 2
+//	description: |
+//	  ## Steps to reproduce
+//
+//	  ## Expected behaviour
+//
+//	  Reported by {{actor}} for {{team}}
+//
+// The title prefix and description may reference {{team}} and {{actor}}, which
+// expand to the team and actor the issue is created with (by default
+// LINEAR_DEFAULT_TEAM and LINEAR_DEFAULT_ACTOR).
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/nicholls-inc/linctl/pkg/security"
+	"gopkg.in/yaml.v3"
+)
+
+// namePattern keeps template names safe to use as file names
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,63}$`)
+
+// extensions are the file extensions recognised as templates, in lookup order
+var extensions = []string{".yaml", ".yml"}
+
+// Template holds the defaults an issue template applies to `issue create`.
+// Explicit command-line flags always win over the template.
+type Template struct {
+	Name        string   `yaml:"-" json:"name"`
+	Path        string   `yaml:"-" json:"path"`
+	TitlePrefix string   `yaml:"title_prefix,omitempty" json:"title_prefix,omitempty"`
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
+	Team        string   `yaml:"team,omitempty" json:"team,omitempty"`
+	Actor       string   `yaml:"actor,omitempty" json:"actor,omitempty"`
+	Labels      []string `yaml:"labels,omitempty" json:"labels,omitempty"`
+	Priority    *int     `yaml:"priority,omitempty" json:"priority,omitempty"`
+}
+
+// Dir returns the directory holding issue templates (~/.linctl/templates)
+func Dir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".linctl", "templates"), nil
+}
+
+// ValidateName checks that a template name can be used as a file name
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("invalid template name %q: use letters, numbers, '-' and '_' (maximum 64 characters)", name)
+	}
+	return nil
+}
+
+// Load reads and validates the named template from the templates directory
+func Load(name string) (*Template, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ext := range extensions {
+		path := filepath.Join(dir, name+ext)
+		if _, err := os.Stat(path); err == nil {
+			return LoadFile(name, path)
+		}
+	}
+
+	return nil, fmt.Errorf("template %q not found in %s", name, dir)
+}
+
+// LoadFile reads and validates a template file. Unknown fields are rejected so
+// a typo does not silently drop a default.
+func LoadFile(name, path string) (*Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template %q: %w", name, err)
+	}
+
+	t := &Template{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(t); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid template %q: %w", name, err)
+	}
+	t.Name = name
+	t.Path = path
+
+	if err := t.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid template %q: %w", name, err)
+	}
+
+	return t, nil
+}
+
+// List returns the templates in the templates directory, sorted by name.
+// Files that fail to load are reported in the returned error after the rest.
+func List() ([]*Template, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read templates directory: %w", err)
+	}
+
+	var templates []*Template
+	var errs []error
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		name := strings.TrimSuffix(entry.Name(), ext)
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") || ValidateName(name) != nil || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		t, err := LoadFile(name, filepath.Join(dir, entry.Name()))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		templates = append(templates, t)
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+
+	return templates, errors.Join(errs...)
+}
+
+// Validate checks the template fields with the same rules used for flags
+func (t *Template) Validate() error {
+	if t.TitlePrefix != "" && security.SanitizeInput(t.TitlePrefix) == "" {
+		return fmt.Errorf("title_prefix cannot be only whitespace")
+	}
+	if len(t.TitlePrefix) > 100 {
+		return fmt.Errorf("title_prefix is too long (maximum 100 characters)")
+	}
+	if err := security.ValidateDescription(t.Description); err != nil {
+		return err
+	}
+	if t.Team != "" {
+		if err := security.ValidateTeamKey(t.Team); err != nil {
+			return err
+		}
+	}
+	if err := security.ValidateActorName(t.Actor); err != nil {
+		return err
+	}
+	for _, label := range t.Labels {
+		if err := security.ValidateLabelName(label); err != nil {
+			return err
+		}
+	}
+	if t.Priority != nil {
+		if err := security.ValidatePriority(*t.Priority); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Title returns title with the template's title prefix applied
+func (t *Template) Title(title, team, actor string) string {
+	return expand(t.TitlePrefix, team, actor) + title
+}
+
+// ExpandDescription returns the description skeleton with {{team}} and
+// {{actor}} replaced
+func (t *Template) ExpandDescription(team, actor string) string {
+	return expand(t.Description, team, actor)
+}
+
+// expand replaces the {{team}} and {{actor}} placeholders in s
+func expand(s, team, actor string) string {
+	return strings.NewReplacer("{{team}}", team, "{{actor}}", actor).Replace(s)
+}
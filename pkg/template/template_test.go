@@ -0,0 +1,101 @@
+package template
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTemplate writes a template file into the templates directory under home
+func writeTemplate(t *testing.T, home, file, content string) {
+	t.Helper()
+
+	dir := filepath.Join(home, ".linctl", "templates")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatalf("Failed to create templates directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, file), []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	writeTemplate(t, home, "bug.yaml", `title_prefix: "[Bug] "
+labels: [bug, triage]
+priority: 2
+description: |
+  Reported by {{actor}} for {{team}}
+`)
+
+	tmpl, err := Load("bug")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tmpl.Name != "bug" || len(tmpl.Labels) != 2 || tmpl.Priority == nil || *tmpl.Priority != 2 {
+		t.Errorf("Unexpected template: %+v", tmpl)
+	}
+	if title := tmpl.Title("Crash on start", "ENG", "Bot"); title != "[Bug] Crash on start" {
+		t.Errorf("Unexpected title %q", title)
+	}
+	if desc := tmpl.ExpandDescription("ENG", "Bot"); desc != "Reported by Bot for ENG\n" {
+		t.Errorf("Unexpected description %q", desc)
+	}
+
+	if _, err := Load("missing"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+	if _, err := Load("../bug"); err == nil {
+		t.Error("Expected invalid template name to be rejected")
+	}
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "unknown field", content: "titel_prefix: x\n"},
+		{name: "priority out of range", content: "priority: 7\n"},
+		{name: "invalid team", content: "team: eng\n"},
+		{name: "empty label", content: "labels: [\"\"]\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+			writeTemplate(t, home, "bad.yaml", tt.content)
+
+			if _, err := Load("bad"); err == nil {
+				t.Error("Expected template to be rejected")
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	templates, err := List()
+	if err != nil || len(templates) != 0 {
+		t.Fatalf("Expected no templates without a directory, got %v, %v", templates, err)
+	}
+
+	writeTemplate(t, home, "feature.yml", "title_prefix: \"[Feature] \"\n")
+	writeTemplate(t, home, "bug.yaml", "labels: [bug]\n")
+	writeTemplate(t, home, "broken.yaml", "priority: 9\n")
+	writeTemplate(t, home, "notes.txt", "ignored")
+
+	templates, err = List()
+	if err == nil || !strings.Contains(err.Error(), "broken") {
+		t.Errorf("Expected the broken template to be reported, got %v", err)
+	}
+	if len(templates) != 2 || templates[0].Name != "bug" || templates[1].Name != "feature" {
+		t.Errorf("Expected bug and feature templates, got %+v", templates)
+	}
+}
@@ -0,0 +1,364 @@
+package tui
+
+import (
+	"context"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+// Client is the subset of the API client used by the issue browser
+type Client interface {
+	GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool) (*api.Issues, error)
+	GetIssue(ctx context.Context, id string) (*api.Issue, error)
+	GetTeamStates(ctx context.Context, teamKey string) ([]api.WorkflowState, error)
+	UpdateIssue(ctx context.Context, id string, input map[string]interface{}) (*api.Issue, error)
+}
+
+// Options configures which issues the browser loads
+type Options struct {
+	Filter  map[string]interface{}
+	Limit   int
+	OrderBy string
+}
+
+// view identifies the screen currently shown
+type view int
+
+const (
+	viewList view = iota
+	viewDetail
+	viewStates
+)
+
+// Messages returned by commands once API calls complete
+type (
+	issuesLoadedMsg struct{ issues []api.Issue }
+	issueLoadedMsg  struct{ issue *api.Issue }
+	statesLoadedMsg struct{ states []api.WorkflowState }
+	issueUpdatedMsg struct{ issue *api.Issue }
+	errMsg          struct{ err error }
+)
+
+// Model is the bubbletea model for the issue browser
+type Model struct {
+	client  Client
+	options Options
+
+	view    view
+	issues  []api.Issue
+	cursor  int
+	filter  string
+	editing bool
+
+	detail      *api.Issue
+	states      []api.WorkflowState
+	stateCursor int
+
+	loading bool
+	status  string
+	err     error
+
+	width  int
+	height int
+}
+
+// NewModel creates an issue browser model
+func NewModel(client Client, options Options) Model {
+	if options.Limit <= 0 {
+		options.Limit = 50
+	}
+
+	return Model{
+		client:  client,
+		options: options,
+		loading: true,
+	}
+}
+
+// Init loads the initial issue list
+func (m Model) Init() tea.Cmd {
+	return m.loadIssues()
+}
+
+// Update handles incoming messages and key presses
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case issuesLoadedMsg:
+		m.loading = false
+		m.err = nil
+		m.issues = msg.issues
+		m.clampCursor()
+		return m, nil
+
+	case issueLoadedMsg:
+		m.loading = false
+		m.err = nil
+		m.detail = msg.issue
+		m.view = viewDetail
+		return m, nil
+
+	case statesLoadedMsg:
+		m.loading = false
+		m.err = nil
+		m.states = msg.states
+		m.stateCursor = 0
+		if m.detail != nil && m.detail.State != nil {
+			for i, state := range m.states {
+				if state.ID == m.detail.State.ID {
+					m.stateCursor = i
+					break
+				}
+			}
+		}
+		m.view = viewStates
+		return m, nil
+
+	case issueUpdatedMsg:
+		m.loading = false
+		m.err = nil
+		m.applyUpdate(msg.issue)
+		m.view = viewDetail
+		if msg.issue.State != nil {
+			m.status = "Moved " + msg.issue.Identifier + " to " + msg.issue.State.Name
+		}
+		return m, nil
+
+	case errMsg:
+		m.loading = false
+		m.err = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+// handleKey dispatches a key press to the handler for the current view
+func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+
+	if m.editing {
+		return m.handleFilterKey(msg)
+	}
+
+	switch m.view {
+	case viewDetail:
+		return m.handleDetailKey(msg)
+	case viewStates:
+		return m.handleStatesKey(msg)
+	default:
+		return m.handleListKey(msg)
+	}
+}
+
+func (m Model) handleListKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	visible := m.visibleIssues()
+
+	switch msg.String() {
+	case "q", "esc":
+		if m.filter != "" && msg.String() == "esc" {
+			m.filter = ""
+			m.clampCursor()
+			return m, nil
+		}
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(visible)-1 {
+			m.cursor++
+		}
+	case "/":
+		m.editing = true
+	case "r":
+		m.loading = true
+		m.status = ""
+		return m, m.loadIssues()
+	case "enter":
+		if len(visible) == 0 {
+			return m, nil
+		}
+		m.loading = true
+		m.status = ""
+		return m, m.loadIssue(visible[m.cursor].Identifier)
+	}
+
+	return m, nil
+}
+
+func (m Model) handleFilterKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.editing = false
+	case tea.KeyEsc:
+		m.editing = false
+		m.filter = ""
+	case tea.KeyBackspace:
+		if len(m.filter) > 0 {
+			runes := []rune(m.filter)
+			m.filter = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.filter += string(msg.Runes)
+	}
+
+	m.clampCursor()
+	return m, nil
+}
+
+func (m Model) handleDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "esc", "backspace", "left", "h":
+		m.view = viewList
+		m.status = ""
+	case "s":
+		if m.detail == nil || m.detail.Team == nil {
+			return m, nil
+		}
+		m.loading = true
+		m.status = ""
+		return m, m.loadStates(m.detail.Team.Key)
+	case "r":
+		if m.detail == nil {
+			return m, nil
+		}
+		m.loading = true
+		return m, m.loadIssue(m.detail.Identifier)
+	}
+
+	return m, nil
+}
+
+func (m Model) handleStatesKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "esc", "backspace", "left", "h":
+		m.view = viewDetail
+	case "up", "k":
+		if m.stateCursor > 0 {
+			m.stateCursor--
+		}
+	case "down", "j":
+		if m.stateCursor < len(m.states)-1 {
+			m.stateCursor++
+		}
+	case "enter":
+		if len(m.states) == 0 || m.detail == nil {
+			return m, nil
+		}
+		state := m.states[m.stateCursor]
+		if m.detail.State != nil && m.detail.State.ID == state.ID {
+			m.view = viewDetail
+			return m, nil
+		}
+		m.loading = true
+		return m, m.updateState(m.detail.ID, state.ID)
+	}
+
+	return m, nil
+}
+
+// visibleIssues returns the issues matching the current filter text
+func (m Model) visibleIssues() []api.Issue {
+	if m.filter == "" {
+		return m.issues
+	}
+
+	needle := strings.ToLower(m.filter)
+	var visible []api.Issue
+	for _, issue := range m.issues {
+		if strings.Contains(strings.ToLower(issue.Title), needle) ||
+			strings.Contains(strings.ToLower(issue.Identifier), needle) {
+			visible = append(visible, issue)
+		}
+	}
+	return visible
+}
+
+// clampCursor keeps the cursor within the visible issues
+func (m *Model) clampCursor() {
+	visible := len(m.visibleIssues())
+	if m.cursor >= visible {
+		m.cursor = visible - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+}
+
+// applyUpdate merges an updated issue into the detail view and list
+func (m *Model) applyUpdate(updated *api.Issue) {
+	if m.detail != nil && m.detail.ID == updated.ID {
+		m.detail.State = updated.State
+		m.detail.Assignee = updated.Assignee
+		m.detail.UpdatedAt = updated.UpdatedAt
+	}
+
+	for i := range m.issues {
+		if m.issues[i].ID == updated.ID {
+			m.issues[i].State = updated.State
+			m.issues[i].Assignee = updated.Assignee
+			m.issues[i].UpdatedAt = updated.UpdatedAt
+		}
+	}
+}
+
+func (m Model) loadIssues() tea.Cmd {
+	client, options := m.client, m.options
+	return func() tea.Msg {
+		issues, err := client.GetIssues(context.Background(), options.Filter, options.Limit, "", options.OrderBy, false)
+		if err != nil {
+			return errMsg{err}
+		}
+		return issuesLoadedMsg{issues.Nodes}
+	}
+}
+
+func (m Model) loadIssue(id string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		issue, err := client.GetIssue(context.Background(), id)
+		if err != nil {
+			return errMsg{err}
+		}
+		return issueLoadedMsg{issue}
+	}
+}
+
+func (m Model) loadStates(teamKey string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		states, err := client.GetTeamStates(context.Background(), teamKey)
+		if err != nil {
+			return errMsg{err}
+		}
+		return statesLoadedMsg{states}
+	}
+}
+
+func (m Model) updateState(issueID, stateID string) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		issue, err := client.UpdateIssue(context.Background(), issueID, map[string]interface{}{"stateId": stateID})
+		if err != nil {
+			return errMsg{err}
+		}
+		return issueUpdatedMsg{issue}
+	}
+}
@@ -0,0 +1,279 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+type fakeClient struct {
+	issues      []api.Issue
+	states      []api.WorkflowState
+	updateErr   error
+	updateInput map[string]interface{}
+}
+
+func (f *fakeClient) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool) (*api.Issues, error) {
+	return &api.Issues{Nodes: f.issues}, nil
+}
+
+func (f *fakeClient) GetIssue(ctx context.Context, id string) (*api.Issue, error) {
+	for _, issue := range f.issues {
+		if issue.Identifier == id {
+			issue := issue
+			return &issue, nil
+		}
+	}
+	return nil, errors.New("Entity not found")
+}
+
+func (f *fakeClient) GetTeamStates(ctx context.Context, teamKey string) ([]api.WorkflowState, error) {
+	return f.states, nil
+}
+
+func (f *fakeClient) UpdateIssue(ctx context.Context, id string, input map[string]interface{}) (*api.Issue, error) {
+	f.updateInput = input
+	if f.updateErr != nil {
+		return nil, f.updateErr
+	}
+	for _, issue := range f.issues {
+		if issue.ID == id {
+			issue := issue
+			for _, state := range f.states {
+				if state.ID == input["stateId"] {
+					issue.State = &api.State{ID: state.ID, Name: state.Name, Type: state.Type}
+				}
+			}
+			return &issue, nil
+		}
+	}
+	return nil, errors.New("Entity not found")
+}
+
+func newFakeClient() *fakeClient {
+	team := &api.Team{Key: "ENG", Name: "Engineering"}
+	todo := &api.State{ID: "state-todo", Name: "Todo", Type: "unstarted"}
+	return &fakeClient{
+		issues: []api.Issue{
+			{ID: "1", Identifier: "ENG-1", Title: "Fix login bug", State: todo, Team: team},
+			{ID: "2", Identifier: "ENG-2", Title: "Add dark mode", State: todo, Team: team},
+			{ID: "3", Identifier: "ENG-3", Title: "Login page copy", State: todo, Team: team},
+		},
+		states: []api.WorkflowState{
+			{ID: "state-todo", Name: "Todo", Type: "unstarted"},
+			{ID: "state-progress", Name: "In Progress", Type: "started"},
+			{ID: "state-done", Name: "Done", Type: "completed"},
+		},
+	}
+}
+
+// send applies a message and runs any resulting command, feeding its message back in
+func send(t *testing.T, m Model, msg tea.Msg) Model {
+	t.Helper()
+
+	next, cmd := m.Update(msg)
+	m = next.(Model)
+	if cmd != nil {
+		result := cmd()
+		if _, quit := result.(tea.QuitMsg); quit {
+			return m
+		}
+		next, _ = m.Update(result)
+		m = next.(Model)
+	}
+	return m
+}
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}
+	case "backspace":
+		return tea.KeyMsg{Type: tea.KeyBackspace}
+	default:
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+	}
+}
+
+func loadedModel(t *testing.T, client *fakeClient) Model {
+	t.Helper()
+
+	m := NewModel(client, Options{})
+	next, _ := m.Update(m.Init()())
+	return next.(Model)
+}
+
+func TestModel_LoadsIssues(t *testing.T) {
+	m := loadedModel(t, newFakeClient())
+
+	if m.loading {
+		t.Error("Expected loading to finish")
+	}
+	if len(m.issues) != 3 {
+		t.Fatalf("Expected 3 issues, got %d", len(m.issues))
+	}
+	if !strings.Contains(m.View(), "ENG-1") {
+		t.Error("Expected list view to show issues")
+	}
+}
+
+func TestModel_CursorNavigation(t *testing.T) {
+	m := loadedModel(t, newFakeClient())
+
+	m = send(t, m, key("up"))
+	if m.cursor != 0 {
+		t.Errorf("Expected cursor to stay at 0, got %d", m.cursor)
+	}
+
+	m = send(t, m, key("down"))
+	m = send(t, m, key("j"))
+	m = send(t, m, key("down"))
+	if m.cursor != 2 {
+		t.Errorf("Expected cursor to stop at last issue, got %d", m.cursor)
+	}
+
+	m = send(t, m, key("k"))
+	if m.cursor != 1 {
+		t.Errorf("Expected cursor 1 after moving up, got %d", m.cursor)
+	}
+}
+
+func TestModel_Filter(t *testing.T) {
+	m := loadedModel(t, newFakeClient())
+	m = send(t, m, key("down"))
+	m = send(t, m, key("down"))
+
+	m = send(t, m, key("/"))
+	if !m.editing {
+		t.Fatal("Expected / to start filtering")
+	}
+	for _, r := range "login" {
+		m = send(t, m, key(string(r)))
+	}
+	m = send(t, m, key("enter"))
+
+	visible := m.visibleIssues()
+	if len(visible) != 2 {
+		t.Fatalf("Expected 2 issues matching 'login', got %d", len(visible))
+	}
+	if m.cursor != 1 {
+		t.Errorf("Expected cursor clamped to 1, got %d", m.cursor)
+	}
+
+	m = send(t, m, key("esc"))
+	if m.filter != "" || len(m.visibleIssues()) != 3 {
+		t.Error("Expected esc to clear the filter")
+	}
+}
+
+func TestModel_OpenDetailAndBack(t *testing.T) {
+	m := loadedModel(t, newFakeClient())
+	m = send(t, m, key("down"))
+	m = send(t, m, key("enter"))
+
+	if m.view != viewDetail {
+		t.Fatalf("Expected detail view, got %v", m.view)
+	}
+	if m.detail == nil || m.detail.Identifier != "ENG-2" {
+		t.Fatalf("Expected ENG-2 in detail, got %+v", m.detail)
+	}
+	if !strings.Contains(m.View(), "Add dark mode") {
+		t.Error("Expected detail view to show the title")
+	}
+
+	m = send(t, m, key("esc"))
+	if m.view != viewList {
+		t.Errorf("Expected esc to return to list, got %v", m.view)
+	}
+}
+
+func TestModel_ChangeState(t *testing.T) {
+	client := newFakeClient()
+	m := loadedModel(t, client)
+	m = send(t, m, key("enter"))
+	m = send(t, m, key("s"))
+
+	if m.view != viewStates {
+		t.Fatalf("Expected state picker, got %v", m.view)
+	}
+	if m.stateCursor != 0 {
+		t.Errorf("Expected picker to start on current state, got %d", m.stateCursor)
+	}
+
+	m = send(t, m, key("down"))
+	m = send(t, m, key("enter"))
+
+	if client.updateInput["stateId"] != "state-progress" {
+		t.Errorf("Expected update with state-progress, got %v", client.updateInput)
+	}
+	if m.view != viewDetail {
+		t.Errorf("Expected to return to detail view, got %v", m.view)
+	}
+	if m.detail.State.Name != "In Progress" {
+		t.Errorf("Expected detail state In Progress, got %s", m.detail.State.Name)
+	}
+	if m.issues[0].State.Name != "In Progress" {
+		t.Errorf("Expected list entry to be updated, got %s", m.issues[0].State.Name)
+	}
+	if !strings.Contains(m.status, "ENG-1") {
+		t.Errorf("Expected status message, got %q", m.status)
+	}
+}
+
+func TestModel_ChangeStateToCurrentIsNoop(t *testing.T) {
+	client := newFakeClient()
+	m := loadedModel(t, client)
+	m = send(t, m, key("enter"))
+	m = send(t, m, key("s"))
+	m = send(t, m, key("enter"))
+
+	if client.updateInput != nil {
+		t.Error("Expected no update when selecting the current state")
+	}
+	if m.view != viewDetail {
+		t.Errorf("Expected detail view, got %v", m.view)
+	}
+}
+
+func TestModel_UpdateError(t *testing.T) {
+	client := newFakeClient()
+	client.updateErr = errors.New("permission denied")
+
+	m := loadedModel(t, client)
+	m = send(t, m, key("enter"))
+	m = send(t, m, key("s"))
+	m = send(t, m, key("down"))
+	m = send(t, m, key("enter"))
+
+	if m.err == nil {
+		t.Fatal("Expected update error to be shown")
+	}
+	if m.view != viewStates {
+		t.Errorf("Expected to stay in state picker on error, got %v", m.view)
+	}
+	if !strings.Contains(m.View(), "permission denied") {
+		t.Error("Expected error in view")
+	}
+}
+
+func TestModel_Quit(t *testing.T) {
+	m := loadedModel(t, newFakeClient())
+
+	_, cmd := m.Update(key("q"))
+	if cmd == nil {
+		t.Fatal("Expected quit command")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("Expected q to quit")
+	}
+}
@@ -0,0 +1,25 @@
+// Package tui implements the interactive terminal issue browser
+package tui
+
+import (
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-isatty"
+)
+
+// IsTerminal reports whether stdin and stdout are both attached to a terminal
+func IsTerminal() bool {
+	return isTTY(os.Stdin.Fd()) && isTTY(os.Stdout.Fd())
+}
+
+func isTTY(fd uintptr) bool {
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// Run starts the issue browser and blocks until the user quits
+func Run(client Client, options Options) error {
+	program := tea.NewProgram(NewModel(client, options), tea.WithAltScreen())
+	_, err := program.Run()
+	return err
+}
@@ -0,0 +1,200 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true)
+	selectedStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+	faintStyle    = lipgloss.NewStyle().Faint(true)
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+	statusStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
+)
+
+// View renders the current screen
+func (m Model) View() string {
+	var b strings.Builder
+
+	switch m.view {
+	case viewDetail:
+		m.renderDetail(&b)
+	case viewStates:
+		m.renderStates(&b)
+	default:
+		m.renderList(&b)
+	}
+
+	b.WriteString("\n")
+	if m.loading {
+		b.WriteString(faintStyle.Render("Loading...") + "\n")
+	}
+	if m.err != nil {
+		b.WriteString(errorStyle.Render("Error: "+m.err.Error()) + "\n")
+	}
+	if m.status != "" {
+		b.WriteString(statusStyle.Render(m.status) + "\n")
+	}
+	b.WriteString(faintStyle.Render(m.help()) + "\n")
+
+	return b.String()
+}
+
+func (m Model) renderList(b *strings.Builder) {
+	visible := m.visibleIssues()
+
+	b.WriteString(titleStyle.Render(fmt.Sprintf("Issues (%d)", len(visible))) + "\n")
+	if m.editing || m.filter != "" {
+		cursor := ""
+		if m.editing {
+			cursor = "_"
+		}
+		fmt.Fprintf(b, "Filter: %s%s\n", m.filter, cursor)
+	}
+	b.WriteString("\n")
+
+	if len(visible) == 0 && !m.loading {
+		b.WriteString(faintStyle.Render("No issues found") + "\n")
+		return
+	}
+
+	start, end := m.listWindow(len(visible))
+	for i := start; i < end; i++ {
+		issue := visible[i]
+		line := fmt.Sprintf("%-10s %-14s %-16s %s",
+			issue.Identifier,
+			truncate(stateName(issue.State), 14),
+			truncate(assigneeName(issue.Assignee), 16),
+			issue.Title)
+		if m.width > 0 {
+			line = truncate(line, m.width-2)
+		}
+
+		if i == m.cursor {
+			b.WriteString(selectedStyle.Render("> "+line) + "\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+}
+
+func (m Model) renderDetail(b *strings.Builder) {
+	issue := m.detail
+	if issue == nil {
+		return
+	}
+
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%s: %s", issue.Identifier, issue.Title)) + "\n\n")
+	fmt.Fprintf(b, "State:    %s\n", stateName(issue.State))
+	fmt.Fprintf(b, "Assignee: %s\n", assigneeName(issue.Assignee))
+	if issue.Team != nil {
+		fmt.Fprintf(b, "Team:     %s (%s)\n", issue.Team.Name, issue.Team.Key)
+	}
+	priority := issue.PriorityLabel
+	if priority == "" {
+		priority = fmt.Sprintf("%d", issue.Priority)
+	}
+	fmt.Fprintf(b, "Priority: %s\n", priority)
+	if issue.URL != "" {
+		fmt.Fprintf(b, "URL:      %s\n", issue.URL)
+	}
+
+	if issue.Description != "" {
+		b.WriteString("\n" + titleStyle.Render("Description") + "\n")
+		b.WriteString(strings.TrimRight(issue.Description, "\n") + "\n")
+	}
+
+	if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
+		b.WriteString("\n" + titleStyle.Render(fmt.Sprintf("Comments (%d)", len(issue.Comments.Nodes))) + "\n")
+		for _, comment := range issue.Comments.Nodes {
+			author := "Unknown"
+			if comment.User != nil {
+				author = comment.User.Name
+			}
+			fmt.Fprintf(b, "%s %s\n", selectedStyle.Render(author), faintStyle.Render(comment.CreatedAt.Format("2006-01-02 15:04")))
+			b.WriteString("  " + strings.ReplaceAll(strings.TrimRight(comment.Body, "\n"), "\n", "\n  ") + "\n")
+		}
+	}
+}
+
+func (m Model) renderStates(b *strings.Builder) {
+	if m.detail != nil {
+		b.WriteString(titleStyle.Render(fmt.Sprintf("Move %s to", m.detail.Identifier)) + "\n\n")
+	}
+
+	for i, state := range m.states {
+		line := fmt.Sprintf("%s (%s)", state.Name, state.Type)
+		if m.detail != nil && m.detail.State != nil && m.detail.State.ID == state.ID {
+			line += " - current"
+		}
+
+		if i == m.stateCursor {
+			b.WriteString(selectedStyle.Render("> "+line) + "\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+}
+
+// help returns the key bindings for the current screen
+func (m Model) help() string {
+	if m.editing {
+		return "type to filter • enter: apply • esc: clear"
+	}
+
+	switch m.view {
+	case viewDetail:
+		return "s: change state • r: refresh • esc: back • q: quit"
+	case viewStates:
+		return "↑/↓: select • enter: apply • esc: cancel • q: quit"
+	default:
+		return "↑/↓: move • enter: open • /: filter • r: refresh • q: quit"
+	}
+}
+
+// listWindow returns the range of list rows that fit on screen, keeping the cursor visible
+func (m Model) listWindow(total int) (int, int) {
+	rows := m.height - 8
+	if m.height == 0 || rows >= total || rows <= 0 {
+		return 0, total
+	}
+
+	start := m.cursor - rows/2
+	if start < 0 {
+		start = 0
+	}
+	if start+rows > total {
+		start = total - rows
+	}
+	return start, start + rows
+}
+
+func stateName(state *api.State) string {
+	if state == nil {
+		return "-"
+	}
+	return state.Name
+}
+
+func assigneeName(user *api.User) string {
+	if user == nil {
+		return "Unassigned"
+	}
+	return user.Name
+}
+
+// truncate shortens s to maxLen runes, marking the cut with an ellipsis
+func truncate(s string, maxLen int) string {
+	runes := []rune(s)
+	if maxLen <= 0 || len(runes) <= maxLen {
+		return s
+	}
+	if maxLen <= 3 {
+		return string(runes[:maxLen])
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
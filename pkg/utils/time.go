@@ -70,3 +70,50 @@ func ParseTimeExpression(expr string) (string, error) {
 	// Return as ISO8601 string
 	return targetTime.Format(time.RFC3339), nil
 }
+
+// ParseDate parses an RFC3339 timestamp or a YYYY-MM-DD date (midnight UTC).
+// dateOnly reports whether the value had no time component.
+func ParseDate(value string) (t time.Time, dateOnly bool, err error) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	return time.Time{}, false, fmt.Errorf("invalid date: %s (expected YYYY-MM-DD or RFC3339, e.g. 2024-01-31 or 2024-01-31T15:04:05Z)", value)
+}
+
+// DateRangeFilter builds a GraphQL date comparator from since and until values.
+// Either may be empty. A date-only until includes that whole day.
+func DateRangeFilter(since, until string) (map[string]interface{}, error) {
+	filter := make(map[string]interface{})
+	var start, end time.Time
+
+	if since != "" {
+		t, _, err := ParseDate(since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --since value: %w", err)
+		}
+		start = t
+		filter["gte"] = t.Format(time.RFC3339)
+	}
+
+	if until != "" {
+		t, dateOnly, err := ParseDate(until)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --until value: %w", err)
+		}
+		end = t
+		if dateOnly {
+			filter["lt"] = t.AddDate(0, 0, 1).Format(time.RFC3339)
+		} else {
+			filter["lte"] = t.Format(time.RFC3339)
+		}
+	}
+
+	if since != "" && until != "" && end.Before(start) {
+		return nil, fmt.Errorf("--until (%s) is before --since (%s)", until, since)
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Time
+		dateOnly bool
+		wantErr  bool
+	}{
+		{value: "2024-01-31", expected: time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), dateOnly: true},
+		{value: "2024-01-31T15:04:05Z", expected: time.Date(2024, 1, 31, 15, 4, 5, 0, time.UTC)},
+		{value: "31/01/2024", wantErr: true},
+		{value: "3_weeks_ago", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, dateOnly, err := ParseDate(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Expected error for %q", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !got.Equal(tt.expected) || dateOnly != tt.dateOnly {
+				t.Errorf("Expected %v (dateOnly=%v), got %v (dateOnly=%v)", tt.expected, tt.dateOnly, got, dateOnly)
+			}
+		})
+	}
+}
+
+func TestDateRangeFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		since    string
+		until    string
+		expected map[string]interface{}
+		errMsg   string
+	}{
+		{
+			name:     "date range includes the whole until day",
+			since:    "2024-01-01",
+			until:    "2024-03-31",
+			expected: map[string]interface{}{"gte": "2024-01-01T00:00:00Z", "lt": "2024-04-01T00:00:00Z"},
+		},
+		{
+			name:     "timestamp until is inclusive",
+			until:    "2024-03-31T12:00:00Z",
+			expected: map[string]interface{}{"lte": "2024-03-31T12:00:00Z"},
+		},
+		{
+			name:     "since only",
+			since:    "2024-01-01T08:00:00+02:00",
+			expected: map[string]interface{}{"gte": "2024-01-01T08:00:00+02:00"},
+		},
+		{
+			name:   "invalid since",
+			since:  "yesterday",
+			errMsg: "invalid --since value",
+		},
+		{
+			name:   "until before since",
+			since:  "2024-03-01",
+			until:  "2024-02-01",
+			errMsg: "is before --since",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DateRangeFilter(tt.since, tt.until)
+			if tt.errMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("Expected error containing %q, got %v", tt.errMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Expected %v, got %v", tt.expected, got)
+			}
+			for key, value := range tt.expected {
+				if got[key] != value {
+					t.Errorf("Expected %s=%v, got %v", key, value, got[key])
+				}
+			}
+		})
+	}
+}
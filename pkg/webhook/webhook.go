@@ -0,0 +1,134 @@
+// Package webhook verifies and parses webhook deliveries sent by Linear.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nicholls-inc/linctl/pkg/api"
+)
+
+// SignatureHeader is the HTTP header carrying the payload signature
+const SignatureHeader = "Linear-Signature"
+
+// Event types with typed payloads
+const (
+	TypeIssue   = "Issue"
+	TypeComment = "Comment"
+)
+
+// ErrInvalidSignature is returned when a payload does not match its signature
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// WebhookEvent is a webhook delivery. Issue or Comment is set for those event
+// types; Data always holds the raw entity payload.
+type WebhookEvent struct {
+	Action           string                 `json:"action"`
+	Type             string                 `json:"type"`
+	URL              string                 `json:"url"`
+	CreatedAt        time.Time              `json:"createdAt"`
+	OrganizationID   string                 `json:"organizationId"`
+	WebhookID        string                 `json:"webhookId"`
+	WebhookTimestamp int64                  `json:"webhookTimestamp"`
+	UpdatedFrom      map[string]interface{} `json:"updatedFrom,omitempty"`
+	Data             json.RawMessage        `json:"data"`
+
+	Issue   *IssueData   `json:"-"`
+	Comment *CommentData `json:"-"`
+}
+
+// IssueData is the entity payload of an Issue event. Webhooks send related
+// entities by ID alongside a subset of their fields.
+type IssueData struct {
+	ID          string     `json:"id"`
+	Identifier  string     `json:"identifier"`
+	Number      int        `json:"number"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Priority    int        `json:"priority"`
+	Estimate    *float64   `json:"estimate"`
+	DueDate     *string    `json:"dueDate"`
+	URL         string     `json:"url"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	ArchivedAt  *time.Time `json:"archivedAt"`
+	TeamID      string     `json:"teamId"`
+	StateID     string     `json:"stateId"`
+	AssigneeID  *string    `json:"assigneeId"`
+	ProjectID   *string    `json:"projectId"`
+	LabelIDs    []string   `json:"labelIds"`
+	Team        *api.Team  `json:"team"`
+	State       *api.State `json:"state"`
+	Assignee    *api.User  `json:"assignee"`
+}
+
+// CommentData is the entity payload of a Comment event
+type CommentData struct {
+	ID        string     `json:"id"`
+	Body      string     `json:"body"`
+	IssueID   string     `json:"issueId"`
+	UserID    string     `json:"userId"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	EditedAt  *time.Time `json:"editedAt"`
+	User      *api.User  `json:"user"`
+	Issue     *api.Issue `json:"issue"`
+}
+
+// VerifySignature checks that signature is the hex-encoded HMAC-SHA256 of
+// payload keyed with the webhook's signing secret. payload must be the raw
+// request body, before any decoding.
+func VerifySignature(payload []byte, signature, secret string) error {
+	if secret == "" {
+		return errors.New("webhook secret is required")
+	}
+
+	got, err := hex.DecodeString(strings.TrimSpace(signature))
+	if err != nil || len(got) == 0 {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// ParseEvent unmarshals a webhook payload, decoding the entity for issue and
+// comment events. Other event types are returned with only Data set.
+func ParseEvent(payload []byte) (*WebhookEvent, error) {
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook payload: %w", err)
+	}
+
+	if event.Type == "" || event.Action == "" {
+		return nil, errors.New("webhook payload is missing type or action")
+	}
+
+	switch event.Type {
+	case TypeIssue:
+		var issue IssueData
+		if err := json.Unmarshal(event.Data, &issue); err != nil {
+			return nil, fmt.Errorf("failed to parse issue data: %w", err)
+		}
+		event.Issue = &issue
+	case TypeComment:
+		var comment CommentData
+		if err := json.Unmarshal(event.Data, &comment); err != nil {
+			return nil, fmt.Errorf("failed to parse comment data: %w", err)
+		}
+		event.Comment = &comment
+	}
+
+	return &event, nil
+}
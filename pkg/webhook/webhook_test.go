@@ -0,0 +1,146 @@
+package webhook
+
+import (
+	"errors"
+	"testing"
+)
+
+const (
+	testSecret    = "whsec_test"
+	testPayload   = `{"action":"create","type":"Issue","data":{"id":"issue-1","identifier":"ENG-1","title":"Fix login"}}`
+	testSignature = "27b306cf0d0742cd976eb2a0ded783792cca49115b4fc3128e9bd4c397978795"
+)
+
+func TestVerifySignature(t *testing.T) {
+	tests := []struct {
+		name      string
+		payload   string
+		signature string
+		secret    string
+		wantErr   error
+	}{
+		{name: "valid", payload: testPayload, signature: testSignature, secret: testSecret},
+		{name: "tampered payload", payload: `{"action":"create","type":"Issue","data":{"id":"issue-1","identifier":"ENG-1","title":"Fix logout"}}`, signature: testSignature, secret: testSecret, wantErr: ErrInvalidSignature},
+		{name: "wrong secret", payload: testPayload, signature: testSignature, secret: "other", wantErr: ErrInvalidSignature},
+		{name: "not hex", payload: testPayload, signature: "not-a-signature", secret: testSecret, wantErr: ErrInvalidSignature},
+		{name: "empty signature", payload: testPayload, signature: "", secret: testSecret, wantErr: ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySignature([]byte(tt.payload), tt.signature, tt.secret)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestVerifySignature_RequiresSecret(t *testing.T) {
+	if err := VerifySignature([]byte(testPayload), testSignature, ""); err == nil {
+		t.Error("Expected error for empty secret")
+	}
+}
+
+func TestParseEvent_Issue(t *testing.T) {
+	payload := `{
+		"action": "update",
+		"type": "Issue",
+		"url": "https://linear.app/acme/issue/ENG-1",
+		"createdAt": "2024-05-01T10:00:00.000Z",
+		"webhookTimestamp": 1714557600000,
+		"updatedFrom": {"stateId": "state-todo"},
+		"data": {
+			"id": "issue-1",
+			"identifier": "ENG-1",
+			"title": "Fix login",
+			"priority": 2,
+			"teamId": "team-1",
+			"stateId": "state-progress",
+			"labelIds": ["label-1"],
+			"team": {"id": "team-1", "key": "ENG", "name": "Engineering"},
+			"state": {"id": "state-progress", "name": "In Progress", "type": "started"}
+		}
+	}`
+
+	event, err := ParseEvent([]byte(payload))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if event.Action != "update" || event.Type != TypeIssue || event.WebhookTimestamp != 1714557600000 {
+		t.Errorf("Unexpected envelope: %+v", event)
+	}
+	if event.Comment != nil {
+		t.Error("Expected no comment data for an issue event")
+	}
+	issue := event.Issue
+	if issue == nil {
+		t.Fatal("Expected issue data")
+	}
+	if issue.Identifier != "ENG-1" || issue.Priority != 2 || issue.StateID != "state-progress" {
+		t.Errorf("Unexpected issue data: %+v", issue)
+	}
+	if issue.Team == nil || issue.Team.Key != "ENG" || issue.State == nil || issue.State.Name != "In Progress" {
+		t.Errorf("Expected nested team and state, got %+v %+v", issue.Team, issue.State)
+	}
+	if event.UpdatedFrom["stateId"] != "state-todo" {
+		t.Errorf("Expected updatedFrom stateId, got %v", event.UpdatedFrom)
+	}
+}
+
+func TestParseEvent_Comment(t *testing.T) {
+	payload := `{
+		"action": "create",
+		"type": "Comment",
+		"data": {
+			"id": "comment-1",
+			"body": "Looks good",
+			"issueId": "issue-1",
+			"userId": "user-1",
+			"user": {"id": "user-1", "name": "Jane"},
+			"issue": {"id": "issue-1", "identifier": "ENG-1", "title": "Fix login"}
+		}
+	}`
+
+	event, err := ParseEvent([]byte(payload))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	comment := event.Comment
+	if comment == nil {
+		t.Fatal("Expected comment data")
+	}
+	if comment.Body != "Looks good" || comment.IssueID != "issue-1" || comment.User.Name != "Jane" {
+		t.Errorf("Unexpected comment data: %+v", comment)
+	}
+	if comment.Issue == nil || comment.Issue.Identifier != "ENG-1" {
+		t.Errorf("Expected nested issue, got %+v", comment.Issue)
+	}
+}
+
+func TestParseEvent_OtherType(t *testing.T) {
+	event, err := ParseEvent([]byte(`{"action":"create","type":"Reaction","data":{"id":"reaction-1","emoji":"+1"}}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if event.Issue != nil || event.Comment != nil {
+		t.Error("Expected no typed data for other event types")
+	}
+	if len(event.Data) == 0 {
+		t.Error("Expected raw data to be kept")
+	}
+}
+
+func TestParseEvent_Invalid(t *testing.T) {
+	for _, payload := range []string{
+		`not json`,
+		`{"data":{}}`,
+		`{"action":"create","type":"Issue","data":"oops"}`,
+	} {
+		if _, err := ParseEvent([]byte(payload)); err == nil {
+			t.Errorf("Expected error for payload %s", payload)
+		}
+	}
+}